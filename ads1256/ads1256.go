@@ -0,0 +1,489 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ads1256
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// MaxSPIFrequency is the maximum SPI clock frequency supported, a quarter of
+// the 7.68MHz master clock.
+const MaxSPIFrequency = 1920 * physic.KiloHertz
+
+// Registers.
+const (
+	regStatus = 0x00
+	regMux    = 0x01
+	regADCON  = 0x02
+	regDRATE  = 0x03
+	regOFC0   = 0x05 // the offset calibration, LSB first, then FSC0 to FSC2
+)
+
+// STATUS register bits.
+const (
+	statusACAL  = 0x04
+	statusBUFEN = 0x02
+	statusDRDY  = 0x01 // the DRDY pin, high while the conversion is not ready
+	statusIDBit = 4    // the ID in bits 7 to 4
+	chipID      = 3
+)
+
+// adconKeep are the clock out and sensor detect bits of ADCON, kept as set by
+// the board.
+const adconKeep = 0x78
+
+// Commands.
+const (
+	cmdRDATA   = 0x01
+	cmdSDATAC  = 0x0F
+	cmdRREG    = 0x10 // | register, then the number of registers - 1
+	cmdWREG    = 0x50 // | register, then the number of registers - 1
+	cmdSYNC    = 0xFC
+	cmdSTANDBY = 0xFD
+	cmdRESET   = 0xFE
+	cmdWAKEUP  = 0x00
+)
+
+// Timings.
+const (
+	// t6 is the delay between a read command and its response, 50 periods of
+	// the master clock.
+	t6 = 7 * time.Microsecond
+	// t11 is the delay after a SYNC command, 24 periods of the master clock.
+	t11 = 4 * time.Microsecond
+	// resetTime is the duration of the reset, before the calibration.
+	resetTime = time.Millisecond
+)
+
+// fullScale is the positive full-scale code.
+const fullScale = 1<<23 - 1
+
+var (
+	// ErrBadID is returned when the device ID doesn't match an ADS1256.
+	ErrBadID = errors.New("ads1256: bad chip ID")
+	// ErrNotReady is returned when a conversion or a calibration doesn't
+	// complete in time.
+	ErrNotReady = errors.New("ads1256: conversion not ready")
+	// ErrInvalidOpts is returned when the options are not supported.
+	ErrInvalidOpts = errors.New("ads1256: invalid options")
+)
+
+// Channel is an analog input of the multiplexer.
+type Channel uint8
+
+// Inputs.
+const (
+	AIN0 Channel = iota
+	AIN1
+	AIN2
+	AIN3
+	AIN4
+	AIN5
+	AIN6
+	AIN7
+	// AINCOM is the common input the single-ended inputs are measured
+	// against.
+	AINCOM
+)
+
+func (c Channel) String() string {
+	switch {
+	case c <= AIN7:
+		return fmt.Sprintf("AIN%d", c)
+	case c == AINCOM:
+		return "AINCOM"
+	default:
+		return fmt.Sprintf("Channel(%d)", c)
+	}
+}
+
+// dataRates are the supported data rates and their DRATE code.
+var dataRates = []struct {
+	f    physic.Frequency
+	code byte
+}{
+	{30 * physic.KiloHertz, 0xF0},
+	{15 * physic.KiloHertz, 0xE0},
+	{7500 * physic.Hertz, 0xD0},
+	{3750 * physic.Hertz, 0xC0},
+	{2 * physic.KiloHertz, 0xB0},
+	{physic.KiloHertz, 0xA1},
+	{500 * physic.Hertz, 0x92},
+	{100 * physic.Hertz, 0x82},
+	{60 * physic.Hertz, 0x72},
+	{50 * physic.Hertz, 0x63},
+	{30 * physic.Hertz, 0x53},
+	{25 * physic.Hertz, 0x43},
+	{15 * physic.Hertz, 0x33},
+	{10 * physic.Hertz, 0x23},
+	{5 * physic.Hertz, 0x13},
+	{2500 * physic.MilliHertz, 0x03},
+}
+
+// drate returns the DRATE code of a data rate, false when unsupported.
+func drate(f physic.Frequency) (byte, bool) {
+	for _, r := range dataRates {
+		if r.f == f {
+			return r.code, true
+		}
+	}
+	return 0, false
+}
+
+// pgaCode returns the ADCON PGA bits of a gain, false when unsupported.
+func pgaCode(gain int) (byte, bool) {
+	for i := 0; i <= 6; i++ {
+		if gain == 1<<i {
+			return byte(i), true
+		}
+	}
+	return 0, false
+}
+
+// DefaultOpts are the options of the common breakout boards, with a 2.5V
+// reference.
+var DefaultOpts = Opts{
+	Gain:      1,
+	DataRate:  physic.KiloHertz,
+	Reference: 2500 * physic.MilliVolt,
+}
+
+// Opts holds the configuration options.
+//
+// Gain: gain of the PGA, 1, 2, 4, 8, 16, 32 or 64. The full-scale input range
+// is ±2×Reference/Gain.
+//
+// DataRate: conversion rate, 30k, 15k, 7.5k, 3.75k, 2k, 1k, 500, 100, 60,
+// 50, 30, 25, 15, 10, 5 or 2.5Hz. The digital filter rejects the 50Hz mains
+// frequency at 50Hz, the 60Hz one at 60Hz, and both at 10Hz, 5Hz and 2.5Hz.
+//
+// Reference: voltage between VREFP and VREFN.
+//
+// Buffer: enable the input buffer, raising the input impedance. The inputs
+// must then stay below AVDD-2V.
+//
+// AutoCalibrate: let the device calibrate itself after a change of the
+// gain, the data rate or the buffer.
+//
+// DRDY: optional pin connected to DRDY, waited on instead of polling the
+// status register.
+//
+// CS: optional pin driving CS. The device resets its serial interface when CS
+// rises, so CS must stay asserted between a command and its response, which
+// the SPI port doesn't do; the port is connected without CS and, when CS is
+// nil, CS must be tied low.
+type Opts struct {
+	Gain          int
+	DataRate      physic.Frequency
+	Reference     physic.ElectricPotential
+	Buffer        bool
+	AutoCalibrate bool
+	DRDY          gpio.PinIn
+	CS            gpio.PinOut
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if _, ok := pgaCode(o.Gain); !ok {
+		return fmt.Errorf("%w: Gain %d, want 1, 2, 4, 8, 16, 32 or 64", ErrInvalidOpts, o.Gain)
+	}
+	if _, ok := drate(o.DataRate); !ok {
+		return fmt.Errorf("%w: DataRate %s, want one of 30kHz to 2.5Hz", ErrInvalidOpts, o.DataRate)
+	}
+	if o.Reference <= 0 {
+		return fmt.Errorf("%w: Reference %s, want more than 0", ErrInvalidOpts, o.Reference)
+	}
+	return nil
+}
+
+// status returns the STATUS register value.
+func (o *Opts) status() byte {
+	var s byte
+	if o.AutoCalibrate {
+		s |= statusACAL
+	}
+	if o.Buffer {
+		s |= statusBUFEN
+	}
+	return s
+}
+
+// calTimeout returns the longest duration of a calibration, 827ms at 2.5Hz.
+func (o *Opts) calTimeout() time.Duration {
+	return 3*o.DataRate.Period() + 50*time.Millisecond
+}
+
+// convTimeout returns the longest duration of a settled conversion, after
+// the multiplexer changed.
+func (o *Opts) convTimeout() time.Duration {
+	return 2*o.DataRate.Period() + 10*time.Millisecond
+}
+
+// Dev is a handle to an initialized ADS1256 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c     conn.Conn
+	opts  Opts
+	adcon byte // ADCON as read at initialization, without the PGA bits
+
+	mu sync.Mutex
+	// mux is the MUX register value, or 0xFF when the conversions must be
+	// restarted.
+	mux byte
+}
+
+// New resets and configures a device on a SPI port and calibrates it.
+//
+// The port is connected in mode 1 at MaxSPIFrequency (1.92 MHz), without
+// CS.
+func New(p spi.Port, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode1|spi.NoCS, 8)
+	if err != nil {
+		return nil, fmt.Errorf("ads1256: connecting SPI: %w", err)
+	}
+	if opts.DRDY != nil {
+		// DRDY falls once a conversion completes, and rises when read.
+		if err := opts.DRDY.In(gpio.PullNoChange, gpio.FallingEdge); err != nil {
+			return nil, fmt.Errorf("ads1256: configuring DRDY: %w", err)
+		}
+	}
+	if opts.CS != nil {
+		if err := opts.CS.Out(gpio.High); err != nil {
+			return nil, fmt.Errorf("ads1256: configuring CS: %w", err)
+		}
+	}
+	d := &Dev{c: c, opts: opts, mux: 0xFF}
+	// Stop the continuous read mode left running, which ignores the other
+	// commands.
+	if err := d.command(cmdSDATAC); err != nil {
+		return nil, err
+	}
+	if err := d.command(cmdRESET); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// The device calibrates itself at 30kHz after a reset.
+	if err := d.waitReady(DefaultOpts.calTimeout()); err != nil {
+		return nil, err
+	}
+	var r [4]byte
+	if err := d.readRegs(regStatus, r[:]); err != nil {
+		return nil, err
+	}
+	if id := r[0] >> statusIDBit; id != chipID {
+		return nil, fmt.Errorf("%w: read %d, want %d", ErrBadID, id, chipID)
+	}
+	d.adcon = r[regADCON] & adconKeep
+	pga, _ := pgaCode(opts.Gain)
+	dr, _ := drate(opts.DataRate)
+	if err := d.writeRegs(regStatus, opts.status(), byte(AIN0)<<4|byte(AINCOM), d.adcon|pga, dr); err != nil {
+		return nil, err
+	}
+	if err := d.calibrate(SelfCalibration); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("ADS1256{%s}", d.c)
+}
+
+// Read converts the voltage of pos against neg.
+//
+// Use AINCOM as neg for a single-ended measurement. Switching the inputs
+// restarts the conversions, so that the result is settled; reading the same
+// inputs again returns the next conversion.
+func (d *Dev) Read(pos, neg Channel) (analog.Sample, error) {
+	if pos > AINCOM || neg > AINCOM || pos == neg {
+		return analog.Sample{}, fmt.Errorf("ads1256: invalid inputs %s and %s", pos, neg)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	mux := byte(pos)<<4 | byte(neg)
+	timeout := d.opts.DataRate.Period() + 10*time.Millisecond
+	if mux != d.mux {
+		if err := d.writeRegs(regMux, mux); err != nil {
+			return analog.Sample{}, err
+		}
+		if err := d.command(cmdSYNC); err != nil {
+			return analog.Sample{}, err
+		}
+		doSleep(t11)
+		if err := d.command(cmdWAKEUP); err != nil {
+			return analog.Sample{}, err
+		}
+		d.mux = mux
+		timeout = d.opts.convTimeout()
+	}
+	if err := d.waitReady(timeout); err != nil {
+		return analog.Sample{}, err
+	}
+	var b [3]byte
+	if err := d.tx([]byte{cmdRDATA}, b[:]); err != nil {
+		return analog.Sample{}, fmt.Errorf("ads1256: reading data: %w", err)
+	}
+	raw := int32(uint32(b[0])<<24|uint32(b[1])<<16|uint32(b[2])<<8) >> 8
+	return analog.Sample{Raw: raw, V: d.voltage(raw)}, nil
+}
+
+// voltage converts a raw conversion.
+func (d *Dev) voltage(raw int32) physic.ElectricPotential {
+	return physic.ElectricPotential(raw) * 2 * d.opts.Reference / physic.ElectricPotential(int64(d.opts.Gain)*fullScale)
+}
+
+// Range returns the full-scale input range at the current gain.
+func (d *Dev) Range() (analog.Sample, analog.Sample) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return analog.Sample{Raw: -fullScale - 1, V: d.voltage(-fullScale - 1)},
+		analog.Sample{Raw: fullScale, V: d.voltage(fullScale)}
+}
+
+// SetGain changes the gain of the PGA, 1, 2, 4, 8, 16, 32 or 64.
+//
+// It waits for the calibration with Opts.AutoCalibrate.
+func (d *Dev) SetGain(gain int) error {
+	pga, ok := pgaCode(gain)
+	if !ok {
+		return fmt.Errorf("%w: Gain %d, want 1, 2, 4, 8, 16, 32 or 64", ErrInvalidOpts, gain)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regADCON, d.adcon|pga); err != nil {
+		return err
+	}
+	d.opts.Gain = gain
+	return d.settle()
+}
+
+// SetDataRate changes the conversion rate, as Opts.DataRate.
+//
+// It waits for the calibration with Opts.AutoCalibrate.
+func (d *Dev) SetDataRate(f physic.Frequency) error {
+	dr, ok := drate(f)
+	if !ok {
+		return fmt.Errorf("%w: DataRate %s, want one of 30kHz to 2.5Hz", ErrInvalidOpts, f)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regDRATE, dr); err != nil {
+		return err
+	}
+	d.opts.DataRate = f
+	return d.settle()
+}
+
+// settle waits for the automatic calibration after a configuration change
+// and restarts the conversions on the next Read.
+//
+// It must be called with d.mu held.
+func (d *Dev) settle() error {
+	d.mux = 0xFF
+	if !d.opts.AutoCalibrate {
+		return nil
+	}
+	return d.waitReady(d.opts.calTimeout())
+}
+
+// Halt puts the device in standby. The next Read wakes it up.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.command(cmdSTANDBY); err != nil {
+		return err
+	}
+	d.mux = 0xFF
+	return nil
+}
+
+// waitReady waits for DRDY, or polls the status register.
+//
+// It must be called with d.mu held.
+func (d *Dev) waitReady(timeout time.Duration) error {
+	if d.opts.DRDY != nil {
+		// DRDY may already be low.
+		if d.opts.DRDY.Read() == gpio.Low || d.opts.DRDY.WaitForEdge(timeout) {
+			return nil
+		}
+		return ErrNotReady
+	}
+	delay := d.opts.DataRate.Period() / 4
+	if delay < 100*time.Microsecond {
+		delay = 100 * time.Microsecond
+	}
+	for i := time.Duration(0); i*delay < timeout; i++ {
+		var s [1]byte
+		if err := d.readRegs(regStatus, s[:]); err != nil {
+			return err
+		}
+		if s[0]&statusDRDY == 0 {
+			return nil
+		}
+		doSleep(delay)
+	}
+	return ErrNotReady
+}
+
+// tx sends w then, after t6, reads r, with CS asserted throughout.
+func (d *Dev) tx(w, r []byte) (err error) {
+	if cs := d.opts.CS; cs != nil {
+		if err := cs.Out(gpio.Low); err != nil {
+			return err
+		}
+		defer func() {
+			if err2 := cs.Out(gpio.High); err == nil {
+				err = err2
+			}
+		}()
+	}
+	if err := d.c.Tx(w, nil); err != nil {
+		return err
+	}
+	if len(r) == 0 {
+		return nil
+	}
+	doSleep(t6)
+	return d.c.Tx(make([]byte, len(r)), r)
+}
+
+func (d *Dev) command(cmd byte) error {
+	if err := d.tx([]byte{cmd}, nil); err != nil {
+		return fmt.Errorf("ads1256: command %#02x: %w", cmd, err)
+	}
+	return nil
+}
+
+func (d *Dev) readRegs(reg byte, r []byte) error {
+	if err := d.tx([]byte{cmdRREG | reg, byte(len(r) - 1)}, r); err != nil {
+		return fmt.Errorf("ads1256: reading register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+func (d *Dev) writeRegs(reg byte, v ...byte) error {
+	if err := d.tx(append([]byte{cmdWREG | reg, byte(len(v) - 1)}, v...), nil); err != nil {
+		return fmt.Errorf("ads1256: writing register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
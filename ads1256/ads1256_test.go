@@ -0,0 +1,327 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ads1256
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+func write(v ...byte) conntest.IO {
+	return conntest.IO{W: v}
+}
+
+// read returns the read phase of a transaction.
+func read(v ...byte) conntest.IO {
+	return conntest.IO{W: make([]byte, len(v)), R: v}
+}
+
+// initOps are the transactions of New with DefaultOpts.
+func initOps() []conntest.IO {
+	return []conntest.IO{
+		write(0x0F),
+		write(0xFE),
+		write(0x10, 0x03),
+		read(0x30, 0x01, 0x20, 0xF0),
+		write(0x50, 0x03, 0x00, 0x08, 0x20, 0xA1),
+		write(0xF0),
+	}
+}
+
+// readOps are the transactions of a Read of new inputs.
+func readOps(mux byte, v ...byte) []conntest.IO {
+	return []conntest.IO{
+		write(0x51, 0x00, mux),
+		write(0xFC),
+		write(0x00),
+		write(0x01),
+		read(v...),
+	}
+}
+
+func newPort(ops ...conntest.IO) *spitest.Playback {
+	return &spitest.Playback{Playback: conntest.Playback{Ops: append(initOps(), ops...)}}
+}
+
+// ready returns a DRDY pin signaling a ready conversion.
+func ready() *gpiotest.Pin {
+	return &gpiotest.Pin{N: "DRDY", L: gpio.Low, EdgesChan: make(chan gpio.Level, 1)}
+}
+
+func newDev(t *testing.T, port *spitest.Playback) *Dev {
+	o := DefaultOpts
+	o.DRDY = ready()
+	d, err := New(port, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestNew(t *testing.T) {
+	port := newPort()
+	d := newDev(t, port)
+	if s := d.String(); s != "ADS1256{playback}" {
+		t.Fatal(s)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_opts(t *testing.T) {
+	ops := initOps()
+	// AutoCalibrate, Buffer, gain 8 and 10Hz, keeping CLKOUT off.
+	ops[3] = read(0x31, 0x01, 0x00, 0xF0)
+	ops[4] = write(0x50, 0x03, 0x06, 0x08, 0x03, 0x23)
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+	cs := &gpiotest.Pin{N: "CS"}
+	o := Opts{Gain: 8, DataRate: 10 * physic.Hertz, Reference: 2500 * physic.MilliVolt, Buffer: true, AutoCalibrate: true, DRDY: ready(), CS: cs}
+	if _, err := New(port, o); err != nil {
+		t.Fatal(err)
+	}
+	if cs.L != gpio.High {
+		t.Fatal("CS left asserted")
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	ops := initOps()[:4]
+	ops[3] = read(0x20, 0x01, 0x20, 0xF0)
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+	o := DefaultOpts
+	o.DRDY = ready()
+	if _, err := New(port, o); !errors.Is(err, ErrBadID) {
+		t.Fatal(err)
+	}
+
+	o.Gain = 3
+	if _, err := New(&spitest.Playback{}, o); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+
+	port = &spitest.Playback{Playback: conntest.Playback{Ops: initOps()[:2], DontPanic: true}}
+	if _, err := New(port, DefaultOpts); err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		o    Opts
+		want string
+	}{
+		{Opts{Gain: 128, DataRate: physic.KiloHertz, Reference: physic.Volt}, "ads1256: invalid options: Gain 128, want 1, 2, 4, 8, 16, 32 or 64"},
+		{Opts{Gain: 1, DataRate: 20 * physic.Hertz, Reference: physic.Volt}, "ads1256: invalid options: DataRate 20Hz, want one of 30kHz to 2.5Hz"},
+		{Opts{Gain: 1, DataRate: physic.KiloHertz}, "ads1256: invalid options: Reference 0V, want more than 0"},
+	}
+	for i, line := range data {
+		err := line.o.Validate()
+		if err == nil || err.Error() != line.want {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if !errors.Is(err, ErrInvalidOpts) {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+	if err := DefaultOpts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead(t *testing.T) {
+	ops := readOps(0x18, 0x40, 0x00, 0x00)
+	// The same inputs read the next conversion.
+	ops = append(ops, write(0x01), read(0xC0, 0x00, 0x00))
+	// Other inputs restart the conversions.
+	ops = append(ops, readOps(0x23, 0x7F, 0xFF, 0xFF)...)
+	port := newPort(ops...)
+	d := newDev(t, port)
+	data := []struct {
+		pos, neg Channel
+		raw      int32
+		v        physic.ElectricPotential
+	}{
+		{AIN1, AINCOM, 0x400000, 2500000298 * physic.NanoVolt},
+		{AIN1, AINCOM, -0x400000, -2500000298 * physic.NanoVolt},
+		{AIN2, AIN3, fullScale, 5 * physic.Volt},
+	}
+	for i, line := range data {
+		s, err := d.Read(line.pos, line.neg)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s.Raw != line.raw || s.V != line.v {
+			t.Fatalf("#%d: %d %s, want %d %s", i, s.Raw, s.V, line.raw, line.v)
+		}
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead_polling(t *testing.T) {
+	status := func(v byte) []conntest.IO {
+		return []conntest.IO{write(0x10, 0x00), read(v)}
+	}
+	setup := initOps()
+	var ops []conntest.IO
+	ops = append(ops, setup[:2]...)
+	// The calibration after the reset, not ready once.
+	ops = append(ops, status(0x31)...)
+	ops = append(ops, status(0x30)...)
+	ops = append(ops, setup[2:]...)
+	ops = append(ops, status(0x30)...)
+	ops = append(ops, write(0x51, 0x00, 0x08), write(0xFC), write(0x00))
+	ops = append(ops, status(0x30)...)
+	ops = append(ops, write(0x01), read(0x00, 0x00, 0x01))
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.Read(AIN0, AINCOM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Raw != 1 || s.V != 596*physic.NanoVolt {
+		t.Fatal(s)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead_fail(t *testing.T) {
+	// The conversion doesn't complete.
+	port := newPort(readOps(0x08)[:3]...)
+	o := DefaultOpts
+	drdy := ready()
+	o.DRDY = drdy
+	d, err := New(port, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drdy.L = gpio.High
+	if _, err := d.Read(AIN0, AINCOM); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, in := range [][2]Channel{{AIN0, AIN0}, {AINCOM + 1, AIN0}} {
+		if _, err := d.Read(in[0], in[1]); err == nil {
+			t.Fatalf("%s-%s: expected failure", in[0], in[1])
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	d := newDev(t, newPort())
+	min, max := d.Range()
+	if min.Raw != -1<<23 || min.V != -5000000596*physic.NanoVolt {
+		t.Fatal(min)
+	}
+	if max.Raw != fullScale || max.V != 5*physic.Volt {
+		t.Fatal(max)
+	}
+}
+
+func TestSetGain(t *testing.T) {
+	port := newPort(write(0x52, 0x00, 0x25))
+	port.Ops = append(port.Ops, readOps(0x08, 0x40, 0x00, 0x00)...)
+	d := newDev(t, port)
+	if err := d.SetGain(32); err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.Read(AIN0, AINCOM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.V != 78125009*physic.NanoVolt {
+		t.Fatal(s.V)
+	}
+	if err := d.SetGain(3); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetDataRate(t *testing.T) {
+	port := newPort(readOps(0x08, 0, 0, 0)...)
+	port.Ops = append(port.Ops, write(0x53, 0x00, 0x03))
+	port.Ops = append(port.Ops, readOps(0x08, 0, 0, 0)...)
+	d := newDev(t, port)
+	if _, err := d.Read(AIN0, AINCOM); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetDataRate(2500 * physic.MilliHertz); err != nil {
+		t.Fatal(err)
+	}
+	// The conversions restart on the next read.
+	if _, err := d.Read(AIN0, AINCOM); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetDataRate(physic.Hertz); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	port := newPort(readOps(0x08, 0, 0, 0)...)
+	port.Ops = append(port.Ops, write(0xFD))
+	port.Ops = append(port.Ops, readOps(0x08, 0, 0, 0)...)
+	d := newDev(t, port)
+	if _, err := d.Read(AIN0, AINCOM); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	// Reading wakes the device up.
+	if _, err := d.Read(AIN0, AINCOM); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChannel_String(t *testing.T) {
+	data := []struct {
+		c    Channel
+		want string
+	}{
+		{AIN0, "AIN0"},
+		{AIN7, "AIN7"},
+		{AINCOM, "AINCOM"},
+		{Channel(9), "Channel(9)"},
+	}
+	for _, line := range data {
+		if s := line.c.String(); s != line.want {
+			t.Fatalf("%s != %s", s, line.want)
+		}
+	}
+}
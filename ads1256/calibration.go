@@ -0,0 +1,102 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ads1256
+
+import "fmt"
+
+// Calibration is a calibration command.
+type Calibration byte
+
+// Calibrations.
+//
+// The self calibrations disconnect the inputs. The system calibrations
+// measure the inputs instead, which must be at the zero level for the offset
+// and at the positive full scale for the gain, correcting the errors of the
+// circuit in front of the ADC too.
+const (
+	SelfCalibration         Calibration = 0xF0 // offset then gain
+	SelfOffsetCalibration   Calibration = 0xF1
+	SelfGainCalibration     Calibration = 0xF2
+	SystemOffsetCalibration Calibration = 0xF3
+	SystemGainCalibration   Calibration = 0xF4
+)
+
+func (c Calibration) String() string {
+	switch c {
+	case SelfCalibration:
+		return "SelfCalibration"
+	case SelfOffsetCalibration:
+		return "SelfOffsetCalibration"
+	case SelfGainCalibration:
+		return "SelfGainCalibration"
+	case SystemOffsetCalibration:
+		return "SystemOffsetCalibration"
+	case SystemGainCalibration:
+		return "SystemGainCalibration"
+	default:
+		return fmt.Sprintf("Calibration(%#02x)", byte(c))
+	}
+}
+
+// Coefficients are the calibration registers, to save and restore a
+// calibration.
+type Coefficients struct {
+	Offset    int32  // OFC, 24 bits
+	FullScale uint32 // FSC, 24 bits
+}
+
+func (c Coefficients) String() string {
+	return fmt.Sprintf("Coefficients{Offset:%d FullScale:%d}", c.Offset, c.FullScale)
+}
+
+// Calibrate runs a calibration and waits for it to complete.
+//
+// The system calibrations measure the inputs selected by the last Read.
+func (d *Dev) Calibrate(c Calibration) error {
+	if c < SelfCalibration || c > SystemGainCalibration {
+		return fmt.Errorf("%w: calibration %s", ErrInvalidOpts, c)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calibrate(c)
+}
+
+// calibrate must be called with d.mu held.
+func (d *Dev) calibrate(c Calibration) error {
+	if err := d.command(byte(c)); err != nil {
+		return err
+	}
+	// Calibrating restarts the conversions, keeping the multiplexer.
+	return d.waitReady(d.opts.calTimeout())
+}
+
+// Coefficients reads the calibration registers.
+func (d *Dev) Coefficients() (Coefficients, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var b [6]byte
+	if err := d.readRegs(regOFC0, b[:]); err != nil {
+		return Coefficients{}, err
+	}
+	return Coefficients{
+		Offset:    int32(uint32(b[2])<<24|uint32(b[1])<<16|uint32(b[0])<<8) >> 8,
+		FullScale: uint32(b[5])<<16 | uint32(b[4])<<8 | uint32(b[3]),
+	}, nil
+}
+
+// SetCoefficients writes the calibration registers, e.g. as saved by
+// Coefficients after a system calibration.
+//
+// It replaces the calibration of the device. Turn Opts.AutoCalibrate off to
+// keep it across changes of the gain or the data rate.
+func (d *Dev) SetCoefficients(c Coefficients) error {
+	if c.Offset < -1<<23 || c.Offset >= 1<<23 || c.FullScale >= 1<<24 {
+		return fmt.Errorf("%w: coefficients %s, want 24 bits", ErrInvalidOpts, c)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	o, f := uint32(c.Offset), c.FullScale
+	return d.writeRegs(regOFC0, byte(o), byte(o>>8), byte(o>>16), byte(f), byte(f>>8), byte(f>>16))
+}
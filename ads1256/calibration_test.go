@@ -0,0 +1,69 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ads1256
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalibrate(t *testing.T) {
+	port := newPort(write(0xF3), write(0xF4))
+	d := newDev(t, port)
+	for _, c := range []Calibration{SystemOffsetCalibration, SystemGainCalibration} {
+		if err := d.Calibrate(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.Calibrate(Calibration(0xF5)); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCoefficients(t *testing.T) {
+	port := newPort(write(0x15, 0x05), read(0xF0, 0xFF, 0xFF, 0x2D, 0x4C, 0x45))
+	port.Ops = append(port.Ops, write(0x55, 0x05, 0xF0, 0xFF, 0xFF, 0x2D, 0x4C, 0x45))
+	d := newDev(t, port)
+	c, err := d.Coefficients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Coefficients{Offset: -16, FullScale: 0x454C2D}); c != want {
+		t.Fatalf("%s, want %s", c, want)
+	}
+	if err := d.SetCoefficients(c); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []Coefficients{{Offset: 1 << 23}, {Offset: -1<<23 - 1}, {FullScale: 1 << 24}} {
+		if err := d.SetCoefficients(c); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(err)
+		}
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCalibration_String(t *testing.T) {
+	data := []struct {
+		c    Calibration
+		want string
+	}{
+		{SelfCalibration, "SelfCalibration"},
+		{SelfOffsetCalibration, "SelfOffsetCalibration"},
+		{SelfGainCalibration, "SelfGainCalibration"},
+		{SystemOffsetCalibration, "SystemOffsetCalibration"},
+		{SystemGainCalibration, "SystemGainCalibration"},
+		{Calibration(1), "Calibration(0x01)"},
+	}
+	for _, line := range data {
+		if s := line.c.String(); s != line.want {
+			t.Fatalf("%s != %s", s, line.want)
+		}
+	}
+}
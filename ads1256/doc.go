@@ -0,0 +1,22 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ads1256 controls a Texas Instruments ADS1256 24 bits
+// Analog-Digital Converter (ADC) over SPI, as on the Waveshare High-Precision
+// AD/DA board.
+//
+// # More details
+//
+// The ADS1256 converts continuously the voltage between two of its 8 inputs
+// and the common input, through a PGA of gain 1 to 64, at 2.5Hz to 30kHz.
+// Read selects the inputs and returns a settled conversion, synchronized on
+// the DRDY pin when Opts.DRDY is set.
+//
+// The device is calibrated by New. Calibrate runs the self and system
+// calibrations, and Coefficients and SetCoefficients save and restore them.
+//
+// # Datasheet
+//
+// https://www.ti.com/lit/ds/symlink/ads1256.pdf
+package ads1256
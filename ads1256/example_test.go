@@ -0,0 +1,47 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ads1256_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/ads1256"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use spireg SPI port registry to find the first available SPI bus.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	// The pins of the Waveshare High-Precision AD/DA board.
+	o := ads1256.DefaultOpts
+	o.DRDY = gpioreg.ByName("GPIO17")
+	o.CS = gpioreg.ByName("GPIO22")
+	d, err := ads1256.New(p, o)
+	if err != nil {
+		log.Fatalf("failed to initialize ads1256: %v", err)
+	}
+	defer d.Halt()
+
+	for _, c := range []ads1256.Channel{ads1256.AIN0, ads1256.AIN1} {
+		s, err := d.Read(c, ads1256.AINCOM)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s: %s\n", c, s.V)
+	}
+}
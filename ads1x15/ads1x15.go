@@ -122,7 +122,11 @@ type Dev struct {
 	c         i2c.Dev
 	name      string
 	dataRates map[int]uint16
-	mu        sync.Mutex // For executePreparedQuery()
+	mu        sync.Mutex // For read()
+	// running is the pin converting continuously, if any.
+	running *analogPin
+	// thresholds are the comparator thresholds written, if any.
+	thresholds *[2]uint16
 }
 
 // NewADS1015 creates a new driver for the ADS1015 (12-bit ADC).
@@ -166,8 +170,16 @@ func (d *Dev) String() string {
 }
 
 // Halt implements conn.Resource.
+//
+// It powers down the ADC if a pin is converting continuously.
 func (d *Dev) Halt() error {
-	return nil
+	d.mu.Lock()
+	p := d.running
+	d.mu.Unlock()
+	if p == nil {
+		return nil
+	}
+	return d.stopContinuous(p)
 }
 
 // PinForChannel returns an AnalogPin for the requested channel at the
@@ -175,8 +187,81 @@ func (d *Dev) Halt() error {
 //
 // The channel can either be an absolute reading or a differential one.
 func (d *Dev) PinForChannel(c Channel, maxVoltage physic.ElectricPotential, f physic.Frequency, q ConversionQuality) (PinADC, error) {
+	return d.PinForChannelOpts(c, &PinOpts{MaxVoltage: maxVoltage, Frequency: f, Quality: q})
+}
+
+// PinOpts holds the options of a pin returned by PinForChannelOpts.
+type PinOpts struct {
+	// MaxVoltage is the highest absolute voltage to read. The gain of the
+	// PGA with the smallest full-scale range above it is selected.
+	MaxVoltage physic.ElectricPotential
+	// Frequency is the frequency at which the samples are read. The data rate
+	// is selected from it and Quality.
+	Frequency physic.Frequency
+	// Quality selects the data rate at or above Frequency: the fastest with
+	// SaveEnergy, to power down sooner, or the slowest with BestQuality, for
+	// the least noise.
+	Quality ConversionQuality
+	// Continuous makes the ADC convert continuously at the data rate, Read
+	// returning the latest conversion, instead of converting once per Read
+	// and powering down in between. The ADC converts one channel at a time,
+	// so reading another pin stops the continuous conversion until this pin
+	// is read again.
+	Continuous bool
+	// Comparator, when set, drives the ALERT/RDY pin.
+	Comparator *Comparator
+}
+
+// Comparator configures the comparator driving the ALERT/RDY pin, which is
+// open drain.
+//
+// In the traditional mode, the pin is asserted when the voltage goes above
+// High and deasserted when it goes below Low. In the window mode, it is
+// asserted while the voltage is outside of [Low, High].
+type Comparator struct {
+	Low, High physic.ElectricPotential
+	// Window selects the window mode.
+	Window bool
+	// ActiveHigh makes the pin active high instead of low.
+	ActiveHigh bool
+	// Latching keeps the pin asserted until the conversion is read.
+	Latching bool
+	// Queue is the number of successive conversions beyond the thresholds
+	// needed to assert the pin: 1, 2 or 4. 0 means 1.
+	Queue int
+}
+
+// config returns the comparator bits of the configuration register.
+func (c *Comparator) config() (uint16, error) {
+	var config uint16
+	switch c.Queue {
+	case 0, 1:
+	case 2:
+		config |= 0x0001
+	case 4:
+		config |= 0x0002
+	default:
+		return 0, fmt.Errorf("comparator queue must be one of: 1, 2, 4; got %d", c.Queue)
+	}
+	if c.Window {
+		config |= ads1x15ConfigCompWindow
+	}
+	if c.ActiveHigh {
+		config |= ads1x15ConfigCompAactiveHigh
+	}
+	if c.Latching {
+		config |= ads1x15ConfigCompLatching
+	}
+	return config, nil
+}
+
+// PinForChannelOpts returns an AnalogPin for the requested channel, as
+// configured by opts.
+//
+// The channel can either be an absolute reading or a differential one.
+func (d *Dev) PinForChannelOpts(c Channel, opts *PinOpts) (PinADC, error) {
 	// Determine the most appropriate gain
-	gain, err := d.bestGainForElectricPotential(maxVoltage)
+	gain, err := d.bestGainForElectricPotential(opts.MaxVoltage)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +279,7 @@ func (d *Dev) PinForChannel(c Channel, maxVoltage physic.ElectricPotential, f ph
 	}
 
 	// Determine the most appropriate data rate.
-	dataRate, err := d.bestDataRateForFrequency(f, q)
+	dataRate, err := d.bestDataRateForFrequency(opts.Frequency, opts.Quality)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +307,24 @@ func (d *Dev) PinForChannel(c Channel, maxVoltage physic.ElectricPotential, f ph
 	// Set the data rate (this is controlled by the subclass as it differs
 	// between ADS1015 and ADS1115).
 	config |= dataRateConf
-	config |= ads1x15ConfigCompQueDisable // Disable comparator mode.
+
+	var thresholds *[2]uint16
+	if opts.Comparator != nil {
+		compConf, err := opts.Comparator.config()
+		if err != nil {
+			return nil, err
+		}
+		config |= compConf
+		if opts.Comparator.Low > opts.Comparator.High {
+			return nil, fmt.Errorf("comparator low threshold %s above high threshold %s", opts.Comparator.Low, opts.Comparator.High)
+		}
+		thresholds = &[2]uint16{
+			uint16(toRaw(opts.Comparator.Low, voltageMultiplier)),
+			uint16(toRaw(opts.Comparator.High, voltageMultiplier)),
+		}
+	} else {
+		config |= ads1x15ConfigCompQueDisable // Disable comparator mode.
+	}
 
 	// Build the query to the ADC.
 	configBytes := [2]byte{}
@@ -237,23 +339,44 @@ func (d *Dev) PinForChannel(c Channel, maxVoltage physic.ElectricPotential, f ph
 		query:              [...]byte{ads1x15PointerConfig, configBytes[0], configBytes[1]},
 		voltageMultiplier:  voltageMultiplier,
 		waitTime:           waitTime,
-		requestedFrequency: f,
+		requestedFrequency: opts.Frequency,
+		continuous:         opts.Continuous,
+		thresholds:         thresholds,
 	}, nil
 }
 
-func (d *Dev) executePreparedQuery(query []byte, waitTime time.Duration, voltageMultiplier physic.ElectricPotential) (analog.Sample, error) {
+// read returns a sample of the pin p.
+func (d *Dev) read(p *analogPin) (analog.Sample, error) {
 	// Lock the ADC converter to avoid multiple simultaneous readings.
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	// Send the config value to start the ADC conversion.
-	// Explicitly break the 16-bit value down to a big endian pair of bytes.
-	if err := d.c.Tx(query, nil); err != nil {
+	if err := d.writeThresholds(p.thresholds); err != nil {
 		return analog.Sample{}, err
 	}
+	if p.continuous {
+		if d.running != p {
+			// Start the continuous conversion, and wait for the first one.
+			query := p.query
+			config := binary.BigEndian.Uint16(query[1:]) &^ (ads1x15ConfigOsSingle | ads1x15ConfigModeSingle)
+			binary.BigEndian.PutUint16(query[1:], config)
+			if err := d.c.Tx(query[:], nil); err != nil {
+				return analog.Sample{}, err
+			}
+			d.running = p
+			time.Sleep(p.waitTime)
+		}
+	} else {
+		// Send the config value to start the ADC conversion. It stops a
+		// continuous conversion.
+		if err := d.c.Tx(p.query[:], nil); err != nil {
+			return analog.Sample{}, err
+		}
+		d.running = nil
 
-	// Wait for the ADC sample to finish.
-	time.Sleep(waitTime)
+		// Wait for the ADC sample to finish.
+		time.Sleep(p.waitTime)
+	}
 
 	// Retrieve the result.
 	data := []byte{0, 0}
@@ -265,10 +388,50 @@ func (d *Dev) executePreparedQuery(query []byte, waitTime time.Duration, voltage
 	raw := int16(binary.BigEndian.Uint16(data))
 	return analog.Sample{
 		Raw: int32(raw),
-		V:   physic.ElectricPotential(raw) * voltageMultiplier / physic.ElectricPotential(1<<15),
+		V:   toVoltage(raw, p.voltageMultiplier),
 	}, nil
 }
 
+// writeThresholds writes the comparator thresholds, unless already written.
+func (d *Dev) writeThresholds(t *[2]uint16) error {
+	if t == nil || (d.thresholds != nil && *d.thresholds == *t) {
+		return nil
+	}
+	d.thresholds = nil
+	for i, reg := range []byte{ads1x15PointerLowThreshold, ads1x15PointerHighThreshold} {
+		if err := d.c.Tx([]byte{reg, byte(t[i] >> 8), byte(t[i])}, nil); err != nil {
+			return err
+		}
+	}
+	d.thresholds = t
+	return nil
+}
+
+// stopContinuous powers down the ADC if converting continuously for p.
+func (d *Dev) stopContinuous(p *analogPin) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.running != p {
+		return nil
+	}
+	d.running = nil
+	config := binary.BigEndian.Uint16(p.query[1:]) &^ ads1x15ConfigOsSingle
+	return d.c.Tx([]byte{ads1x15PointerConfig, byte(config >> 8), byte(config)}, nil)
+}
+
+// toVoltage converts a raw reading at the full-scale range fs. The ADS1015
+// readings are left aligned, so they convert the same.
+func toVoltage(raw int16, fs physic.ElectricPotential) physic.ElectricPotential {
+	return physic.ElectricPotential(raw) * fs / physic.ElectricPotential(1<<15)
+}
+
+// toRaw converts a voltage to a raw reading at the full-scale range fs,
+// clamped to the range.
+func toRaw(v, fs physic.ElectricPotential) int16 {
+	r := math.Round(float64(v) * (1 << 15) / float64(fs))
+	return int16(math.Max(math.MinInt16, math.Min(math.MaxInt16, r)))
+}
+
 // bestGainForElectricPotential returns the gain the most adapted to read up to
 // the specified difference of potential.
 func (d *Dev) bestGainForElectricPotential(voltage physic.ElectricPotential) (int, error) {
@@ -397,10 +560,13 @@ type analogPin struct {
 	voltageMultiplier  physic.ElectricPotential
 	waitTime           time.Duration
 	requestedFrequency physic.Frequency
+	continuous         bool
+	thresholds         *[2]uint16
 
 	// Mutable.
 	mu   sync.Mutex
 	stop chan struct{}
+	wg   sync.WaitGroup
 }
 
 // Range returns the maximum supported range [min, max] of the values.
@@ -412,7 +578,7 @@ func (p *analogPin) Range() (analog.Sample, analog.Sample) {
 
 // Read returns the current pin level.
 func (p *analogPin) Read() (analog.Sample, error) {
-	return p.adc.executePreparedQuery(p.query[:], p.waitTime, p.voltageMultiplier)
+	return p.adc.read(p)
 }
 
 func (p *analogPin) ReadContinuous() <-chan analog.Sample {
@@ -422,15 +588,14 @@ func (p *analogPin) ReadContinuous() <-chan analog.Sample {
 	defer p.mu.Unlock()
 
 	// First release the current continuous reading if there is one
-	if p.stop != nil {
-		p.stop <- struct{}{}
-		p.stop = nil
-	}
+	p.stopReading()
 	reading := make(chan analog.Sample, 16)
 	p.stop = make(chan struct{})
 	t := time.NewTicker(p.requestedFrequency.Period())
 
+	p.wg.Add(1)
 	go func(s <-chan struct{}) {
+		defer p.wg.Done()
 		defer t.Stop()
 		defer close(reading)
 		for {
@@ -443,7 +608,11 @@ func (p *analogPin) ReadContinuous() <-chan analog.Sample {
 					// In continuous mode, we'll ignore errors silently.
 					continue
 				}
-				reading <- value
+				select {
+				case reading <- value:
+				case <-s:
+					return
+				}
 			}
 		}
 	}(p.stop)
@@ -451,6 +620,17 @@ func (p *analogPin) ReadContinuous() <-chan analog.Sample {
 	return reading
 }
 
+// stopReading stops the goroutine started by ReadContinuous, if any.
+//
+// It must be called with p.mu held.
+func (p *analogPin) stopReading() {
+	if p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+		p.wg.Wait()
+	}
+}
+
 func (p *analogPin) Name() string {
 	return p.adc.name + "(" + p.c.String() + ")"
 }
@@ -487,11 +667,9 @@ func (p *analogPin) Halt() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	if p.stop != nil {
-		p.stop <- struct{}{}
-		p.stop = nil
-	}
-	return nil
+	p.stopReading()
+	// Power down the ADC if converting continuously for this pin.
+	return p.adc.stopContinuous(p)
 }
 
 func (p *analogPin) String() string {
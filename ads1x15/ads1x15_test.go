@@ -7,6 +7,7 @@ package ads1x15
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"periph.io/x/conn/v3/analog"
 	"periph.io/x/conn/v3/i2c/i2ctest"
@@ -252,3 +253,166 @@ func TestPinADC_ReadContinous(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestPinADC_Read_continuous(t *testing.T) {
+	b := i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// Start the continuous conversion once.
+			{Addr: 0x48, W: []byte{0x1, 0x10, 0x3}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0xff, 0x50}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0x52, 0xd0}},
+			// Halt powers down the ADC.
+			{Addr: 0x48, W: []byte{0x1, 0x11, 0x3}},
+		},
+	}
+	defer b.Close()
+
+	d, err := NewADS1015(&b, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.PinForChannelOpts(Channel0Minus3, &PinOpts{MaxVoltage: 5 * physic.Volt, Frequency: physic.Hertz, Quality: BestQuality, Continuous: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, raw := range []int32{-176, 21200} {
+		reading, err := p.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reading.Raw != raw {
+			t.Fatalf("Found %d, expected %d", reading.Raw, raw)
+		}
+	}
+	if err := p.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	// Already powered down.
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPinADC_Read_continuous_switch(t *testing.T) {
+	b := i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			{Addr: 0x48, W: []byte{0x1, 0x10, 0x3}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0xff, 0x50}},
+			// A single-shot read on another channel stops the conversion.
+			{Addr: 0x48, W: []byte{0x1, 0xc1, 0x3}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0x00, 0x10}},
+			// So it is restarted.
+			{Addr: 0x48, W: []byte{0x1, 0x10, 0x3}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0x52, 0xd0}},
+			// Dev.Halt powers down the ADC.
+			{Addr: 0x48, W: []byte{0x1, 0x11, 0x3}},
+		},
+	}
+	defer b.Close()
+
+	d, err := NewADS1015(&b, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.PinForChannelOpts(Channel0Minus3, &PinOpts{MaxVoltage: 5 * physic.Volt, Frequency: physic.Hertz, Quality: BestQuality, Continuous: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := d.PinForChannel(Channel0, 5*physic.Volt, physic.Hertz, BestQuality)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pin := range []PinADC{p, other, p} {
+		if _, err := pin.Read(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Halt(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPinADC_Read_comparator(t *testing.T) {
+	b := i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// Thresholds of 1V and 2V at a full-scale range of 6.144V.
+			{Addr: 0x48, W: []byte{0x2, 0x14, 0xd5}},
+			{Addr: 0x48, W: []byte{0x3, 0x29, 0xab}},
+			{Addr: 0x48, W: []byte{0x1, 0x91, 0x15}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0xff, 0x50}},
+			// The thresholds are written once.
+			{Addr: 0x48, W: []byte{0x1, 0x91, 0x15}},
+			{Addr: 0x48, W: []byte{0x0}, R: []byte{0xff, 0x50}},
+		},
+	}
+	defer b.Close()
+
+	d, err := NewADS1015(&b, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Comparator{Low: physic.Volt, High: 2 * physic.Volt, Window: true, Latching: true, Queue: 2}
+	p, err := d.PinForChannelOpts(Channel0Minus3, &PinOpts{MaxVoltage: 5 * physic.Volt, Frequency: physic.Hertz, Quality: BestQuality, Comparator: &c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := p.Read(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPinForChannelOpts_fail(t *testing.T) {
+	d, err := NewADS1115(&i2ctest.Playback{}, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []Comparator{
+		{Low: physic.Volt, High: 2 * physic.Volt, Queue: 3},
+		{Low: 2 * physic.Volt, High: physic.Volt},
+	}
+	for i, c := range data {
+		if _, err := d.PinForChannelOpts(Channel0, &PinOpts{MaxVoltage: 5 * physic.Volt, Frequency: physic.Hertz, Comparator: &c}); err == nil {
+			t.Fatalf("#%d: expected failure", i)
+		}
+	}
+}
+
+func TestPinADC_ReadContinuous_Halt(t *testing.T) {
+	// Halt must not block when the samples are not consumed.
+	ops := []i2ctest.IO{{Addr: 0x48, W: []byte{0x1, 0x10, 0xc3}}}
+	// 16 samples fill the channel, the 17th blocks the goroutine.
+	for i := 0; i < 17; i++ {
+		ops = append(ops, i2ctest.IO{Addr: 0x48, W: []byte{0x0}, R: []byte{0x52, 0xd0}})
+	}
+	ops = append(ops, i2ctest.IO{Addr: 0x48, W: []byte{0x1, 0x11, 0xc3}})
+	b := i2ctest.Playback{Ops: ops}
+	defer b.Close()
+	d, err := NewADS1015(&b, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.PinForChannelOpts(Channel0Minus3, &PinOpts{MaxVoltage: 5 * physic.Volt, Frequency: 1000 * physic.Hertz, Continuous: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := p.ReadContinuous()
+	for {
+		b.Lock()
+		n := b.Count
+		b.Unlock()
+		if n == len(ops)-1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := p.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	for range c {
+	}
+}
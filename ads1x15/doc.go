@@ -5,6 +5,12 @@
 // Package ads1x15 controls ADS1015/ADS1115 Analog-Digital Converters (ADC) via
 // I²C interface.
 //
+// Pins are single-shot by default: each Read starts a conversion and the ADC
+// powers down once done. With PinOpts.Continuous, the ADC converts
+// continuously and Read returns the latest conversion. With
+// PinOpts.Comparator, the ALERT/RDY pin signals the voltage crossing the
+// thresholds.
+//
 // # Datasheet
 //
 // ADS1015: http://www.ti.com/product/ADS1015
@@ -58,3 +58,42 @@ func Example() {
 		fmt.Println(reading)
 	}
 }
+
+func ExampleDev_PinForChannelOpts() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	adc, err := ads1x15.NewADS1115(bus, &ads1x15.DefaultOpts)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Convert continuously and assert ALERT/RDY while the voltage is outside
+	// of 1V to 2V.
+	pin, err := adc.PinForChannelOpts(ads1x15.Channel0, &ads1x15.PinOpts{
+		MaxVoltage: 4 * physic.Volt,
+		Frequency:  10 * physic.Hertz,
+		Quality:    ads1x15.BestQuality,
+		Continuous: true,
+		Comparator: &ads1x15.Comparator{Low: physic.Volt, High: 2 * physic.Volt, Window: true},
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer pin.Halt()
+
+	reading, err := pin.Read()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(reading)
+}
@@ -34,6 +34,7 @@ const (
 	TimeInact        = 0x26 // Inactivity time
 	ActInactCtl      = 0x27 // Axis control for activity/inactivity detection
 	ThreshFf         = 0x28 // Free-fall threshold
+	TimeFf           = 0x29 // Free-fall time
 	TapAxes          = 0x2A // Axis control for single tap/double tap
 	TapStatus        = 0x2B // Source of single tap/double tap
 	ActivityStatus   = 0x2A // Source of activity detection
@@ -92,8 +93,11 @@ var DefaultOpts = Opts{
 }
 
 type Opts struct {
-	ExpectedDeviceID byte        // Expected device ID used to verify that the device is an ADXL345.
-	Sensitivity      Sensitivity // Sensitivity of the device (2G, 4G, 8G, 16G)
+	ExpectedDeviceID byte          // Expected device ID used to verify that the device is an ADXL345.
+	Sensitivity      Sensitivity   // Sensitivity of the device (2G, 4G, 8G, 16G)
+	FIFO             bool          // Queue the samples in the FIFO, read with ReadFIFO.
+	Tap              *TapOpts      // Detect single and double taps, reported by Events.
+	FreeFall         *FreeFallOpts // Detect free falls, reported by Events.
 }
 
 // Dev is a driver for the ADXL345 accelerometer
@@ -105,6 +109,7 @@ type Dev struct {
 	// The sensitivity of the device (2G, 4G, 8G, 16G)
 	// Set to 2G by default, can be changed in the Opts at initialization.
 	sensitivity Sensitivity
+	fifo        bool
 }
 
 func (d *Dev) Mode() string {
@@ -167,22 +172,23 @@ func (d *Dev) makeDev(o *Opts) error {
 		}
 	}
 	// Verify that the device Id
-	tx := []byte{DeviceID | 0x80, 0x00}
-	rx := make([]byte, len(tx))
-	err = d.c.Tx(tx, rx)
+	var id [1]byte
+	err = d.readRegs(DeviceID, id[:])
 	if err != nil {
 		return fmt.Errorf("unable to read the deviceID \"%s\"", err.Error())
 	}
-	switch rx[1] {
+	switch id[0] {
 	case Adxl345:
 		d.name = "adxl345"
-		return nil
 	case o.ExpectedDeviceID:
 		d.name = fmt.Sprintf("expected%#x", o.ExpectedDeviceID)
-		return nil
 	default:
-		return fmt.Errorf("unrecognized device expected=\"%#02x\" or \"%#02x\"found=\"%#02x\" ", o.ExpectedDeviceID, Adxl345, rx)
+		return fmt.Errorf("unrecognized device expected=\"%#02x\" or \"%#02x\"found=\"%#02x\" ", o.ExpectedDeviceID, Adxl345, id[0])
+	}
+	if err = d.setupFIFO(o); err != nil {
+		return err
 	}
+	return d.setupInterrupts(o)
 }
 
 // SetSensitivity sets the sensitivity of the ADXL345.
@@ -211,40 +217,60 @@ func (d *Dev) TurnOff() error {
 
 // Update reads the acceleration values from the ADXL345.
 // By reading the acceleration the 3 axes acceleration values.
-// This is a simple synchronous implementation.
+// This is a simple synchronous implementation; errors are ignored, use Sense
+// to get them.
 func (d *Dev) Update() Acceleration {
-	return Acceleration{
-		X: d.readAndCombine(DataX0, DataX1),
-		Y: d.readAndCombine(DataY0, DataY1),
-		Z: d.readAndCombine(DataZ0, DataZ1),
-	}
+	a, _ := d.Sense()
+	return a
 }
 
-// readAndCombine combines two registers to form a 16-bit value.
-// The ADXL345 uses two 8-bit registers to store the output data for each axis.
-// X := d.readAndCombine(DataX0, DataX1) where:
-// `DataX0` is the address of the lower byte (LSB, least significant byte)
-// `DataX1` is the address of the upper byte (MSB, most significant byte)
-// The ADXL345 combines both registers to deliver 16-bit output for each acceleration axis.
-// A similar approach is used for the Y and Z axes. This technique provides higher precision in the measurements.
-func (d *Dev) readAndCombine(reg1, reg2 byte) int16 {
-	low, _ := d.Read(reg1)
-	high, _ := d.Read(reg2)
-	return int16(uint16(high)<<8) | int16(low)
+// Sense reads the acceleration values of the 3 axes.
+//
+// The 6 data registers are read in one transaction, so that the 3 axes belong
+// to the same sample. With Opts.FIFO, this pops the oldest sample of the FIFO.
+func (d *Dev) Sense() (Acceleration, error) {
+	// The ADXL345 uses two 8-bit registers to store the output data for each
+	// axis, the lower byte first.
+	var b [6]byte
+	if err := d.readRegs(DataX0, b[:]); err != nil {
+		return Acceleration{}, err
+	}
+	return Acceleration{
+		X: int16(binary.LittleEndian.Uint16(b[0:])),
+		Y: int16(binary.LittleEndian.Uint16(b[2:])),
+		Z: int16(binary.LittleEndian.Uint16(b[4:])),
+	}, nil
 }
 
-// Read reads a 16-bit value from the specified register address.
+// Read reads a 16-bit little endian value from the specified register address
+// and the next one.
 func (d *Dev) Read(regAddress byte) (int16, error) {
-	// Send a two-byte sequence:
-	// - The first byte contains the address with bit 7 set high to indicate read op
-	// - The second byte is a "don't care" value, usually zero
-	tx := []byte{regAddress | 0x80, 0x00}
-	rx := make([]byte, len(tx))
-	err := d.c.Tx(tx, rx)
-	if err != nil {
+	var b [2]byte
+	if err := d.readRegs(regAddress, b[:]); err != nil {
 		return 0, err
 	}
-	return int16(binary.LittleEndian.Uint16(rx)), nil
+	return int16(binary.LittleEndian.Uint16(b[:])), nil
+}
+
+// readRegs reads consecutive registers starting at regAddress into b.
+func (d *Dev) readRegs(regAddress byte, b []byte) error {
+	if !d.isSPI {
+		return d.c.Tx([]byte{regAddress}, b)
+	}
+	// SPI is full duplex. The first byte contains the address with bit 7 set
+	// high to indicate a read and bit 6 set for a multiple-byte read; the
+	// data is clocked out while "don't care" values are sent.
+	tx := make([]byte, len(b)+1)
+	tx[0] = regAddress | 0x80
+	if len(b) > 1 {
+		tx[0] |= 0x40
+	}
+	rx := make([]byte, len(tx))
+	if err := d.c.Tx(tx, rx); err != nil {
+		return err
+	}
+	copy(b, rx[1:])
+	return nil
 }
 
 // Write writes a 1 byte value to the specified register address.
@@ -254,10 +280,10 @@ func (d *Dev) Write(regAddress byte, value byte) error {
 
 // Acceleration represents the acceleration on the three axes X,Y,Z.
 // The sensitivity can be set to different levels: ±2g, ±4g, ±8g, or ±16g. (S2G, S4G, S8G, S16G)
-// The output are 16-bit integers, so the device measures between -32768 and +32767 for each axis.
-// For example, if the sensitivity is set to ±2g and you're getting a reading of 16384 on the X axis, that would correspond to 1g of acceleration along the X axis.
+// The output are 10-bit integers, so the device measures between -512 and +511 for each axis.
+// For example, if the sensitivity is set to ±2g and you're getting a reading of 256 on the X axis, that would correspond to 1g of acceleration along the X axis.
 // To convert the raw values to a physical unit (like g or m/s²), you would need to know the sensitivity setting of your device.
-// For instance, if your sensitivity is set to ±2g, the conversion factor would be 2 / 32768 = 0.000061g per count.
+// For instance, if your sensitivity is set to ±2g, the conversion factor would be 4 / 1024 = 0.0039g per count.
 // So, you would multiply the raw acceleration values by this factor to get the acceleration in `g`.
 type Acceleration struct {
 	X int16
@@ -282,6 +308,6 @@ func (s Sensitivity) String() string {
 	case S16G:
 		return "+/-16g"
 	default:
-		return fmt.Sprintf("unknown sensitivity: %#x", s)
+		return fmt.Sprintf("unknown sensitivity: %#x", byte(s))
 	}
 }
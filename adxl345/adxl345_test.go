@@ -0,0 +1,150 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package adxl345
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+const testAddr = 0x53
+
+// initOps are the I²C transactions issued by NewI2C without FIFO nor
+// interrupts.
+func initOps(s Sensitivity) []i2ctest.IO {
+	ops := []i2ctest.IO{{Addr: testAddr, W: []byte{PowerCtl, 0x08}}}
+	if s != S2G {
+		ops = append(ops, i2ctest.IO{Addr: testAddr, W: []byte{DataFormat, byte(s)}})
+	}
+	return append(ops, i2ctest.IO{Addr: testAddr, W: []byte{DeviceID}, R: []byte{Adxl345}})
+}
+
+// dataOp returns a read of the data registers.
+func dataOp(x, y, z int16) i2ctest.IO {
+	return i2ctest.IO{Addr: testAddr, W: []byte{DataX0}, R: []byte{
+		byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8),
+	}}
+}
+
+func TestNewI2C(t *testing.T) {
+	for i, s := range []Sensitivity{S2G, S16G} {
+		bus := &i2ctest.Playback{Ops: initOps(s)}
+		d, err := NewI2C(bus, testAddr, &Opts{ExpectedDeviceID: AdxlXXX, Sensitivity: s})
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want := "adxl345{Sensitivity:" + s.String() + ", Mode:I²C}"; d.String() != want {
+			t.Errorf("#%d: String() = %q, want %q", i, d, want)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNewI2C_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: testAddr, W: []byte{PowerCtl, 0x08}},
+		{Addr: testAddr, W: []byte{DeviceID}, R: []byte{0x00}},
+	}}
+	if _, err := NewI2C(bus, testAddr, &DefaultOpts); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: testAddr, W: []byte{PowerCtl, 0x08}},
+		{Addr: testAddr, W: []byte{DeviceID}, R: []byte{0x01}},
+	}}
+	d, err := NewI2C(bus, testAddr, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "expected0x1{Sensitivity:+/-2g, Mode:I²C}" {
+		t.Fatalf("String() = %q", s)
+	}
+}
+
+func TestNewI2C_BadSensitivity(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps(S2G)[:1]}
+	if _, err := NewI2C(bus, testAddr, &Opts{Sensitivity: 4}); err == nil || err.Error() != "invalid sensitivity: 4. Valid values are 2, 4, 8, 16" {
+		t.Fatalf("NewI2C() = %v", err)
+	}
+	if s := Sensitivity(4).String(); s != "unknown sensitivity: 0x4" {
+		t.Fatalf("String() = %q", s)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := append(initOps(S2G), dataOp(256, -1, 512), dataOp(1, 2, 3),
+		i2ctest.IO{Addr: testAddr, W: []byte{OfsX}, R: []byte{0x34, 0x12}},
+		i2ctest.IO{Addr: testAddr, W: []byte{PowerCtl, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := NewI2C(bus, testAddr, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := d.Sense(); err != nil || a != (Acceleration{256, -1, 512}) {
+		t.Fatalf("Sense() = %s, %v", a, err)
+	}
+	if a := d.Update(); a != (Acceleration{1, 2, 3}) {
+		t.Fatalf("Update() = %s", a)
+	}
+	if v, err := d.Read(OfsX); err != nil || v != 0x1234 {
+		t.Fatalf("Read() = %#x, %v", v, err)
+	}
+	if err := d.TurnOff(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSpi(t *testing.T) {
+	port := spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				{W: []byte{PowerCtl, 0x08}},
+				{W: []byte{DataFormat, byte(S4G)}},
+				{W: []byte{DeviceID | 0x80, 0}, R: []byte{0, Adxl345}},
+				{W: []byte{DataX0 | 0xC0, 0, 0, 0, 0, 0, 0}, R: []byte{0, 0x00, 0x01, 0xFF, 0xFF, 0x02, 0x00}},
+			},
+		},
+	}
+	d, err := NewSpi(&port, &Opts{Sensitivity: S4G})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "adxl345{Sensitivity:+/-4g, Mode:SPI}" {
+		t.Errorf("String() = %q", s)
+	}
+	if a, err := d.Sense(); err != nil || a != (Acceleration{256, -1, 2}) {
+		t.Fatalf("Sense() = %s, %v", a, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := NewI2C(bus, testAddr, &DefaultOpts); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(S2G), DontPanic: true}
+	d, err := NewI2C(bus, testAddr, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.Read(DataX0); err == nil {
+		t.Fatal("expected error")
+	}
+}
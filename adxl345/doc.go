@@ -2,7 +2,15 @@
 // Use of this source code is governed under the Apache License, Version 2.0
 // that can be found in the LICENSE file.
 
-// Package adxl345 controls an ADXL345 3-axis accelerometer over SPI.
+// Package adxl345 controls an ADXL345 3-axis accelerometer over I²C or SPI.
+//
+// # More details
+//
+// The ADXL345 measures up to ±16g at 100Hz by default. With Opts.FIFO, the
+// last 32 samples are queued in its FIFO, read with ReadFIFO.
+//
+// It detects single and double taps and free falls, configured with Opts.Tap
+// and Opts.FreeFall. They drive its INT1 pin high, and are reported by Events.
 //
 // # Datasheet
 //
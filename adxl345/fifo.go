@@ -0,0 +1,62 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package adxl345
+
+import "errors"
+
+// FIFOWatermark is the number of samples queued in the FIFO that triggers the
+// Watermark event.
+const FIFOWatermark = 16
+
+// FIFO register values.
+const (
+	fifoStream  = 0x80 // FIFO_MODE in FifoCtl: the oldest samples are overwritten
+	fifoEntries = 0x3F // in FifoStatus
+)
+
+var errFIFODisabled = errors.New("FIFO not enabled in Opts")
+
+// setupFIFO puts the FIFO in stream mode with Opts.FIFO.
+func (d *Dev) setupFIFO(o *Opts) error {
+	if !o.FIFO {
+		return nil
+	}
+	d.fifo = true
+	return d.Write(FifoCtl, fifoStream|FIFOWatermark)
+}
+
+// FIFOLen returns the number of samples queued in the FIFO, up to 32. It
+// requires Opts.FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	if !d.fifo {
+		return 0, errFIFODisabled
+	}
+	var b [1]byte
+	if err := d.readRegs(FifoStatus, b[:]); err != nil {
+		return 0, err
+	}
+	return int(b[0] & fifoEntries), nil
+}
+
+// ReadFIFO reads up to len(a) samples from the FIFO, oldest first, and returns
+// the number read. It requires Opts.FIFO.
+//
+// The FIFO holds the last 32 samples; when it was full, the oldest samples
+// were overwritten and the Overrun event is reported by Events.
+func (d *Dev) ReadFIFO(a []Acceleration) (int, error) {
+	n, err := d.FIFOLen()
+	if err != nil {
+		return 0, err
+	}
+	n = min(n, len(a))
+	// Each read of the data registers pops a sample. The SPI transactions are
+	// far enough apart for the FIFO to update between them.
+	for i := range a[:n] {
+		if a[i], err = d.Sense(); err != nil {
+			return i, err
+		}
+	}
+	return n, nil
+}
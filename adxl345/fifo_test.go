@@ -0,0 +1,53 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package adxl345
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestReadFIFO(t *testing.T) {
+	ops := append(initOps(S2G),
+		i2ctest.IO{Addr: testAddr, W: []byte{FifoCtl, 0x90}},
+		i2ctest.IO{Addr: testAddr, W: []byte{IntEnable, byte(Watermark)}},
+		i2ctest.IO{Addr: testAddr, W: []byte{FifoStatus}, R: []byte{0x83}},
+		i2ctest.IO{Addr: testAddr, W: []byte{FifoStatus}, R: []byte{0x83}},
+		dataOp(1, 0, 0),
+		dataOp(2, 0, 0),
+		i2ctest.IO{Addr: testAddr, W: []byte{FifoStatus}, R: []byte{0x01}},
+		dataOp(3, 0, 0),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := NewI2C(bus, testAddr, &Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 3 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	a := make([]Acceleration, 2)
+	if n, err := d.ReadFIFO(a); err != nil || n != 2 || a[0].X != 1 || a[1].X != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v, %v", n, err, a)
+	}
+	if n, err := d.ReadFIFO(a); err != nil || n != 1 || a[0].X != 3 {
+		t.Fatalf("ReadFIFO() = %d, %v, %v", n, err, a)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps(S2G)}
+	d, err := NewI2C(bus, testAddr, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]Acceleration, 1)); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package adxl345
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Events is a set of interrupt sources, as reported by the IntSource
+// register.
+type Events byte
+
+// Interrupt sources. The enabled ones drive the INT1 pin high.
+const (
+	DataReady  Events = 0x80 // A new sample is available
+	SingleTap  Events = 0x40 // A single tap was detected
+	DoubleTap  Events = 0x20 // A double tap was detected
+	Activity   Events = 0x10 // Activity was detected
+	Inactivity Events = 0x08 // Inactivity was detected
+	FreeFall   Events = 0x04 // A free fall was detected
+	Watermark  Events = 0x02 // The FIFO holds at least FIFOWatermark samples
+	Overrun    Events = 0x01 // Samples were lost
+)
+
+var eventNames = []string{"Overrun", "Watermark", "FreeFall", "Inactivity", "Activity", "DoubleTap", "SingleTap", "DataReady"}
+
+// String returns the names of the events, separated by "|".
+func (e Events) String() string {
+	if e == 0 {
+		return "0"
+	}
+	var names []string
+	for i, n := range eventNames {
+		if e&(1<<i) != 0 {
+			names = append(names, n)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// TapOpts configures the detection of taps with Opts.Tap.
+//
+// A tap is an acceleration above Threshold on any axis that lasts less than
+// Duration. With a Window, a second tap starting after Latency and within
+// Window of the first one is reported as a double tap.
+type TapOpts struct {
+	Threshold int           // Threshold in mg, up to 15937mg, by steps of 62.5mg. 3000mg is a good start.
+	Duration  time.Duration // Maximum duration of a tap, up to 159ms, by steps of 625µs.
+	Latency   time.Duration // Wait time after a tap before the window of the second tap, up to 318ms, by steps of 1.25ms.
+	Window    time.Duration // Window of the second tap of a double tap, up to 318ms, by steps of 1.25ms. 0 disables the double tap detection.
+}
+
+// FreeFallOpts configures the detection of free falls with Opts.FreeFall.
+//
+// A free fall is an acceleration below Threshold on all the axes that lasts
+// at least Time.
+type FreeFallOpts struct {
+	Threshold int           // Threshold in mg, up to 15937mg, by steps of 62.5mg. 300 to 600mg are recommended.
+	Time      time.Duration // Minimum duration, up to 1.275s, by steps of 5ms. 100 to 350ms are recommended.
+}
+
+// tapAxesXYZ enables the tap detection on the 3 axes in TapAxes.
+const tapAxesXYZ = 0x07
+
+// setupInterrupts configures the tap and free fall detections and enables
+// their interrupts.
+func (d *Dev) setupInterrupts(o *Opts) error {
+	var enable Events
+	if o.FIFO {
+		enable |= Watermark
+	}
+	if t := o.Tap; t != nil {
+		thresh, err := threshold(t.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid tap %w", err)
+		}
+		dur, err := steps(t.Duration, 625*time.Microsecond)
+		if err != nil {
+			return fmt.Errorf("invalid tap duration: %w", err)
+		}
+		latent, err := steps(t.Latency, 1250*time.Microsecond)
+		if err != nil {
+			return fmt.Errorf("invalid tap latency: %w", err)
+		}
+		window, err := steps(t.Window, 1250*time.Microsecond)
+		if err != nil {
+			return fmt.Errorf("invalid tap window: %w", err)
+		}
+		for _, w := range [][2]byte{{ThreshTap, thresh}, {Dur, dur}, {Latent, latent}, {Window, window}, {TapAxes, tapAxesXYZ}} {
+			if err := d.Write(w[0], w[1]); err != nil {
+				return err
+			}
+		}
+		enable |= SingleTap
+		if window != 0 {
+			enable |= DoubleTap
+		}
+	}
+	if f := o.FreeFall; f != nil {
+		thresh, err := threshold(f.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid free fall %w", err)
+		}
+		t, err := steps(f.Time, 5*time.Millisecond)
+		if err != nil {
+			return fmt.Errorf("invalid free fall time: %w", err)
+		}
+		if err := d.Write(ThreshFf, thresh); err != nil {
+			return err
+		}
+		if err := d.Write(TimeFf, t); err != nil {
+			return err
+		}
+		enable |= FreeFall
+	}
+	if enable == 0 {
+		return nil
+	}
+	return d.Write(IntEnable, byte(enable))
+}
+
+// Events returns the interrupt sources that occurred since the last call.
+//
+// Reading them releases the INT1 pin, except for DataReady, Watermark and
+// Overrun that are cleared by reading the samples. Those three are reported
+// even when not enabled.
+func (d *Dev) Events() (Events, error) {
+	var b [1]byte
+	if err := d.readRegs(IntSource, b[:]); err != nil {
+		return 0, err
+	}
+	return Events(b[0]), nil
+}
+
+// threshold returns the register value of a threshold in mg.
+func threshold(mg int) (byte, error) {
+	if mg < 0 || mg > 15937 {
+		return 0, fmt.Errorf("threshold: %dmg. Valid values are 0 to 15937mg", mg)
+	}
+	// 62.5mg per LSB, rounded to the nearest.
+	return byte((mg*2 + 62) / 125), nil
+}
+
+// steps returns the register value of a duration in steps of unit.
+func steps(t, unit time.Duration) (byte, error) {
+	if t < 0 || t > 255*unit {
+		return 0, fmt.Errorf("%s. Valid values are 0 to %s", t, 255*unit)
+	}
+	return byte(t / unit), nil
+}
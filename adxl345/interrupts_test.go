@@ -0,0 +1,112 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package adxl345
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestNewI2C_Interrupts(t *testing.T) {
+	w := func(reg, v byte) i2ctest.IO { return i2ctest.IO{Addr: testAddr, W: []byte{reg, v}} }
+	data := []struct {
+		opts Opts
+		ops  []i2ctest.IO
+	}{
+		{
+			Opts{Tap: &TapOpts{Threshold: 3000, Duration: 10 * time.Millisecond}},
+			[]i2ctest.IO{w(ThreshTap, 48), w(Dur, 16), w(Latent, 0), w(Window, 0), w(TapAxes, 0x07), w(IntEnable, 0x40)},
+		},
+		{
+			Opts{Tap: &TapOpts{Threshold: 2500, Duration: 20 * time.Millisecond, Latency: 100 * time.Millisecond, Window: 250 * time.Millisecond}},
+			[]i2ctest.IO{w(ThreshTap, 40), w(Dur, 32), w(Latent, 80), w(Window, 200), w(TapAxes, 0x07), w(IntEnable, 0x60)},
+		},
+		{
+			Opts{FreeFall: &FreeFallOpts{Threshold: 400, Time: 200 * time.Millisecond}},
+			[]i2ctest.IO{w(ThreshFf, 6), w(TimeFf, 40), w(IntEnable, 0x04)},
+		},
+		{
+			Opts{FIFO: true, FreeFall: &FreeFallOpts{Threshold: 500, Time: 100 * time.Millisecond}},
+			[]i2ctest.IO{w(FifoCtl, 0x90), w(ThreshFf, 8), w(TimeFf, 20), w(IntEnable, 0x06)},
+		},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: append(initOps(S2G), line.ops...)}
+		if _, err := NewI2C(bus, testAddr, &line.opts); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNewI2C_BadInterrupts(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{Tap: &TapOpts{Threshold: 16000}}, "invalid tap threshold: 16000mg. Valid values are 0 to 15937mg"},
+		{Opts{Tap: &TapOpts{Duration: 200 * time.Millisecond}}, "invalid tap duration: 200ms. Valid values are 0 to 159.375ms"},
+		{Opts{Tap: &TapOpts{Latency: -time.Millisecond}}, "invalid tap latency: -1ms. Valid values are 0 to 318.75ms"},
+		{Opts{Tap: &TapOpts{Window: time.Second}}, "invalid tap window: 1s. Valid values are 0 to 318.75ms"},
+		{Opts{FreeFall: &FreeFallOpts{Threshold: -1}}, "invalid free fall threshold: -1mg. Valid values are 0 to 15937mg"},
+		{Opts{FreeFall: &FreeFallOpts{Time: 2 * time.Second}}, "invalid free fall time: 2s. Valid values are 0 to 1.275s"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(S2G)}
+		if _, err := NewI2C(bus, testAddr, &line.opts); err == nil || err.Error() != line.want {
+			t.Errorf("#%d: NewI2C() = %v, want %q", i, err, line.want)
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	ops := append(initOps(S2G),
+		i2ctest.IO{Addr: testAddr, W: []byte{IntSource}, R: []byte{0xE3}},
+		i2ctest.IO{Addr: testAddr, W: []byte{IntSource}, R: []byte{0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := NewI2C(bus, testAddr, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := d.Events()
+	if err != nil || e != DataReady|SingleTap|DoubleTap|Watermark|Overrun {
+		t.Fatalf("Events() = %s, %v", e, err)
+	}
+	if s := e.String(); s != "Overrun|Watermark|DoubleTap|SingleTap|DataReady" {
+		t.Errorf("String() = %q", s)
+	}
+	if e, err := d.Events(); err != nil || e != 0 || e.String() != "0" {
+		t.Fatalf("Events() = %s, %v", e, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	data := []struct {
+		mg   int
+		want byte
+	}{
+		{0, 0},
+		{31, 0},
+		{32, 1},
+		{3000, 48},
+		{15937, 255},
+	}
+	for _, line := range data {
+		if v, err := threshold(line.mg); err != nil || v != line.want {
+			t.Errorf("threshold(%d) = %d, %v, want %d", line.mg, v, err, line.want)
+		}
+	}
+	if _, err := threshold(15938); err == nil {
+		t.Error("threshold(15938) succeeded")
+	}
+}
@@ -142,24 +142,29 @@ func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error)
 
 	sensing := make(chan physic.Env)
 	d.stop = make(chan struct{})
-	go func() {
+	go func(stop <-chan struct{}) {
 		defer d.wg.Done()
 		defer close(sensing)
 		dMeasurement := 100 * time.Millisecond // duration of last measurement
 		for {
 			select {
-			case <-d.stop:
+			case <-stop:
 				return
 			case <-time.After(interval - dMeasurement):
 				var e physic.Env
 				now := time.Now()
 				if err := d.Sense(&e); err == nil {
-					sensing <- e
+					// Don't block Halt() if the caller stopped reading.
+					select {
+					case sensing <- e:
+					case <-stop:
+						return
+					}
 				}
 				dMeasurement = time.Since(now)
 			}
 		}
-	}()
+	}(d.stop)
 	return sensing, nil
 }
 
@@ -180,14 +185,17 @@ func (d *Dev) SoftReset() error {
 
 // Halt stops the AHT20 from acquiring measurements as initiated by SenseContinuous().
 func (d *Dev) Halt() error {
+	// Release the lock before waiting since the goroutine needs it to finish
+	// a pending measurement.
 	d.mu.Lock()
-	defer d.mu.Unlock()
-	if d.stop == nil {
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop == nil {
 		return nil
 	}
-	close(d.stop)
+	close(stop)
 	d.wg.Wait()
-	d.stop = nil
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"periph.io/x/conn/v3/i2c/i2ctest"
 	"periph.io/x/conn/v3/physic"
 	"testing"
+	"time"
 )
 
 const byteStatusInitialized = bitInitialized | 0x10
@@ -162,6 +163,35 @@ func TestDev_Sense_error(t *testing.T) {
 	}
 }
 
+func TestDev_SenseContinuous(t *testing.T) {
+	measurement := []i2ctest.IO{
+		// Trigger measurement
+		{Addr: deviceAddress, W: argsMeasure},
+		// Read measurement
+		{Addr: deviceAddress, R: []byte{byteStatusInitialized, 0x75, 0x52, 0x05, 0x8E, 0x40, 0x7F}},
+	}
+	bus := i2ctest.Playback{Ops: append(append([]i2ctest.IO{}, measurement...), measurement...)}
+	dev := Dev{d: &i2c.Dev{Bus: &bus, Addr: deviceAddress}, opts: DefaultOpts}
+	c, err := dev.SenseContinuous(100 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := <-c; e.Humidity != 4582824*physic.TenthMicroRH {
+		t.Fatalf("unexpected measurement %v", e)
+	}
+	// Let the second measurement wait for a reader; Halt must not block on it.
+	time.Sleep(150 * time.Millisecond)
+	if err := dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestDev_SoftReset(t *testing.T) {
 	bus := i2ctest.Playback{
 		Ops: []i2ctest.IO{
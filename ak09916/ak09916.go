@@ -0,0 +1,389 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ak09916
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address of the AK09916, which can't be changed.
+const DefaultAddr = 0x0C
+
+// Register map.
+const (
+	regWIA1  = 0x00 // WIA1, WIA2
+	regST1   = 0x10 // DRDY bit 0, DOR bit 1
+	regHXL   = 0x11 // HXL, HXH, HYL, HYH, HZL, HZH, TMPS, ST2
+	regCNTL2 = 0x31 // MODE bits 4..0
+	regCNTL3 = 0x32 // SRST bit 0
+)
+
+// Identification, WIA1 and WIA2.
+const (
+	companyID = 0x48
+	deviceID  = 0x09
+)
+
+// Register bits.
+const (
+	st1DRDY  = 0x01
+	st2HOFL  = 0x08 // magnetic sensor overflow
+	cntl3RST = 0x01
+)
+
+// MODE values of CNTL2.
+const (
+	modePowerDown = 0x00
+	modeSingle    = 0x01
+)
+
+// Timings from the datasheet, rounded up.
+const (
+	resetTime  = time.Millisecond
+	modeTime   = 100 * time.Microsecond // in power-down mode before a mode change
+	singleTime = 9 * time.Millisecond
+)
+
+// nanoTeslaPerLSB is the sensitivity of the output.
+const nanoTeslaPerLSB = 150
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the identification registers don't
+	// read 0x48 0x09.
+	ErrBadID = errors.New("ak09916: bad chip ID")
+	// ErrOverflow is returned along with the measurement when the field
+	// saturated the sensor; the values must be discarded.
+	ErrOverflow = errors.New("ak09916: measurement overflow")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("ak09916: device halted")
+	// ErrNotReady is returned when a single measurement didn't complete in
+	// time.
+	ErrNotReady = errors.New("ak09916: measurement not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("ak09916: invalid options")
+)
+
+// Adjustment holds per-axis scale factors, applied to the scaled values, e.g.
+// from a calibration.
+type Adjustment struct {
+	X, Y, Z float64
+}
+
+// Opts holds initialization options.
+//
+// ODRHz: continuous measurement rate, 10, 20, 50 or 100Hz. 0 (default) takes
+// a single measurement on demand in each Sense call.
+// Adjustment: optional per-axis scale factors. When nil, the values are not
+// adjusted.
+type Opts struct {
+	ODRHz      int
+	Adjustment *Adjustment
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if _, ok := o.mode(); !ok {
+		return fmt.Errorf("%w: ODRHz %d, want 10, 20, 50 or 100", ErrInvalidOpts, o.ODRHz)
+	}
+	if a := o.Adjustment; a != nil {
+		for _, f := range [...]float64{a.X, a.Y, a.Z} {
+			if !(f > 0) {
+				return fmt.Errorf("%w: Adjustment %+v, want factors more than 0", ErrInvalidOpts, *a)
+			}
+		}
+	}
+	return nil
+}
+
+// mode returns the MODE value for ODRHz.
+func (o *Opts) mode() (byte, bool) {
+	switch o.ODRHz {
+	case 0:
+		return modeSingle, true
+	case 10:
+		return 0x02, true
+	case 20:
+		return 0x04, true
+	case 50:
+		return 0x06, true
+	case 100:
+		return 0x08, true
+	}
+	return 0, false
+}
+
+// Dev represents an AK09916 device.
+// Sense returns X,Y,Z values in µT×10.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c      conn.Conn
+	odr    int
+	adj    [3]float64
+	halted bool
+	data   [9]byte // ST1 to ST2
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets the device on an I²C bus and starts the selected mode.
+//
+// Call EnableICM20948Bypass first to reach the AK09916 of an ICM-20948.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	mode, _ := opts.mode()
+	d := &Dev{c: &i2c.Dev{Addr: DefaultAddr, Bus: bus}, odr: opts.ODRHz, adj: [3]float64{1, 1, 1}}
+	if a := opts.Adjustment; a != nil {
+		d.adj = [3]float64{a.X, a.Y, a.Z}
+	}
+	var id [2]byte
+	if err := d.readRegBlock(regWIA1, id[:]); err != nil {
+		return nil, err
+	}
+	if id[0] != companyID || id[1] != deviceID {
+		return nil, fmt.Errorf("%w: read %#02x %#02x, want %#02x %#02x", ErrBadID, id[0], id[1], companyID, deviceID)
+	}
+	if err := d.writeReg(regCNTL3, cntl3RST); err != nil {
+		return nil, err
+	}
+	// The reset leaves the device in power-down mode.
+	doSleep(resetTime)
+	if mode != modeSingle {
+		if err := d.writeReg(regCNTL2, mode); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	if d.odr == 0 {
+		return fmt.Sprintf("AK09916{%s, on demand}", d.c)
+	}
+	return fmt.Sprintf("AK09916{%s, %dHz}", d.c, d.odr)
+}
+
+// Halt stops SenseMagneticContinuous and puts the device in power-down
+// mode. It implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeReg(regCNTL2, modePowerDown); err != nil {
+		return err
+	}
+	doSleep(modeTime)
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns X,Y,Z as int16 counts, at 0.15µT/LSB, without the
+// adjustment.
+//
+// It takes a single measurement when ODRHz is 0, otherwise it reads the
+// latest one. On overflow, the counts are returned along with ErrOverflow.
+func (d *Dev) SenseRaw() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	return c[0], c[1], c[2], err
+}
+
+func (d *Dev) senseRaw() ([3]int16, error) {
+	if d.halted {
+		return [3]int16{}, ErrHalted
+	}
+	if d.odr == 0 {
+		if err := d.measure(); err != nil {
+			return [3]int16{}, err
+		}
+	}
+	// ST1, the data and ST2 in one transaction. Reading ST2 ends the read
+	// and lets the device update the data registers.
+	if err := d.readRegBlock(regST1, d.data[:]); err != nil {
+		return [3]int16{}, err
+	}
+	b := d.data[1:]
+	c := [3]int16{
+		int16(b[1])<<8 | int16(b[0]),
+		int16(b[3])<<8 | int16(b[2]),
+		int16(b[5])<<8 | int16(b[4]),
+	}
+	if b[7]&st2HOFL != 0 {
+		return c, ErrOverflow
+	}
+	return c, nil
+}
+
+// measure starts a single measurement and polls ST1 until it completes.
+func (d *Dev) measure() error {
+	if err := d.writeReg(regCNTL2, modeSingle); err != nil {
+		return err
+	}
+	doSleep(singleTime)
+	for i := 0; i < 10; i++ {
+		var st1 [1]byte
+		if err := d.readRegBlock(regST1, st1[:]); err != nil {
+			return err
+		}
+		if st1[0]&st1DRDY != 0 {
+			return nil
+		}
+		doSleep(singleTime / 8)
+	}
+	return ErrNotReady
+}
+
+// sense returns the adjusted measurement in nT.
+func (d *Dev) sense() ([3]float64, error) {
+	c, err := d.senseRaw()
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return [3]float64{}, err
+	}
+	var v [3]float64
+	for i := range v {
+		v[i] = float64(c[i]) * nanoTeslaPerLSB * d.adj[i]
+	}
+	return v, err
+}
+
+// Sense reads and scales to µT×10 (int16) for X,Y,Z.
+//
+// The values are truncated to 0.1µT; use SenseMagnetic for full resolution.
+// On overflow, the values are returned along with ErrOverflow.
+func (d *Dev) Sense() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.sense()
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return 0, 0, 0, err
+	}
+	// µT×10 = nT / 100
+	return int16(v[0] / 100), int16(v[1] / 100), int16(v[2] / 100), err
+}
+
+// SenseMagnetic reads a measurement. It implements sensor.Magnetometer.
+//
+// On overflow, the values are returned along with ErrOverflow.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.sense()
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return err
+	}
+	f.X = physic.MagneticFluxDensity(math.Round(v[0]))
+	f.Y = physic.MagneticFluxDensity(math.Round(v[1]))
+	f.Z = physic.MagneticFluxDensity(math.Round(v[2]))
+	return err
+}
+
+// SenseMagneticContinuous returns a channel delivering measurements every
+// interval, until Halt is called. It implements sensor.Magnetometer.
+//
+// An interval of 0 or less uses ODRHz, or the single measurement time when
+// measuring on demand. Measurements that overflow are skipped. Calling
+// SenseMagneticContinuous again stops the previous channel.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = singleTime
+		if d.odr != 0 {
+			interval = time.Second / time.Duration(d.odr)
+		}
+	}
+	c := make(chan sensor.Field)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- sensor.Field) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var f sensor.Field
+		if err := d.SenseMagnetic(&f); err != nil {
+			if errors.Is(err, ErrOverflow) {
+				continue
+			}
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- f:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseMagneticContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if err := d.c.Tx([]byte{addr}, out); err != nil {
+		return fmt.Errorf("ak09916: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+func (d *Dev) writeReg(addr, v byte) error {
+	if err := d.c.Tx([]byte{addr, v}, nil); err != nil {
+		return fmt.Errorf("ak09916: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
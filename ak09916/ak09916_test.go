@@ -0,0 +1,220 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ak09916
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New, followed by the mode
+// written when not single.
+func initOps(mode byte) []i2ctest.IO {
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWIA1}, R: []byte{companyID, deviceID}},
+		{Addr: DefaultAddr, W: []byte{regCNTL3, cntl3RST}},
+	}
+	if mode != modeSingle {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regCNTL2, mode}})
+	}
+	return ops
+}
+
+// dataOp returns a read of ST1 to ST2.
+func dataOp(x, y, z int16, st2 byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regST1}, R: []byte{
+		st1DRDY, byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8), 0, st2,
+	}}
+}
+
+// singleOps are the bus transactions of a single measurement.
+func singleOps(x, y, z int16, st2 byte) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regCNTL2, modeSingle}},
+		{Addr: DefaultAddr, W: []byte{regST1}, R: []byte{0}},
+		{Addr: DefaultAddr, W: []byte{regST1}, R: []byte{st1DRDY}},
+		dataOp(x, y, z, st2),
+	}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		mode byte
+		s    string
+	}{
+		{Opts{}, modeSingle, "AK09916{playback(12), on demand}"},
+		{Opts{ODRHz: 100}, 0x08, "AK09916{playback(12), 100Hz}"},
+		{Opts{ODRHz: 20}, 0x04, "AK09916{playback(12), 20Hz}"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.mode)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regWIA1}, R: []byte{companyID, 0x05}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{ODRHz: 50, Adjustment: &Adjustment{X: 1.1, Y: 1, Z: 0.9}}, ""},
+		{Opts{ODRHz: 8}, "ak09916: invalid options: ODRHz 8, want 10, 20, 50 or 100"},
+		{Opts{Adjustment: &Adjustment{X: 1, Y: -1, Z: 1}}, "ak09916: invalid options: Adjustment {X:1 Y:-1 Z:1}, want factors more than 0"},
+		{Opts{Adjustment: &Adjustment{}}, "ak09916: invalid options: Adjustment {X:0 Y:0 Z:0}, want factors more than 0"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{ODRHz: 8}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := initOps(modeSingle)
+	ops = append(ops, singleOps(100, -200, 300, 0)...)
+	ops = append(ops, singleOps(100, -200, 300, 0)...)
+	ops = append(ops, singleOps(-1, 2, -3, 0)...)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{Adjustment: &Adjustment{X: 1.25, Y: 1, Z: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.15µT/LSB.
+	if x, y, z, err := d.Sense(); err != nil || x != 187 || y != -300 || z != 450 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := (sensor.Field{X: 18750 * physic.NanoTesla, Y: -30 * physic.MicroTesla, Z: 45 * physic.MicroTesla}); f != want {
+		t.Fatalf("SenseMagnetic() = %s, want %s", f, want)
+	}
+	// Not adjusted.
+	if x, y, z, err := d.SenseRaw(); err != nil || x != -1 || y != 2 || z != -3 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_Overflow(t *testing.T) {
+	ops := append(initOps(0x02), dataOp(4900, 0, 0, st2HOFL))
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, _, _, err := d.Sense(); !errors.Is(err, ErrOverflow) || x != 7350 {
+		t.Fatalf("Sense() = %d, %v, want ErrOverflow", x, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_NotReady(t *testing.T) {
+	ops := append(initOps(modeSingle), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCNTL2, modeSingle}})
+	for i := 0; i < 10; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regST1}, R: []byte{0}})
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrNotReady {
+		t.Fatalf("SenseRaw() = %v, want ErrNotReady", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	ops := append(initOps(0x08),
+		dataOp(1, 0, 0, st2HOFL),
+		dataOp(100, 0, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCNTL2, modePowerDown}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The overflowed measurement is skipped.
+	if f := <-c; f.X != 15*physic.MicroTesla {
+		t.Fatalf("got %s", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrHalted {
+		t.Fatalf("SenseRaw() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(modeSingle), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ak09916 controls an AKM AK09916 3-axis magnetometer over I²C.
+//
+// # More details
+//
+// The AK09916 has a fixed sensitivity of 0.15µT/LSB over ±4912µT. It is
+// sold standalone and is the magnetometer of the TDK ICM-20948, where it sits
+// on the auxiliary I²C bus of the ICM-20948; EnableICM20948Bypass connects it
+// to the main bus so New can reach it.
+//
+// The driver takes single measurements on demand, or runs one of the
+// continuous measurement modes at 10, 20, 50 or 100Hz. Saturated measurements
+// are reported with ErrOverflow. SenseRaw returns the counts and Sense returns
+// µT×10, with Opts.Adjustment applied.
+//
+// # Datasheet
+//
+// https://invensense.tdk.com/wp-content/uploads/2016/06/DS-000189-ICM-20948-v1.3.pdf
+package ak09916
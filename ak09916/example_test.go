@@ -0,0 +1,45 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ak09916_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/ak09916"
+	"periph.io/x/devices/v3/sensor"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// The AK09916 of an ICM-20948 is behind its auxiliary bus.
+	if err := ak09916.EnableICM20948Bypass(bus, ak09916.ICM20948Addr); err != nil {
+		log.Fatal(err)
+	}
+	d, err := ak09916.New(bus, ak09916.Opts{ODRHz: 100})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(f)
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ak09916
+
+import (
+	"fmt"
+
+	"periph.io/x/conn/v3/i2c"
+)
+
+// ICM20948Addr is the I²C address of an ICM-20948 with AD0 low; it is 0x69
+// with AD0 high.
+const ICM20948Addr = 0x68
+
+// ICM-20948 registers, in user bank 0.
+const (
+	icmWHOAMI     = 0x00
+	icmUSERCTRL   = 0x03 // I2C_MST_EN bit 5
+	icmPWRMGMT1   = 0x06 // SLEEP bit 6, CLKSEL bits 2..0
+	icmINTPINCFG  = 0x0F // BYPASS_EN bit 1
+	icmREGBANKSEL = 0x7F
+)
+
+// icmWhoAmI is the value of the WHO_AM_I register of the ICM-20948.
+const icmWhoAmI = 0xEA
+
+// EnableICM20948Bypass connects the AK09916 of the ICM-20948 at addr to the
+// main I²C bus, so New can be called on bus.
+//
+// It wakes up the ICM-20948, disables its I²C master and enables the bypass
+// of the auxiliary bus. A driver of the ICM-20948 that re-enables the I²C
+// master disconnects the AK09916.
+func EnableICM20948Bypass(bus i2c.Bus, addr uint16) error {
+	icm := &i2c.Dev{Addr: addr, Bus: bus}
+	write := func(reg, v byte) error {
+		if err := icm.Tx([]byte{reg, v}, nil); err != nil {
+			return fmt.Errorf("ak09916: writing ICM-20948 register 0x%02x: %w", reg, err)
+		}
+		return nil
+	}
+	if err := write(icmREGBANKSEL, 0x00); err != nil {
+		return err
+	}
+	var id [1]byte
+	if err := icm.Tx([]byte{icmWHOAMI}, id[:]); err != nil {
+		return fmt.Errorf("ak09916: reading ICM-20948 register 0x%02x: %w", icmWHOAMI, err)
+	}
+	if id[0] != icmWhoAmI {
+		return fmt.Errorf("%w: ICM-20948 WHO_AM_I read %#02x, want %#02x", ErrBadID, id[0], icmWhoAmI)
+	}
+	// Wake up with the best available clock.
+	if err := write(icmPWRMGMT1, 0x01); err != nil {
+		return err
+	}
+	if err := write(icmUSERCTRL, 0x00); err != nil {
+		return err
+	}
+	return write(icmINTPINCFG, 0x02)
+}
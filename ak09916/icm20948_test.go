@@ -0,0 +1,42 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ak09916
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestEnableICM20948Bypass(t *testing.T) {
+	ops := []i2ctest.IO{
+		{Addr: ICM20948Addr, W: []byte{icmREGBANKSEL, 0x00}},
+		{Addr: ICM20948Addr, W: []byte{icmWHOAMI}, R: []byte{icmWhoAmI}},
+		{Addr: ICM20948Addr, W: []byte{icmPWRMGMT1, 0x01}},
+		{Addr: ICM20948Addr, W: []byte{icmUSERCTRL, 0x00}},
+		{Addr: ICM20948Addr, W: []byte{icmINTPINCFG, 0x02}},
+	}
+	bus := &i2ctest.Playback{Ops: append(ops, initOps(modeSingle)...)}
+	if err := EnableICM20948Bypass(bus, ICM20948Addr); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(bus, Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEnableICM20948Bypass_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: 0x69, W: []byte{icmREGBANKSEL, 0x00}},
+		{Addr: 0x69, W: []byte{icmWHOAMI}, R: []byte{0x71}},
+	}}
+	if err := EnableICM20948Bypass(bus, 0x69); !errors.Is(err, ErrBadID) {
+		t.Fatalf("EnableICM20948Bypass() = %v, want ErrBadID", err)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package amg8833
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// I²C addresses, selected by the AD_SELECT pin.
+const (
+	DefaultAddr = 0x69 // AD_SELECT high
+	AltAddr     = 0x68 // AD_SELECT low
+)
+
+// Size of the array.
+const (
+	Width  = 8
+	Height = 8
+	Pixels = Width * Height
+)
+
+// Registers.
+const (
+	regPCTL       = 0x00
+	regRST        = 0x01
+	regFPSC       = 0x02
+	regINTC       = 0x03
+	regSTAT       = 0x04
+	regSCLR       = 0x05
+	regAVE        = 0x07
+	regINTHL      = 0x08 // followed by INTHH, INTLL, INTLH, IHYSL and IHYSH
+	regThermistor = 0x0E
+	regINT        = 0x10 // interrupt table, 8 bytes
+	regPixels     = 0x80 // 64 words
+	regUnlock     = 0x1F // unlocks AVE with a sequence
+)
+
+// Register values.
+const (
+	pctlNormal   = 0x00
+	rstInitial   = 0x3F
+	fpsc1        = 0x01
+	aveMovingAvg = 0x20
+)
+
+// startTime is the duration from the initial reset to the first frame.
+const startTime = 100 * time.Millisecond
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrInvalidOpts is returned for options or arguments that are out of
+	// range.
+	ErrInvalidOpts = errors.New("amg8833: invalid options")
+)
+
+// DefaultOpts are the recommended options.
+var DefaultOpts = Opts{
+	Rate: 10 * physic.Hertz,
+}
+
+// Opts holds the configuration options.
+//
+// Rate: frame rate, 1Hz or 10Hz.
+//
+// MovingAverage: average the pixels over frames, halving their noise.
+type Opts struct {
+	Addr          uint16 // I²C address, DefaultAddr if 0.
+	Rate          physic.Frequency
+	MovingAverage bool
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Addr != 0 && o.Addr != DefaultAddr && o.Addr != AltAddr {
+		return fmt.Errorf("%w: Addr %#x, want 0x68 or 0x69", ErrInvalidOpts, o.Addr)
+	}
+	if o.Rate != physic.Hertz && o.Rate != 10*physic.Hertz {
+		return fmt.Errorf("%w: Rate %s, want 1Hz or 10Hz", ErrInvalidOpts, o.Rate)
+	}
+	return nil
+}
+
+// Frame is a thermal image.
+type Frame struct {
+	// Pixels are the temperatures, indexed by row then column as in the
+	// registers of the device.
+	Pixels [Height][Width]physic.Temperature
+	// Thermistor is the temperature of the device.
+	Thermistor physic.Temperature
+}
+
+// Dev is a handle to an initialized AMG8833 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets a device on an I²C bus and configures it.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: addr}, opts: opts}
+	if err := d.writeRegs(regPCTL, pctlNormal); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regRST, rstInitial); err != nil {
+		return nil, err
+	}
+	var fpsc byte
+	if opts.Rate == physic.Hertz {
+		fpsc = fpsc1
+	}
+	if err := d.writeRegs(regFPSC, fpsc); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regINTC, 0); err != nil {
+		return nil, err
+	}
+	if err := d.setMovingAverage(opts.MovingAverage); err != nil {
+		return nil, err
+	}
+	doSleep(startTime)
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("AMG8833{%s}", d.c)
+}
+
+// ReadFrame reads the latest frame into f.
+func (d *Dev) ReadFrame(f *Frame) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("amg8833: already streaming")
+	}
+	return d.readFrame(f)
+}
+
+// StreamFrames returns frames on a continuous basis, at the frame rate.
+//
+// The application must call Halt() to stop the streaming when done to close
+// the channel.
+func (d *Dev) StreamFrames() (<-chan Frame, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	frames := make(chan Frame)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(frames)
+		d.streaming(d.opts.Rate.Period(), frames, stop)
+	}(d.stop)
+	return frames, nil
+}
+
+func (d *Dev) streaming(interval time.Duration, frames chan<- Frame, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial read right away.
+		var f Frame
+		d.mu.Lock()
+		err := d.readFrame(&f)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to read frame: %v", d, err)
+			return
+		}
+		select {
+		case frames <- f:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Halt stops the streaming initiated by StreamFrames(). The device keeps
+// measuring.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// setMovingAverage writes the AVE register, unlocked by the sequence of the
+// datasheet.
+func (d *Dev) setMovingAverage(on bool) error {
+	var ave byte
+	if on {
+		ave = aveMovingAvg
+	}
+	for _, w := range [...][2]byte{{regUnlock, 0x50}, {regUnlock, 0x45}, {regUnlock, 0x57}, {regAVE, ave}, {regUnlock, 0x00}} {
+		if err := d.writeRegs(w[0], w[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFrame reads the thermistor and the pixels.
+//
+// It must be called with d.mu held.
+func (d *Dev) readFrame(f *Frame) error {
+	var t [2]byte
+	if err := d.readRegs(regThermistor, t[:]); err != nil {
+		return err
+	}
+	var b [2 * Pixels]byte
+	if err := d.readRegs(regPixels, b[:]); err != nil {
+		return err
+	}
+	// The thermistor is 12 bits sign and magnitude in 0.0625°C.
+	th := physic.Temperature(uint16(t[1]&0x07)<<8|uint16(t[0])) * 62500 * physic.MicroKelvin
+	if t[1]&0x08 != 0 {
+		th = -th
+	}
+	f.Thermistor = physic.ZeroCelsius + th
+	for i := 0; i < Pixels; i++ {
+		f.Pixels[i/Width][i%Width] = physic.ZeroCelsius + fromCode(uint16(b[2*i+1])<<8|uint16(b[2*i]))
+	}
+	return nil
+}
+
+// fromCode converts a 12 bits two's complement value in 0.25°C, as used by
+// the pixels and the interrupt levels.
+func fromCode(v uint16) physic.Temperature {
+	return physic.Temperature(int16(v<<4)>>4) * 250 * physic.MilliKelvin
+}
+
+func (d *Dev) readRegs(reg byte, b []byte) error {
+	if err := d.c.Tx([]byte{reg}, b); err != nil {
+		return fmt.Errorf("amg8833: reading register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+func (d *Dev) writeRegs(reg byte, v ...byte) error {
+	if err := d.c.Tx(append([]byte{reg}, v...), nil); err != nil {
+		return fmt.Errorf("amg8833: writing register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
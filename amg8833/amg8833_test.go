@@ -0,0 +1,219 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package amg8833
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+func write(v ...byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: v}
+}
+
+// initOps are the bus transactions issued by New with DefaultOpts.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		write(regPCTL, 0x00),
+		write(regRST, 0x3F),
+		write(regFPSC, 0x00),
+		write(regINTC, 0x00),
+		write(regUnlock, 0x50),
+		write(regUnlock, 0x45),
+		write(regUnlock, 0x57),
+		write(regAVE, 0x00),
+		write(regUnlock, 0x00),
+	}
+}
+
+// frameOps are the bus transactions of the read of testFrame.
+func frameOps() []i2ctest.IO {
+	b := make([]byte, 2*Pixels)
+	for i := 0; i < Pixels; i++ {
+		// 25°C, except the last pixel at -0.25°C.
+		b[2*i] = 0x64
+	}
+	b[2*Pixels-2], b[2*Pixels-1] = 0xFF, 0x0F
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regThermistor}, R: []byte{0xA8, 0x01}},
+		{Addr: DefaultAddr, W: []byte{regPixels}, R: b},
+	}
+}
+
+var testFrame = func() Frame {
+	var f Frame
+	for y := range f.Pixels {
+		for x := range f.Pixels[y] {
+			f.Pixels[y][x] = physic.ZeroCelsius + 25*physic.Celsius
+		}
+	}
+	f.Pixels[Height-1][Width-1] = physic.ZeroCelsius - 250*physic.MilliKelvin
+	f.Thermistor = physic.ZeroCelsius + 26500*physic.MilliKelvin
+	return f
+}()
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "AMG8833{playback(105)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_opts(t *testing.T) {
+	ops := initOps()
+	for i := range ops {
+		ops[i].Addr = AltAddr
+	}
+	ops[2].W = []byte{regFPSC, 0x01}
+	ops[7].W = []byte{regAVE, 0x20}
+	bus := i2ctest.Playback{Ops: ops}
+	if _, err := New(&bus, Opts{Addr: AltAddr, Rate: physic.Hertz, MovingAverage: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(initOps()); i++ {
+		bus := i2ctest.Playback{Ops: initOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Addr: 0x70, Rate: physic.Hertz}, "amg8833: invalid options: Addr 0x70, want 0x68 or 0x69"},
+		{Opts{Rate: 2 * physic.Hertz}, "amg8833: invalid options: Rate 2Hz, want 1Hz or 10Hz"},
+		{Opts{}, "amg8833: invalid options: Rate 0Hz, want 1Hz or 10Hz"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestReadFrame(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), frameOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Frame
+	if err := d.ReadFrame(&f); err != nil {
+		t.Fatal(err)
+	}
+	if f != testFrame {
+		t.Fatalf("%v, want %v", f, testFrame)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFrame_negative_thermistor(t *testing.T) {
+	ops := append(initOps(), frameOps()...)
+	ops[len(initOps())].R = []byte{0x14, 0x08}
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Frame
+	if err := d.ReadFrame(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := physic.ZeroCelsius - 1250*physic.MilliKelvin; f.Thermistor != want {
+		t.Fatalf("%s, want %s", f.Thermistor, want)
+	}
+}
+
+func TestReadFrame_fail(t *testing.T) {
+	for i := 0; i < len(frameOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), frameOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var f Frame
+		if err := d.ReadFrame(&f); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestStreamFrames(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(initOps(), frameOps()...), frameOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.StreamFrames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if f := <-c; f != testFrame {
+			t.Fatalf("#%d: %v, want %v", i, f, testFrame)
+		}
+	}
+	var f Frame
+	if err := d.ReadFrame(&f); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFromCode(t *testing.T) {
+	data := []struct {
+		v    uint16
+		want physic.Temperature
+	}{
+		{0x000, 0},
+		{0x001, 250 * physic.MilliKelvin},
+		{0x7FF, 511750 * physic.MilliKelvin},
+		{0x800, -512 * physic.Kelvin},
+		{0xFFF, -250 * physic.MilliKelvin},
+	}
+	for _, line := range data {
+		if got := fromCode(line.v); got != line.want {
+			t.Errorf("fromCode(%#x) = %s, want %s", line.v, got, line.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package amg8833 controls a Panasonic AMG8833 (Grid-EYE) 8x8 pixels thermal
+// array over I²C.
+//
+// # More details
+//
+// The device measures continuously at 1 or 10 frames per second, each pixel
+// from 0°C to 80°C at 0.25°C resolution, along with its own temperature from
+// a thermistor. The moving average mode averages the pixels over frames,
+// halving their noise.
+//
+// The INT pin can signal pixels above or below thresholds, or changing more
+// than them between two frames, configured with SetInterrupt. The pixels
+// that triggered it are read with InterruptPixels.
+//
+// # Datasheet
+//
+// https://industrial.panasonic.com/cdbs/www-data/pdf/ADI8000/ADI8000C66.pdf
+package amg8833
@@ -0,0 +1,43 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package amg8833_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/amg8833"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use i2creg I²C bus registry to find the first available I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := amg8833.New(bus, amg8833.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize amg8833: %v", err)
+	}
+	var f amg8833.Frame
+	if err := d.ReadFrame(&f); err != nil {
+		log.Fatal(err)
+	}
+	for _, row := range f.Pixels {
+		for _, t := range row {
+			fmt.Printf("%9s", t)
+		}
+		fmt.Println()
+	}
+}
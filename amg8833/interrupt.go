@@ -0,0 +1,157 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package amg8833
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// INTC bits.
+const (
+	intcEnable   = 0x01
+	intcAbsolute = 0x02
+)
+
+// SCLR bits.
+const (
+	sclrInterrupt = 0x02
+	sclrOverflows = 0x0C // pixels and thermistor
+)
+
+// InterruptOpts configures the interrupt with SetInterrupt.
+//
+// With Absolute, a pixel triggers the interrupt above High or below Low.
+// Otherwise a pixel triggers it when it changes by more than High or less
+// than Low between two frames, e.g. High 2°C and Low -2°C to detect motion.
+// The pixel stops triggering it once back within the levels by Hysteresis.
+//
+// The levels are -512K to 511.75K from 0°C with Absolute, e.g.
+// physic.ZeroCelsius + 30*physic.Celsius, or from 0K otherwise, at 0.25K
+// resolution.
+type InterruptOpts struct {
+	High, Low  physic.Temperature
+	Hysteresis physic.Temperature
+	Absolute   bool
+}
+
+// Status is the content of the status register.
+type Status uint8
+
+// Status bits.
+const (
+	StatusInterrupt          Status = 1 << 1 // a pixel triggered the interrupt
+	StatusPixelOverflow      Status = 1 << 2 // a pixel overflowed
+	StatusThermistorOverflow Status = 1 << 3 // the thermistor overflowed
+)
+
+var statusNames = []struct {
+	s    Status
+	name string
+}{
+	{StatusInterrupt, "Interrupt"},
+	{StatusPixelOverflow, "PixelOverflow"},
+	{StatusThermistorOverflow, "ThermistorOverflow"},
+}
+
+func (s Status) String() string {
+	var names []string
+	for _, n := range statusNames {
+		if s&n.s != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return "Status{" + strings.Join(names, "|") + "}"
+}
+
+// SetInterrupt enables the interrupt on the INT pin, active low.
+func (d *Dev) SetInterrupt(o InterruptOpts) error {
+	base := physic.Temperature(0)
+	if o.Absolute {
+		base = physic.ZeroCelsius
+	}
+	var b [6]byte
+	for i, l := range []struct {
+		name string
+		t    physic.Temperature
+	}{{"High", o.High - base}, {"Low", o.Low - base}, {"Hysteresis", o.Hysteresis}} {
+		c := math.Round(float64(l.t) / float64(250*physic.MilliKelvin))
+		if c < -2048 || c > 2047 {
+			return fmt.Errorf("%w: %s %s, want -512K to 511.75K", ErrInvalidOpts, l.name, kelvin(l.t))
+		}
+		v := uint16(int16(c)) & 0x0FFF
+		b[2*i], b[2*i+1] = byte(v), byte(v>>8)
+	}
+	if o.Hysteresis < 0 {
+		return fmt.Errorf("%w: Hysteresis %s, want 0 or more", ErrInvalidOpts, kelvin(o.Hysteresis))
+	}
+	if o.Low > o.High {
+		if o.Absolute {
+			return fmt.Errorf("%w: Low %s above High %s", ErrInvalidOpts, o.Low, o.High)
+		}
+		return fmt.Errorf("%w: Low %s above High %s", ErrInvalidOpts, kelvin(o.Low), kelvin(o.High))
+	}
+	intc := byte(intcEnable)
+	if o.Absolute {
+		intc |= intcAbsolute
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regINTHL, b[:]...); err != nil {
+		return err
+	}
+	return d.writeRegs(regINTC, intc)
+}
+
+// DisableInterrupt disables the interrupt.
+func (d *Dev) DisableInterrupt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeRegs(regINTC, 0)
+}
+
+// InterruptPixels reads the pixels that triggered the interrupt.
+func (d *Dev) InterruptPixels() ([Height][Width]bool, error) {
+	var p [Height][Width]bool
+	var b [Height]byte
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.readRegs(regINT, b[:]); err != nil {
+		return p, err
+	}
+	for y, row := range b {
+		for x := 0; x < Width; x++ {
+			p[y][x] = row&(1<<uint(x)) != 0
+		}
+	}
+	return p, nil
+}
+
+// kelvin formats a temperature difference.
+func kelvin(t physic.Temperature) string {
+	return fmt.Sprintf("%gK", float64(t)/float64(physic.Kelvin))
+}
+
+// Status reads the status register.
+func (d *Dev) Status() (Status, error) {
+	var b [1]byte
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.readRegs(regSTAT, b[:]); err != nil {
+		return 0, err
+	}
+	return Status(b[0]) & (StatusInterrupt | StatusPixelOverflow | StatusThermistorOverflow), nil
+}
+
+// ClearStatus clears the interrupt and overflow flags, releasing the INT
+// pin.
+func (d *Dev) ClearStatus() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeRegs(regSCLR, sclrInterrupt|sclrOverflows)
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package amg8833
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSetInterrupt(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		// 30°C, 10°C and 1°C.
+		write(regINTHL, 0x78, 0x00, 0x28, 0x00, 0x04, 0x00),
+		write(regINTC, 0x03),
+		// 2°C and -2°C.
+		write(regINTHL, 0x08, 0x00, 0xF8, 0x0F, 0x00, 0x00),
+		write(regINTC, 0x01),
+		write(regINTC, 0x00),
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetInterrupt(InterruptOpts{
+		High:       physic.ZeroCelsius + 30*physic.Celsius,
+		Low:        physic.ZeroCelsius + 10*physic.Celsius,
+		Hysteresis: physic.Kelvin,
+		Absolute:   true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetInterrupt(InterruptOpts{High: 2 * physic.Kelvin, Low: -2 * physic.Kelvin}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DisableInterrupt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetInterrupt_invalid(t *testing.T) {
+	data := []struct {
+		o   InterruptOpts
+		err string
+	}{
+		{InterruptOpts{High: 600 * physic.Kelvin}, "amg8833: invalid options: High 600K, want -512K to 511.75K"},
+		{InterruptOpts{Low: -physic.Kelvin, Hysteresis: -physic.Kelvin}, "amg8833: invalid options: Hysteresis -1K, want 0 or more"},
+		{InterruptOpts{Low: physic.Kelvin}, "amg8833: invalid options: Low 1K above High 0K"},
+		{InterruptOpts{High: physic.ZeroCelsius + 20*physic.Celsius, Low: physic.ZeroCelsius + 30*physic.Celsius, Absolute: true}, "amg8833: invalid options: Low 30°C above High 20°C"},
+	}
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, line := range data {
+		if err := d.SetInterrupt(line.o); !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+	}
+}
+
+func TestInterruptPixels(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINT}, R: []byte{0x01, 0, 0, 0, 0, 0, 0, 0x80}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.InterruptPixels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want [Height][Width]bool
+	want[0][0] = true
+	want[7][7] = true
+	if p != want {
+		t.Fatal(p)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTAT}, R: []byte{0x07}},
+		write(regSCLR, 0x0E),
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != StatusInterrupt|StatusPixelOverflow {
+		t.Fatal(s)
+	}
+	if err := d.ClearStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus_String(t *testing.T) {
+	data := []struct {
+		s    Status
+		want string
+	}{
+		{0, "Status{}"},
+		{StatusInterrupt, "Status{Interrupt}"},
+		{StatusPixelOverflow | StatusThermistorOverflow, "Status{PixelOverflow|ThermistorOverflow}"},
+	}
+	for _, line := range data {
+		if s := line.s.String(); s != line.want {
+			t.Errorf("%s, want %s", s, line.want)
+		}
+	}
+}
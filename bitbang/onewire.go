@@ -0,0 +1,229 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Specification
+//
+// https://www.maximintegrated.com/en/app-notes/index.mvp/id/126
+
+package bitbang
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/onewire"
+	"periph.io/x/host/v3/cpu"
+)
+
+// Standard speed timings, section "Standard Speed" of application note 126.
+const (
+	owA = 6 * time.Microsecond   // write 1 and read low time
+	owB = 64 * time.Microsecond  // write 1 recovery
+	owC = 60 * time.Microsecond  // write 0 low time
+	owD = 10 * time.Microsecond  // write 0 recovery
+	owE = 9 * time.Microsecond   // read sample delay
+	owF = 55 * time.Microsecond  // read recovery
+	owH = 480 * time.Microsecond // reset low time
+	owI = 70 * time.Microsecond  // presence sample delay
+	owJ = 410 * time.Microsecond // reset recovery
+)
+
+// NewOneWire returns an object that communicates 1-wire over one pin.
+//
+// The pin is driven in open drain: low to pull the line, and as an input to
+// release it. The line needs an external pull-up, typically 4.7kΩ to 3.3V;
+// the internal pull-up of the pin is too weak.
+//
+// Timings are kept by busy looping; they are only met if the process isn't
+// preempted too often, so transactions may fail on a loaded system.
+func NewOneWire(q gpio.PinIO) (*OneWire, error) {
+	if err := q.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return nil, err
+	}
+	return &OneWire{q: q}, nil
+}
+
+// OneWire represents a 1-wire master implemented as bit-banging on a GPIO
+// pin.
+type OneWire struct {
+	mu sync.Mutex
+	q  gpio.PinIO // Data line
+}
+
+func (o *OneWire) String() string {
+	return fmt.Sprintf("bitbang/onewire(%s)", o.q)
+}
+
+// Close implements onewire.BusCloser.
+func (o *OneWire) Close() error {
+	return nil
+}
+
+// Tx implements onewire.Bus.
+//
+// With onewire.StrongPullup, the line is driven high after the last byte
+// until the next transaction, to power parasitically powered devices.
+func (o *OneWire) Tx(w, r []byte, power onewire.Pullup) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := o.reset(); err != nil {
+		return err
+	}
+	for _, b := range w {
+		o.writeByte(b)
+	}
+	for i := range r {
+		r[i] = o.readByte()
+	}
+	if power == onewire.StrongPullup {
+		return o.q.Out(gpio.High)
+	}
+	return nil
+}
+
+// Search implements onewire.Bus.
+func (o *OneWire) Search(alarmOnly bool) ([]onewire.Address, error) {
+	return onewire.Search(o, alarmOnly)
+}
+
+// SearchTriplet implements onewire.BusSearcher.
+//
+// SearchTriplet should not be used directly, use Search instead.
+func (o *OneWire) SearchTriplet(direction byte) (onewire.TripletResult, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	// The devices send the bit of their address then its complement; a 0
+	// wins over a 1 on the line.
+	id := o.readBit()
+	complement := o.readBit()
+	tr := onewire.TripletResult{
+		GotZero: !id,
+		GotOne:  !complement,
+	}
+	switch {
+	case tr.GotZero && tr.GotOne:
+		tr.Taken = direction & 1
+	case tr.GotOne:
+		tr.Taken = 1
+	}
+	o.writeBit(tr.Taken == 1)
+	return tr, nil
+}
+
+// Q implements onewire.Pins.
+func (o *OneWire) Q() gpio.PinIO {
+	return o.q
+}
+
+//
+
+// reset issues a reset pulse and checks for a presence pulse.
+//
+// Lasts 960µs.
+func (o *OneWire) reset() error {
+	if err := o.release(); err != nil {
+		return err
+	}
+	if o.q.Read() == gpio.Low {
+		return shortedBusError("bitbang-onewire: bus is shorted")
+	}
+	if err := o.q.Out(gpio.Low); err != nil {
+		return err
+	}
+	nanospin(owH)
+	if err := o.release(); err != nil {
+		return err
+	}
+	nanospin(owI)
+	// Presence == Low.
+	present := o.q.Read() == gpio.Low
+	nanospin(owJ)
+	if !present {
+		return noDevicesError("bitbang-onewire: no device present")
+	}
+	return nil
+}
+
+// writeByte writes 8 bits, LSB first.
+func (o *OneWire) writeByte(b byte) {
+	for x := 0; x < 8; x++ {
+		o.writeBit(b&(1<<byte(x)) != 0)
+	}
+}
+
+// readByte reads 8 bits, LSB first.
+func (o *OneWire) readByte() byte {
+	var b byte
+	for x := 0; x < 8; x++ {
+		if o.readBit() {
+			b |= 1 << byte(x)
+		}
+	}
+	return b
+}
+
+// writeBit writes one time slot.
+//
+// Lasts 70µs.
+func (o *OneWire) writeBit(bit bool) {
+	_ = o.q.Out(gpio.Low)
+	if bit {
+		nanospin(owA)
+		_ = o.release()
+		nanospin(owB)
+	} else {
+		nanospin(owC)
+		_ = o.release()
+		nanospin(owD)
+	}
+}
+
+// readBit reads one time slot.
+//
+// Lasts 70µs.
+func (o *OneWire) readBit() bool {
+	_ = o.q.Out(gpio.Low)
+	nanospin(owA)
+	_ = o.release()
+	nanospin(owE)
+	bit := o.q.Read() == gpio.High
+	nanospin(owF)
+	return bit
+}
+
+// release lets the pull-up pull the line high.
+func (o *OneWire) release() error {
+	return o.q.In(gpio.PullUp, gpio.NoEdge)
+}
+
+// noDevicesError implements error, onewire.NoDevicesError and
+// onewire.BusError.
+type noDevicesError string
+
+func (e noDevicesError) Error() string   { return string(e) }
+func (e noDevicesError) NoDevices() bool { return true }
+func (e noDevicesError) BusError() bool  { return true }
+
+// shortedBusError implements error, onewire.ShortedBusError and
+// onewire.BusError.
+type shortedBusError string
+
+func (e shortedBusError) Error() string   { return string(e) }
+func (e shortedBusError) IsShorted() bool { return true }
+func (e shortedBusError) BusError() bool  { return true }
+
+var nanospin = cpu.Nanospin
+
+var _ onewire.BusCloser = &OneWire{}
+var _ onewire.BusSearcher = &OneWire{}
+var _ onewire.Pins = &OneWire{}
@@ -0,0 +1,293 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bitbang
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/onewire"
+	"periph.io/x/host/v3/cpu"
+)
+
+func TestNewOneWire(t *testing.T) {
+	p := &gpiotest.Pin{N: "Q", Num: 4}
+	o, err := NewOneWire(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.P != gpio.PullUp {
+		t.Fatalf("pull = %s, want PullUp", p.P)
+	}
+	if s := o.String(); s != "bitbang/onewire(Q(4))" {
+		t.Fatal(s)
+	}
+	if o.Q() != p {
+		t.Fatal("Q() is not the pin")
+	}
+}
+
+func TestOneWire_Tx(t *testing.T) {
+	// Reset with presence, then the bits 0x3C LSB first.
+	p := newOWPin(gpio.High, gpio.Low,
+		gpio.Low, gpio.Low, gpio.High, gpio.High, gpio.High, gpio.High, gpio.Low, gpio.Low)
+	o := newOneWire(t, p)
+	var r [1]byte
+	if err := o.Tx([]byte{0xA5}, r[:], onewire.WeakPullup); err != nil {
+		t.Fatal(err)
+	}
+	if r[0] != 0x3C {
+		t.Fatalf("read %#02x, want 0x3c", r[0])
+	}
+	slots := p.decode(t)
+	if len(slots) != 16 {
+		t.Fatalf("%d slots, want 16", len(slots))
+	}
+	var w byte
+	for i, s := range slots[:8] {
+		if s.read {
+			t.Fatalf("slot %d: read, want write", i)
+		}
+		if s.bit {
+			w |= 1 << byte(i)
+		}
+	}
+	if w != 0xA5 {
+		t.Fatalf("wrote %#02x, want 0xa5", w)
+	}
+	for i, s := range slots[8:] {
+		if !s.read {
+			t.Fatalf("slot %d: write, want read", i+8)
+		}
+	}
+	if p.L != gpio.High || p.P != gpio.PullUp {
+		t.Fatal("line not released")
+	}
+}
+
+func TestOneWire_Tx_StrongPullup(t *testing.T) {
+	p := newOWPin(gpio.High, gpio.Low)
+	o := newOneWire(t, p)
+	if err := o.Tx([]byte{0x44}, nil, onewire.StrongPullup); err != nil {
+		t.Fatal(err)
+	}
+	if last := p.events[len(p.events)-1]; last.op != opHigh {
+		t.Fatalf("last operation %c, want the line driven high", last.op)
+	}
+}
+
+func TestOneWire_Tx_NoDevices(t *testing.T) {
+	p := newOWPin(gpio.High, gpio.High)
+	o := newOneWire(t, p)
+	err := o.Tx([]byte{0xCC}, nil, onewire.WeakPullup)
+	if e, ok := err.(onewire.NoDevicesError); !ok || !e.NoDevices() {
+		t.Fatalf("Tx() = %v, want a NoDevicesError", err)
+	}
+	if e, ok := err.(onewire.BusError); !ok || !e.BusError() {
+		t.Fatalf("Tx() = %v, want a BusError", err)
+	}
+	p.decode(t)
+}
+
+func TestOneWire_Tx_Shorted(t *testing.T) {
+	p := newOWPin(gpio.Low)
+	o := newOneWire(t, p)
+	err := o.Tx([]byte{0xCC}, nil, onewire.WeakPullup)
+	if e, ok := err.(onewire.ShortedBusError); !ok || !e.IsShorted() {
+		t.Fatalf("Tx() = %v, want a ShortedBusError", err)
+	}
+	for _, e := range p.events {
+		if e.op == opLow {
+			t.Fatal("a shorted line was pulled")
+		}
+	}
+}
+
+func TestOneWire_SearchTriplet(t *testing.T) {
+	data := []struct {
+		id, complement gpio.Level
+		direction      byte
+		want           onewire.TripletResult
+	}{
+		// Devices with a 0 and a 1: the direction is taken.
+		{gpio.Low, gpio.Low, 0, onewire.TripletResult{GotZero: true, GotOne: true, Taken: 0}},
+		{gpio.Low, gpio.Low, 1, onewire.TripletResult{GotZero: true, GotOne: true, Taken: 1}},
+		// All devices have the same bit.
+		{gpio.Low, gpio.High, 1, onewire.TripletResult{GotZero: true, Taken: 0}},
+		{gpio.High, gpio.Low, 0, onewire.TripletResult{GotOne: true, Taken: 1}},
+		// No device.
+		{gpio.High, gpio.High, 1, onewire.TripletResult{Taken: 0}},
+	}
+	for i, line := range data {
+		p := newOWPin(line.id, line.complement)
+		o := newOneWire(t, p)
+		tr, err := o.SearchTriplet(line.direction)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if tr != line.want {
+			t.Fatalf("#%d: SearchTriplet() = %+v, want %+v", i, tr, line.want)
+		}
+		slots := p.decodeSlots(t, p.events)
+		if len(slots) != 3 || !slots[0].read || !slots[1].read || slots[2].read {
+			t.Fatalf("#%d: slots %+v, want 2 reads and a write", i, slots)
+		}
+		if got := slots[2].bit; got != (line.want.Taken == 1) {
+			t.Fatalf("#%d: wrote %t, want the taken direction", i, got)
+		}
+	}
+}
+
+//
+
+// Operations on the line, recorded by owPin.
+const (
+	opLow     = 'L' // Out(Low)
+	opHigh    = 'H' // Out(High)
+	opRelease = 'R' // In(PullUp)
+	opRead    = 'r' // Read
+)
+
+type owEvent struct {
+	op byte
+	t  time.Duration
+}
+
+// owPin is a 1-wire line recording the operations and their time, on a clock
+// advanced by nanospin.
+//
+// Read returns levels in order, then High as the idle line.
+type owPin struct {
+	gpiotest.Pin
+	levels []gpio.Level
+	events []owEvent
+	now    time.Duration
+}
+
+func newOWPin(levels ...gpio.Level) *owPin {
+	return &owPin{Pin: gpiotest.Pin{N: "Q"}, levels: levels}
+}
+
+func (p *owPin) Out(l gpio.Level) error {
+	op := byte(opLow)
+	if l == gpio.High {
+		op = opHigh
+	}
+	p.events = append(p.events, owEvent{op, p.now})
+	return p.Pin.Out(l)
+}
+
+func (p *owPin) In(pull gpio.Pull, edge gpio.Edge) error {
+	p.events = append(p.events, owEvent{opRelease, p.now})
+	return p.Pin.In(pull, edge)
+}
+
+func (p *owPin) Read() gpio.Level {
+	p.events = append(p.events, owEvent{opRead, p.now})
+	if len(p.levels) == 0 {
+		return gpio.High
+	}
+	l := p.levels[0]
+	p.levels = p.levels[1:]
+	return l
+}
+
+// newOneWire returns a OneWire on p, with the events of its initialization
+// cleared.
+func newOneWire(t *testing.T, p *owPin) *OneWire {
+	nanospin = func(d time.Duration) { p.now += d }
+	t.Cleanup(func() { nanospin = cpu.Nanospin })
+	o, err := NewOneWire(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.events = nil
+	return o
+}
+
+// slot is a decoded time slot.
+type slot struct {
+	read bool
+	bit  bool // the written bit
+}
+
+// decode checks the reset pulse and the timings of the time slots that
+// follow, and returns the slots.
+func (p *owPin) decode(t *testing.T) []slot {
+	t.Helper()
+	ev := p.events
+	if len(ev) < 5 || p.ops(ev[:5]) != "RrLRr" {
+		t.Fatalf("reset %s, want RrLRr", p.ops(ev))
+	}
+	if d := ev[3].t - ev[2].t; d != owH {
+		t.Fatalf("reset low for %s, want %s", d, owH)
+	}
+	if d := ev[4].t - ev[3].t; d != owI {
+		t.Fatalf("presence sampled after %s, want %s", d, owI)
+	}
+	if d := p.next(ev, 5) - ev[4].t; d != owJ {
+		t.Fatalf("reset recovery %s, want %s", d, owJ)
+	}
+	return p.decodeSlots(t, ev[5:])
+}
+
+// decodeSlots checks the timings of time slots and returns them.
+func (p *owPin) decodeSlots(t *testing.T, ev []owEvent) []slot {
+	t.Helper()
+	var slots []slot
+	for i := 0; i < len(ev); {
+		if ev[i].op == opHigh && i == len(ev)-1 {
+			break
+		}
+		if i+1 >= len(ev) || ev[i].op != opLow || ev[i+1].op != opRelease {
+			t.Fatalf("slot %d: %s, want LR", len(slots), p.ops(ev[i:]))
+		}
+		low := ev[i+1].t - ev[i].t
+		if i+2 < len(ev) && ev[i+2].op == opRead {
+			if low != owA {
+				t.Fatalf("slot %d: read low for %s, want %s", len(slots), low, owA)
+			}
+			if d := ev[i+2].t - ev[i+1].t; d != owE {
+				t.Fatalf("slot %d: sampled after %s, want %s", len(slots), d, owE)
+			}
+			if d := p.next(ev, i+3) - ev[i+2].t; d != owF {
+				t.Fatalf("slot %d: read recovery %s, want %s", len(slots), d, owF)
+			}
+			slots = append(slots, slot{read: true})
+			i += 3
+			continue
+		}
+		var s slot
+		recovery := p.next(ev, i+2) - ev[i+1].t
+		switch {
+		case low == owA && recovery == owB:
+			s.bit = true
+		case low == owC && recovery == owD:
+		default:
+			t.Fatalf("slot %d: low for %s then released for %s, want %s/%s or %s/%s", len(slots), low, recovery, owA, owB, owC, owD)
+		}
+		slots = append(slots, s)
+		i += 2
+	}
+	return slots
+}
+
+// next returns the time of ev[i], or the current time after the last event.
+func (p *owPin) next(ev []owEvent, i int) time.Duration {
+	if i < len(ev) {
+		return ev[i].t
+	}
+	return p.now
+}
+
+func (p *owPin) ops(ev []owEvent) string {
+	b := make([]byte, len(ev))
+	for i, e := range ev {
+		b[i] = e.op
+	}
+	return string(b)
+}
@@ -0,0 +1,487 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// I²C addresses, selected by the SDO pin.
+const (
+	DefaultAddr = 0x76 // SDO low
+	AltAddr     = 0x77 // SDO high
+)
+
+// Register map.
+const (
+	regResHeatVal = 0x00 // res_heat_val, then res_heat_range and range_sw_err
+	regMeasStatus = 0x1D // status, gas index, press, temp, hum, gas
+	regResHeat0   = 0x5A
+	regGasWait0   = 0x64
+	regCtrlGas1   = 0x71 // run_gas bit 4, nb_conv bits 3..0
+	regCtrlHum    = 0x72 // osrs_h bits 2..0
+	regCtrlMeas   = 0x74 // osrs_t bits 7..5, osrs_p bits 4..2, mode bits 1..0
+	regConfig     = 0x75 // filter bits 4..2
+	regCoeff1     = 0x89
+	regChipID     = 0xD0
+	regReset      = 0xE0
+	regCoeff2     = 0xE1
+)
+
+// Lengths of the register blocks.
+const (
+	coeff1Len    = 25
+	coeff2Len    = 16
+	heatCalibLen = 5
+	dataLen      = 15
+)
+
+// Register values.
+const (
+	chipID         = 0x61
+	resetCmd       = 0xB6
+	modeForced     = 0x01
+	ctrlGas1RunGas = 0x10
+	statusNewData  = 0x80
+	gasLSBValid    = 0x20
+	gasLSBHeatStab = 0x10
+)
+
+// Limits of the heater profile.
+const (
+	maxHeaterTemp = 400 // °C
+	maxHeaterDur  = 4032 * time.Millisecond
+)
+
+// defaultAmbient is the ambient temperature in °C assumed for the heater
+// before the first measurement.
+const defaultAmbient = 25
+
+// resetTime is the start up time after a soft reset.
+const resetTime = 5 * time.Millisecond
+
+// dataPolls is the number of times the data is polled for completion after
+// the expected measurement duration, one millisecond apart.
+const dataPolls = 10
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the chip ID doesn't read 0x61.
+	ErrBadID = errors.New("bme680: bad chip ID")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("bme680: invalid options")
+	// ErrNotReady is returned when a measurement doesn't complete in time.
+	ErrNotReady = errors.New("bme680: measurement not ready")
+)
+
+// Oversampling affects how much time is taken to measure each of
+// temperature, pressure and humidity.
+type Oversampling uint8
+
+// Possible oversampling values.
+const (
+	Off  Oversampling = 0
+	O1x  Oversampling = 1
+	O2x  Oversampling = 2
+	O4x  Oversampling = 3
+	O8x  Oversampling = 4
+	O16x Oversampling = 5
+)
+
+// cycles is the number of conversions of each oversampling.
+var cycles = [...]int{0, 1, 2, 4, 8, 16}
+
+func (o Oversampling) String() string {
+	switch {
+	case o == Off:
+		return "Off"
+	case o <= O16x:
+		return fmt.Sprintf("%dx", cycles[o])
+	}
+	return fmt.Sprintf("Oversampling(%d)", uint8(o))
+}
+
+// Filter is the coefficient of the IIR filter applied to the temperature and
+// pressure.
+type Filter uint8
+
+// Possible filter coefficients. The higher the coefficient, the slower the
+// value converges but the more stable the measurement is.
+const (
+	NoFilter Filter = 0
+	F1       Filter = 1
+	F3       Filter = 2
+	F7       Filter = 3
+	F15      Filter = 4
+	F31      Filter = 5
+	F63      Filter = 6
+	F127     Filter = 7
+)
+
+// HeaterProfile is the heating of the gas sensor before each gas measurement.
+//
+// Temperature: target temperature of the plate, 200 to 400°C. 320°C for
+// 150ms is a typical profile.
+// Duration: heating time, up to 4.032s. The precision is lower above 63ms.
+//
+// The zero value disables the gas measurements.
+type HeaterProfile struct {
+	Temperature physic.Temperature
+	Duration    time.Duration
+}
+
+func (h *HeaterProfile) enabled() bool {
+	return h.Duration != 0
+}
+
+// DefaultOpts are the options recommended by the manufacturer for indoor air
+// quality.
+var DefaultOpts = Opts{
+	Temperature: O8x,
+	Pressure:    O4x,
+	Humidity:    O2x,
+	Filter:      F3,
+	Heater:      HeaterProfile{Temperature: physic.ZeroCelsius + 320*physic.Celsius, Duration: 150 * time.Millisecond},
+}
+
+// Opts holds initialization options.
+//
+// Temperature: oversampling of the temperature, which is required to
+// compensate the other measurements. At least O1x.
+// Pressure and Humidity: oversampling, Off to skip the measurement.
+// Filter: IIR filter of the temperature and pressure.
+// Heater: heater profile of the gas measurements, disabled by default.
+// Addr: I²C address, DefaultAddr by default.
+type Opts struct {
+	Temperature Oversampling
+	Pressure    Oversampling
+	Humidity    Oversampling
+	Filter      Filter
+	Heater      HeaterProfile
+	Addr        uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Temperature == Off || o.Temperature > O16x {
+		return fmt.Errorf("%w: Temperature %s, want O1x to O16x", ErrInvalidOpts, o.Temperature)
+	}
+	if o.Pressure > O16x {
+		return fmt.Errorf("%w: Pressure %s, want Off or O1x to O16x", ErrInvalidOpts, o.Pressure)
+	}
+	if o.Humidity > O16x {
+		return fmt.Errorf("%w: Humidity %s, want Off or O1x to O16x", ErrInvalidOpts, o.Humidity)
+	}
+	if o.Filter > F127 {
+		return fmt.Errorf("%w: Filter %d, want NoFilter to F127", ErrInvalidOpts, o.Filter)
+	}
+	return o.Heater.validate()
+}
+
+func (h *HeaterProfile) validate() error {
+	if !h.enabled() {
+		return nil
+	}
+	if c := h.celsius(); c < 200 || c > maxHeaterTemp {
+		return fmt.Errorf("%w: Heater.Temperature %s, want 200°C to 400°C", ErrInvalidOpts, h.Temperature)
+	}
+	if h.Duration < time.Millisecond || h.Duration > maxHeaterDur {
+		return fmt.Errorf("%w: Heater.Duration %s, want 1ms to %s", ErrInvalidOpts, h.Duration, maxHeaterDur)
+	}
+	return nil
+}
+
+func (h *HeaterProfile) celsius() int32 {
+	return int32((h.Temperature - physic.ZeroCelsius) / physic.Celsius)
+}
+
+// measDuration returns the duration of a forced measurement, rounded up to
+// the millisecond as in the reference driver.
+func (o *Opts) measDuration() time.Duration {
+	µs := (cycles[o.Temperature] + cycles[o.Pressure] + cycles[o.Humidity]) * 1963
+	// Switching between the measurements, the gas measurement and the wake
+	// up.
+	µs += 477*4 + 477*5 + 500
+	d := time.Duration((µs+500)/1000+1) * time.Millisecond
+	if o.Heater.enabled() {
+		d += o.Heater.Duration
+	}
+	return d
+}
+
+// Measurement is the result of SenseGas.
+type Measurement struct {
+	physic.Env
+	// GasResistance is the resistance of the heated plate. It is 0 without a
+	// heater profile.
+	GasResistance physic.ElectricResistance
+	// GasValid reports that the gas measurement completed.
+	GasValid bool
+	// HeaterStable reports that the plate reached the target temperature.
+	// The resistance is meaningless otherwise.
+	HeaterStable bool
+}
+
+func (m *Measurement) String() string {
+	return fmt.Sprintf("%s %s %s %s", m.Temperature, m.Pressure, m.Humidity, m.GasResistance)
+}
+
+// Dev is a handle to an initialized BME680 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c         conn.Conn
+	opts      Opts
+	measDelay time.Duration
+	cal       calibration
+	ambient   int32 // last measured temperature in °C, to compute the heater resistance
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+//
+// It is recommended to call Halt() when done with the device so it stops
+// sampling.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{
+		c:         &i2c.Dev{Bus: bus, Addr: addr},
+		opts:      opts,
+		measDelay: opts.measDuration(),
+		ambient:   defaultAmbient,
+	}
+	var id [1]byte
+	if err := d.readReg(regChipID, id[:]); err != nil {
+		return nil, err
+	}
+	if id[0] != chipID {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id[0], chipID)
+	}
+	if err := d.writeCommands(regReset, resetCmd); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	var c1 [coeff1Len]byte
+	var c2 [coeff2Len]byte
+	var h [heatCalibLen]byte
+	if err := d.readReg(regCoeff1, c1[:]); err != nil {
+		return nil, err
+	}
+	if err := d.readReg(regCoeff2, c2[:]); err != nil {
+		return nil, err
+	}
+	if err := d.readReg(regResHeatVal, h[:]); err != nil {
+		return nil, err
+	}
+	d.cal = newCalibration(c1[:], c2[:], h[:])
+	// As with the BME280, ctrl_hum takes effect when ctrl_meas is written,
+	// so it goes first.
+	cmds := []byte{
+		regCtrlHum, byte(opts.Humidity),
+		regCtrlMeas, d.ctrlMeas(0),
+		regConfig, byte(opts.Filter) << 2,
+	}
+	cmds = append(cmds, d.heaterCommands(&opts.Heater)...)
+	if err := d.writeCommands(cmds...); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("BME680{%s}", d.c)
+}
+
+// ctrlMeas returns the ctrl_meas register value for the mode.
+func (d *Dev) ctrlMeas(mode byte) byte {
+	return byte(d.opts.Temperature)<<5 | byte(d.opts.Pressure)<<2 | mode
+}
+
+// Sense requests a one time measurement as °C, kPa and % of relative
+// humidity. It implements physic.SenseEnv.
+//
+// With a heater profile, it also takes the gas measurement, which is
+// discarded; use SenseGas to read it.
+func (d *Dev) Sense(e *physic.Env) error {
+	var m Measurement
+	if err := d.SenseGas(&m); err != nil {
+		return err
+	}
+	*e = m.Env
+	return nil
+}
+
+// SenseGas requests a one time measurement, including the gas resistance
+// with a heater profile.
+func (d *Dev) SenseGas(m *Measurement) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("bme680: already sensing continuously")
+	}
+	return d.sense(m)
+}
+
+// SenseContinuous returns measurements as °C, kPa and % of relative humidity
+// on a continuous basis. It implements physic.SenseEnv.
+//
+// Each measurement is a forced measurement; the interval must be longer than
+// its duration, including the heater profile.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval < d.measDelay {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, d.measDelay)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var m Measurement
+		d.mu.Lock()
+		err := d.sense(&m)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- m.Env:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 10 * physic.MilliKelvin
+	e.Pressure = physic.Pascal
+	e.Humidity = 100 * physic.TenthMicroRH
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device sleeps between measurements.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense triggers a forced measurement and reads it.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(m *Measurement) error {
+	if err := d.writeCommands(regCtrlMeas, d.ctrlMeas(modeForced)); err != nil {
+		return err
+	}
+	doSleep(d.measDelay)
+	var b [dataLen]byte
+	for i := 0; ; i++ {
+		if err := d.readReg(regMeasStatus, b[:]); err != nil {
+			return err
+		}
+		if b[0]&statusNewData != 0 {
+			break
+		}
+		if i == dataPolls {
+			return ErrNotReady
+		}
+		doSleep(time.Millisecond)
+	}
+	// The ADC values are 20 bits for the pressure and temperature, 16 bits for
+	// the humidity and 10 bits for the gas.
+	pRaw := int32(b[2])<<12 | int32(b[3])<<4 | int32(b[4])>>4
+	tRaw := int32(b[5])<<12 | int32(b[6])<<4 | int32(b[7])>>4
+	hRaw := int32(b[8])<<8 | int32(b[9])
+	t, tFine := d.cal.compensateTemp(tRaw)
+	// Convert centi °C to Kelvin.
+	m.Temperature = physic.Temperature(t)*10*physic.MilliCelsius + physic.ZeroCelsius
+	d.ambient = t / 100
+	if d.opts.Pressure != Off {
+		m.Pressure = physic.Pressure(d.cal.compensatePressure(pRaw, tFine)) * physic.Pascal
+	}
+	if d.opts.Humidity != Off {
+		// Convert milli %RH.
+		m.Humidity = physic.RelativeHumidity(d.cal.compensateHumidity(hRaw, tFine)) * (physic.PercentRH / 1000)
+	}
+	if d.opts.Heater.enabled() {
+		gRaw := uint32(b[13])<<2 | uint32(b[14])>>6
+		gasRange := b[14] & 0x0F
+		m.GasValid = b[14]&gasLSBValid != 0
+		m.HeaterStable = b[14]&gasLSBHeatStab != 0
+		m.GasResistance = physic.ElectricResistance(d.cal.gasResistance(gRaw, gasRange)) * physic.Ohm
+	}
+	return nil
+}
+
+func (d *Dev) readReg(reg uint8, b []byte) error {
+	if err := d.c.Tx([]byte{reg}, b); err != nil {
+		return fmt.Errorf("bme680: reading register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
+
+// writeCommands writes register and value pairs in one transaction.
+func (d *Dev) writeCommands(b ...byte) error {
+	if err := d.c.Tx(b, nil); err != nil {
+		return fmt.Errorf("bme680: writing register 0x%02x: %w", b[0], err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,307 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// Calibration data of a device, as read at 0x89, 0xE1 and 0x00.
+var (
+	testCoeff1 = []byte{
+		0x00, 0xA9, 0x67, 0x03, 0x00, 0x8A, 0x8F, 0x45, 0xD7, 0x58, 0x00, 0xA2, 0x0C,
+		0x8F, 0xFF, 0x2F, 0x1E, 0x00, 0x00, 0x4E, 0xF4, 0x68, 0xF6, 0x1E, 0x00,
+	}
+	testCoeff2 = []byte{0x3F, 0x1F, 0x30, 0x00, 0x2D, 0x14, 0x78, 0x9C, 0x55, 0x65, 0xED, 0xD3, 0xCE, 0x12, 0x00, 0x00}
+	testHeat   = []byte{0x2C, 0x00, 0x10, 0x00, 0x00}
+)
+
+// initOps are the bus transactions issued by New, ending with the write of
+// the configuration cmds.
+func initOps(cmds ...byte) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regChipID}, R: []byte{chipID}},
+		{Addr: DefaultAddr, W: []byte{regReset, resetCmd}},
+		{Addr: DefaultAddr, W: []byte{regCoeff1}, R: testCoeff1},
+		{Addr: DefaultAddr, W: []byte{regCoeff2}, R: testCoeff2},
+		{Addr: DefaultAddr, W: []byte{regResHeatVal}, R: testHeat},
+		{Addr: DefaultAddr, W: cmds},
+	}
+}
+
+// defaultOps are the bus transactions issued by New with DefaultOpts.
+func defaultOps() []i2ctest.IO {
+	return initOps(0x72, 0x02, 0x74, 0x8C, 0x75, 0x08, 0x5A, 0x6D, 0x64, 0x65, 0x71, 0x10)
+}
+
+// senseOps are the bus transactions of a measurement with DefaultOpts,
+// returning 26.88°C, 101.770kPa, 37.184%rH and 248.262kΩ.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regCtrlMeas, 0x8D}},
+		{Addr: DefaultAddr, W: []byte{regMeasStatus}, R: testData},
+	}
+}
+
+var testData = []byte{0x80, 0x00, 0x61, 0xA8, 0x00, 0x7A, 0x12, 0x00, 0x4E, 0x20, 0x00, 0x00, 0x00, 0x80, 0x35}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		cmds []byte
+		d    time.Duration
+	}{
+		{DefaultOpts, []byte{0x72, 0x02, 0x74, 0x8C, 0x75, 0x08, 0x5A, 0x6D, 0x64, 0x65, 0x71, 0x10}, 183 * time.Millisecond},
+		{Opts{Temperature: O1x}, []byte{0x72, 0x00, 0x74, 0x20, 0x75, 0x00, 0x71, 0x00}, 8 * time.Millisecond},
+		{
+			Opts{Temperature: O2x, Pressure: O16x, Humidity: O1x, Filter: F127},
+			[]byte{0x72, 0x01, 0x74, 0x54, 0x75, 0x1C, 0x71, 0x00},
+			43 * time.Millisecond,
+		},
+	}
+	for i, line := range data {
+		bus := i2ctest.Playback{Ops: initOps(line.cmds...)}
+		d, err := New(&bus, line.opts)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if d.measDelay != line.d {
+			t.Fatalf("#%d: measurement duration %s, want %s", i, d.measDelay, line.d)
+		}
+		if s := d.String(); s != "BME680{playback(118)}" {
+			t.Fatal(s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestNew_addr(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{{Addr: AltAddr, W: []byte{regChipID}, R: []byte{0x60}}}}
+	if _, err := New(&bus, Opts{Temperature: O1x, Addr: AltAddr}); !errors.Is(err, ErrBadID) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(defaultOps()); i++ {
+		bus := i2ctest.Playback{Ops: defaultOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{}, "bme680: invalid options: Temperature Off, want O1x to O16x"},
+		{Opts{Temperature: 6}, "bme680: invalid options: Temperature Oversampling(6), want O1x to O16x"},
+		{Opts{Temperature: O1x, Pressure: 6}, "bme680: invalid options: Pressure Oversampling(6), want Off or O1x to O16x"},
+		{Opts{Temperature: O1x, Humidity: 6}, "bme680: invalid options: Humidity Oversampling(6), want Off or O1x to O16x"},
+		{Opts{Temperature: O1x, Filter: 8}, "bme680: invalid options: Filter 8, want NoFilter to F127"},
+		{
+			Opts{Temperature: O1x, Heater: HeaterProfile{Temperature: physic.ZeroCelsius + 150*physic.Celsius, Duration: time.Millisecond}},
+			"bme680: invalid options: Heater.Temperature 150°C, want 200°C to 400°C",
+		},
+		{
+			Opts{Temperature: O1x, Heater: HeaterProfile{Temperature: physic.ZeroCelsius + 300*physic.Celsius, Duration: 5 * time.Second}},
+			"bme680: invalid options: Heater.Duration 5s, want 1ms to 4.032s",
+		},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(i, err)
+		}
+	}
+	if err := DefaultOpts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseGas(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(defaultOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m Measurement
+	if err := d.SenseGas(&m); err != nil {
+		t.Fatal(err)
+	}
+	want := Measurement{
+		Env: physic.Env{
+			Temperature: physic.ZeroCelsius + 26880*physic.MilliCelsius,
+			Pressure:    101770 * physic.Pascal,
+			Humidity:    37184 * (physic.PercentRH / 1000),
+		},
+		GasResistance: 248262 * physic.Ohm,
+		GasValid:      true,
+		HeaterStable:  true,
+	}
+	if m != want {
+		t.Fatalf("%#v, want %#v", m, want)
+	}
+	if s := m.String(); s != "26.880°C 101.770kPa 37.1%rH 248.262kΩ" {
+		t.Fatal(s)
+	}
+	if d.ambient != 26 {
+		t.Fatal(d.ambient)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	// Without the gas measurement nor the humidity.
+	bus := i2ctest.Playback{Ops: append(
+		initOps(0x72, 0x00, 0x74, 0x8C, 0x75, 0x00, 0x71, 0x00),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCtrlMeas, 0x8D}},
+		// Not ready yet.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMeasStatus}, R: make([]byte, dataLen)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMeasStatus}, R: testData},
+	)}
+	d, err := New(&bus, Opts{Temperature: O8x, Pressure: O4x})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	want := physic.Env{Temperature: physic.ZeroCelsius + 26880*physic.MilliCelsius, Pressure: 101770 * physic.Pascal}
+	if e != want {
+		t.Fatalf("%#v, want %#v", e, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_not_ready(t *testing.T) {
+	ops := append(defaultOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCtrlMeas, 0x8D}})
+	for i := 0; i <= dataPolls; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regMeasStatus}, R: make([]byte, dataLen)})
+	}
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(defaultOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(defaultOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(100 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(200 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := physic.Env{
+		Temperature: physic.ZeroCelsius + 26880*physic.MilliCelsius,
+		Pressure:    101770 * physic.Pascal,
+		Humidity:    37184 * (physic.PercentRH / 1000),
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != want {
+			t.Fatalf("#%d: %#v, want %#v", i, e, want)
+		}
+	}
+	var m Measurement
+	if err := d.SenseGas(&m); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 10*physic.MilliKelvin {
+		t.Fatal(e.Temperature)
+	}
+	if e.Pressure != physic.Pascal {
+		t.Fatal(e.Pressure)
+	}
+	if e.Humidity != 100*physic.TenthMicroRH {
+		t.Fatal(e.Humidity)
+	}
+}
+
+func TestOversampling_String(t *testing.T) {
+	data := []struct {
+		o Oversampling
+		s string
+	}{
+		{Off, "Off"},
+		{O1x, "1x"},
+		{O16x, "16x"},
+		{6, "Oversampling(6)"},
+	}
+	for _, line := range data {
+		if s := line.o.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
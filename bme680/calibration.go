@@ -0,0 +1,123 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680
+
+// calibration holds the compensation coefficients of the device.
+type calibration struct {
+	t1                 uint16
+	t2                 int16
+	t3                 int8
+	p1                 uint16
+	p2, p4, p5, p8, p9 int16
+	p3, p6, p7         int8
+	p10                uint8
+	h1, h2             uint16
+	h3, h4, h5, h7     int8
+	h6                 uint8
+	gh1, gh3           int8
+	gh2                int16
+	resHeatRange       uint8
+	resHeatVal         int8
+	rangeSwErr         int8
+}
+
+// newCalibration parses the calibration data read at 0x89, 0xE1 and 0x00.
+func newCalibration(c1, c2, h []byte) (c calibration) {
+	c.t2 = int16(c1[1]) | int16(c1[2])<<8
+	c.t3 = int8(c1[3])
+	c.p1 = uint16(c1[5]) | uint16(c1[6])<<8
+	c.p2 = int16(c1[7]) | int16(c1[8])<<8
+	c.p3 = int8(c1[9])
+	c.p4 = int16(c1[11]) | int16(c1[12])<<8
+	c.p5 = int16(c1[13]) | int16(c1[14])<<8
+	c.p7 = int8(c1[15])
+	c.p6 = int8(c1[16])
+	c.p8 = int16(c1[19]) | int16(c1[20])<<8
+	c.p9 = int16(c1[21]) | int16(c1[22])<<8
+	c.p10 = c1[23]
+
+	// H1 and H2 are 12 bits, sharing the nibbles of one byte.
+	c.h2 = uint16(c2[0])<<4 | uint16(c2[1])>>4
+	c.h1 = uint16(c2[2])<<4 | uint16(c2[1])&0x0F
+	c.h3 = int8(c2[3])
+	c.h4 = int8(c2[4])
+	c.h5 = int8(c2[5])
+	c.h6 = c2[6]
+	c.h7 = int8(c2[7])
+	c.t1 = uint16(c2[8]) | uint16(c2[9])<<8
+	c.gh2 = int16(c2[10]) | int16(c2[11])<<8
+	c.gh1 = int8(c2[12])
+	c.gh3 = int8(c2[13])
+
+	c.resHeatVal = int8(h[0])
+	c.resHeatRange = h[2] >> 4 & 0x03
+	// The switching error is the signed high nibble.
+	c.rangeSwErr = int8(h[4]) >> 4
+	return c
+}
+
+// compensateTemp returns the temperature in °C with a resolution of 0.01°C,
+// and the fine temperature used by the other compensations. Output value of
+// 5123 equals 51.23°C.
+//
+// raw has 20 bits of resolution.
+func (c *calibration) compensateTemp(raw int32) (int32, int32) {
+	x1 := raw>>3 - int32(c.t1)<<1
+	x2 := x1 * int32(c.t2) >> 11
+	x3 := (x1 >> 1) * (x1 >> 1) >> 12
+	x3 = x3 * (int32(c.t3) << 4) >> 14
+	tFine := x2 + x3
+	return (tFine*5 + 128) >> 8, tFine
+}
+
+// compensatePressure returns the pressure in Pa.
+//
+// raw has 20 bits of resolution.
+func (c *calibration) compensatePressure(raw, tFine int32) uint32 {
+	x1 := tFine>>1 - 64000
+	x2 := ((x1 >> 2) * (x1 >> 2) >> 11) * int32(c.p6) >> 2
+	x2 += x1 * int32(c.p5) << 1
+	x2 = x2>>2 + int32(c.p4)<<16
+	x1 = (((x1>>2)*(x1>>2))>>13)*(int32(c.p3)<<5)>>3 + int32(c.p2)*x1>>1
+	x1 >>= 18
+	x1 = (32768 + x1) * int32(c.p1) >> 15
+	if x1 == 0 {
+		return 0
+	}
+	p := 1048576 - raw
+	p = int32(uint32(p-x2>>12) * 3125)
+	// Avoid the overflow of p<<1.
+	if p >= 1<<30 {
+		p = p / x1 << 1
+	} else {
+		p = (p << 1) / x1
+	}
+	x1 = int32(c.p9) * (((p >> 3) * (p >> 3)) >> 13) >> 12
+	x2 = (p >> 2) * int32(c.p8) >> 13
+	x3 := (p >> 8) * (p >> 8) * (p >> 8) * int32(c.p10) >> 17
+	return uint32(p + (x1+x2+x3+int32(c.p7)<<7)>>4)
+}
+
+// compensateHumidity returns the humidity in %RH with a resolution of
+// 0.001%RH, clamped to 0 to 100%. Output value of 46333 equals 46.333%RH.
+//
+// raw has 16 bits of resolution.
+func (c *calibration) compensateHumidity(raw, tFine int32) uint32 {
+	t := (tFine*5 + 128) >> 8
+	x1 := raw - int32(c.h1)*16 - (t*int32(c.h3)/100)>>1
+	x2 := int32(c.h2) * (t*int32(c.h4)/100 + (t*(t*int32(c.h5)/100))>>6/100 + 1<<14) >> 10
+	x3 := x1 * x2
+	x4 := (int32(c.h6)<<7 + t*int32(c.h7)/100) >> 4
+	x5 := ((x3 >> 14) * (x3 >> 14)) >> 10
+	x6 := x4 * x5 >> 1
+	h := ((x3 + x6) >> 10) * 1000 >> 12
+	switch {
+	case h > 100000:
+		return 100000
+	case h < 0:
+		return 0
+	}
+	return uint32(h)
+}
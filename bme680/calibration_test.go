@@ -0,0 +1,57 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680
+
+import "testing"
+
+func TestNewCalibration(t *testing.T) {
+	want := calibration{
+		t1: 25941, t2: 26537, t3: 3,
+		p1: 36746, p2: -10427, p3: 88, p4: 3234, p5: -113, p6: 30, p7: 47, p8: -2994, p9: -2456, p10: 30,
+		h1: 783, h2: 1009, h3: 0, h4: 45, h5: 20, h6: 120, h7: -100,
+		gh1: -50, gh2: -11283, gh3: 18,
+		resHeatRange: 1, resHeatVal: 44,
+	}
+	if c := newCalibration(testCoeff1, testCoeff2, testHeat); c != want {
+		t.Fatalf("%+v, want %+v", c, want)
+	}
+	h := []byte{0x2C, 0x00, 0x10, 0x00, 0xE0}
+	if c := newCalibration(testCoeff1, testCoeff2, h); c.rangeSwErr != -2 {
+		t.Fatal(c.rangeSwErr)
+	}
+}
+
+// The expected values are within rounding of the floating point formulas of
+// the datasheet.
+func TestCompensate(t *testing.T) {
+	c := newCalibration(testCoeff1, testCoeff2, testHeat)
+	temp, tFine := c.compensateTemp(500000)
+	if temp != 2688 {
+		t.Fatal(temp)
+	}
+	data := []struct {
+		raw  int32
+		want uint32
+	}{
+		{380000, 105210},
+		{400000, 101770},
+		{420000, 98341},
+	}
+	for _, line := range data {
+		if p := c.compensatePressure(line.raw, tFine); p != line.want {
+			t.Fatalf("%d: %dPa, want %dPa", line.raw, p, line.want)
+		}
+	}
+	if h := c.compensateHumidity(20000, tFine); h != 37184 {
+		t.Fatal(h)
+	}
+	// Clamped.
+	if h := c.compensateHumidity(0, tFine); h != 0 {
+		t.Fatal(h)
+	}
+	if h := c.compensateHumidity(65535, tFine); h != 100000 {
+		t.Fatal(h)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package bme680 controls a Bosch BME680 gas, pressure, temperature and
+// humidity sensor over I²C.
+//
+// # More details
+//
+// The temperature, pressure and humidity are compensated with the integer
+// formulas of the datasheet from the calibration coefficients of each device,
+// like the BME280 of the bmxx80 package. Each measurement is a forced
+// measurement; the IIR filter smooths the pressure and temperature across
+// them.
+//
+// With a heater profile, each measurement also heats the metal oxide plate to
+// the target temperature, then reads its resistance. The resistance drops in
+// the presence of volatile organic compounds; it is returned raw with
+// SenseGas, to be tracked against a baseline or fed to an air quality index
+// algorithm. The plate takes a few minutes of regular measurements to
+// stabilize after power up.
+//
+// # Datasheet
+//
+// https://www.bosch-sensortec.com/media/boschsensortec/downloads/datasheets/bst-bme680-ds001.pdf
+package bme680
@@ -0,0 +1,47 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/bme680"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := bme680.New(bus, bme680.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize bme680: %v", err)
+	}
+	defer d.Halt()
+
+	// Sample every 3 seconds, as recommended for a steady gas resistance.
+	for range time.Tick(3 * time.Second) {
+		var m bme680.Measurement
+		if err := d.SenseGas(&m); err != nil {
+			log.Fatal(err)
+		}
+		if !m.HeaterStable {
+			continue
+		}
+		fmt.Println(&m)
+	}
+}
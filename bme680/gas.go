@@ -0,0 +1,96 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680
+
+import (
+	"time"
+)
+
+// SetHeater changes the heater profile. The zero value disables the gas
+// measurements.
+//
+// The heater resistance for the target temperature is computed for the last
+// measured temperature, or 25°C before the first measurement; calling
+// SetHeater again after the ambient temperature changed keeps the target
+// accurate.
+func (d *Dev) SetHeater(h HeaterProfile) error {
+	if err := h.validate(); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeCommands(d.heaterCommands(&h)...); err != nil {
+		return err
+	}
+	d.opts.Heater = h
+	d.measDelay = d.opts.measDuration()
+	return nil
+}
+
+// heaterCommands returns the register writes selecting the heater profile h
+// as profile 0, or disabling the gas measurements.
+func (d *Dev) heaterCommands(h *HeaterProfile) []byte {
+	if !h.enabled() {
+		return []byte{regCtrlGas1, 0}
+	}
+	return []byte{
+		regResHeat0, d.cal.heaterResistance(h.celsius(), d.ambient),
+		regGasWait0, gasWait(h.Duration),
+		// nb_conv selects profile 0.
+		regCtrlGas1, ctrlGas1RunGas,
+	}
+}
+
+// gasWait encodes the heating duration: 6 bits of milliseconds and a 2 bits
+// multiplier by 1, 4, 16 or 64.
+func gasWait(d time.Duration) byte {
+	ms := d.Milliseconds()
+	if ms >= 0xFC0 {
+		return 0xFF
+	}
+	var factor byte
+	for ms > 0x3F {
+		ms /= 4
+		factor++
+	}
+	return factor<<6 | byte(ms)
+}
+
+// heaterResistance returns the res_heat register value heating the plate to
+// target from ambient, both in °C.
+func (c *calibration) heaterResistance(target, ambient int32) byte {
+	if target > maxHeaterTemp {
+		target = maxHeaterTemp
+	}
+	x1 := ambient * int32(c.gh3) / 1000 * 256
+	x2 := (int32(c.gh1) + 784) * (((int32(c.gh2)+154009)*target*5/100 + 3276800) / 10)
+	x3 := x1 + x2/2
+	x4 := x3 / (int32(c.resHeatRange) + 4)
+	x5 := 131*int32(c.resHeatVal) + 65536
+	resX100 := (x4/x5 - 250) * 34
+	return byte((resX100 + 50) / 100)
+}
+
+// gasLookup1 and gasLookup2 are the constants of the gas resistance of each
+// range.
+var (
+	gasLookup1 = [16]int64{
+		2147483647, 2147483647, 2147483647, 2147483647, 2147483647, 2126008810, 2147483647, 2130303777,
+		2147483647, 2147483647, 2143188679, 2136746228, 2147483647, 2126008810, 2147483647, 2147483647,
+	}
+	gasLookup2 = [16]int64{
+		4096000000, 2048000000, 1024000000, 512000000, 255744255, 127110228, 64000000, 32258064,
+		16016016, 8000000, 4000000, 2000000, 1000000, 500000, 250000, 125000,
+	}
+)
+
+// gasResistance returns the resistance in Ω of the 10 bits ADC value in the
+// range.
+func (c *calibration) gasResistance(raw uint32, gasRange byte) uint32 {
+	x1 := ((1340 + 5*int64(c.rangeSwErr)) * gasLookup1[gasRange]) >> 16
+	x2 := int64(raw)<<15 - 16777216 + x1
+	x3 := (gasLookup2[gasRange] * x1) >> 9
+	return uint32((x3 + x2>>1) / x2)
+}
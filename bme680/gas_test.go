@@ -0,0 +1,102 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bme680
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSetHeater(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(
+		initOps(0x72, 0x00, 0x74, 0x20, 0x75, 0x00, 0x71, 0x00),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x5A, 0x50, 0x64, 0xBE, 0x71, 0x10}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x71, 0x00}},
+	)}
+	d, err := New(&bus, Opts{Temperature: O1x})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(HeaterProfile{Temperature: physic.ZeroCelsius + 450*physic.Celsius, Duration: time.Second}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(HeaterProfile{Temperature: physic.ZeroCelsius + 200*physic.Celsius, Duration: time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if d.measDelay != 1008*time.Millisecond {
+		t.Fatal(d.measDelay)
+	}
+	if err := d.SetHeater(HeaterProfile{}); err != nil {
+		t.Fatal(err)
+	}
+	if d.measDelay != 8*time.Millisecond {
+		t.Fatal(d.measDelay)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGasWait(t *testing.T) {
+	data := []struct {
+		d    time.Duration
+		want byte
+	}{
+		{time.Millisecond, 0x01},
+		{63 * time.Millisecond, 0x3F},
+		{64 * time.Millisecond, 0x50},
+		{150 * time.Millisecond, 0x65},
+		{time.Second, 0xBE},
+		{maxHeaterDur, 0xFF},
+	}
+	for _, line := range data {
+		if b := gasWait(line.d); b != line.want {
+			t.Fatalf("%s: %#02x, want %#02x", line.d, b, line.want)
+		}
+	}
+}
+
+func TestHeaterResistance(t *testing.T) {
+	c := newCalibration(testCoeff1, testCoeff2, testHeat)
+	data := []struct {
+		target, ambient int32
+		want            byte
+	}{
+		{200, 25, 80},
+		{320, 25, 109},
+		{400, 10, 130},
+		// Clamped to the maximum.
+		{450, 10, 130},
+	}
+	for _, line := range data {
+		if b := c.heaterResistance(line.target, line.ambient); b != line.want {
+			t.Fatalf("%d°C from %d°C: %d, want %d", line.target, line.ambient, b, line.want)
+		}
+	}
+}
+
+func TestGasResistance(t *testing.T) {
+	c := newCalibration(testCoeff1, testCoeff2, testHeat)
+	data := []struct {
+		raw      uint32
+		gasRange byte
+		want     uint32
+	}{
+		{512, 0, 8000000},
+		{300, 0, 9503546},
+		{512, 5, 248262},
+		{512, 10, 7812},
+		{300, 15, 290},
+	}
+	for _, line := range data {
+		if r := c.gasResistance(line.raw, line.gasRange); r != line.want {
+			t.Fatalf("%d in range %d: %dΩ, want %dΩ", line.raw, line.gasRange, r, line.want)
+		}
+	}
+}
@@ -0,0 +1,587 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi088
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// I²C addresses of the dies; the Alt ones are used with SDO1 or SDO2 high.
+const (
+	DefaultAccelAddr = 0x18
+	AltAccelAddr     = 0x19
+	DefaultGyroAddr  = 0x68
+	AltGyroAddr      = 0x69
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the BMI088.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Accelerometer register map.
+const (
+	regACCCHIPID    = 0x00
+	regACCERR       = 0x02
+	regACCX         = 0x12 // accelerometer, sensor time, interrupt status, temperature
+	regACCCONF      = 0x40 // followed by ACC_RANGE
+	regINT1IOCTRL   = 0x53
+	regINTMAPDATA   = 0x58 // INT1_INT2_MAP_DATA
+	regACCPWRCONF   = 0x7C
+	regACCPWRCTRL   = 0x7D
+	regACCSOFTRESET = 0x7E
+)
+
+// Gyroscope register map.
+const (
+	regGYROCHIPID    = 0x00
+	regRATEX         = 0x02
+	regGYRORANGE     = 0x0F // followed by GYRO_BANDWIDTH
+	regGYROLPM1      = 0x11
+	regGYROSOFTRESET = 0x14
+	regGYROINTCTRL   = 0x15 // followed by INT3_INT4_IO_CONF
+	regINT3INT4IOMAP = 0x18
+)
+
+// Chip IDs.
+const (
+	accChipID  = 0x1E
+	gyroChipID = 0x0F
+)
+
+// Register values.
+const (
+	softReset      = 0xB6
+	accActive      = 0x00
+	accSuspend     = 0x03
+	accOn          = 0x04
+	accErrCodeMask = 0x1C
+	int1DataReady  = 0x04
+	intPushPullHi  = 0x0A // INT1 output, push-pull, active high
+	gyroSuspend    = 0x80
+	gyroIntEnable  = 0x80
+	int3ActiveHigh = 0x01 // push-pull
+	int3DataReady  = 0x01
+	spiRead        = 0x80 // bit 7 of the address; it auto-increments
+)
+
+// Timings, rounded up.
+const (
+	accResetTime  = time.Millisecond
+	accPowerTime  = 5 * time.Millisecond
+	gyroResetTime = 30 * time.Millisecond
+)
+
+// accDataLen is the length of ACC_X_LSB to TEMP_LSB.
+const accDataLen = 18
+
+// standardGravity is in m/s² per g.
+const standardGravity = 9.80665
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when a chip ID doesn't read 0x1E for the
+	// accelerometer or 0x0F for the gyroscope.
+	ErrBadID = errors.New("bmi088: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("bmi088: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("bmi088: invalid options")
+	// ErrConfig is returned by New when the accelerometer rejected its
+	// configuration.
+	ErrConfig = errors.New("bmi088: configuration rejected")
+)
+
+// accODRs are the accelerometer output data rates by acc_odr value.
+var accODRs = map[physic.Frequency]byte{
+	12500 * physic.MilliHertz: 0x05,
+	25 * physic.Hertz:         0x06,
+	50 * physic.Hertz:         0x07,
+	100 * physic.Hertz:        0x08,
+	200 * physic.Hertz:        0x09,
+	400 * physic.Hertz:        0x0A,
+	800 * physic.Hertz:        0x0B,
+	1600 * physic.Hertz:       0x0C,
+}
+
+// gyroBandwidths are the gyroscope output data rates and filter bandwidths
+// by GYRO_BANDWIDTH value.
+var gyroBandwidths = [...]struct {
+	odr physic.Frequency
+	bw  int
+}{
+	{2000 * physic.Hertz, 532},
+	{2000 * physic.Hertz, 230},
+	{1000 * physic.Hertz, 116},
+	{400 * physic.Hertz, 47},
+	{200 * physic.Hertz, 23},
+	{100 * physic.Hertz, 12},
+	{200 * physic.Hertz, 64},
+	{100 * physic.Hertz, 32},
+}
+
+// Opts holds initialization options.
+//
+// AccelRange: accelerometer full scale in g, 3, 6 (default), 12 or 24.
+// AccelODR: accelerometer output data rate, 12.5, 25, 50, 100 (default), 200,
+// 400, 800 or 1600Hz.
+// AccelOversampling: 1 (default), 2 or 4. Higher values lower the bandwidth
+// of the filter, and the noise, for the same ODR.
+// GyroRange: gyroscope full scale in °/s, 125, 250, 500, 1000 or 2000
+// (default).
+// GyroODR: gyroscope output data rate, 100, 200, 400, 1000 or 2000Hz
+// (default).
+// GyroBandwidthHz: gyroscope filter bandwidth, 532 or 230Hz at 2000Hz, 116Hz
+// at 1000Hz, 47Hz at 400Hz, 64 or 23Hz at 200Hz and 32 or 12Hz at 100Hz. 0
+// (default) selects the widest at GyroODR.
+// AccelINT: optional pin connected to INT1, configured as the accelerometer
+// data-ready output.
+// GyroINT: optional pin connected to INT3, configured as the gyroscope
+// data-ready output and waited on by SenseContinuous.
+// AccelAddr, GyroAddr: I²C addresses, DefaultAccelAddr and DefaultGyroAddr
+// by default. Ignored by NewSPI.
+type Opts struct {
+	AccelRange        int
+	AccelODR          physic.Frequency
+	AccelOversampling int
+	GyroRange         int
+	GyroODR           physic.Frequency
+	GyroBandwidthHz   int
+	AccelINT          gpio.PinIn
+	GyroINT           gpio.PinIn
+	AccelAddr         uint16
+	GyroAddr          uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if accelFullScale(o.AccelRange) < 0 {
+		return fmt.Errorf("%w: AccelRange %d, want 3, 6, 12 or 24", ErrInvalidOpts, o.AccelRange)
+	}
+	if _, ok := accODRs[o.AccelODR]; o.AccelODR != 0 && !ok {
+		return fmt.Errorf("%w: AccelODR %s, want 12.5, 25, 50, 100, 200, 400, 800 or 1600Hz", ErrInvalidOpts, o.AccelODR)
+	}
+	if accBWP(o.AccelOversampling) == 0 {
+		return fmt.Errorf("%w: AccelOversampling %d, want 1, 2 or 4", ErrInvalidOpts, o.AccelOversampling)
+	}
+	if gyroFullScale(o.GyroRange) < 0 {
+		return fmt.Errorf("%w: GyroRange %d, want 125, 250, 500, 1000 or 2000", ErrInvalidOpts, o.GyroRange)
+	}
+	if o.gyroBandwidth() < 0 {
+		return fmt.Errorf("%w: GyroODR %s with GyroBandwidthHz %d, want 2000Hz with 532 or 230, 1000Hz with 116, 400Hz with 47, 200Hz with 64 or 23, or 100Hz with 32 or 12", ErrInvalidOpts, o.GyroODR, o.GyroBandwidthHz)
+	}
+	return nil
+}
+
+// accelFullScale returns ACC_RANGE for a range in g, or -1. 0 selects ±6g.
+func accelFullScale(g int) int {
+	if g == 0 {
+		return 1
+	}
+	for fs := 0; fs < 4; fs++ {
+		if g == 3<<fs {
+			return fs
+		}
+	}
+	return -1
+}
+
+// gyroFullScale returns GYRO_RANGE for a range in °/s, or -1. 0 selects
+// ±2000°/s.
+func gyroFullScale(dps int) int {
+	if dps == 0 {
+		return 0
+	}
+	for fs := 0; fs < 5; fs++ {
+		if dps == 2000>>fs {
+			return fs
+		}
+	}
+	return -1
+}
+
+// accBWP returns acc_bwp for an oversampling ratio, or 0.
+func accBWP(osr int) byte {
+	switch osr {
+	case 0, 1:
+		return 0x0A
+	case 2:
+		return 0x09
+	case 4:
+		return 0x08
+	}
+	return 0
+}
+
+// gyroBandwidth returns GYRO_BANDWIDTH, or -1.
+func (o *Opts) gyroBandwidth() int {
+	odr := o.GyroODR
+	if odr == 0 {
+		odr = 2000 * physic.Hertz
+	}
+	best := -1
+	for i, g := range gyroBandwidths {
+		if g.odr != odr {
+			continue
+		}
+		if g.bw == o.GyroBandwidthHz {
+			return i
+		}
+		if o.GyroBandwidthHz == 0 && (best < 0 || g.bw > gyroBandwidths[best].bw) {
+			best = i
+		}
+	}
+	return best
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Gyro is the angular rate in rad/s, in X,Y,Z order.
+	Gyro [3]float64
+	// Temperature is the temperature of the accelerometer die, updated every
+	// 1.28s with a resolution of 0.125°C.
+	Temperature physic.Temperature
+	// RawAccel and RawGyro are the counts the values were computed from.
+	RawAccel, RawGyro [3]int16
+	// SensorTime is the sensor time of the accelerometer, with a resolution
+	// of 39.0625µs and wrapping every 655.36s.
+	SensorTime time.Duration
+	// Timestamp is the time at which the sample was read from the device.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s² gyro=%.4f,%.4f,%.4frad/s",
+		s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2])
+}
+
+// die is the bus connection to one of the dies.
+type die struct {
+	c     conn.Conn
+	name  string
+	isSPI bool
+	dummy int // bytes preceding the data of SPI reads
+	w, r  [accDataLen + 2]byte
+}
+
+// readRegs reads consecutive registers starting at addr into out.
+func (d *die) readRegs(addr byte, out []byte) error {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows the dummy bytes.
+		n := len(out) + 1 + d.dummy
+		w, r := d.w[:n], d.r[:n]
+		clear(w)
+		w[0] = addr | spiRead
+		if err := d.c.Tx(w, r); err != nil {
+			return fmt.Errorf("bmi088: reading %s register 0x%02x: %w", d.name, addr, err)
+		}
+		copy(out, r[1+d.dummy:])
+		return nil
+	}
+	if err := d.c.Tx([]byte{addr}, out); err != nil {
+		return fmt.Errorf("bmi088: reading %s register 0x%02x: %w", d.name, addr, err)
+	}
+	return nil
+}
+
+func (d *die) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	err := d.readRegs(addr, b[:])
+	return b[0], err
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *die) writeRegs(addr byte, v ...byte) error {
+	w := append(append(d.w[:0], addr), v...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("bmi088: writing %s register 0x%02x: %w", d.name, addr, err)
+	}
+	return nil
+}
+
+// Dev represents a BMI088 device.
+// Sense returns values in m/s² and rad/s.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	acc        die
+	gyro       die
+	accelRange int
+	gyroRange  int
+	accelLSB   float64 // LSB per m/s²
+	gyroLSB    float64 // LSB per rad/s
+	accelODR   physic.Frequency
+	gyroODR    physic.Frequency
+	gyroINT    gpio.PinIn
+	halted     bool
+	data       [accDataLen]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	aa, ga := opts.AccelAddr, opts.GyroAddr
+	if aa == 0 {
+		aa = DefaultAccelAddr
+	}
+	if ga == 0 {
+		ga = DefaultGyroAddr
+	}
+	return newDev(&i2c.Dev{Addr: aa, Bus: bus}, &i2c.Dev{Addr: ga, Bus: bus}, false, opts)
+}
+
+// NewSPI resets and configures a device on two 4-wire SPI ports, sharing the
+// clock and data lines with a chip select for each die.
+//
+// The ports are connected in mode 0 at MaxSPIFrequency (10 MHz).
+func NewSPI(accel, gyro spi.Port, opts Opts) (*Dev, error) {
+	ac, err := accel.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("bmi088: connecting SPI: %w", err)
+	}
+	gc, err := gyro.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("bmi088: connecting SPI: %w", err)
+	}
+	return newDev(ac, gc, true, opts)
+}
+
+func newDev(ac, gc conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	afs, gfs := accelFullScale(opts.AccelRange), gyroFullScale(opts.GyroRange)
+	aodr := opts.AccelODR
+	if aodr == 0 {
+		aodr = 100 * physic.Hertz
+	}
+	gbw := opts.gyroBandwidth()
+	d := &Dev{
+		acc:        die{c: ac, name: "accelerometer", isSPI: isSPI},
+		gyro:       die{c: gc, name: "gyroscope", isSPI: isSPI},
+		accelRange: 3 << afs,
+		gyroRange:  2000 >> gfs,
+		// The full scale spans the 16 bits.
+		accelLSB: 32768 / float64(int(3)<<afs) / standardGravity,
+		gyroLSB:  32768 / float64(int(2000)>>gfs) * 180 / math.Pi,
+		accelODR: aodr,
+		gyroODR:  gyroBandwidths[gbw].odr,
+		gyroINT:  opts.GyroINT,
+	}
+	if isSPI {
+		d.acc.dummy = 1
+	}
+	if err := d.initAccel(opts, byte(afs)); err != nil {
+		return nil, err
+	}
+	if err := d.initGyro(opts, byte(gfs), byte(gbw)); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) initAccel(opts Opts, afs byte) error {
+	// The rising edge of the chip select switches the accelerometer to SPI.
+	spiMode := func() error {
+		if !d.acc.isSPI {
+			return nil
+		}
+		_, err := d.acc.readReg(regACCCHIPID)
+		return err
+	}
+	if err := spiMode(); err != nil {
+		return err
+	}
+	id, err := d.acc.readReg(regACCCHIPID)
+	if err != nil {
+		return err
+	}
+	if id != accChipID {
+		return fmt.Errorf("%w: accelerometer read %#02x, want %#02x", ErrBadID, id, accChipID)
+	}
+	if err := d.acc.writeRegs(regACCSOFTRESET, softReset); err != nil {
+		return err
+	}
+	doSleep(accResetTime)
+	if err := spiMode(); err != nil {
+		return err
+	}
+	// The accelerometer is suspended after the reset.
+	if err := d.acc.writeRegs(regACCPWRCONF, accActive); err != nil {
+		return err
+	}
+	doSleep(accPowerTime)
+	if err := d.acc.writeRegs(regACCPWRCTRL, accOn); err != nil {
+		return err
+	}
+	doSleep(accPowerTime)
+	if err := d.acc.writeRegs(regACCCONF, accBWP(opts.AccelOversampling)<<4|accODRs[d.accelODR], afs); err != nil {
+		return err
+	}
+	e, err := d.acc.readReg(regACCERR)
+	if err != nil {
+		return err
+	}
+	if e&accErrCodeMask != 0 {
+		return fmt.Errorf("%w: ACC_ERR_REG %#02x", ErrConfig, e)
+	}
+	if opts.AccelINT != nil {
+		if err := d.acc.writeRegs(regINT1IOCTRL, intPushPullHi); err != nil {
+			return err
+		}
+		if err := d.acc.writeRegs(regINTMAPDATA, int1DataReady); err != nil {
+			return err
+		}
+		if err := opts.AccelINT.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return fmt.Errorf("bmi088: configuring INT1: %w", err)
+		}
+	}
+	return nil
+}
+
+func (d *Dev) initGyro(opts Opts, gfs, gbw byte) error {
+	id, err := d.gyro.readReg(regGYROCHIPID)
+	if err != nil {
+		return err
+	}
+	if id != gyroChipID {
+		return fmt.Errorf("%w: gyroscope read %#02x, want %#02x", ErrBadID, id, gyroChipID)
+	}
+	if err := d.gyro.writeRegs(regGYROSOFTRESET, softReset); err != nil {
+		return err
+	}
+	doSleep(gyroResetTime)
+	// The gyroscope is in normal mode after the reset.
+	if err := d.gyro.writeRegs(regGYRORANGE, gfs, gbw); err != nil {
+		return err
+	}
+	if opts.GyroINT != nil {
+		// GYRO_INT_CTRL and INT3_INT4_IO_CONF.
+		if err := d.gyro.writeRegs(regGYROINTCTRL, gyroIntEnable, int3ActiveHigh); err != nil {
+			return err
+		}
+		if err := d.gyro.writeRegs(regINT3INT4IOMAP, int3DataReady); err != nil {
+			return err
+		}
+		if err := opts.GyroINT.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return fmt.Errorf("bmi088: configuring INT3: %w", err)
+		}
+	}
+	return nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("BMI088{%s, %s, ±%dg, ±%d°/s}", d.acc.c, d.gyro.c, d.accelRange, d.gyroRange)
+}
+
+// AccelSampleRate returns the output data rate of the accelerometer.
+func (d *Dev) AccelSampleRate() physic.Frequency {
+	return d.accelODR
+}
+
+// GyroSampleRate returns the output data rate of the gyroscope.
+func (d *Dev) GyroSampleRate() physic.Frequency {
+	return d.gyroODR
+}
+
+// Halt stops SenseContinuous and suspends both dies. It implements
+// conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil
+	}
+	if err := d.gyro.writeRegs(regGYROLPM1, gyroSuspend); err != nil {
+		return err
+	}
+	if err := d.acc.writeRegs(regACCPWRCTRL, 0); err != nil {
+		return err
+	}
+	if err := d.acc.writeRegs(regACCPWRCONF, accSuspend); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns the latest accelerometer and gyroscope counts, in X,Y,Z
+// order.
+func (d *Dev) SenseRaw() ([3]int16, [3]int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.sense(&s)
+	return s.RawAccel, s.RawGyro, err
+}
+
+// Sense reads the latest sample of both dies into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(s)
+}
+
+func (d *Dev) sense(s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	g := d.data[:6]
+	if err := d.gyro.readRegs(regRATEX, g); err != nil {
+		return err
+	}
+	for i := range s.RawGyro {
+		s.RawGyro[i] = int16(g[2*i]) | int16(g[2*i+1])<<8
+		s.Gyro[i] = float64(s.RawGyro[i]) / d.gyroLSB
+	}
+	b := d.data[:]
+	if err := d.acc.readRegs(regACCX, b); err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	for i := range s.RawAccel {
+		s.RawAccel[i] = int16(b[2*i]) | int16(b[2*i+1])<<8
+		s.Accel[i] = float64(s.RawAccel[i]) / d.accelLSB
+	}
+	// 39.0625µs per LSB.
+	st := uint32(b[6]) | uint32(b[7])<<8 | uint32(b[8])<<16
+	s.SensorTime = time.Duration(st) * 78125 * time.Nanosecond / 2
+	// TEMP_MSB and the 3 MSBs of TEMP_LSB form a signed 11 bits value, in
+	// 0.125°C above 23°C.
+	t := int16(uint16(b[16])<<8|uint16(b[17])) >> 5
+	s.Temperature = physic.ZeroCelsius + 23*physic.Celsius + physic.Temperature(t)*125*physic.MilliKelvin
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
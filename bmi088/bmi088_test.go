@@ -0,0 +1,352 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi088
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// Dies of the expected transactions.
+const (
+	accel = iota
+	gyro
+)
+
+// ops builds the expected bus transactions of both dies, in order.
+type ops struct {
+	io    []conntest.IO
+	dies  []int
+	isSPI bool
+}
+
+func (o *ops) write(die int, addr byte, v ...byte) {
+	o.io = append(o.io, conntest.IO{W: append([]byte{addr}, v...)})
+	o.dies = append(o.dies, die)
+}
+
+func (o *ops) read(die int, addr byte, v ...byte) {
+	o.dies = append(o.dies, die)
+	if !o.isSPI {
+		o.io = append(o.io, conntest.IO{W: []byte{addr}, R: v})
+		return
+	}
+	r := []byte{0}
+	if die == accel {
+		// The dummy byte.
+		r = append(r, 0xFF)
+	}
+	r = append(r, v...)
+	w := make([]byte, len(r))
+	w[0] = addr | spiRead
+	o.io = append(o.io, conntest.IO{W: w, R: r})
+}
+
+// ports returns SPI ports replaying the transactions of each die.
+func (o *ops) ports() (*spitest.Playback, *spitest.Playback) {
+	var p [2]spitest.Playback
+	for i, io := range o.io {
+		p[o.dies[i]].Ops = append(p[o.dies[i]].Ops, io)
+	}
+	return &p[accel], &p[gyro]
+}
+
+// bus returns an I²C bus replaying the transactions.
+func (o *ops) bus() *i2ctest.Playback {
+	b := &i2ctest.Playback{}
+	for i, io := range o.io {
+		addr := uint16(DefaultAccelAddr)
+		if o.dies[i] == gyro {
+			addr = DefaultGyroAddr
+		}
+		b.Ops = append(b.Ops, i2ctest.IO{Addr: addr, W: io.W, R: io.R})
+	}
+	return b
+}
+
+// config holds the values written by New.
+type config struct {
+	accConf, accRange, gyroRange, gyroBW byte
+	accINT, gyroINT                      bool
+}
+
+var defaultConfig = config{accConf: 0xA8, accRange: 0x01, gyroBW: 0x00}
+
+// newOps returns the bus transactions issued by New.
+func newOps(isSPI bool, c config) *ops {
+	o := &ops{isSPI: isSPI}
+	if isSPI {
+		o.read(accel, regACCCHIPID, 0)
+	}
+	o.read(accel, regACCCHIPID, accChipID)
+	o.write(accel, regACCSOFTRESET, softReset)
+	if isSPI {
+		o.read(accel, regACCCHIPID, 0)
+	}
+	o.write(accel, regACCPWRCONF, accActive)
+	o.write(accel, regACCPWRCTRL, accOn)
+	o.write(accel, regACCCONF, c.accConf, c.accRange)
+	o.read(accel, regACCERR, 0)
+	if c.accINT {
+		o.write(accel, regINT1IOCTRL, intPushPullHi)
+		o.write(accel, regINTMAPDATA, int1DataReady)
+	}
+	o.read(gyro, regGYROCHIPID, gyroChipID)
+	o.write(gyro, regGYROSOFTRESET, softReset)
+	o.write(gyro, regGYRORANGE, c.gyroRange, c.gyroBW)
+	if c.gyroINT {
+		o.write(gyro, regGYROINTCTRL, gyroIntEnable, int3ActiveHigh)
+		o.write(gyro, regINT3INT4IOMAP, int3DataReady)
+	}
+	return o
+}
+
+// sense appends the bus transactions of Sense.
+func (o *ops) sense(a, g [3]int16, sensorTime uint32, temp int16) {
+	var b []byte
+	for _, v := range g {
+		b = append(b, byte(v), byte(uint16(v)>>8))
+	}
+	o.read(gyro, regRATEX, b...)
+	b = nil
+	for _, v := range a {
+		b = append(b, byte(v), byte(uint16(v)>>8))
+	}
+	b = append(b, byte(sensorTime), byte(sensorTime>>8), byte(sensorTime>>16), 0, 0, 0, 0, 0, 0, 0)
+	t := uint16(temp) << 5
+	o.read(accel, regACCX, append(b, byte(t>>8), byte(t))...)
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		c    config
+		s    string
+	}{
+		{Opts{}, defaultConfig, "BMI088{playback(24), playback(104), ±6g, ±2000°/s}"},
+		{
+			Opts{AccelRange: 24, AccelODR: 1600 * physic.Hertz, AccelOversampling: 4, GyroRange: 125, GyroODR: 200 * physic.Hertz},
+			config{accConf: 0x8C, accRange: 0x03, gyroRange: 0x04, gyroBW: 0x06},
+			"BMI088{playback(24), playback(104), ±24g, ±125°/s}",
+		},
+		{
+			Opts{AccelRange: 3, AccelODR: 12500 * physic.MilliHertz, AccelOversampling: 2, GyroRange: 500, GyroODR: 100 * physic.Hertz, GyroBandwidthHz: 12},
+			config{accConf: 0x95, accRange: 0x00, gyroRange: 0x02, gyroBW: 0x05},
+			"BMI088{playback(24), playback(104), ±3g, ±500°/s}",
+		},
+	}
+	for i, line := range data {
+		bus := newOps(false, line.c).bus()
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+	d, err := New(newOps(false, defaultConfig).bus(), Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, g := d.AccelSampleRate(), d.GyroSampleRate(); a != 100*physic.Hertz || g != 2000*physic.Hertz {
+		t.Fatalf("rates = %s, %s", a, g)
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	o := &ops{}
+	o.read(accel, regACCCHIPID, 0x1F)
+	if _, err := New(o.bus(), Opts{}); !errors.Is(err, ErrBadID) || err.Error() != "bmi088: bad chip ID: accelerometer read 0x1f, want 0x1e" {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+	o = newOps(false, defaultConfig)
+	o.io, o.dies = o.io[:len(o.io)-2], o.dies[:len(o.dies)-2]
+	o.io[len(o.io)-1].R = []byte{0x1E}
+	if _, err := New(o.bus(), Opts{}); !errors.Is(err, ErrBadID) || err.Error() != "bmi088: bad chip ID: gyroscope read 0x1e, want 0x0f" {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestNew_ConfigRejected(t *testing.T) {
+	o := newOps(false, defaultConfig)
+	o.io, o.dies = o.io[:6], o.dies[:6]
+	o.io[5].R = []byte{0x04}
+	if _, err := New(o.bus(), Opts{}); !errors.Is(err, ErrConfig) {
+		t.Fatalf("New() = %v, want ErrConfig", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{AccelRange: 12, AccelODR: 800 * physic.Hertz, GyroRange: 1000, GyroODR: 1000 * physic.Hertz, GyroBandwidthHz: 116}, ""},
+		{Opts{AccelRange: 16}, "bmi088: invalid options: AccelRange 16, want 3, 6, 12 or 24"},
+		{Opts{AccelODR: 3200 * physic.Hertz}, "bmi088: invalid options: AccelODR 3.200kHz, want 12.5, 25, 50, 100, 200, 400, 800 or 1600Hz"},
+		{Opts{AccelOversampling: 3}, "bmi088: invalid options: AccelOversampling 3, want 1, 2 or 4"},
+		{Opts{GyroRange: 4000}, "bmi088: invalid options: GyroRange 4000, want 125, 250, 500, 1000 or 2000"},
+		{Opts{GyroODR: 400 * physic.Hertz, GyroBandwidthHz: 64}, "bmi088: invalid options: GyroODR 400Hz with GyroBandwidthHz 64, want 2000Hz with 532 or 230, 1000Hz with 116, 400Hz with 47, 200Hz with 64 or 23, or 100Hz with 32 or 12"},
+		{Opts{GyroODR: 800 * physic.Hertz}, "bmi088: invalid options: GyroODR 800Hz with GyroBandwidthHz 0, want 2000Hz with 532 or 230, 1000Hz with 116, 400Hz with 47, 200Hz with 64 or 23, or 100Hz with 32 or 12"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{AccelRange: 1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	o := newOps(false, defaultConfig)
+	o.sense([3]int16{16384, -8192, 0}, [3]int16{16384, 0, -16384}, 25600, -8)
+	o.sense([3]int16{1, 2, 3}, [3]int16{-4, -5, -6}, 0, 0)
+	bus := o.bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 5461.33 LSB/g and 16.384 LSB/(°/s).
+	want := [6]float64{3 * standardGravity, -1.5 * standardGravity, 0, 1000 * math.Pi / 180, 0, -1000 * math.Pi / 180}
+	got := [6]float64{s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2]}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Sense() = %s, want %v", s, want)
+		}
+	}
+	if s.SensorTime != time.Second {
+		t.Fatalf("SensorTime = %s", s.SensorTime)
+	}
+	// 23 - 8 × 0.125
+	if want := physic.ZeroCelsius + 22*physic.Celsius; s.Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	a, g, err := d.SenseRaw()
+	if err != nil || a != [3]int16{1, 2, 3} || g != [3]int16{-4, -5, -6} {
+		t.Fatalf("SenseRaw() = %v, %v, %v", a, g, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_SPI(t *testing.T) {
+	o := newOps(true, defaultConfig)
+	o.sense([3]int16{5461, 0, 0}, [3]int16{0, 16, 0}, 0, 1023)
+	ap, gp := o.ports()
+	d, err := NewSPI(ap, gp, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "BMI088{playback, playback, ±6g, ±2000°/s}" {
+		t.Fatalf("String() = %q", s)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.RawAccel != [3]int16{5461, 0, 0} || s.RawGyro != [3]int16{0, 16, 0} {
+		t.Fatalf("Sense() = %v, %v", s.RawAccel, s.RawGyro)
+	}
+	// 23 + 1023 × 0.125
+	if want := physic.ZeroCelsius + 150875*physic.MilliKelvin; s.Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	if err := ap.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gp.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_Interrupts(t *testing.T) {
+	int1 := &gpiotest.Pin{N: "INT1", EdgesChan: make(chan gpio.Level)}
+	int3 := &gpiotest.Pin{N: "INT3", EdgesChan: make(chan gpio.Level)}
+	c := defaultConfig
+	c.accINT, c.gyroINT = true, true
+	bus := newOps(false, c).bus()
+	if _, err := New(bus, Opts{AccelINT: int1, GyroINT: int3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	o := newOps(false, defaultConfig)
+	o.write(gyro, regGYROLPM1, gyroSuspend)
+	o.write(accel, regACCPWRCTRL, 0)
+	o.write(accel, regACCPWRCONF, accSuspend)
+	bus := o.bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = newOps(false, defaultConfig).bus()
+	bus.DontPanic = true
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err == nil || !strings.HasPrefix(err.Error(), "bmi088: reading gyroscope register 0x02: ") {
+		t.Fatalf("Sense() = %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi088
+
+import (
+	"log"
+	"time"
+)
+
+// SenseContinuous returns a channel delivering samples until Halt is called.
+//
+// An interval of 0 or less reads a sample on each data-ready interrupt of the
+// gyroscope when Opts.GyroINT is set, or every gyroscope period otherwise.
+// Calling SenseContinuous again stops the previous channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan Sample, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 && d.gyroINT == nil {
+		interval = d.gyroODR.Period()
+	}
+	c := make(chan Sample)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- Sample) {
+	var tick <-chan time.Time
+	if interval > 0 {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	// The timeout bounds how long Halt waits for the goroutine.
+	timeout := 2*d.gyroODR.Period() + 10*time.Millisecond
+	for {
+		if tick != nil {
+			select {
+			case <-stop:
+				return
+			case <-tick:
+			}
+		} else {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if !d.gyroINT.WaitForEdge(timeout) {
+				continue
+			}
+		}
+		var s Sample
+		if err := d.Sense(&s); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- s:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
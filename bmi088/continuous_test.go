@@ -0,0 +1,90 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi088
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+)
+
+func TestSenseContinuous(t *testing.T) {
+	o := newOps(false, defaultConfig)
+	o.sense([3]int16{1, 2, 3}, [3]int16{}, 0, 0)
+	o.sense([3]int16{4, 5, 6}, [3]int16{}, 0, 0)
+	o.write(gyro, regGYROLPM1, gyroSuspend)
+	o.write(accel, regACCPWRCTRL, 0)
+	o.write(accel, regACCPWRCONF, accSuspend)
+	bus := o.bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-c; s.RawAccel != [3]int16{1, 2, 3} {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	// Restarting stops the previous channel.
+	c2, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("previous channel not closed")
+	}
+	if s := <-c2; s.RawAccel != [3]int16{4, 5, 6} {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c2; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous_DataReady(t *testing.T) {
+	int3 := &gpiotest.Pin{N: "INT3", EdgesChan: make(chan gpio.Level)}
+	c := defaultConfig
+	c.gyroINT = true
+	o := newOps(false, c)
+	o.sense([3]int16{}, [3]int16{1, 0, 0}, 0, 0)
+	o.sense([3]int16{}, [3]int16{2, 0, 0}, 0, 0)
+	o.write(gyro, regGYROLPM1, gyroSuspend)
+	o.write(accel, regACCPWRCTRL, 0)
+	o.write(accel, regACCPWRCONF, accSuspend)
+	bus := o.bus()
+	d, err := New(bus, Opts{GyroINT: int3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int16(1); i <= 2; i++ {
+		int3.EdgesChan <- gpio.High
+		if s := <-ch; s.RawGyro[0] != i {
+			t.Fatalf("#%d: got %v", i, s.RawGyro)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
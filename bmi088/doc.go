@@ -0,0 +1,30 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package bmi088 controls a Bosch BMI088 6-axis IMU over I²C or SPI.
+//
+// # More details
+//
+// The BMI088 is made of two dies in one package: an accelerometer and a
+// gyroscope, each with its own I²C address or SPI chip select, registers and
+// output rate. Its vibration robustness makes it a common choice for drone
+// flight controllers.
+//
+// Sense reads both dies back to back, the gyroscope first, so that a sample
+// combines the latest data of each. The hardware data synchronization
+// feature isn't supported, as it requires uploading a configuration file to
+// the accelerometer.
+//
+// The data-ready interrupts of both dies can be routed to INT1 for the
+// accelerometer and INT3 for the gyroscope. When the INT3 pin is given,
+// SenseContinuous reads a sample each time the gyroscope has new data.
+//
+// On SPI, the accelerometer starts in I²C mode until a rising edge on its
+// chip select, so NewSPI makes a dummy read first. Its reads also return a
+// dummy byte before the data, which the driver discards.
+//
+// # Datasheet
+//
+// https://www.bosch-sensortec.com/media/boschsensortec/downloads/datasheets/bst-bmi088-ds001.pdf
+package bmi088
@@ -0,0 +1,55 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi088_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/bmi088"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open the SPI ports of both chip selects.
+	accel, err := spireg.Open("SPI0.0")
+	if err != nil {
+		log.Fatalf("failed to open SPI: %v", err)
+	}
+	defer accel.Close()
+	gyro, err := spireg.Open("SPI0.1")
+	if err != nil {
+		log.Fatalf("failed to open SPI: %v", err)
+	}
+	defer gyro.Close()
+
+	opts := bmi088.Opts{
+		AccelRange: 24,
+		AccelODR:   1600 * physic.Hertz,
+		GyroODR:    1000 * physic.Hertz,
+		GyroINT:    gpioreg.ByName("GPIO25"),
+	}
+	d, err := bmi088.NewSPI(accel, gyro, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for s := range c {
+		fmt.Println(s)
+	}
+}
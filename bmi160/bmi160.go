@@ -0,0 +1,477 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// DefaultAddr is the I²C address with SDO low; AltAddr is used with SDO
+// high.
+const (
+	DefaultAddr = 0x68
+	AltAddr     = 0x69
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the BMI160.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Register map.
+const (
+	regCHIPID      = 0x00
+	regERR         = 0x02
+	regGYRX        = 0x0C // gyroscope, accelerometer, sensor time, status, interrupt status, temperature
+	regINTSTATUS0  = 0x1C
+	regFIFOLENGTH  = 0x22
+	regFIFODATA    = 0x24
+	regACCCONF     = 0x40 // followed by ACC_RANGE, GYR_CONF and GYR_RANGE
+	regFIFOCONFIG1 = 0x47
+	regINTEN0      = 0x50
+	regINTOUTCTRL  = 0x53 // followed by INT_LATCH and INT_MAP_0
+	regINTMOTION3  = 0x62
+	regSTEPCNT     = 0x78
+	regSTEPCONF    = 0x7A
+	regCMD         = 0x7E
+	regSPIDummy    = 0x7F
+)
+
+// chipID is the value of the CHIP_ID register.
+const chipID = 0xD1
+
+// Commands written to CMD.
+const (
+	cmdAccNormal  = 0x11
+	cmdAccSuspend = 0x10
+	cmdGyrNormal  = 0x15
+	cmdGyrSuspend = 0x14
+	cmdFIFOFlush  = 0xB0
+	cmdIntReset   = 0xB1
+	cmdStepCntClr = 0xB2
+	cmdSoftReset  = 0xB6
+)
+
+// Register values.
+const (
+	errCodeMask      = 0x1E // err_code, 0 when the configuration is valid
+	fifoAccGyrHeader = 0xD0 // fifo_gyr_en, fifo_acc_en and fifo_header_en
+	spiRead          = 0x80 // bit 7 of the address; it auto-increments
+)
+
+// Timings, rounded up.
+const (
+	resetTime    = time.Millisecond
+	accStartTime = 4 * time.Millisecond
+	gyrStartTime = 81 * time.Millisecond
+)
+
+// Sizes of the data blocks.
+const (
+	dataLen  = 22 // GYR_X to TEMPERATURE
+	fifoSize = 1024
+)
+
+// standardGravity is in m/s² per g.
+const standardGravity = 9.80665
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when CHIP_ID doesn't read 0xD1.
+	ErrBadID = errors.New("bmi160: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("bmi160: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("bmi160: invalid options")
+	// ErrConfig is returned by New when the device rejected the
+	// configuration.
+	ErrConfig = errors.New("bmi160: configuration rejected")
+	// ErrFIFOOverflow is returned by ReadFIFO when frames were dropped because
+	// the FIFO was full.
+	ErrFIFOOverflow = errors.New("bmi160: FIFO overflow")
+)
+
+// odrs are the output data rates by ACC_ODR and GYR_ODR value.
+var odrs = map[physic.Frequency]byte{
+	25 * physic.Hertz:   0x06,
+	50 * physic.Hertz:   0x07,
+	100 * physic.Hertz:  0x08,
+	200 * physic.Hertz:  0x09,
+	400 * physic.Hertz:  0x0A,
+	800 * physic.Hertz:  0x0B,
+	1600 * physic.Hertz: 0x0C,
+}
+
+// accelRanges are the ACC_RANGE values by full scale in g.
+var accelRanges = map[int]byte{2: 0x03, 4: 0x05, 8: 0x08, 16: 0x0C}
+
+// Opts holds initialization options.
+//
+// AccelRange: accelerometer full scale in g, 2 (default), 4, 8 or 16.
+// GyroRange: gyroscope full scale in °/s, 125, 250, 500, 1000 or 2000
+// (default).
+// ODR: output data rate of both sensors, 25, 50, 100 (default), 200, 400, 800
+// or 1600Hz.
+// Oversampling: 1 (default), 2 or 4. Higher values lower the bandwidth of the
+// filters, and the noise, for the same ODR.
+// FIFO: queue the samples in the FIFO, read with ReadFIFO.
+// SignificantMotion: enable the significant motion detector, read with
+// SignificantMotion and mapped to the INT1 pin, active high.
+// StepCounter: enable the step counter, read with StepCount.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	AccelRange        int
+	GyroRange         int
+	ODR               physic.Frequency
+	Oversampling      int
+	FIFO              bool
+	SignificantMotion bool
+	StepCounter       bool
+	Addr              uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if _, ok := accelRanges[o.AccelRange]; o.AccelRange != 0 && !ok {
+		return fmt.Errorf("%w: AccelRange %d, want 2, 4, 8 or 16", ErrInvalidOpts, o.AccelRange)
+	}
+	if gyroFullScale(o.GyroRange) < 0 {
+		return fmt.Errorf("%w: GyroRange %d, want 125, 250, 500, 1000 or 2000", ErrInvalidOpts, o.GyroRange)
+	}
+	if _, ok := odrs[o.ODR]; o.ODR != 0 && !ok {
+		return fmt.Errorf("%w: ODR %s, want 25, 50, 100, 200, 400, 800 or 1600Hz", ErrInvalidOpts, o.ODR)
+	}
+	if bwp(o.Oversampling) < 0 {
+		return fmt.Errorf("%w: Oversampling %d, want 1, 2 or 4", ErrInvalidOpts, o.Oversampling)
+	}
+	return nil
+}
+
+// gyroFullScale returns GYR_RANGE for a range in °/s, or -1. 0 selects
+// ±2000°/s.
+func gyroFullScale(dps int) int {
+	if dps == 0 {
+		return 0
+	}
+	for fs := 0; fs < 5; fs++ {
+		if dps == 2000>>fs {
+			return fs
+		}
+	}
+	return -1
+}
+
+// bwp returns the filter mode of ACC_BWP and GYR_BWP for an oversampling
+// ratio, or -1. 0 selects the normal mode.
+func bwp(osr int) int {
+	switch osr {
+	case 0, 1:
+		return 2
+	case 2:
+		return 1
+	case 4:
+		return 0
+	}
+	return -1
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Gyro is the angular rate in rad/s, in X,Y,Z order.
+	Gyro [3]float64
+	// Temperature is the die temperature. It is 0 for samples read from the
+	// FIFO.
+	Temperature physic.Temperature
+	// RawAccel and RawGyro are the counts the values were computed from.
+	RawAccel, RawGyro [3]int16
+	// SensorTime is the sensor time of the device, with a resolution of
+	// 39.0625µs and wrapping every 655.36s. It is 0 for samples read from the
+	// FIFO.
+	SensorTime time.Duration
+	// Timestamp is the time at which the sample was read from the device, or
+	// estimated from the sample rate for samples read from the FIFO.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s² gyro=%.4f,%.4f,%.4frad/s",
+		s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2])
+}
+
+// Dev represents a BMI160 device.
+// Sense returns values in m/s² and rad/s.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c           conn.Conn
+	isSPI       bool
+	accelRange  int
+	gyroRange   int
+	accelLSB    float64 // LSB per m/s²
+	gyroLSB     float64 // LSB per rad/s
+	odr         physic.Frequency
+	fifo        bool
+	sigMotion   bool
+	stepCounter bool
+	halted      bool
+
+	// Preallocated bus buffers, one byte longer than the largest read for the
+	// SPI address byte.
+	w, r [fifoSize + 1]byte
+
+	// mu serializes bus transactions and guards the fields above.
+	mu sync.Mutex
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI resets and configures a device on a 4-wire SPI port.
+//
+// The port is connected in mode 0 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("bmi160: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	ar := opts.AccelRange
+	if ar == 0 {
+		ar = 2
+	}
+	gfs := gyroFullScale(opts.GyroRange)
+	odr := opts.ODR
+	if odr == 0 {
+		odr = 100 * physic.Hertz
+	}
+	d := &Dev{
+		c:          c,
+		isSPI:      isSPI,
+		accelRange: ar,
+		gyroRange:  2000 >> gfs,
+		// The full scale spans the 16 bits.
+		accelLSB:    32768 / float64(ar) / standardGravity,
+		gyroLSB:     16.4 * float64(int(1)<<gfs) * 180 / math.Pi,
+		odr:         odr,
+		fifo:        opts.FIFO,
+		sigMotion:   opts.SignificantMotion,
+		stepCounter: opts.StepCounter,
+	}
+	if err := d.selectSPI(); err != nil {
+		return nil, err
+	}
+	id, err := d.readReg(regCHIPID)
+	if err != nil {
+		return nil, err
+	}
+	if id != chipID {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, chipID)
+	}
+	if err := d.writeRegs(regCMD, cmdSoftReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	if err := d.selectSPI(); err != nil {
+		return nil, err
+	}
+	// Both sensors are suspended after the reset, when writes must be 450µs
+	// apart. Start them first.
+	if err := d.writeRegs(regCMD, cmdAccNormal); err != nil {
+		return nil, err
+	}
+	doSleep(accStartTime)
+	if err := d.writeRegs(regCMD, cmdGyrNormal); err != nil {
+		return nil, err
+	}
+	doSleep(gyrStartTime)
+	code, mode := odrs[odr], byte(bwp(opts.Oversampling))
+	if err := d.writeRegs(regACCCONF, mode<<4|code, accelRanges[ar], mode<<4|code, byte(gfs)); err != nil {
+		return nil, err
+	}
+	var e [1]byte
+	if err := d.readRegs(regERR, e[:]); err != nil {
+		return nil, err
+	}
+	if e[0]&errCodeMask != 0 {
+		return nil, fmt.Errorf("%w: ERR_REG %#02x", ErrConfig, e[0])
+	}
+	if opts.FIFO {
+		if err := d.writeRegs(regFIFOCONFIG1, fifoAccGyrHeader); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regCMD, cmdFIFOFlush); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.initFeatures(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("BMI160{%s, ±%dg, ±%d°/s, %s}", d.c, d.accelRange, d.gyroRange, d.odr)
+}
+
+// SampleRate returns the output data rate of both sensors.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.odr
+}
+
+// Halt suspends both sensors. It implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil
+	}
+	if err := d.writeRegs(regCMD, cmdGyrSuspend); err != nil {
+		return err
+	}
+	if err := d.writeRegs(regCMD, cmdAccSuspend); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns the latest accelerometer and gyroscope counts, in X,Y,Z
+// order.
+func (d *Dev) SenseRaw() ([3]int16, [3]int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.sense(&s)
+	return s.RawAccel, s.RawGyro, err
+}
+
+// Sense reads the latest sample into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(s)
+}
+
+func (d *Dev) sense(s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	b, err := d.readBlock(regGYRX, dataLen)
+	if err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	d.decode(s, b[6:12], b[0:6])
+	// 39.0625µs per LSB.
+	st := uint32(b[12]) | uint32(b[13])<<8 | uint32(b[14])<<16
+	s.SensorTime = time.Duration(st) * 78125 * time.Nanosecond / 2
+	t := int16(b[20]) | int16(b[21])<<8
+	// °C = 23 + raw / 512
+	s.Temperature = physic.ZeroCelsius + physic.Temperature(math.Round((23+float64(t)/512)*1000))*physic.MilliKelvin
+	return nil
+}
+
+// decode scales the little-endian accelerometer and gyroscope counts into s.
+func (d *Dev) decode(s *Sample, accel, gyro []byte) {
+	for i := 0; i < 3; i++ {
+		s.RawAccel[i] = int16(accel[2*i]) | int16(accel[2*i+1])<<8
+		s.RawGyro[i] = int16(gyro[2*i]) | int16(gyro[2*i+1])<<8
+		s.Accel[i] = float64(s.RawAccel[i]) / d.accelLSB
+		s.Gyro[i] = float64(s.RawGyro[i]) / d.gyroLSB
+	}
+}
+
+// selectSPI makes the dummy read switching the device to SPI.
+func (d *Dev) selectSPI() error {
+	if !d.isSPI {
+		return nil
+	}
+	_, err := d.readReg(regSPIDummy)
+	return err
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegs(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// readRegs reads consecutive registers starting at addr into out.
+func (d *Dev) readRegs(addr byte, out []byte) error {
+	b, err := d.readBlock(addr, len(out))
+	if err != nil {
+		return err
+	}
+	copy(out, b)
+	return nil
+}
+
+// readBlock reads n consecutive registers starting at addr. The returned
+// slice is only valid until the next transaction.
+func (d *Dev) readBlock(addr byte, n int) ([]byte, error) {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, r := d.w[:n+1], d.r[:n+1]
+		clear(w)
+		w[0] = addr | spiRead
+		if err := d.c.Tx(w, r); err != nil {
+			return nil, fmt.Errorf("bmi160: reading register 0x%02x: %w", addr, err)
+		}
+		return r[1:], nil
+	}
+	w, r := append(d.w[:0], addr), d.r[:n]
+	if err := d.c.Tx(w, r); err != nil {
+		return nil, fmt.Errorf("bmi160: reading register 0x%02x: %w", addr, err)
+	}
+	return r, nil
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, v ...byte) error {
+	w := append(append(d.w[:0], addr), v...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("bmi160: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
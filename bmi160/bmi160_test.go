@@ -0,0 +1,266 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// ops builds the expected bus transactions.
+type ops struct {
+	io    []conntest.IO
+	isSPI bool
+}
+
+func (o *ops) write(addr byte, v ...byte) {
+	o.io = append(o.io, conntest.IO{W: append([]byte{addr}, v...)})
+}
+
+func (o *ops) read(addr byte, v ...byte) {
+	if !o.isSPI {
+		o.io = append(o.io, conntest.IO{W: []byte{addr}, R: v})
+		return
+	}
+	w := make([]byte, len(v)+1)
+	w[0] = addr | spiRead
+	o.io = append(o.io, conntest.IO{W: w, R: append([]byte{0}, v...)})
+}
+
+// port returns an SPI port replaying the transactions.
+func (o *ops) port() *spitest.Playback {
+	return &spitest.Playback{Playback: conntest.Playback{Ops: o.io}}
+}
+
+// bus returns an I²C bus replaying the transactions.
+func (o *ops) bus() *i2ctest.Playback {
+	b := &i2ctest.Playback{}
+	for _, io := range o.io {
+		b.Ops = append(b.Ops, i2ctest.IO{Addr: DefaultAddr, W: io.W, R: io.R})
+	}
+	return b
+}
+
+// newOps returns the bus transactions issued by New up to the FIFO
+// configuration, for the ACC_CONF to GYR_RANGE values.
+func newOps(isSPI bool, conf ...byte) *ops {
+	o := &ops{isSPI: isSPI}
+	if isSPI {
+		o.read(regSPIDummy, 0)
+	}
+	o.read(regCHIPID, chipID)
+	o.write(regCMD, cmdSoftReset)
+	if isSPI {
+		o.read(regSPIDummy, 0)
+	}
+	o.write(regCMD, cmdAccNormal)
+	o.write(regCMD, cmdGyrNormal)
+	o.write(regACCCONF, conf...)
+	o.read(regERR, 0)
+	return o
+}
+
+// defaultConf are the ACC_CONF to GYR_RANGE values with the default options.
+var defaultConf = []byte{0x28, 0x03, 0x28, 0x00}
+
+// data returns the GYR_X to TEMPERATURE registers.
+func data(accel, gyro [3]int16, sensorTime uint32, temp int16) []byte {
+	var b []byte
+	for _, v := range append(gyro[:], accel[:]...) {
+		b = append(b, byte(v), byte(uint16(v)>>8))
+	}
+	b = append(b, byte(sensorTime), byte(sensorTime>>8), byte(sensorTime>>16), 0, 0, 0, 0, 0)
+	return append(b, byte(temp), byte(uint16(temp)>>8))
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		conf []byte
+		s    string
+	}{
+		{Opts{}, defaultConf, "BMI160{playback(104), ±2g, ±2000°/s, 100Hz}"},
+		{Opts{AccelRange: 16, GyroRange: 125, ODR: 1600 * physic.Hertz, Oversampling: 4}, []byte{0x0C, 0x0C, 0x0C, 0x04}, "BMI160{playback(104), ±16g, ±125°/s, 1.600kHz}"},
+		{Opts{AccelRange: 4, GyroRange: 500, ODR: 25 * physic.Hertz, Oversampling: 2}, []byte{0x16, 0x05, 0x16, 0x02}, "BMI160{playback(104), ±4g, ±500°/s, 25Hz}"},
+	}
+	for i, line := range data {
+		bus := newOps(false, line.conf...).bus()
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	o := &ops{}
+	o.read(regCHIPID, 0xD8)
+	if _, err := New(o.bus(), Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestNew_ConfigRejected(t *testing.T) {
+	o := newOps(false, defaultConf...)
+	o.io[len(o.io)-1].R = []byte{0x0C}
+	if _, err := New(o.bus(), Opts{}); !errors.Is(err, ErrConfig) || err.Error() != "bmi160: configuration rejected: ERR_REG 0x0c" {
+		t.Fatalf("New() = %v, want ErrConfig", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{AccelRange: 8, GyroRange: 1000, ODR: 800 * physic.Hertz, Oversampling: 1}, ""},
+		{Opts{AccelRange: 6}, "bmi160: invalid options: AccelRange 6, want 2, 4, 8 or 16"},
+		{Opts{GyroRange: 4000}, "bmi160: invalid options: GyroRange 4000, want 125, 250, 500, 1000 or 2000"},
+		{Opts{ODR: 3200 * physic.Hertz}, "bmi160: invalid options: ODR 3.200kHz, want 25, 50, 100, 200, 400, 800 or 1600Hz"},
+		{Opts{Oversampling: 8}, "bmi160: invalid options: Oversampling 8, want 1, 2 or 4"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{AccelRange: 1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	o := newOps(false, defaultConf...)
+	o.read(regGYRX, data([3]int16{16384, -8192, 0}, [3]int16{164, 0, -164}, 25600, 512)...)
+	o.read(regGYRX, data([3]int16{1, 2, 3}, [3]int16{-4, -5, -6}, 0, 0)...)
+	bus := o.bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 16384 LSB/g and 16.4 LSB/(°/s).
+	want := [6]float64{standardGravity, -standardGravity / 2, 0, math.Pi / 18, 0, -math.Pi / 18}
+	got := [6]float64{s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2]}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Sense() = %s, want %v", s, want)
+		}
+	}
+	// 25600 × 39.0625µs
+	if s.SensorTime != time.Second {
+		t.Fatalf("SensorTime = %s", s.SensorTime)
+	}
+	if want := physic.ZeroCelsius + 24*physic.Celsius; s.Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	a, g, err := d.SenseRaw()
+	if err != nil || a != [3]int16{1, 2, 3} || g != [3]int16{-4, -5, -6} {
+		t.Fatalf("SenseRaw() = %v, %v, %v", a, g, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_SPI(t *testing.T) {
+	o := newOps(true, 0x28, 0x0C, 0x28, 0x03)
+	o.read(regGYRX, data([3]int16{2048, 0, 0}, [3]int16{0, 131, 0}, 0, -512)...)
+	port := o.port()
+	d, err := NewSPI(port, Opts{AccelRange: 16, GyroRange: 250})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "BMI160{playback, ±16g, ±250°/s, 100Hz}" {
+		t.Fatalf("String() = %q", s)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 2048 LSB/g and 131.2 LSB/(°/s).
+	if math.Abs(s.Accel[0]-standardGravity) > 1e-9 || math.Abs(s.Gyro[1]-131/131.2*math.Pi/180) > 1e-9 {
+		t.Fatalf("Sense() = %s", s)
+	}
+	if want := physic.ZeroCelsius + 22*physic.Celsius; s.Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	o := newOps(false, defaultConf...)
+	o.write(regCMD, cmdGyrSuspend)
+	o.write(regCMD, cmdAccSuspend)
+	bus := o.bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != ErrHalted {
+		t.Fatalf("ReadFIFO() = %v, want ErrHalted", err)
+	}
+	if _, err := d.StepCount(); err != ErrHalted {
+		t.Fatalf("StepCount() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = newOps(false, defaultConf...).bus()
+	bus.DontPanic = true
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package bmi160 controls a Bosch BMI160 6-axis IMU over I²C or SPI.
+//
+// # More details
+//
+// The BMI160 combines a 3-axis accelerometer and a 3-axis gyroscope. New
+// starts both in normal mode at Opts.ODR; Sense reads the latest sample with
+// the temperature and the 24 bits sensor time of the device in a single burst.
+//
+// The device starts in I²C mode. NewSPI switches it to SPI with a dummy read
+// of register 0x7F, as required after power up and after each soft reset.
+//
+// With Opts.FIFO, the samples are queued in the 1KiB FIFO in header mode:
+// each frame starts with a header describing its content, which ReadFIFO
+// parses. Skip frames, inserted when frames were dropped because the FIFO was
+// full, are reported as ErrFIFOOverflow.
+//
+// Two of the motion features of the device can be enabled: the significant
+// motion detector, which triggers once the device moved for a while, mapped
+// to the INT1 pin; and the step counter, in its normal mode.
+//
+// # Datasheet
+//
+// https://www.bosch-sensortec.com/media/boschsensortec/downloads/datasheets/bst-bmi160-ds000.pdf
+package bmi160
@@ -0,0 +1,46 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/bmi160"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := bmi160.New(bus, bmi160.Opts{AccelRange: 4, GyroRange: 500, ODR: 200 * physic.Hertz, StepCounter: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	var s bmi160.Sample
+	if err := d.Sense(&s); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(s, s.Temperature)
+	steps, err := d.StepCount()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(steps, "steps")
+}
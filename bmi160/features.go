@@ -0,0 +1,107 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160
+
+import "errors"
+
+// Register values of the motion features.
+const (
+	// int_sig_mot_sel with a proof time of 0.5s and a skip time of 3s.
+	sigMotionConf = 0x16
+	anyMotionXYZ  = 0x07 // the significant motion detector uses their engine
+	int1Output    = 0x0A // INT1 enabled, push-pull, active high, level
+	intLatched    = 0x0F
+	int1AnyMotion = 0x04 // any and significant motion on INT1
+	intSigMotion  = 0x02 // sigmot_int in INT_STATUS_0
+	// Normal mode of the step counter, with step_cnt_en.
+	stepConf0 = 0x15
+	stepConf1 = 0x0B
+)
+
+// initFeatures enables the motion features selected in Opts.
+func (d *Dev) initFeatures() error {
+	if d.sigMotion {
+		if err := d.writeRegs(regINTMOTION3, sigMotionConf); err != nil {
+			return err
+		}
+		if err := d.writeRegs(regINTEN0, anyMotionXYZ); err != nil {
+			return err
+		}
+		// INT_OUT_CTRL, INT_LATCH and INT_MAP_0.
+		if err := d.writeRegs(regINTOUTCTRL, int1Output, intLatched, int1AnyMotion); err != nil {
+			return err
+		}
+	}
+	if d.stepCounter {
+		if err := d.writeRegs(regSTEPCONF, stepConf0, stepConf1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignificantMotion returns true when significant motion was detected since
+// the last call. It requires Opts.SignificantMotion.
+//
+// The interrupt is latched; it is reset when reported, which also releases
+// the INT1 pin.
+func (d *Dev) SignificantMotion() (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return false, ErrHalted
+	}
+	if !d.sigMotion {
+		return false, errNoSigMotion
+	}
+	s, err := d.readReg(regINTSTATUS0)
+	if err != nil || s&intSigMotion == 0 {
+		return false, err
+	}
+	if err := d.writeRegs(regCMD, cmdIntReset); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// StepCount returns the number of steps counted, wrapping at 65536. It
+// requires Opts.StepCounter.
+func (d *Dev) StepCount() (uint16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkStepCounter(); err != nil {
+		return 0, err
+	}
+	var b [2]byte
+	if err := d.readRegs(regSTEPCNT, b[:]); err != nil {
+		return 0, err
+	}
+	return uint16(b[0]) | uint16(b[1])<<8, nil
+}
+
+// ResetStepCount sets the step count back to 0. It requires Opts.StepCounter.
+func (d *Dev) ResetStepCount() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkStepCounter(); err != nil {
+		return err
+	}
+	return d.writeRegs(regCMD, cmdStepCntClr)
+}
+
+func (d *Dev) checkStepCounter() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.stepCounter {
+		return errNoStepCounter
+	}
+	return nil
+}
+
+var (
+	errNoSigMotion   = errors.New("bmi160: significant motion detector not enabled in Opts")
+	errNoStepCounter = errors.New("bmi160: step counter not enabled in Opts")
+)
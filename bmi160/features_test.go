@@ -0,0 +1,62 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160
+
+import "testing"
+
+func TestSignificantMotion(t *testing.T) {
+	o := newOps(false, defaultConf...)
+	o.write(regINTMOTION3, sigMotionConf)
+	o.write(regINTEN0, anyMotionXYZ)
+	o.write(regINTOUTCTRL, int1Output, intLatched, int1AnyMotion)
+	o.read(regINTSTATUS0, 0x04)
+	o.read(regINTSTATUS0, 0x06)
+	o.write(regCMD, cmdIntReset)
+	bus := o.bus()
+	d, err := New(bus, Opts{SignificantMotion: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Any-motion alone doesn't count.
+	if m, err := d.SignificantMotion(); err != nil || m {
+		t.Fatalf("SignificantMotion() = %t, %v", m, err)
+	}
+	if m, err := d.SignificantMotion(); err != nil || !m {
+		t.Fatalf("SignificantMotion() = %t, %v", m, err)
+	}
+	if _, err := d.StepCount(); err != errNoStepCounter {
+		t.Fatalf("StepCount() = %v", err)
+	}
+	if err := d.ResetStepCount(); err != errNoStepCounter {
+		t.Fatalf("ResetStepCount() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStepCount(t *testing.T) {
+	o := newOps(false, defaultConf...)
+	o.write(regSTEPCONF, stepConf0, stepConf1)
+	o.read(regSTEPCNT, 0x34, 0x12)
+	o.write(regCMD, cmdStepCntClr)
+	bus := o.bus()
+	d, err := New(bus, Opts{StepCounter: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.StepCount(); err != nil || n != 0x1234 {
+		t.Fatalf("StepCount() = %d, %v", n, err)
+	}
+	if err := d.ResetStepCount(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SignificantMotion(); err != errNoSigMotion {
+		t.Fatalf("SignificantMotion() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
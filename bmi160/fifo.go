@@ -0,0 +1,147 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FIFO frame headers.
+const (
+	headerRegular  = 0x80 // fh_mode of data frames, fh_parm in bits 4..2
+	headerModeMask = 0xC0
+	headerOverRead = 0x80 // no data frame: the FIFO is empty
+	headerSkip     = 0x40 // followed by the number of frames dropped
+	headerTime     = 0x44 // followed by the 24 bits sensor time
+	headerConfig   = 0x48 // followed by the changed configuration
+	parmAcc        = 0x01
+	parmGyr        = 0x02
+	parmMag        = 0x04
+)
+
+// fifoFrameLen is the length of a frame with accelerometer and gyroscope
+// data, with its header.
+const fifoFrameLen = 13
+
+// FIFOLen returns the approximate number of samples queued in the FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	n, err := d.fifoBytes()
+	return n / fifoFrameLen, err
+}
+
+// ReadFIFO reads up to len(s) samples from the FIFO, oldest first, and
+// returns the number read. It requires Opts.FIFO.
+//
+// The samples have no temperature nor sensor time. Their timestamps are
+// estimated from the sample rate, the newest one being the time of the read.
+//
+// When the FIFO dropped frames because it was full, the samples read are
+// returned with ErrFIFOOverflow; those after the gap are read by the next
+// call.
+func (d *Dev) ReadFIFO(s []Sample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	l, err := d.fifoBytes()
+	if err != nil || l == 0 || len(s) == 0 {
+		return 0, err
+	}
+	// A frame read partially is read again in full by the next read.
+	b, err := d.readBlock(regFIFODATA, min(l, len(s)*fifoFrameLen, fifoSize))
+	if err != nil {
+		return 0, err
+	}
+	n, overflow := 0, false
+parse:
+	for i := 0; i < len(b) && n < len(s); {
+		h := b[i]
+		switch {
+		case h == headerOverRead:
+			break parse
+		case h&headerModeMask == headerRegular:
+			p := h >> 2
+			l := 1
+			if p&parmMag != 0 {
+				l += 8
+			}
+			if p&parmGyr != 0 {
+				l += 6
+			}
+			if p&parmAcc != 0 {
+				l += 6
+			}
+			if i+l > len(b) {
+				break parse
+			}
+			// The frames before both sensors started may have only one.
+			if l == fifoFrameLen && p&parmMag == 0 {
+				d.decode(&s[n], b[i+7:i+13], b[i+1:i+7])
+				s[n].Temperature = 0
+				s[n].SensorTime = 0
+				n++
+			}
+			i += l
+		case h == headerSkip:
+			overflow = true
+			i += 2
+		case h == headerConfig:
+			i += 2
+		case h == headerTime:
+			i += 4
+		default:
+			return n, fmt.Errorf("bmi160: unexpected FIFO frame header 0x%02x", h)
+		}
+	}
+	now := time.Now()
+	period := d.odr.Period()
+	for i := range s[:n] {
+		s[i].Timestamp = now.Add(-time.Duration(n-1-i) * period)
+	}
+	if overflow {
+		return n, ErrFIFOOverflow
+	}
+	return n, nil
+}
+
+// ResetFIFO discards the samples queued in the FIFO. It requires Opts.FIFO.
+func (d *Dev) ResetFIFO() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return err
+	}
+	return d.writeRegs(regCMD, cmdFIFOFlush)
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+// fifoBytes returns the number of bytes queued in the FIFO.
+func (d *Dev) fifoBytes() (int, error) {
+	var b [2]byte
+	if err := d.readRegs(regFIFOLENGTH, b[:]); err != nil {
+		return 0, err
+	}
+	// fifo_byte_counter is 11 bits, little endian.
+	return int(b[1]&0x07)<<8 | int(b[0]), nil
+}
+
+var errFIFODisabled = errors.New("bmi160: FIFO not enabled in Opts")
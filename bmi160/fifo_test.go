@@ -0,0 +1,118 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bmi160
+
+import (
+	"errors"
+	"testing"
+)
+
+// frame returns a FIFO frame with accelerometer and gyroscope data.
+func frame(accel, gyro [3]int16) []byte {
+	b := []byte{0x8C}
+	for _, v := range append(gyro[:], accel[:]...) {
+		b = append(b, byte(v), byte(uint16(v)>>8))
+	}
+	return b
+}
+
+// fifoNewOps returns the bus transactions issued by New with Opts.FIFO.
+func fifoNewOps() *ops {
+	o := newOps(false, defaultConf...)
+	o.write(regFIFOCONFIG1, fifoAccGyrHeader)
+	o.write(regCMD, cmdFIFOFlush)
+	return o
+}
+
+func TestReadFIFO(t *testing.T) {
+	o := fifoNewOps()
+	o.read(regFIFOLENGTH, 28, 0)
+	// A configuration frame and a frame with only the accelerometer precede
+	// the complete ones.
+	var b []byte
+	b = append(b, headerConfig, 0x01)
+	b = append(b, 0x84, 1, 0, 2, 0, 3, 0)
+	b = append(b, frame([3]int16{16384, 0, 0}, [3]int16{164, 0, 0})...)
+	b = append(b, headerOverRead)
+	o.read(regFIFOLENGTH, 23, 0)
+	o.read(regFIFODATA, b...)
+	o.read(regFIFOLENGTH, 0, 0)
+	o.write(regCMD, cmdFIFOFlush)
+	bus := o.bus()
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 2 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]Sample, 4)
+	if n, err := d.ReadFIFO(s); err != nil || n != 1 || s[0].RawAccel != [3]int16{16384, 0, 0} || s[0].RawGyro != [3]int16{164, 0, 0} {
+		t.Fatalf("ReadFIFO() = %d, %v, %v", n, s[0], err)
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := d.ResetFIFO(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Overflow(t *testing.T) {
+	o := fifoNewOps()
+	var b []byte
+	b = append(b, frame([3]int16{1, 0, 0}, [3]int16{})...)
+	b = append(b, headerSkip, 3)
+	b = append(b, frame([3]int16{2, 0, 0}, [3]int16{})...)
+	b = append(b, frame([3]int16{3, 0, 0}, [3]int16{})...)
+	o.read(regFIFOLENGTH, 41, 0)
+	// Limited by len(s); the last frame is read partially.
+	o.read(regFIFODATA, b[:39]...)
+	o.read(regFIFOLENGTH, 0xFF, 0xF8)
+	o.read(regFIFODATA, append([]byte{0x1C}, make([]byte, 12)...)...)
+	bus := o.bus()
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]Sample, 3)
+	n, err := d.ReadFIFO(s)
+	if !errors.Is(err, ErrFIFOOverflow) || n != 2 || s[0].RawAccel[0] != 1 || s[1].RawAccel[0] != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if diff := s[1].Timestamp.Sub(s[0].Timestamp); diff != d.odr.Period() {
+		t.Fatalf("timestamps %s apart", diff)
+	}
+	// fifo_byte_counter is 11 bits.
+	if _, err := d.ReadFIFO(s[:1]); err == nil || err.Error() != "bmi160: unexpected FIFO frame header 0x1c" {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	bus := newOps(false, defaultConf...).bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	if _, err := d.FIFOLen(); err != errFIFODisabled {
+		t.Fatalf("FIFOLen() = %v", err)
+	}
+	if err := d.ResetFIFO(); err != errFIFODisabled {
+		t.Fatalf("ResetFIFO() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
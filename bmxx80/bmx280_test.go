@@ -10,6 +10,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -349,6 +350,128 @@ func TestI2CSenseBMP280_success(t *testing.T) {
 	}
 }
 
+func TestI2CSenseBMP280_standby(t *testing.T) {
+	bus := i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// Chip ID detection.
+			{Addr: 0x76, W: []byte{0xd0}, R: []byte{0x58}},
+			// Calibration data.
+			{
+				Addr: 0x76,
+				W:    []byte{0x88},
+				R:    []byte{0x10, 0x6e, 0x6c, 0x66, 0x32, 0x0, 0x5d, 0x95, 0xb8, 0xd5, 0xd0, 0xb, 0x77, 0x1e, 0x9d, 0xff, 0xf9, 0xff, 0xac, 0x26, 0xa, 0xd8, 0xbd, 0x10, 0x0, 0x4b},
+			},
+			// Configuration in normal mode, 500ms standby, filter F4.
+			{Addr: 0x76, W: []byte{0xf4, 0x2c, 0xf5, 0x88, 0xf4, 0x2f}},
+			// Read, without forced mode.
+			{Addr: 0x76, W: []byte{0xf7}, R: []byte{0x4a, 0x52, 0xc0, 0x80, 0x96, 0xc0}},
+			// Halt puts the device to sleep.
+			{Addr: 0x76, W: []byte{0xf5, 0xa8, 0xf4, 0x2c}},
+			// Forced mode.
+			{Addr: 0x76, W: []byte{0xf4, 0x2d}},
+			// Check if idle.
+			{Addr: 0x76, W: []byte{0xf3}, R: []byte{0}},
+			// Read.
+			{Addr: 0x76, W: []byte{0xf7}, R: []byte{0x4a, 0x52, 0xc0, 0x80, 0x96, 0xc0}},
+		},
+	}
+	opts := Opts{Temperature: O1x, Pressure: O4x, Filter: F4, Standby: 500 * time.Millisecond}
+	dev, err := NewI2C(&bus, 0x76, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := physic.Env{}
+	if err := dev.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if expected := 23720*physic.MilliCelsius + physic.ZeroCelsius; e.Temperature != expected {
+		t.Fatalf("temperature %s(%d) != %s(%d)", expected, expected, e.Temperature, e.Temperature)
+	}
+	if err := dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestI2CSenseBME280_standby_filter(t *testing.T) {
+	calib := []i2ctest.IO{
+		// Chip ID detection.
+		{Addr: 0x76, W: []byte{0xd0}, R: []byte{0x60}},
+		// Calibration data.
+		{
+			Addr: 0x76,
+			W:    []byte{0x88},
+			R:    []byte{0x10, 0x6e, 0x6c, 0x66, 0x32, 0x0, 0x5d, 0x95, 0xb8, 0xd5, 0xd0, 0xb, 0x77, 0x1e, 0x9d, 0xff, 0xf9, 0xff, 0xac, 0x26, 0xa, 0xd8, 0xbd, 0x10, 0x0, 0x4b},
+		},
+		// Calibration data humidity.
+		{Addr: 0x76, W: []byte{0xe1}, R: []byte{0x6e, 0x1, 0x0, 0x13, 0x5, 0x0, 0x1e}},
+	}
+	read := i2ctest.IO{Addr: 0x76, W: []byte{0xf7}, R: []byte{0x4a, 0x52, 0xc0, 0x80, 0x96, 0xc0, 0x7a, 0x76}}
+	var ops []i2ctest.IO
+	// Standby without filter: normal mode, 20ms standby.
+	ops = append(ops, calib...)
+	ops = append(ops,
+		i2ctest.IO{Addr: 0x76, W: []byte{0xf4, 0x54, 0xf2, 0x1, 0xf5, 0xe0, 0xf4, 0x57}},
+		read,
+		i2ctest.IO{Addr: 0x76, W: []byte{0xf5, 0xa0, 0xf4, 0x54}},
+	)
+	// Filter without standby: forced mode, filter F16.
+	ops = append(ops, calib...)
+	ops = append(ops,
+		i2ctest.IO{Addr: 0x76, W: []byte{0xf4, 0x6c, 0xf2, 0x3, 0xf5, 0xb0, 0xf4, 0x6c}},
+		i2ctest.IO{Addr: 0x76, W: []byte{0xf4, 0x6d}},
+		i2ctest.IO{Addr: 0x76, W: []byte{0xf3}, R: []byte{0}},
+		read,
+	)
+	// Invalid filter.
+	ops = append(ops, calib[0])
+	bus := i2ctest.Playback{Ops: ops}
+
+	dev, err := NewI2C(&bus, 0x76, &Opts{Temperature: O2x, Pressure: O16x, Humidity: O1x, Standby: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := physic.Env{}
+	if err := dev.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if expected := 6530560 * physic.TenthMicroRH; e.Humidity != expected {
+		t.Fatalf("humidity %s(%d) != %s(%d)", expected, expected, e.Humidity, e.Humidity)
+	}
+	if err := dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := DefaultOpts
+	opts.Filter = F16
+	if dev, err = NewI2C(&bus, 0x76, &opts); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	// Already asleep.
+	if err := dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Filter = F16 + 1
+	if _, err := NewI2C(&bus, 0x76, &opts); err == nil || err.Error() != "bme280: invalid filter 5, use at most F16" {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestI2CSenseBME280_success(t *testing.T) {
 	bus := i2ctest.Playback{
 		Ops: []i2ctest.IO{
@@ -582,6 +705,64 @@ func TestI2CSenseContinuous280_success(t *testing.T) {
 	}
 }
 
+func TestI2CSenseContinuous280_halt_during_sense(t *testing.T) {
+	bus := &blockingBus{
+		Playback: i2ctest.Playback{
+			Ops: []i2ctest.IO{
+				// Chip ID detection.
+				{Addr: 0x76, W: []byte{0xd0}, R: []byte{0x60}},
+				// Calibration data.
+				{
+					Addr: 0x76,
+					W:    []byte{0x88},
+					R:    []byte{0x10, 0x6e, 0x6c, 0x66, 0x32, 0x0, 0x5d, 0x95, 0xb8, 0xd5, 0xd0, 0xb, 0x77, 0x1e, 0x9d, 0xff, 0xf9, 0xff, 0xac, 0x26, 0xa, 0xd8, 0xbd, 0x10, 0x0, 0x4b},
+				},
+				// Calibration data humidity.
+				{Addr: 0x76, W: []byte{0xe1}, R: []byte{0x6e, 0x1, 0x0, 0x13, 0x5, 0x0, 0x1e}},
+				// Configuration.
+				{Addr: 0x76, W: []byte{0xf4, 0x6c, 0xf2, 0x3, 0xf5, 0xa0, 0xf4, 0x6c}, R: nil},
+				// Normal mode.
+				{Addr: 0x76, W: []byte{0xF5, 0, 0xf4, 0x6f}},
+				// Sleep mode, by Halt.
+				{Addr: 0x76, W: []byte{0xF5, 0xa0, 0xf4, 0x6c}},
+			},
+		},
+		reading: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	dev, err := NewI2C(bus, 0x76, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := dev.SenseContinuous(time.Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for range c {
+		}
+	}()
+	<-bus.reading
+	done := make(chan error)
+	go func() {
+		done <- dev.Halt()
+	}()
+	// Let Halt wait for the lock held by the sample in flight.
+	time.Sleep(10 * time.Millisecond)
+	close(bus.release)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Halt deadlocked")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestI2CSenseContinuous280_command_fail(t *testing.T) {
 	bus := i2ctest.Playback{
 		Ops: []i2ctest.IO{
@@ -1025,6 +1206,27 @@ func (s *spiFail) Connect(f physic.Frequency, mode spi.Mode, bits int) (spi.Conn
 	return nil, errors.New("failing")
 }
 
+// blockingBus answers the measurement reads with a fixed sample, blocking the
+// first one until release is closed. The other transactions are played back.
+type blockingBus struct {
+	i2ctest.Playback
+	reading chan struct{} // closed when the first measurement read starts
+	release chan struct{}
+	once    sync.Once
+}
+
+func (b *blockingBus) Tx(addr uint16, w, r []byte) error {
+	if len(w) == 1 && w[0] == 0xf7 {
+		b.once.Do(func() {
+			close(b.reading)
+			<-b.release
+		})
+		copy(r, []byte{0x4a, 0x52, 0xc0, 0x80, 0x96, 0xc0, 0x7a, 0x76})
+		return nil
+	}
+	return b.Playback.Tx(addr, w, r)
+}
+
 func TestMain(m *testing.M) {
 	flag.Parse()
 	if !testing.Verbose() {
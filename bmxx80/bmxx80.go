@@ -138,11 +138,14 @@ type Opts struct {
 	// Humidity sensing is only supported on BME280. The value is ignored on other
 	// devices.
 	Humidity Oversampling
-	// Filter is only used while using SenseContinuous() or with a pre-set standby duration
-	// It is only supported on BMx280.
+	// Filter is applied to the pressure and temperature measurements, in both
+	// the forced and normal modes. It is only supported on BMx280.
 	Filter Filter
-	// Standby Used with Filter to control the time between samples.
-	// If this is set we enable the filter on device creation and set the device mode to normal instead of sleep.
+	// Standby is the time between samples in normal mode. If this is set, the
+	// device is put in normal mode on creation instead of sleep, and Sense
+	// reads the latest sample instead of triggering a forced measurement. It
+	// is rounded down to a duration supported by the device. It is only
+	// supported on BMx280.
 	Standby time.Duration
 }
 
@@ -240,7 +243,7 @@ func (d *Dev) Sense(e *physic.Env) error {
 
 	if d.is280 {
 		// Skip setting mode to forced if we are already in normal mode
-		if d.opts.Filter == NoFilter || d.opts.Standby == 0 {
+		if d.opts.Standby == 0 {
 			err := d.writeCommands([]byte{
 				// ctrl_meas
 				0xF4, byte(d.opts.Temperature)<<5 | byte(d.opts.Pressure)<<2 | byte(forced),
@@ -269,14 +272,10 @@ func (d *Dev) Sense(e *physic.Env) error {
 // It's the responsibility of the caller to retrieve the values from the
 // channel as fast as possible, otherwise the interval may not be respected.
 func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	// Don't send the stop command to the device.
+	d.stopContinuous()
 	d.mu.Lock()
 	defer d.mu.Unlock()
-	if d.stop != nil {
-		// Don't send the stop command to the device.
-		close(d.stop)
-		d.stop = nil
-		d.wg.Wait()
-	}
 
 	if d.is280 {
 		s := chooseStandby(d.isBME, interval-d.measDelay)
@@ -294,11 +293,11 @@ func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error)
 	sensing := make(chan physic.Env)
 	d.stop = make(chan struct{})
 	d.wg.Add(1)
-	go func() {
+	go func(stop <-chan struct{}) {
 		defer d.wg.Done()
 		defer close(sensing)
-		d.sensingContinuous(interval, sensing, d.stop)
-	}()
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
 	return sensing, nil
 }
 
@@ -318,25 +317,29 @@ func (d *Dev) Precision(e *physic.Env) {
 }
 
 // Halt stops the BMxx80 from acquiring measurements as initiated by
-// SenseContinuous().
+// SenseContinuous(), or by Opts.Standby on the BMx280.
 //
 // It is recommended to call this function before terminating the process to
 // reduce idle power usage and a goroutine leak.
+//
+// After a Halt, Sense triggers a forced measurement even if Opts.Standby was
+// set.
 func (d *Dev) Halt() error {
+	sensing := d.stopContinuous()
 	d.mu.Lock()
 	defer d.mu.Unlock()
-	if d.stop == nil {
+	if !sensing && (!d.is280 || d.opts.Standby == 0) {
+		// The device is already asleep.
 		return nil
 	}
-	close(d.stop)
-	d.stop = nil
-	d.wg.Wait()
 
 	if d.is280 {
+		// The device is not cycling in normal mode anymore.
+		d.opts.Standby = 0
 		// Page 27 (for register) and 12~13 section 3.3.
 		return d.writeCommands([]byte{
 			// config
-			0xF5, byte(s1s)<<5 | byte(NoFilter)<<2,
+			0xF5, byte(s1s)<<5 | byte(d.opts.Filter)<<2,
 			// ctrl_meas
 			0xF4, byte(d.opts.Temperature)<<5 | byte(d.opts.Pressure)<<2 | byte(sleep),
 		})
@@ -374,6 +377,10 @@ func (d *Dev) makeDev(opts *Opts) error {
 		return fmt.Errorf("bmxx80: unexpected chip id %x", chipID[0])
 	}
 
+	if d.is280 && opts.Filter > F16 {
+		return d.wrap(fmt.Errorf("invalid filter %d, use at most F16", opts.Filter))
+	}
+
 	if d.is280 && opts.Temperature == Off {
 		// Ignore the value for BMP180, since it's not controllable.
 		return d.wrap(errors.New("temperature measurement is required, use at least O1x"))
@@ -395,11 +402,10 @@ func (d *Dev) makeDev(opts *Opts) error {
 		}
 		d.cal280 = newCalibration(tph[:], h[:])
 		standbyDuration := s1s
-		filter := NoFilter
+		filter := d.opts.Filter
 		startingMode := sleep
-		if d.opts.Filter != NoFilter && d.opts.Standby != 0 {
+		if d.opts.Standby != 0 {
 			standbyDuration = chooseStandby(d.isBME, d.opts.Standby)
-			filter = d.opts.Filter
 			startingMode = normal
 		}
 		var b []byte
@@ -442,6 +448,24 @@ func (d *Dev) makeDev(opts *Opts) error {
 	return nil
 }
 
+// stopContinuous stops the SenseContinuous goroutine, if any, and reports
+// whether it was running.
+//
+// It releases the lock before waiting since the goroutine needs it to finish
+// a pending measurement.
+func (d *Dev) stopContinuous() bool {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop == nil {
+		return false
+	}
+	close(stop)
+	d.wg.Wait()
+	return true
+}
+
 func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
 	t := time.NewTicker(interval)
 	defer t.Stop()
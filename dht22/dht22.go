@@ -0,0 +1,282 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dht22
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"runtime"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Protocol timings.
+const (
+	// minInterval is the minimum time between two reads.
+	minInterval = 2 * time.Second
+	// startTime is how long the host pulls the line low to start a read,
+	// at least 1ms.
+	startTime = 1100 * time.Microsecond
+	// responseTimeout bounds the response of the device, 20µs to 40µs after
+	// the line is released, and its 80µs low and high pulses.
+	responseTimeout = 200 * time.Microsecond
+	// bitTimeout bounds the 50µs low and the 26µs to 70µs high pulses of a
+	// bit.
+	bitTimeout = 100 * time.Microsecond
+	// bitThreshold tells a 0 from a 1 by the width of the high pulse.
+	bitThreshold = 48 * time.Microsecond
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrChecksum is returned when a read fails its checksum.
+	ErrChecksum = errors.New("dht22: checksum mismatch")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("dht22: invalid options")
+	// ErrTimeout is returned when the device doesn't respond or a pulse is
+	// missed.
+	ErrTimeout = errors.New("dht22: timeout")
+)
+
+// DefaultOpts are the recommended options.
+var DefaultOpts = Opts{
+	Retries: 2,
+}
+
+// Opts holds initialization options.
+//
+// Retries: number of times a read is retried after ErrTimeout or
+// ErrChecksum, minInterval apart.
+type Opts struct {
+	Retries int
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Retries < 0 {
+		return fmt.Errorf("%w: Retries %d, want 0 or more", ErrInvalidOpts, o.Retries)
+	}
+	return nil
+}
+
+// Dev is a handle to a DHT22 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	p    gpio.PinIO
+	opts Opts
+
+	mu   sync.Mutex
+	last time.Time // end of the last read
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a handle to a device on the pin p, releasing the line.
+func New(p gpio.PinIO, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := p.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return nil, fmt.Errorf("dht22: %w", err)
+	}
+	return &Dev{p: p, opts: opts, last: now()}, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("DHT22{%s}", d.p)
+}
+
+// Sense reads the temperature and the humidity. It implements
+// physic.SenseEnv.
+//
+// It blocks until 2 seconds after the previous read.
+//
+// The pressure is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("dht22: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseContinuous returns measurements of the temperature and humidity on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be at least 2 seconds.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval < minInterval {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, minInterval)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 100 * physic.MilliKelvin
+	e.Humidity = physic.MilliRH
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device idles between reads.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense reads the device, with retries.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	var err error
+	for i := 0; i <= d.opts.Retries; i++ {
+		if wait := minInterval - now().Sub(d.last); wait > 0 {
+			doSleep(wait)
+		}
+		var b [5]byte
+		b, err = d.read()
+		d.last = now()
+		if err == nil {
+			err = decode(b, e)
+		}
+		if !errors.Is(err, ErrTimeout) && !errors.Is(err, ErrChecksum) {
+			return err
+		}
+	}
+	return err
+}
+
+// read runs one read of the 40 bits.
+func (d *Dev) read() ([5]byte, error) {
+	var b [5]byte
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := d.p.Out(gpio.Low); err != nil {
+		return b, fmt.Errorf("dht22: %w", err)
+	}
+	doSleep(startTime)
+	if err := d.p.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return b, fmt.Errorf("dht22: %w", err)
+	}
+	// The response: the device pulls low for 80µs then releases for 80µs.
+	for _, l := range []gpio.Level{gpio.Low, gpio.High, gpio.Low} {
+		if _, err := d.waitFor(l, responseTimeout); err != nil {
+			return b, fmt.Errorf("%w: no response", err)
+		}
+	}
+	// Each bit is 50µs low then 26µs high for a 0 or 70µs for a 1, MSB
+	// first.
+	for i := 0; i < 40; i++ {
+		if _, err := d.waitFor(gpio.High, bitTimeout); err != nil {
+			return b, fmt.Errorf("%w: bit %d", err, i)
+		}
+		w, err := d.waitFor(gpio.Low, bitTimeout)
+		if err != nil {
+			return b, fmt.Errorf("%w: bit %d", err, i)
+		}
+		if w > bitThreshold {
+			b[i/8] |= 0x80 >> uint(i%8)
+		}
+	}
+	return b, nil
+}
+
+// waitFor busy loops until the line reads l, and returns how long it took.
+func (d *Dev) waitFor(l gpio.Level, timeout time.Duration) (time.Duration, error) {
+	start := now()
+	for {
+		if d.p.Read() == l {
+			return now().Sub(start), nil
+		}
+		if w := now().Sub(start); w > timeout {
+			return w, ErrTimeout
+		}
+	}
+}
+
+// decode checks the checksum of a read and converts it.
+//
+// The humidity and the temperature are in 0.1 units, the temperature in
+// sign and magnitude.
+func decode(b [5]byte, e *physic.Env) error {
+	if sum := b[0] + b[1] + b[2] + b[3]; sum != b[4] {
+		return fmt.Errorf("%w: %#02x, want %#02x", ErrChecksum, sum, b[4])
+	}
+	h := uint16(b[0])<<8 | uint16(b[1])
+	t := physic.Temperature(uint16(b[2]&0x7F)<<8|uint16(b[3])) * 100 * physic.MilliKelvin
+	if b[2]&0x80 != 0 {
+		t = -t
+	}
+	e.Temperature = t + physic.ZeroCelsius
+	e.Humidity = physic.RelativeHumidity(h) * physic.MilliRH
+	return nil
+}
+
+var (
+	doSleep = time.Sleep
+	now     = time.Now
+)
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,277 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dht22
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// clock is a virtual clock, advanced by sleeps and by each read of the pin
+// so the pulses are timed deterministically.
+var clock struct {
+	sync.Mutex
+	t time.Time
+}
+
+func advance(d time.Duration) time.Time {
+	clock.Lock()
+	defer clock.Unlock()
+	clock.t = clock.t.Add(d)
+	return clock.t
+}
+
+func init() {
+	clock.t = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	doSleep = func(d time.Duration) { advance(d) }
+	now = func() time.Time { return advance(0) }
+}
+
+// pulse is a level held on the line for a duration.
+type pulse struct {
+	l gpio.Level
+	d time.Duration
+}
+
+// waveform returns the response of the device sending b.
+func waveform(b [5]byte) []pulse {
+	p := []pulse{
+		{gpio.High, 30 * time.Microsecond},
+		{gpio.Low, 80 * time.Microsecond},
+		{gpio.High, 80 * time.Microsecond},
+	}
+	for i := 0; i < 40; i++ {
+		w := 26 * time.Microsecond
+		if b[i/8]&(0x80>>uint(i%8)) != 0 {
+			w = 70 * time.Microsecond
+		}
+		p = append(p, pulse{gpio.Low, 50 * time.Microsecond}, pulse{gpio.High, w})
+	}
+	return append(p, pulse{gpio.Low, 50 * time.Microsecond})
+}
+
+// fakePin plays one waveform per read, then leaves the line high.
+type fakePin struct {
+	gpiotest.Pin
+	waves    [][]pulse
+	attempts int
+	pulled   bool
+	start    time.Time
+}
+
+func (f *fakePin) In(pull gpio.Pull, edge gpio.Edge) error {
+	if f.pulled {
+		f.pulled = false
+		f.attempts++
+		f.start = advance(0)
+	}
+	return f.Pin.In(pull, edge)
+}
+
+func (f *fakePin) Out(l gpio.Level) error {
+	f.pulled = l == gpio.Low
+	return f.Pin.Out(l)
+}
+
+func (f *fakePin) Read() gpio.Level {
+	t := advance(time.Microsecond).Sub(f.start)
+	if f.attempts == 0 || f.attempts > len(f.waves) {
+		return gpio.High
+	}
+	for _, p := range f.waves[f.attempts-1] {
+		if t < p.d {
+			return p.l
+		}
+		t -= p.d
+	}
+	return gpio.High
+}
+
+// testData is 65.2%rH at -10.1°C.
+var testData = [5]byte{0x02, 0x8C, 0x80, 0x65, 0x73}
+
+var testEnv = physic.Env{
+	Temperature: physic.ZeroCelsius - 10100*physic.MilliKelvin,
+	Humidity:    652 * physic.MilliRH,
+}
+
+func newPin(waves ...[]pulse) *fakePin {
+	return &fakePin{Pin: gpiotest.Pin{N: "GPIO4", Num: 4}, waves: waves}
+}
+
+func TestNew(t *testing.T) {
+	p := newPin()
+	d, err := New(p, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.P != gpio.PullUp {
+		t.Fatal(p.P)
+	}
+	if s := d.String(); s != "DHT22{GPIO4(4)}" {
+		t.Fatal(s)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	o := Opts{Retries: -1}
+	const want = "dht22: invalid options: Retries -1, want 0 or more"
+	if err := o.Validate(); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+	if _, err := New(newPin(), o); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	p := newPin(waveform(testData))
+	d, err := New(p, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := now()
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if w := now().Sub(start); w < minInterval {
+		t.Fatalf("read after %s, want at least %s", w, minInterval)
+	}
+	if p.attempts != 1 {
+		t.Fatal(p.attempts)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_retry(t *testing.T) {
+	bad := testData
+	bad[4]++
+	p := newPin(nil, waveform(bad), waveform(testData))
+	d, err := New(p, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if p.attempts != 3 {
+		t.Fatal(p.attempts)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	bad := testData
+	bad[4]++
+	// A waveform cut short in the middle of the bits.
+	short := waveform(testData)[:20]
+	data := []struct {
+		waves [][]pulse
+		err   error
+		msg   string
+	}{
+		{nil, ErrTimeout, "dht22: timeout: no response"},
+		{[][]pulse{short}, ErrTimeout, "dht22: timeout: bit 8"},
+		{[][]pulse{waveform(bad)}, ErrChecksum, "dht22: checksum mismatch: 0x73, want 0x74"},
+	}
+	for i, line := range data {
+		p := newPin(line.waves...)
+		d, err := New(p, Opts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); !errors.Is(err, line.err) || err.Error() != line.msg {
+			t.Fatalf("#%d: %v, want %q", i, err, line.msg)
+		}
+		if p.attempts != 1 {
+			t.Fatal(i, p.attempts)
+		}
+	}
+	p := newPin()
+	d, err := New(p, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); !errors.Is(err, ErrTimeout) {
+		t.Fatal(err)
+	}
+	if p.attempts != DefaultOpts.Retries+1 {
+		t.Fatal(p.attempts)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	p := newPin(waveform(testData))
+	d, err := New(p, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(time.Second); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(minInterval)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := <-c; e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 100*physic.MilliKelvin || e.Humidity != physic.MilliRH {
+		t.Fatal(e)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	data := []struct {
+		b [5]byte
+		e physic.Env
+	}{
+		{testData, testEnv},
+		{[5]byte{0x03, 0xE8, 0x00, 0xFA, 0xE5}, physic.Env{Temperature: physic.ZeroCelsius + 25*physic.Celsius, Humidity: 100 * physic.PercentRH}},
+		{[5]byte{0x00, 0x00, 0x00, 0x00, 0x00}, physic.Env{Temperature: physic.ZeroCelsius}},
+	}
+	for i, line := range data {
+		var e physic.Env
+		if err := decode(line.b, &e); err != nil {
+			t.Fatal(i, err)
+		}
+		if e != line.e {
+			t.Fatalf("#%d: %#v, want %#v", i, e, line.e)
+		}
+	}
+}
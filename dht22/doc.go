@@ -0,0 +1,27 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package dht22 controls an Aosong DHT22 (AM2302) temperature and humidity
+// sensor on a GPIO pin.
+//
+// # More details
+//
+// The device uses its own single wire protocol where the bits are encoded in
+// the width of pulses of 26µs to 70µs. The driver times them by busy looping
+// on the pin, which requires a fast GPIO driver, e.g. the memory mapped
+// drivers of periph.io/x/host, and a system that doesn't preempt the process
+// mid-read. Reads that miss a pulse or fail their checksum are retried, as
+// set by Opts.Retries.
+//
+// The device measures at most every 2 seconds; Sense waits in between. Each
+// read returns the measurement made at the end of the previous read, so the
+// first read after a long pause may be stale.
+//
+// The data line needs a pull-up, typically 4.7kΩ to 10kΩ to 3.3V; most
+// modules include it.
+//
+// # Datasheet
+//
+// https://www.sparkfun.com/datasheets/Sensors/Temperature/DHT22.pdf
+package dht22
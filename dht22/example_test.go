@@ -0,0 +1,36 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dht22_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/dht22"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	p := gpioreg.ByName("GPIO4")
+	if p == nil {
+		log.Fatal("failed to find GPIO4")
+	}
+	d, err := dht22.New(p, dht22.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize dht22: %v", err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%8s %9s\n", e.Temperature, e.Humidity)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dps310
+
+// coefLen is the length of the coefficients block.
+const coefLen = 18
+
+// scaleFactors are the compensation scale factors of each oversampling.
+var scaleFactors = [...]float64{524288, 1572864, 3670016, 7864320, 253952, 516096, 1040384, 2088960}
+
+// coefficients holds the calibration coefficients of the device.
+type coefficients struct {
+	c0, c1                  int32 // 12 bits
+	c00, c10                int32 // 20 bits
+	c01, c11, c20, c21, c30 int32 // 16 bits
+}
+
+// newCoefficients parses the coefficients read at 0x10.
+func newCoefficients(b []byte) coefficients {
+	return coefficients{
+		c0:  signExtend(uint32(b[0])<<4|uint32(b[1])>>4, 12),
+		c1:  signExtend(uint32(b[1])&0x0F<<8|uint32(b[2]), 12),
+		c00: signExtend(uint32(b[3])<<12|uint32(b[4])<<4|uint32(b[5])>>4, 20),
+		c10: signExtend(uint32(b[5])&0x0F<<16|uint32(b[6])<<8|uint32(b[7]), 20),
+		c01: signExtend(uint32(b[8])<<8|uint32(b[9]), 16),
+		c11: signExtend(uint32(b[10])<<8|uint32(b[11]), 16),
+		c20: signExtend(uint32(b[12])<<8|uint32(b[13]), 16),
+		c21: signExtend(uint32(b[14])<<8|uint32(b[15]), 16),
+		c30: signExtend(uint32(b[16])<<8|uint32(b[17]), 16),
+	}
+}
+
+// signExtend returns the two's complement value of the low bits of v.
+func signExtend(v uint32, bits uint) int32 {
+	return int32(v<<(32-bits)) >> (32 - bits)
+}
+
+// temperature returns the temperature in °C from the scaled raw temperature.
+func (c *coefficients) temperature(t float64) float64 {
+	return float64(c.c0)/2 + float64(c.c1)*t
+}
+
+// pressure returns the pressure in Pa from the scaled raw pressure and
+// temperature.
+func (c *coefficients) pressure(p, t float64) float64 {
+	return float64(c.c00) +
+		p*(float64(c.c10)+p*(float64(c.c20)+p*float64(c.c30))) +
+		t*float64(c.c01) +
+		t*p*(float64(c.c11)+p*float64(c.c21))
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dps310
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewCoefficients(t *testing.T) {
+	want := coefficients{
+		c0: 209, c1: -259,
+		c00: 80472, c10: -54135,
+		c01: -2886, c11: 1180, c20: -10349, c21: 112, c30: -1168,
+	}
+	if c := newCoefficients(testCoef); c != want {
+		t.Fatalf("%+v, want %+v", c, want)
+	}
+}
+
+func TestCompensate(t *testing.T) {
+	c := newCoefficients(testCoef)
+	tSc := 167000 / scaleFactors[O1x]
+	if v := c.temperature(tSc); math.Abs(v-22.00145) > 0.00001 {
+		t.Fatal(v)
+	}
+	if v := c.pressure(-405000/scaleFactors[O64x], tSc); math.Abs(v-98986.0887) > 0.0001 {
+		t.Fatal(v)
+	}
+}
+
+func TestSignExtend(t *testing.T) {
+	data := []struct {
+		v    uint32
+		bits uint
+		want int32
+	}{
+		{0x7FF, 12, 2047},
+		{0x800, 12, -2048},
+		{0xFFFFF, 20, -1},
+		{0x8000, 16, -32768},
+	}
+	for _, line := range data {
+		if v := signExtend(line.v, line.bits); v != line.want {
+			t.Fatalf("%#x on %d bits: %d, want %d", line.v, line.bits, v, line.want)
+		}
+	}
+}
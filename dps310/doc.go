@@ -0,0 +1,28 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package dps310 controls an Infineon DPS310 barometric pressure sensor over
+// I²C or SPI.
+//
+// # More details
+//
+// The DPS310 is a capacitive pressure sensor with a precision of ±0.002hPa,
+// about 2cm of altitude, at its highest oversampling. The raw pressure and
+// temperature are compensated with the calibration coefficients read from the
+// device at initialization, with the formulas of the datasheet.
+//
+// With the default Opts, each Sense commands a temperature then a pressure
+// measurement. With Opts.Rate, the device measures both in the background at
+// 1 to 128Hz; with Opts.FIFO the results are queued in its 32 levels FIFO,
+// read in batches with ReadFIFO.
+//
+// Some devices report a temperature about twice the actual one, and so a
+// wrong pressure, after power up. New applies the register sequence
+// recommended by the manufacturer to correct it, then takes a first
+// temperature measurement.
+//
+// # Datasheet
+//
+// https://www.infineon.com/dgdl/Infineon-DPS310-DataSheet-v01_02-EN.pdf?fileId=5546d462576f34750157750826c42242
+package dps310
@@ -0,0 +1,521 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dps310
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// I²C addresses, selected by the SDO pin. SDO has an internal pull-up.
+const (
+	DefaultAddr = 0x77 // SDO high or unconnected
+	AltAddr     = 0x76 // SDO low
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the DPS310.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Register map.
+const (
+	regPSR      = 0x00 // PSR_B2, B1, B0, then TMP_B2, B1, B0
+	regTMP      = 0x03
+	regPRSCFG   = 0x06 // PM_RATE bits 6..4, PM_PRC bits 3..0
+	regTMPCFG   = 0x07 // TMP_EXT bit 7, TMP_RATE bits 6..4, TMP_PRC bits 3..0
+	regMEASCFG  = 0x08 // COEF_RDY bit 7, SENSOR_RDY bit 6, TMP_RDY bit 5, PRS_RDY bit 4, MEAS_CTRL bits 2..0
+	regCFG      = 0x09 // T_SHIFT bit 3, P_SHIFT bit 2, FIFO_EN bit 1
+	regFIFOSTS  = 0x0B // FIFO_FULL bit 1, FIFO_EMPTY bit 0
+	regRESET    = 0x0C // FIFO_FLUSH bit 7, SOFT_RST bits 3..0
+	regID       = 0x0D
+	regCOEF     = 0x10
+	regCOEFSRCE = 0x28 // TMP_COEF_SRCE bit 7
+)
+
+// productID is the value of the product and revision ID register.
+const productID = 0x10
+
+// Register values.
+const (
+	measCoefRdy   = 0x80
+	measSensorRdy = 0x40
+	measTmpRdy    = 0x20
+	measPrsRdy    = 0x10
+	measIdle      = 0x00
+	measPressure  = 0x01
+	measTemp      = 0x02
+	measBoth      = 0x07 // continuous pressure and temperature
+	cfgTShift     = 0x08 // required above 8 times oversampling
+	cfgPShift     = 0x04
+	cfgFIFOEn     = 0x02
+	softReset     = 0x09
+	tmpExt        = 0x80 // the temperature of the MEMS element, as selected by COEF_SRCE
+)
+
+// tempFix are the register writes recommended by the manufacturer to correct
+// the temperature of some devices, as register and value pairs.
+var tempFix = [...][2]byte{{0x0E, 0xA5}, {0x0F, 0x96}, {0x62, 0x02}, {0x0E, 0x00}, {0x0F, 0x00}}
+
+// resetTime is the time to load the coefficients after a soft reset.
+const resetTime = 40 * time.Millisecond
+
+// readyPolls is the number of times the status is polled for completion
+// after the expected duration, one millisecond apart.
+const readyPolls = 10
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the ID register doesn't read 0x10.
+	ErrBadID = errors.New("dps310: bad chip ID")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("dps310: invalid options")
+	// ErrNotReady is returned when a measurement doesn't complete in time.
+	ErrNotReady = errors.New("dps310: not ready")
+	// ErrFIFOOverflow is returned by ReadFIFO along with the measurements
+	// when newer ones were discarded because the FIFO was full.
+	ErrFIFOOverflow = errors.New("dps310: FIFO overflow")
+)
+
+// Oversampling is the number of measurements averaged in each result.
+type Oversampling uint8
+
+// Possible oversampling values. Each step lowers the noise and lengthens the
+// measurement, from 3.6ms at O1x to 206.8ms at O128x.
+const (
+	O1x   Oversampling = 0
+	O2x   Oversampling = 1
+	O4x   Oversampling = 2
+	O8x   Oversampling = 3
+	O16x  Oversampling = 4
+	O32x  Oversampling = 5
+	O64x  Oversampling = 6
+	O128x Oversampling = 7
+)
+
+func (o Oversampling) String() string {
+	if o <= O128x {
+		return fmt.Sprintf("%dx", 1<<o)
+	}
+	return fmt.Sprintf("Oversampling(%d)", uint8(o))
+}
+
+// measTime is the measurement time of each oversampling.
+var measTime = [...]time.Duration{
+	3600 * time.Microsecond,
+	5200 * time.Microsecond,
+	8400 * time.Microsecond,
+	14800 * time.Microsecond,
+	27600 * time.Microsecond,
+	53200 * time.Microsecond,
+	104400 * time.Microsecond,
+	206800 * time.Microsecond,
+}
+
+// DefaultOpts are the options recommended by the manufacturer for a high
+// precision of the pressure, the temperature changing slowly.
+var DefaultOpts = Opts{
+	Pressure:    O64x,
+	Temperature: O1x,
+}
+
+// Opts holds initialization options.
+//
+// Pressure and Temperature: oversampling of each measurement.
+// Rate: measurement rate of the background mode, 1 to 128Hz by powers of 2.
+// 0 (default) measures on demand in Sense. The measurement time of both at
+// Rate must fit in one second, e.g. up to 8Hz with DefaultOpts.
+// FIFO: queue the measurements in the FIFO, read with ReadFIFO. It requires
+// Rate.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	Pressure    Oversampling
+	Temperature Oversampling
+	Rate        physic.Frequency
+	FIFO        bool
+	Addr        uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Pressure > O128x {
+		return fmt.Errorf("%w: Pressure %s, want O1x to O128x", ErrInvalidOpts, o.Pressure)
+	}
+	if o.Temperature > O128x {
+		return fmt.Errorf("%w: Temperature %s, want O1x to O128x", ErrInvalidOpts, o.Temperature)
+	}
+	if _, ok := o.rateCode(); !ok {
+		return fmt.Errorf("%w: Rate %s, want 0 or 1Hz to 128Hz by powers of 2", ErrInvalidOpts, o.Rate)
+	}
+	if o.Rate != 0 {
+		if busy := time.Duration(o.Rate/physic.Hertz) * o.measDuration(); busy >= time.Second {
+			return fmt.Errorf("%w: Rate %s takes %s per second at Pressure %s and Temperature %s, want below 1s", ErrInvalidOpts, o.Rate, busy, o.Pressure, o.Temperature)
+		}
+	}
+	if o.FIFO && o.Rate == 0 {
+		return fmt.Errorf("%w: FIFO with Rate 0, want a background mode Rate", ErrInvalidOpts)
+	}
+	return nil
+}
+
+// rateCode returns the PM_RATE and TMP_RATE bits, or false if the rate isn't
+// supported.
+func (o *Opts) rateCode() (byte, bool) {
+	if o.Rate == 0 {
+		return 0, true
+	}
+	for i := 0; i < 8; i++ {
+		if o.Rate == physic.Frequency(1<<i)*physic.Hertz {
+			return byte(i), true
+		}
+	}
+	return 0, false
+}
+
+// measDuration returns the duration of a temperature and a pressure
+// measurement.
+func (o *Opts) measDuration() time.Duration {
+	return measTime[o.Pressure] + measTime[o.Temperature]
+}
+
+// cfgReg returns the CFG_REG value.
+func (o *Opts) cfgReg() byte {
+	var cfg byte
+	if o.Temperature > O8x {
+		cfg |= cfgTShift
+	}
+	if o.Pressure > O8x {
+		cfg |= cfgPShift
+	}
+	if o.FIFO {
+		cfg |= cfgFIFOEn
+	}
+	return cfg
+}
+
+// Dev is a handle to an initialized DPS310 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c     conn.Conn
+	isSPI bool
+	opts  Opts
+	coef  coefficients
+	// tmpExt selects the temperature sensor matching the coefficients.
+	tmpExt byte
+	// tScaled is the last scaled raw temperature, to compensate the pressure.
+	tScaled float64
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+//
+// It is recommended to call Halt() when done with the device so it stops
+// sampling.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Bus: bus, Addr: addr}, false, opts)
+}
+
+// NewSPI resets and configures a device on a 4-wire SPI port.
+//
+// The port is connected in mode 3 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode3, 8)
+	if err != nil {
+		return nil, fmt.Errorf("dps310: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	d := &Dev{c: c, isSPI: isSPI, opts: opts}
+	var id [1]byte
+	if err := d.readReg(regID, id[:]); err != nil {
+		return nil, err
+	}
+	if id[0] != productID {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id[0], productID)
+	}
+	if err := d.writeReg(regRESET, softReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	if err := d.waitReady(measCoefRdy | measSensorRdy); err != nil {
+		return nil, err
+	}
+	var b [coefLen]byte
+	if err := d.readReg(regCOEF, b[:]); err != nil {
+		return nil, err
+	}
+	d.coef = newCoefficients(b[:])
+	if err := d.readReg(regCOEFSRCE, b[:1]); err != nil {
+		return nil, err
+	}
+	d.tmpExt = b[0] & tmpExt
+	for _, w := range tempFix {
+		if err := d.writeReg(w[0], w[1]); err != nil {
+			return nil, err
+		}
+	}
+	rate, _ := opts.rateCode()
+	// PRS_CFG, TMP_CFG, MEAS_CFG and CFG_REG, staying idle.
+	if err := d.writeReg(regPRSCFG, rate<<4|byte(opts.Pressure), d.tmpExt|rate<<4|byte(opts.Temperature), measIdle, opts.cfgReg()); err != nil {
+		return nil, err
+	}
+	// The correction takes effect with the next temperature measurement,
+	// which also provides the temperature to compensate the pressure.
+	var raw int32
+	if err := d.measure(measTemp, measTmpRdy, regTMP, measTime[opts.Temperature], &raw); err != nil {
+		return nil, err
+	}
+	d.tScaled = d.scaleTemp(raw)
+	if opts.Rate != 0 {
+		if err := d.writeReg(regMEASCFG, measBoth); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("DPS310{%s}", d.c)
+}
+
+// Sense measures the pressure and the temperature. It implements
+// physic.SenseEnv.
+//
+// In the background mode of Opts.Rate, it returns the latest measurements.
+// The humidity is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("dps310: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseContinuous returns measurements of the pressure and the temperature on
+// a continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than the duration of both measurements.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if d.opts.FIFO {
+		return nil, errors.New("dps310: use ReadFIFO with Opts.FIFO")
+	}
+	if m := d.opts.measDuration(); interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = physic.MilliKelvin
+	e.Pressure = physic.MilliPascal
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous() and the
+// background mode of Opts.Rate. Sense then measures on demand.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.opts.Rate == 0 {
+		return nil
+	}
+	if err := d.writeReg(regMEASCFG, measIdle); err != nil {
+		return err
+	}
+	if d.opts.FIFO {
+		d.opts.FIFO = false
+		if err := d.writeReg(regCFG, d.opts.cfgReg()); err != nil {
+			return err
+		}
+	}
+	d.opts.Rate = 0
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense measures, or reads the latest background measurements, and
+// compensates them.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	if d.opts.FIFO {
+		return errors.New("dps310: use ReadFIFO with Opts.FIFO")
+	}
+	var p, t int32
+	if d.opts.Rate != 0 {
+		var b [6]byte
+		if err := d.readReg(regPSR, b[:]); err != nil {
+			return err
+		}
+		p, t = raw24(b[0:3]), raw24(b[3:6])
+	} else {
+		if err := d.measure(measTemp, measTmpRdy, regTMP, measTime[d.opts.Temperature], &t); err != nil {
+			return err
+		}
+		if err := d.measure(measPressure, measPrsRdy, regPSR, measTime[d.opts.Pressure], &p); err != nil {
+			return err
+		}
+	}
+	d.tScaled = d.scaleTemp(t)
+	d.env(e, p)
+	return nil
+}
+
+// env sets e from the raw pressure and the last temperature.
+func (d *Dev) env(e *physic.Env, p int32) {
+	t := d.coef.temperature(d.tScaled)
+	e.Temperature = physic.Temperature(math.Round(t*1000))*physic.MilliCelsius + physic.ZeroCelsius
+	pa := d.coef.pressure(float64(p)/scaleFactors[d.opts.Pressure], d.tScaled)
+	e.Pressure = physic.Pressure(math.Round(pa*1000)) * physic.MilliPascal
+}
+
+func (d *Dev) scaleTemp(t int32) float64 {
+	return float64(t) / scaleFactors[d.opts.Temperature]
+}
+
+// measure commands a measurement, waits for its ready bit and reads the raw
+// result at reg.
+func (d *Dev) measure(cmd, ready, reg byte, wait time.Duration, raw *int32) error {
+	if err := d.writeReg(regMEASCFG, cmd); err != nil {
+		return err
+	}
+	doSleep(wait)
+	if err := d.waitReady(ready); err != nil {
+		return err
+	}
+	var b [3]byte
+	if err := d.readReg(reg, b[:]); err != nil {
+		return err
+	}
+	*raw = raw24(b[:])
+	return nil
+}
+
+// waitReady polls MEAS_CFG until the bits are set.
+func (d *Dev) waitReady(bits byte) error {
+	var b [1]byte
+	for i := 0; ; i++ {
+		if err := d.readReg(regMEASCFG, b[:]); err != nil {
+			return err
+		}
+		if b[0]&bits == bits {
+			return nil
+		}
+		if i == readyPolls {
+			return ErrNotReady
+		}
+		doSleep(time.Millisecond)
+	}
+}
+
+// raw24 returns the big-endian 24 bits two's complement value of b.
+func raw24(b []byte) int32 {
+	return int32(uint32(b[0])<<24|uint32(b[1])<<16|uint32(b[2])<<8) >> 8
+}
+
+func (d *Dev) readReg(reg uint8, b []byte) error {
+	var err error
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address
+		// and the read bit, the data follows.
+		w := make([]byte, len(b)+1)
+		r := make([]byte, len(b)+1)
+		w[0] = reg | 0x80
+		err = d.c.Tx(w, r)
+		copy(b, r[1:])
+	} else {
+		err = d.c.Tx([]byte{reg}, b)
+	}
+	if err != nil {
+		return fmt.Errorf("dps310: reading register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
+
+// writeReg writes consecutive registers starting at reg in one transaction.
+func (d *Dev) writeReg(reg uint8, vals ...byte) error {
+	if err := d.c.Tx(append([]byte{reg}, vals...), nil); err != nil {
+		return fmt.Errorf("dps310: writing register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,310 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dps310
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// testCoef are the coefficients of a device, as read at 0x10.
+var testCoef = []byte{0x0D, 0x1E, 0xFD, 0x13, 0xA5, 0x8F, 0x2C, 0x89, 0xF4, 0xBA, 0x04, 0x9C, 0xD7, 0x93, 0x00, 0x70, 0xFB, 0x70}
+
+// Raw measurements of 22.001°C and 98986.089Pa at DefaultOpts.
+var (
+	testTmp = []byte{0x02, 0x8C, 0x58}
+	testPSR = []byte{0xF9, 0xD1, 0xF8}
+	testEnv = physic.Env{
+		Temperature: physic.ZeroCelsius + 22001*physic.MilliCelsius,
+		Pressure:    98986089 * physic.MilliPascal,
+	}
+)
+
+// initOps are the bus transactions issued by New, writing cfg to PRS_CFG,
+// TMP_CFG, MEAS_CFG and CFG_REG and ending with the first temperature
+// measurement.
+func initOps(cfg ...byte) []i2ctest.IO {
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regID}, R: []byte{productID}},
+		{Addr: DefaultAddr, W: []byte{regRESET, softReset}},
+		{Addr: DefaultAddr, W: []byte{regMEASCFG}, R: []byte{measCoefRdy | measSensorRdy}},
+		{Addr: DefaultAddr, W: []byte{regCOEF}, R: testCoef},
+		{Addr: DefaultAddr, W: []byte{regCOEFSRCE}, R: []byte{0x80}},
+	}
+	for _, w := range tempFix {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: w[:]})
+	}
+	return append(ops,
+		i2ctest.IO{Addr: DefaultAddr, W: append([]byte{regPRSCFG}, cfg...)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG, measTemp}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG}, R: []byte{0xC0 | measTmpRdy}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regTMP}, R: testTmp},
+	)
+}
+
+// defaultOps are the bus transactions issued by New with DefaultOpts.
+func defaultOps() []i2ctest.IO {
+	return initOps(0x06, 0x80, 0x00, cfgPShift)
+}
+
+// senseOps are the bus transactions of a measurement on demand.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regMEASCFG, measTemp}},
+		{Addr: DefaultAddr, W: []byte{regMEASCFG}, R: []byte{0xC0 | measTmpRdy}},
+		{Addr: DefaultAddr, W: []byte{regTMP}, R: testTmp},
+		{Addr: DefaultAddr, W: []byte{regMEASCFG, measPressure}},
+		{Addr: DefaultAddr, W: []byte{regMEASCFG}, R: []byte{0xC0 | measPrsRdy}},
+		{Addr: DefaultAddr, W: []byte{regPSR}, R: testPSR},
+	}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		cfg  []byte
+	}{
+		{Opts{}, []byte{0x00, 0x80, 0x00, 0x00}},
+		{DefaultOpts, []byte{0x06, 0x80, 0x00, cfgPShift}},
+		{Opts{Pressure: O8x, Temperature: O16x}, []byte{0x03, 0x84, 0x00, cfgTShift}},
+	}
+	for i, line := range data {
+		bus := i2ctest.Playback{Ops: initOps(line.cfg...)}
+		d, err := New(&bus, line.opts)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if s := d.String(); s != "DPS310{playback(119)}" {
+			t.Fatal(s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestNew_bad_id(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{{Addr: AltAddr, W: []byte{regID}, R: []byte{0x11}}}}
+	if _, err := New(&bus, Opts{Addr: AltAddr}); !errors.Is(err, ErrBadID) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_not_ready(t *testing.T) {
+	ops := defaultOps()[:2]
+	for i := 0; i <= readyPolls; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG}, R: []byte{measSensorRdy}})
+	}
+	bus := i2ctest.Playback{Ops: ops}
+	if _, err := New(&bus, DefaultOpts); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(defaultOps()); i++ {
+		bus := i2ctest.Playback{Ops: defaultOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	var ops []conntest.IO
+	for _, op := range append(defaultOps(), senseOps()...) {
+		if op.R == nil {
+			ops = append(ops, conntest.IO{W: op.W})
+			continue
+		}
+		w := make([]byte, len(op.R)+1)
+		w[0] = op.W[0] | 0x80
+		ops = append(ops, conntest.IO{W: w, R: append([]byte{0}, op.R...)})
+	}
+	port := spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+	d, err := NewSPI(&port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Pressure: 8}, "dps310: invalid options: Pressure Oversampling(8), want O1x to O128x"},
+		{Opts{Temperature: 8}, "dps310: invalid options: Temperature Oversampling(8), want O1x to O128x"},
+		{Opts{Rate: 3 * physic.Hertz}, "dps310: invalid options: Rate 3Hz, want 0 or 1Hz to 128Hz by powers of 2"},
+		{
+			Opts{Pressure: O64x, Rate: 16 * physic.Hertz},
+			"dps310: invalid options: Rate 16Hz takes 1.728s per second at Pressure 64x and Temperature 1x, want below 1s",
+		},
+		{Opts{FIFO: true}, "dps310: invalid options: FIFO with Rate 0, want a background mode Rate"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(i, err)
+		}
+	}
+	if err := (&Opts{Pressure: O128x, Temperature: O128x, Rate: 2 * physic.Hertz}).Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(defaultOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	// Idle already.
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(defaultOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSense_background(t *testing.T) {
+	ops := append(initOps(0x36, 0xB0, 0x00, cfgPShift),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG, measBoth}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regPSR}, R: append(append([]byte{}, testPSR...), testTmp...)},
+		// Halt goes back to measuring on demand.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG, measIdle}},
+	)
+	ops = append(ops, senseOps()...)
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, Opts{Pressure: O64x, Rate: 8 * physic.Hertz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(defaultOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(100 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(200 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != physic.MilliKelvin || e.Pressure != physic.MilliPascal {
+		t.Fatal(e)
+	}
+}
+
+func TestOversampling_String(t *testing.T) {
+	data := []struct {
+		o Oversampling
+		s string
+	}{
+		{O1x, "1x"},
+		{O128x, "128x"},
+		{8, "Oversampling(8)"},
+	}
+	for _, line := range data {
+		if s := line.o.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
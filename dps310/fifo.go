@@ -0,0 +1,75 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dps310
+
+import (
+	"errors"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+const (
+	fifoSize = 32 // results
+
+	fifoStsFull = 0x02
+	fifoFlush   = 0x80 // FIFO_FLUSH in RESET
+
+	// fifoEmpty is the value read from an empty FIFO.
+	fifoEmpty = -0x800000
+)
+
+// ReadFIFO reads up to len(e) measurements from the FIFO into e, oldest
+// first, and returns the number read. It requires Opts.FIFO.
+//
+// The FIFO queues the pressure and temperature results separately, up to 32
+// of them; each pressure is compensated with the temperature that precedes
+// it. When the FIFO is full, newer results are discarded and the
+// measurements are returned along with ErrFIFOOverflow.
+func (d *Dev) ReadFIFO(e []physic.Env) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.opts.FIFO {
+		return 0, errFIFODisabled
+	}
+	var st [1]byte
+	if err := d.readReg(regFIFOSTS, st[:]); err != nil {
+		return 0, err
+	}
+	n := 0
+	var b [3]byte
+	for i := 0; i < fifoSize && n < len(e); i++ {
+		// Each read of the result registers pops one result.
+		if err := d.readReg(regPSR, b[:]); err != nil {
+			return n, err
+		}
+		v := raw24(b[:])
+		if v == fifoEmpty {
+			break
+		}
+		// The LSB flags the pressure results.
+		if v&1 == 0 {
+			d.tScaled = d.scaleTemp(v)
+			continue
+		}
+		d.env(&e[n], v)
+		n++
+	}
+	if st[0]&fifoStsFull != 0 {
+		return n, ErrFIFOOverflow
+	}
+	return n, nil
+}
+
+// FlushFIFO discards the content of the FIFO. It requires Opts.FIFO.
+func (d *Dev) FlushFIFO() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.opts.FIFO {
+		return errFIFODisabled
+	}
+	return d.writeReg(regRESET, fifoFlush)
+}
+
+var errFIFODisabled = errors.New("dps310: FIFO not enabled in Opts")
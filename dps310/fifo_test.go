@@ -0,0 +1,108 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dps310
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+var fifoOpts = Opts{Pressure: O64x, Rate: 4 * physic.Hertz, FIFO: true}
+
+// fifoOps are the bus transactions issued by New with fifoOpts.
+func fifoOps() []i2ctest.IO {
+	return append(initOps(0x26, 0xA0, 0x00, cfgPShift|cfgFIFOEn),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG, measBoth}},
+	)
+}
+
+// resultOp returns a read of a FIFO result.
+func resultOp(b ...byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regPSR}, R: b}
+}
+
+func TestReadFIFO(t *testing.T) {
+	ops := append(fifoOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOSTS}, R: []byte{0x00}},
+		// Pressure results have the LSB set.
+		resultOp(0xF9, 0xD1, 0xF9),
+		resultOp(testTmp...),
+		resultOp(0xF9, 0xD1, 0xF9),
+		resultOp(0x80, 0x00, 0x00),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOSTS}, R: []byte{fifoStsFull}},
+		resultOp(0xF9, 0xD1, 0xF9),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regRESET, fifoFlush}},
+	)
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, fifoOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := make([]physic.Env, 4)
+	n, err := d.ReadFIFO(e)
+	if err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if e[0].Temperature != testEnv.Temperature || e[1].Temperature != testEnv.Temperature {
+		t.Fatalf("%+v", e)
+	}
+	if e[0].Pressure != 98986044*physic.MilliPascal {
+		t.Fatal(e[0].Pressure)
+	}
+	if n, err := d.ReadFIFO(e[:1]); !errors.Is(err, ErrFIFOOverflow) || n != 1 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := d.FlushFIFO(); err != nil {
+		t.Fatal(err)
+	}
+	var env physic.Env
+	if err := d.Sense(&env); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.SenseContinuous(d.opts.measDuration()); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_disabled(t *testing.T) {
+	bus := i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]physic.Env, 1)); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.FlushFIFO(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestHalt_FIFO(t *testing.T) {
+	ops := append(fifoOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMEASCFG, measIdle}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCFG, cfgPShift}},
+	)
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, fifoOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]physic.Env, 1)); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -11,6 +11,9 @@
 // as long as the bus driver can provide sufficient power using an active
 // pull-up.
 //
+// The bus can be a DS2482 or DS2483 I²C master, see the ds248x package, or a
+// GPIO pin driven by bitbang.NewOneWire.
+//
 // The DS18B20/DS18S20 alarm functionality and reading/writing the 2 alarm
 // bytes in the EEPROM are not supported.
 //
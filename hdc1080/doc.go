@@ -0,0 +1,21 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package hdc1080 controls a Texas Instruments HDC1080 temperature and
+// humidity sensor over I²C.
+//
+// # More details
+//
+// The driver uses the acquisition mode where one trigger measures the
+// temperature then the humidity, at the resolution set by Opts.
+//
+// The heater, enabled with SetHeater, only heats during measurements; the
+// sensor is warmed by measuring repeatedly, e.g. with SenseContinuous.
+//
+// SerialNumber returns the unique 41 bits serial number of the device.
+//
+// # Datasheet
+//
+// https://www.ti.com/lit/ds/symlink/hdc1080.pdf
+package hdc1080
@@ -0,0 +1,39 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hdc1080_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/hdc1080"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := hdc1080.New(bus, hdc1080.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize hdc1080: %v", err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%8s %9s\n", e.Temperature, e.Humidity)
+}
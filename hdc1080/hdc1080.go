@@ -0,0 +1,328 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hdc1080
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Addr is the I²C address of the device.
+const Addr = 0x40
+
+// Registers.
+const (
+	regTemperature    = 0x00
+	regConfiguration  = 0x02
+	regSerialID       = 0xFB // to 0xFD
+	regManufacturerID = 0xFE
+	regDeviceID       = 0xFF
+)
+
+// Configuration register bits.
+const (
+	cfgReset  = 0x8000
+	cfgHeater = 0x2000
+	cfgMode   = 0x1000 // temperature and humidity in sequence
+	cfgTRes   = 10     // shift of the temperature resolution bit
+	cfgHRes   = 8      // shift of the humidity resolution bits
+)
+
+const (
+	manufacturerID = 0x5449 // "TI"
+	deviceID       = 0x1050
+	resetTime      = 15 * time.Millisecond
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the manufacturer or device ID doesn't
+	// match an HDC1080.
+	ErrBadID = errors.New("hdc1080: bad ID")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("hdc1080: invalid options")
+)
+
+// Resolution is the resolution of a measurement. A lower resolution shortens
+// the measurement.
+type Resolution uint8
+
+// Possible resolutions. Res8 is only supported by the humidity.
+const (
+	Res14 Resolution = 0
+	Res11 Resolution = 1
+	Res8  Resolution = 2
+)
+
+func (r Resolution) String() string {
+	switch r {
+	case Res14:
+		return "14 bits"
+	case Res11:
+		return "11 bits"
+	case Res8:
+		return "8 bits"
+	default:
+		return fmt.Sprintf("Resolution(%d)", uint8(r))
+	}
+}
+
+// Conversion time and LSB of each resolution.
+var (
+	temperatureTime      = [...]time.Duration{6350 * time.Microsecond, 3650 * time.Microsecond}
+	humidityTime         = [...]time.Duration{6500 * time.Microsecond, 3850 * time.Microsecond, 2500 * time.Microsecond}
+	temperaturePrecision = [...]physic.Temperature{10 * physic.MilliKelvin, 81 * physic.MilliKelvin}
+	humidityPrecision    = [...]physic.RelativeHumidity{61 * physic.MicroRH, 488 * physic.MicroRH, 3906 * physic.MicroRH}
+)
+
+// DefaultOpts are the options for the highest resolution.
+var DefaultOpts = Opts{}
+
+// Opts holds initialization options.
+//
+// Temperature: resolution of the temperature, Res14 or Res11.
+// Humidity: resolution of the humidity, Res14, Res11 or Res8.
+type Opts struct {
+	Temperature Resolution
+	Humidity    Resolution
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Temperature > Res11 {
+		return fmt.Errorf("%w: Temperature %s, want 14 or 11 bits", ErrInvalidOpts, o.Temperature)
+	}
+	if o.Humidity > Res8 {
+		return fmt.Errorf("%w: Humidity %s, want 14, 11 or 8 bits", ErrInvalidOpts, o.Humidity)
+	}
+	return nil
+}
+
+// measDuration returns the duration of both conversions.
+func (o *Opts) measDuration() time.Duration {
+	return temperatureTime[o.Temperature] + humidityTime[o.Humidity]
+}
+
+// Dev is a handle to an initialized HDC1080 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu     sync.Mutex
+	config uint16
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New checks the IDs of a device on an I²C bus, resets it and sets its
+// resolution.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: Addr}, opts: opts}
+	m, err := d.readReg(regManufacturerID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := d.readReg(regDeviceID)
+	if err != nil {
+		return nil, err
+	}
+	if m != manufacturerID || id != deviceID {
+		return nil, fmt.Errorf("%w: read %#04x %#04x, want %#04x %#04x", ErrBadID, m, id, manufacturerID, deviceID)
+	}
+	if err := d.writeReg(regConfiguration, cfgReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	d.config = cfgMode | uint16(opts.Temperature)<<cfgTRes | uint16(opts.Humidity)<<cfgHRes
+	if err := d.writeReg(regConfiguration, d.config); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("HDC1080{%s}", d.c)
+}
+
+// Sense measures the temperature and the humidity. It implements
+// physic.SenseEnv.
+//
+// The pressure is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("hdc1080: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseContinuous returns measurements of the temperature and humidity on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than the duration of both conversions.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if m := d.opts.measDuration(); interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = temperaturePrecision[d.opts.Temperature]
+	e.Humidity = humidityPrecision[d.opts.Humidity]
+}
+
+// SetHeater turns on or off the heater.
+func (d *Dev) SetHeater(on bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	config := d.config &^ cfgHeater
+	if on {
+		config |= cfgHeater
+	}
+	if err := d.writeReg(regConfiguration, config); err != nil {
+		return err
+	}
+	d.config = config
+	return nil
+}
+
+// SerialNumber reads the unique serial number of the device.
+func (d *Dev) SerialNumber() (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var id [3]uint16
+	for i := range id {
+		v, err := d.readReg(regSerialID + byte(i))
+		if err != nil {
+			return 0, err
+		}
+		id[i] = v
+	}
+	// The last register holds the 9 LSBs in its MSBs.
+	return uint64(id[0])<<25 | uint64(id[1])<<9 | uint64(id[2])>>7, nil
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device sleeps between measurements.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense triggers a measurement, waits for it and reads both results.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	if err := d.c.Tx([]byte{regTemperature}, nil); err != nil {
+		return fmt.Errorf("hdc1080: triggering measurement: %w", err)
+	}
+	doSleep(d.opts.measDuration())
+	// The device doesn't acknowledge its address until the end of the
+	// conversions.
+	var b [4]byte
+	if err := d.c.Tx(nil, b[:]); err != nil {
+		return fmt.Errorf("hdc1080: reading measurement: %w", err)
+	}
+	e.Temperature = temperature(uint16(b[0])<<8 | uint16(b[1]))
+	e.Humidity = humidity(uint16(b[2])<<8 | uint16(b[3]))
+	return nil
+}
+
+// temperature converts a raw temperature, as raw/2^16*165 - 40 °C.
+func temperature(raw uint16) physic.Temperature {
+	return physic.ZeroCelsius - 40*physic.Celsius + physic.Temperature(int64(raw)*int64(165*physic.Celsius)>>16)
+}
+
+// humidity converts a raw humidity, as raw/2^16*100 %RH.
+func humidity(raw uint16) physic.RelativeHumidity {
+	return physic.RelativeHumidity(int64(raw) * int64(100*physic.PercentRH) >> 16)
+}
+
+func (d *Dev) readReg(reg byte) (uint16, error) {
+	var b [2]byte
+	if err := d.c.Tx([]byte{reg}, b[:]); err != nil {
+		return 0, fmt.Errorf("hdc1080: reading register %#02x: %w", reg, err)
+	}
+	return uint16(b[0])<<8 | uint16(b[1]), nil
+}
+
+func (d *Dev) writeReg(reg byte, v uint16) error {
+	if err := d.c.Tx([]byte{reg, byte(v >> 8), byte(v)}, nil); err != nil {
+		return fmt.Errorf("hdc1080: writing register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
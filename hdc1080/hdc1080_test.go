@@ -0,0 +1,251 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hdc1080
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New with DefaultOpts.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: Addr, W: []byte{regManufacturerID}, R: []byte{0x54, 0x49}},
+		{Addr: Addr, W: []byte{regDeviceID}, R: []byte{0x10, 0x50}},
+		{Addr: Addr, W: []byte{regConfiguration, 0x80, 0x00}},
+		{Addr: Addr, W: []byte{regConfiguration, 0x10, 0x00}},
+	}
+}
+
+// senseOps are the bus transactions of a measurement of 21.875°C and 50%RH.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: Addr, W: []byte{regTemperature}},
+		{Addr: Addr, R: []byte{0x60, 0x00, 0x80, 0x00}},
+	}
+}
+
+// testEnv is the measurement of senseOps.
+var testEnv = physic.Env{
+	Temperature: physic.ZeroCelsius + 21875*physic.MilliCelsius,
+	Humidity:    50 * physic.PercentRH,
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "HDC1080{playback(64)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_resolution(t *testing.T) {
+	ops := initOps()
+	ops[3].W = []byte{regConfiguration, 0x16, 0x00}
+	bus := i2ctest.Playback{Ops: ops}
+	if _, err := New(&bus, Opts{Temperature: Res11, Humidity: Res8}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_bad_id(t *testing.T) {
+	ops := initOps()[:2]
+	ops[1].R = []byte{0x10, 0x00}
+	bus := i2ctest.Playback{Ops: ops}
+	want := "hdc1080: bad ID: read 0x5449 0x1000, want 0x5449 0x1050"
+	if _, err := New(&bus, DefaultOpts); !errors.Is(err, ErrBadID) || err.Error() != want {
+		t.Fatalf("%v, want %s", err, want)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(initOps()); i++ {
+		bus := i2ctest.Playback{Ops: initOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		o   Opts
+		err string
+	}{
+		{Opts{Temperature: Res8}, "hdc1080: invalid options: Temperature 8 bits, want 14 or 11 bits"},
+		{Opts{Humidity: 3}, "hdc1080: invalid options: Humidity Resolution(3), want 14, 11 or 8 bits"},
+	}
+	for i, line := range data {
+		err := line.o.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %s", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.o); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(initOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(10 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(15 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetHeater(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: Addr, W: []byte{regConfiguration, 0x30, 0x00}},
+		i2ctest.IO{Addr: Addr, W: []byte{regConfiguration, 0x10, 0x00}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSerialNumber(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: Addr, W: []byte{0xFB}, R: []byte{0x12, 0x34}},
+		i2ctest.IO{Addr: Addr, W: []byte{0xFC}, R: []byte{0x56, 0x78}},
+		i2ctest.IO{Addr: Addr, W: []byte{0xFD}, R: []byte{0x9A, 0x80}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.SerialNumber()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(0x1234)<<25 | 0x5678<<9 | 0x135; s != want {
+		t.Fatalf("%#x, want %#x", s, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{opts: Opts{Temperature: Res11, Humidity: Res8}}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 81*physic.MilliKelvin || e.Humidity != 3906*physic.MicroRH {
+		t.Fatal(e)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	if v := temperature(0); v != physic.ZeroCelsius-40*physic.Celsius {
+		t.Fatal(v)
+	}
+	if v := humidity(0); v != 0 {
+		t.Fatal(v)
+	}
+	if v := humidity(0xFFFC); v >= 100*physic.PercentRH {
+		t.Fatal(v)
+	}
+}
+
+func TestResolution_String(t *testing.T) {
+	data := []struct {
+		r Resolution
+		s string
+	}{
+		{Res14, "14 bits"},
+		{Res11, "11 bits"},
+		{Res8, "8 bits"},
+		{3, "Resolution(3)"},
+	}
+	for _, line := range data {
+		if s := line.r.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
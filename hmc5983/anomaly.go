@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Magnitude returns the total field, independent of the orientation of the
+// sensor.
+func (f Field) Magnitude() physic.MagneticFluxDensity {
+	x, y, z := float64(f.X), float64(f.Y), float64(f.Z)
+	return physic.MagneticFluxDensity(math.Round(math.Sqrt(x*x + y*y + z*z)))
+}
+
+// AnomalyEvent is a change of state of an AnomalyDetector.
+type AnomalyEvent struct {
+	// Timestamp is the time of the sample that caused the change.
+	Timestamp time.Time
+	// Magnitude is the total field of that sample and Deviation its
+	// difference from the baseline.
+	Magnitude physic.MagneticFluxDensity
+	Deviation physic.MagneticFluxDensity
+	// Active is true when the anomaly starts and false when it ends.
+	Active bool
+}
+
+// AnomalyDetector reports when the total field deviates from a baseline, as
+// when a vehicle passes over the sensor or a magnet on a door moves away.
+//
+// Feed it the samples from Stream or SenseContinuous. An anomaly starts when
+// the deviation exceeds Threshold and ends when it falls below half of it, so
+// that noise around the threshold doesn't cause a burst of events.
+//
+// The magnitude doesn't depend on the orientation, but hard iron offsets do
+// change it; calibrate the device before recording the baseline.
+type AnomalyDetector struct {
+	// Baseline is the total field in the absence of anomalies, see
+	// SetBaseline.
+	Baseline physic.MagneticFluxDensity
+	// Threshold is the deviation from Baseline that starts an anomaly.
+	Threshold physic.MagneticFluxDensity
+
+	active bool
+}
+
+// SetBaseline sets Baseline to the mean magnitude of samples, e.g. from
+// Dev.Last. Overflowed samples are ignored. It returns false and leaves
+// Baseline unchanged when no sample is usable.
+func (a *AnomalyDetector) SetBaseline(samples []Sample) bool {
+	sum, n := 0.0, 0
+	for i := range samples {
+		if !samples[i].Overflow {
+			sum += float64(samples[i].Magnitude())
+			n++
+		}
+	}
+	if n == 0 {
+		return false
+	}
+	a.Baseline = physic.MagneticFluxDensity(math.Round(sum / float64(n)))
+	a.active = false
+	return true
+}
+
+// Active reports whether an anomaly is in progress.
+func (a *AnomalyDetector) Active() bool {
+	return a.active
+}
+
+// Update processes s and returns an event and true when an anomaly starts or
+// ends. Overflowed samples are ignored.
+func (a *AnomalyDetector) Update(s Sample) (AnomalyEvent, bool) {
+	if s.Overflow {
+		return AnomalyEvent{}, false
+	}
+	m := s.Magnitude()
+	dev := m - a.Baseline
+	abs := dev
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case !a.active && abs > a.Threshold:
+		a.active = true
+	case a.active && abs < a.Threshold/2:
+		a.active = false
+	default:
+		return AnomalyEvent{}, false
+	}
+	return AnomalyEvent{Timestamp: s.Timestamp, Magnitude: m, Deviation: dev, Active: a.active}, true
+}
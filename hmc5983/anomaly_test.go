@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestMagnitude(t *testing.T) {
+	f := Field{X: 30 * physic.MicroTesla, Y: -40 * physic.MicroTesla}
+	if m := f.Magnitude(); m != 50*physic.MicroTesla {
+		t.Fatalf("Magnitude() = %s", m)
+	}
+	s := Sample{Field: Field{Z: -12 * physic.MicroTesla}}
+	if m := s.Magnitude(); m != 12*physic.MicroTesla {
+		t.Fatalf("Magnitude() = %s", m)
+	}
+}
+
+func TestAnomalyDetector(t *testing.T) {
+	sample := func(x physic.MagneticFluxDensity) Sample {
+		return Sample{Field: Field{X: x}}
+	}
+	a := AnomalyDetector{Threshold: 10 * physic.MicroTesla}
+	if a.SetBaseline([]Sample{{Overflow: true}}) {
+		t.Fatal("SetBaseline() used an overflowed sample")
+	}
+	if !a.SetBaseline([]Sample{sample(49 * physic.MicroTesla), sample(51 * physic.MicroTesla)}) || a.Baseline != 50*physic.MicroTesla {
+		t.Fatalf("Baseline = %s", a.Baseline)
+	}
+	data := []struct {
+		x      physic.MagneticFluxDensity
+		event  bool
+		active bool
+	}{
+		{55 * physic.MicroTesla, false, false},
+		{61 * physic.MicroTesla, true, true},
+		{70 * physic.MicroTesla, false, true},
+		// Hysteresis.
+		{56 * physic.MicroTesla, false, true},
+		{54 * physic.MicroTesla, true, false},
+		{39 * physic.MicroTesla, true, true},
+	}
+	for i, line := range data {
+		e, ok := a.Update(sample(line.x))
+		if ok != line.event || a.Active() != line.active {
+			t.Fatalf("#%d: Update() = %+v, %t; Active() = %t", i, e, ok, a.Active())
+		}
+		if ok && (e.Active != line.active || e.Magnitude != line.x || e.Deviation != line.x-a.Baseline) {
+			t.Fatalf("#%d: Update() = %+v", i, e)
+		}
+	}
+	if _, ok := a.Update(Sample{Overflow: true}); ok {
+		t.Fatal("Update() used an overflowed sample")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSample_MarshalBinary(t *testing.T) {
+	want := Sample{
+		Field:     Field{X: 100146 * physic.NanoTesla, Y: -3 * physic.MicroTesla, Z: 1},
+		Raw:       [3]int16{1372, -33, -4096},
+		Timestamp: time.Unix(1760000000, 123456789),
+		Overflow:  true,
+	}
+	b, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != SampleBinarySize || b[0] != 1 || b[1] != 1 {
+		t.Fatalf("MarshalBinary() = %x", b)
+	}
+	var got Sample
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Timestamp.Equal(want.Timestamp) || got.Field != want.Field || got.Raw != want.Raw || !got.Overflow {
+		t.Fatalf("UnmarshalBinary() = %+v, want %+v", got, want)
+	}
+
+	// The zero time is preserved.
+	b, _ = Sample{}.MarshalBinary()
+	got.Timestamp = time.Now()
+	if err := got.UnmarshalBinary(b); err != nil || !got.Timestamp.IsZero() || got.Overflow {
+		t.Fatalf("UnmarshalBinary() = %+v, %v", got, err)
+	}
+
+	if err := got.UnmarshalBinary(b[:10]); err == nil {
+		t.Fatal("expected error on short input")
+	}
+	b[0] = 2
+	if err := got.UnmarshalBinary(b); err == nil {
+		t.Fatal("expected error on unknown version")
+	}
+}
+
+func TestSample_AppendBinary(t *testing.T) {
+	s := Sample{Raw: [3]int16{1, 2, 3}}
+	buf := make([]byte, 0, 2*SampleBinarySize)
+	if n := testing.AllocsPerRun(10, func() { _, _ = s.AppendBinary(buf[:0]) }); n != 0 {
+		t.Fatalf("AppendBinary() allocates %g times", n)
+	}
+	buf, err := s.AppendBinary(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf, err = s.AppendBinary(buf); err != nil || len(buf) != 2*SampleBinarySize {
+		t.Fatalf("AppendBinary() = %d bytes, %v", len(buf), err)
+	}
+}
+
+func TestSample_Gob(t *testing.T) {
+	want := Sample{Field: Field{X: 5}, Raw: [3]int16{5}}
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+	var got Sample
+	if err := gob.NewDecoder(&b).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Field != want.Field || got.Raw != want.Raw {
+		t.Fatalf("got %+v", got)
+	}
+}
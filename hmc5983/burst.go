@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Burst is the result of SenseBurst.
+type Burst struct {
+	// Samples in acquisition order. Overflowed samples are kept, with
+	// Sample.Overflow set.
+	Samples []Sample
+	// Period is the requested interval between samples.
+	Period time.Duration
+	// MeanPeriod is the mean interval between the sample timestamps.
+	MeanPeriod time.Duration
+	// Jitter is the standard deviation of the intervals.
+	Jitter time.Duration
+	// MaxDeviation is the largest difference between an interval and Period.
+	MaxDeviation time.Duration
+}
+
+// SenseBurst captures n samples at rate and reports the timing of the
+// acquisition.
+//
+// A rate of 0 uses the output data rate, which is the highest rate in
+// continuous mode; lower rates take the first new conversion after each
+// sample is due, see SenseNext. The device is locked for the whole burst.
+func (d *Dev) SenseBurst(ctx context.Context, n int, rate physic.Frequency) (Burst, error) {
+	if n <= 0 {
+		return Burst{}, fmt.Errorf("%w: burst of %d samples", ErrInvalidOpts, n)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	minPeriod := odrPeriods[(d.cra>>2)&0b111]
+	if d.mode == modeSingle {
+		minPeriod = singleMeasurementTime
+	}
+	minPeriod *= time.Duration(max(d.oversample, 1))
+	b := Burst{Period: minPeriod}
+	if rate > 0 {
+		b.Period = rate.Period()
+	}
+	if b.Period < minPeriod {
+		return Burst{}, fmt.Errorf("%w: burst rate %s is faster than the device, at most %s", ErrInvalidOpts, rate, physic.PeriodToFrequency(minPeriod))
+	}
+
+	b.Samples = make([]Sample, n)
+	var start time.Time
+	for i := range b.Samples {
+		if i > 0 {
+			if wait := time.Until(start.Add(time.Duration(i) * b.Period)); wait > 0 {
+				if err := sleepContext(ctx, wait); err != nil {
+					return b, err
+				}
+			}
+		}
+		if err := d.senseNext(ctx, &b.Samples[i]); err != nil && !errors.Is(err, ErrOverflow) {
+			b.Samples = b.Samples[:i]
+			return b, err
+		}
+		if i == 0 {
+			start = b.Samples[0].Timestamp
+		}
+	}
+	b.stats()
+	return b, nil
+}
+
+// stats computes the interval statistics of the samples.
+func (b *Burst) stats() {
+	if len(b.Samples) < 2 {
+		return
+	}
+	n := float64(len(b.Samples) - 1)
+	var sum time.Duration
+	for i := 1; i < len(b.Samples); i++ {
+		dt := b.Samples[i].Timestamp.Sub(b.Samples[i-1].Timestamp)
+		sum += dt
+		dev := dt - b.Period
+		if dev < 0 {
+			dev = -dev
+		}
+		if dev > b.MaxDeviation {
+			b.MaxDeviation = dev
+		}
+	}
+	mean := float64(sum) / n
+	var sq float64
+	for i := 1; i < len(b.Samples); i++ {
+		dt := float64(b.Samples[i].Timestamp.Sub(b.Samples[i-1].Timestamp)) - mean
+		sq += dt * dt
+	}
+	b.MeanPeriod = time.Duration(math.Round(mean))
+	b.Jitter = time.Duration(math.Round(math.Sqrt(sq / n)))
+}
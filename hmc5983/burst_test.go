@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSenseBurst(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: odr220Ops(3, true)}
+	d, err := New(bus, Opts{ODRHz: 220})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseBurst(context.Background(), 0, 0); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("SenseBurst() = %v", err)
+	}
+	if _, err := d.SenseBurst(context.Background(), 3, 300*physic.Hertz); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("SenseBurst() = %v", err)
+	}
+	b, err := d.SenseBurst(context.Background(), 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Samples) != 3 || b.Period != odrPeriods[7] || b.MeanPeriod < odrPeriods[7]/2 {
+		t.Fatalf("SenseBurst() = %+v", b)
+	}
+	for i, s := range b.Samples {
+		if s.Raw[0] != int16(i+1) {
+			t.Fatalf("sample %d = %v", i, s)
+		}
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBurst_stats(t *testing.T) {
+	start := time.Now()
+	b := Burst{Period: 10 * time.Millisecond}
+	for _, ms := range []int{0, 10, 22, 30, 40} {
+		b.Samples = append(b.Samples, Sample{Timestamp: start.Add(time.Duration(ms) * time.Millisecond)})
+	}
+	b.stats()
+	// Intervals of 10, 12, 8 and 10ms.
+	if b.MeanPeriod != 10*time.Millisecond || b.MaxDeviation != 2*time.Millisecond {
+		t.Fatalf("stats = %+v", b)
+	}
+	if want := 1414214 * time.Nanosecond; b.Jitter != want {
+		t.Fatalf("Jitter = %s, want %s", b.Jitter, want)
+	}
+}
@@ -0,0 +1,426 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// calibration is a hard and soft iron correction, in the Gauss domain so it
+// stays valid across gain changes.
+type calibration struct {
+	set    bool
+	offset [3]float64
+	matrix [3][3]float64
+}
+
+// apply returns matrix × (g - offset).
+func (c *calibration) apply(g [3]float64) [3]float64 {
+	if !c.set {
+		return g
+	}
+	var v [3]float64
+	for i := range v {
+		v[i] = g[i] - c.offset[i]
+	}
+	var out [3]float64
+	for i := range out {
+		out[i] = c.matrix[i][0]*v[0] + c.matrix[i][1]*v[1] + c.matrix[i][2]*v[2]
+	}
+	return out
+}
+
+// SetCalibration sets the hard and soft iron correction applied by Sense,
+// SenseField and SenseContinuous.
+//
+// offset is the hard iron bias in raw counts, and matrix the soft iron
+// correction applied to the counts after removing the bias, both in X,Y,Z
+// order and relative to the current gain:
+//
+//	corrected = matrix × (raw - offset)
+//
+// They are converted internally so they remain valid when the gain changes
+// afterwards, including with Opts.AutoRange. The correction is in chip axes,
+// applied before Opts.Rotation. SenseRaw is not affected.
+func (d *Dev) SetCalibration(offset [3]float64, matrix [3][3]float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.setCalibration(offset, matrix, d.gainCode)
+}
+
+// ApplyCalibration sets the correction computed by Calibrate.
+//
+// It is equivalent to SetCalibration, except that the calibration is relative
+// to c.GainCode instead of the current gain.
+func (d *Dev) ApplyCalibration(c *Calibration) error {
+	if c.GainCode < 0 || c.GainCode >= len(gainXY) {
+		return fmt.Errorf("%w: calibration gain code %d", ErrInvalidOpts, c.GainCode)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.setCalibration(c.Offset, c.Matrix, c.GainCode)
+	return nil
+}
+
+func (d *Dev) setCalibration(offset [3]float64, matrix [3][3]float64, gainCode int) {
+	lsb := lsbPerGauss(gainCode)
+	c := calibration{set: true}
+	for i := range offset {
+		c.offset[i] = offset[i] / lsb[i]
+		for j := range matrix[i] {
+			// Counts to Gauss: D⁻¹ × matrix × D with D the diagonal of LSB/Gauss.
+			c.matrix[i][j] = matrix[i][j] * lsb[j] / lsb[i]
+		}
+	}
+	d.cal = c
+}
+
+// ClearCalibration removes the correction set by SetCalibration.
+func (d *Dev) ClearCalibration() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cal = calibration{}
+}
+
+// SetOffset sets a per axis bias, in raw counts at the current gain and in
+// X,Y,Z order, subtracted from every sample before scaling.
+//
+// It is a lighter alternative to SetCalibration for biases computed
+// externally, and is applied before it if both are set. Like the calibration,
+// it is converted internally so it remains valid when the gain changes. It
+// doesn't affect SenseRaw.
+func (d *Dev) SetOffset(x, y, z float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	lsb := lsbPerGauss(d.gainCode)
+	d.offset = [3]float64{x / lsb[0], y / lsb[1], z / lsb[2]}
+}
+
+// GetOffset returns the bias set with SetOffset, in raw counts at the current
+// gain.
+func (d *Dev) GetOffset() (float64, float64, float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	lsb := lsbPerGauss(d.gainCode)
+	return d.offset[0] * lsb[0], d.offset[1] * lsb[1], d.offset[2] * lsb[2]
+}
+
+// lsbPerGauss returns the X,Y,Z sensitivities for a gain code.
+func lsbPerGauss(gainCode int) [3]float64 {
+	return [3]float64{float64(gainXY[gainCode]), float64(gainXY[gainCode]), float64(gainZ[gainCode])}
+}
+
+// Calibration is the result of Calibrate.
+type Calibration struct {
+	// Offset is the hard iron bias in raw counts.
+	Offset [3]float64
+	// Matrix is the soft iron correction applied to the counts after removing
+	// the bias. It maps the fitted ellipsoid onto a sphere of radius Radius.
+	Matrix [3][3]float64
+	// GainCode is the gain Offset and Matrix are relative to.
+	GainCode int
+	// Timestamp is when the calibration was computed.
+	Timestamp time.Time
+
+	// Samples is the number of samples used by the fit.
+	Samples int
+	// Radius is the magnitude of the local field, in Gauss.
+	Radius float64
+	// Residual is the RMS deviation of the corrected samples from the
+	// sphere, relative to Radius. Well distributed samples of a healthy
+	// sensor away from magnetic disturbances are typically below 0.02.
+	Residual float64
+}
+
+// minCalibrationSamples is the minimum number of samples needed by
+// Calibrate. The ellipsoid has 9 parameters; more samples are needed to
+// average out noise.
+const minCalibrationSamples = 30
+
+// Calibrate collects samples for the given duration while the board is
+// rotated in every direction, for example in a figure-eight pattern, and fits
+// an ellipsoid to them.
+//
+// The result describes the hard iron bias (ellipsoid center) and soft iron
+// distortion (ellipsoid shape) and can be used with ApplyCalibration. The
+// current calibration is not used while collecting samples. Samples are taken
+// at the output data rate; overflowed samples are skipped.
+//
+// Returns an error if too few samples were collected, or if they don't cover
+// enough orientations to fit an ellipsoid.
+func (d *Dev) Calibrate(ctx context.Context, duration time.Duration) (*Calibration, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var pts [][3]float64
+	for start := time.Now(); time.Since(start) < duration; {
+		g, err := d.nextUncorrected(ctx)
+		if errors.Is(err, ErrOverflow) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pts = append(pts, g)
+	}
+	if len(pts) < minCalibrationSamples {
+		return nil, fmt.Errorf("hmc5983: calibration collected %d samples, need at least %d", len(pts), minCalibrationSamples)
+	}
+	cal, err := fitEllipsoid(pts)
+	if err != nil {
+		return nil, err
+	}
+	// Convert from Gauss to counts at the current gain.
+	lsb := lsbPerGauss(d.gainCode)
+	for i := range cal.Offset {
+		cal.Offset[i] *= lsb[i]
+		for j := range cal.Matrix[i] {
+			cal.Matrix[i][j] *= lsb[i] / lsb[j]
+		}
+	}
+	cal.GainCode = d.gainCode
+	cal.Timestamp = time.Now()
+	return cal, nil
+}
+
+// calibrationVersion is the version of the JSON encoding of Calibration.
+const calibrationVersion = 1
+
+// calibrationJSON is the stable JSON encoding of Calibration.
+type calibrationJSON struct {
+	Version   int           `json:"version"`
+	GainCode  int           `json:"gain_code"`
+	Timestamp time.Time     `json:"timestamp"`
+	Offset    [3]float64    `json:"offset"`
+	Matrix    [3][3]float64 `json:"matrix"`
+	Samples   int           `json:"samples"`
+	Radius    float64       `json:"radius"`
+	Residual  float64       `json:"residual"`
+}
+
+// Save writes the calibration as JSON, so it can be loaded back with
+// LoadCalibration.
+func (c *Calibration) Save(w io.Writer) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(calibrationJSON{
+		Version:   calibrationVersion,
+		GainCode:  c.GainCode,
+		Timestamp: c.Timestamp,
+		Offset:    c.Offset,
+		Matrix:    c.Matrix,
+		Samples:   c.Samples,
+		Radius:    c.Radius,
+		Residual:  c.Residual,
+	})
+}
+
+// LoadCalibration reads a calibration written by Calibration.Save.
+func LoadCalibration(r io.Reader) (*Calibration, error) {
+	var j calibrationJSON
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&j); err != nil {
+		return nil, fmt.Errorf("hmc5983: reading calibration: %w", err)
+	}
+	if j.Version != calibrationVersion {
+		return nil, fmt.Errorf("hmc5983: unsupported calibration version %d", j.Version)
+	}
+	if j.GainCode < 0 || j.GainCode >= len(gainXY) {
+		return nil, fmt.Errorf("hmc5983: invalid calibration gain code %d", j.GainCode)
+	}
+	return &Calibration{
+		Offset:    j.Offset,
+		Matrix:    j.Matrix,
+		GainCode:  j.GainCode,
+		Timestamp: j.Timestamp,
+		Samples:   j.Samples,
+		Radius:    j.Radius,
+		Residual:  j.Residual,
+	}, nil
+}
+
+// errBadFit is returned when samples don't describe an ellipsoid.
+var errBadFit = errors.New("hmc5983: calibration samples don't fit an ellipsoid; rotate the sensor through more orientations")
+
+// fitEllipsoid fits the quadric
+//
+//	ax² + by² + cz² + 2fyz + 2gxz + 2hxy + 2px + 2qy + 2rz = 1
+//
+// to pts with linear least squares, and derives the center and the matrix
+// mapping the ellipsoid to a sphere with the same volume. The Calibration is
+// in the units of pts.
+func fitEllipsoid(pts [][3]float64) (*Calibration, error) {
+	// Normal equations of the least squares problem.
+	var m [9][10]float64
+	for _, p := range pts {
+		x, y, z := p[0], p[1], p[2]
+		row := [9]float64{x * x, y * y, z * z, 2 * y * z, 2 * x * z, 2 * x * y, 2 * x, 2 * y, 2 * z}
+		for i := range row {
+			for j := range row {
+				m[i][j] += row[i] * row[j]
+			}
+			m[i][9] += row[i]
+		}
+	}
+	v, ok := solve9(m)
+	if !ok {
+		return nil, errBadFit
+	}
+	a := [3][3]float64{
+		{v[0], v[5], v[4]},
+		{v[5], v[1], v[3]},
+		{v[4], v[3], v[2]},
+	}
+	ai, ok := invert3(a)
+	if !ok {
+		return nil, errBadFit
+	}
+	// Center: c = -A⁻¹b; the ellipsoid is then (x-c)ᵀA(x-c) = 1 + cᵀAc.
+	var center [3]float64
+	for i := range center {
+		center[i] = -(ai[i][0]*v[6] + ai[i][1]*v[7] + ai[i][2]*v[8])
+	}
+	k := 1.0
+	for i := range center {
+		for j := range center {
+			k += center[i] * a[i][j] * center[j]
+		}
+	}
+	if k <= 0 {
+		return nil, errBadFit
+	}
+	for i := range a {
+		for j := range a[i] {
+			a[i][j] /= k
+		}
+	}
+	// A = VΛVᵀ; the semi-axes are 1/√λ. W = R·VΛ^½Vᵀ maps the ellipsoid to a
+	// sphere of radius R, the geometric mean of the semi-axes.
+	vals, vecs := eigenSym3(a)
+	radius := 1.0
+	for _, l := range vals {
+		if l <= 0 {
+			return nil, errBadFit
+		}
+		radius /= math.Sqrt(l)
+	}
+	radius = math.Cbrt(radius)
+	cal := &Calibration{Offset: center, Samples: len(pts), Radius: radius}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for n := 0; n < 3; n++ {
+				cal.Matrix[i][j] += vecs[i][n] * math.Sqrt(vals[n]) * vecs[j][n]
+			}
+			cal.Matrix[i][j] *= radius
+		}
+	}
+	c := calibration{set: true, offset: cal.Offset, matrix: cal.Matrix}
+	var sum float64
+	for _, p := range pts {
+		g := c.apply(p)
+		r := math.Sqrt(g[0]*g[0]+g[1]*g[1]+g[2]*g[2])/radius - 1
+		sum += r * r
+	}
+	cal.Residual = math.Sqrt(sum / float64(len(pts)))
+	return cal, nil
+}
+
+// solve9 solves the augmented 9x9 linear system with Gaussian elimination and
+// partial pivoting.
+func solve9(m [9][10]float64) ([9]float64, bool) {
+	const n = 9
+	for col := 0; col < n; col++ {
+		p := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(m[r][col]) > math.Abs(m[p][col]) {
+				p = r
+			}
+		}
+		if math.Abs(m[p][col]) < 1e-12 {
+			return [9]float64{}, false
+		}
+		m[col], m[p] = m[p], m[col]
+		for r := col + 1; r < n; r++ {
+			f := m[r][col] / m[col][col]
+			for c := col; c <= n; c++ {
+				m[r][c] -= f * m[col][c]
+			}
+		}
+	}
+	var x [9]float64
+	for r := n - 1; r >= 0; r-- {
+		s := m[r][n]
+		for c := r + 1; c < n; c++ {
+			s -= m[r][c] * x[c]
+		}
+		x[r] = s / m[r][r]
+	}
+	return x, true
+}
+
+// invert3 inverts a 3x3 matrix.
+func invert3(a [3][3]float64) ([3][3]float64, bool) {
+	det := a[0][0]*(a[1][1]*a[2][2]-a[1][2]*a[2][1]) -
+		a[0][1]*(a[1][0]*a[2][2]-a[1][2]*a[2][0]) +
+		a[0][2]*(a[1][0]*a[2][1]-a[1][1]*a[2][0])
+	if math.Abs(det) < 1e-18 {
+		return [3][3]float64{}, false
+	}
+	var r [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			// Cofactor of a[j][i].
+			i1, i2 := (j+1)%3, (j+2)%3
+			j1, j2 := (i+1)%3, (i+2)%3
+			r[i][j] = (a[i1][j1]*a[i2][j2] - a[i1][j2]*a[i2][j1]) / det
+		}
+	}
+	return r, true
+}
+
+// eigenSym3 returns the eigenvalues and eigenvectors (as columns) of a
+// symmetric 3x3 matrix, using Jacobi rotations.
+func eigenSym3(a [3][3]float64) ([3]float64, [3][3]float64) {
+	v := [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	for sweep := 0; sweep < 50; sweep++ {
+		off := a[0][1]*a[0][1] + a[0][2]*a[0][2] + a[1][2]*a[1][2]
+		if off < 1e-30 {
+			break
+		}
+		for p := 0; p < 2; p++ {
+			for q := p + 1; q < 3; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < 3; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+				for k := 0; k < 3; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+				for k := 0; k < 3; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+	return [3]float64{a[0][0], a[1][1], a[2][2]}, v
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSetCalibration(t *testing.T) {
+	data := func(x, y, z int16) []byte {
+		return []byte{byte(x >> 8), byte(x), byte(z >> 8), byte(z), byte(y >> 8), byte(y)}
+	}
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(1470, -1270, 1330)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x20}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(0, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(1250, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(0, 0, 0)},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 100 counts of bias on X and Y, Y scaled down by 2, Z bleeds into X.
+	d.SetCalibration([3]float64{100, 100, 0}, [3][3]float64{{1, 0, 0.5}, {0, 0.5, 0}, {0, 0, 1}})
+	var f Field
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	// X: (1370 + 0.5*1330) counts / 1370 LSB/Gauss; Y: -0.5 Gauss; Z: 1 Gauss.
+	want := Field{X: 148540 * physic.NanoTesla, Y: -50 * physic.MicroTesla, Z: 100 * physic.MicroTesla}
+	if f != want {
+		t.Fatalf("SenseField() = %+v, want %+v", f, want)
+	}
+
+	// The bias is preserved in Gauss across gain changes: 100 counts at gain
+	// 0 is 100*1090/1370 counts at gain 1.
+	if err := d.SetGain(1); err != nil {
+		t.Fatal(err)
+	}
+	x, _, _, err := d.Sense()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (1250/1090 - 100/1370) Gauss = 107.38µT.
+	if x != 1073 {
+		t.Fatalf("Sense() x = %d", x)
+	}
+
+	d.ClearCalibration()
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	if f != (Field{}) {
+		t.Fatalf("SenseField() = %+v", f)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFitEllipsoid(t *testing.T) {
+	center := [3]float64{0.1, -0.2, 0.05}
+	// Soft iron distortion of a 0.5 Gauss field.
+	s := [3][3]float64{{0.6, 0.05, 0}, {0.05, 0.45, 0.02}, {0, 0.02, 0.5}}
+	var pts [][3]float64
+	for i := 0; i < 12; i++ {
+		theta := math.Pi * (float64(i) + 0.5) / 12
+		for j := 0; j < 24; j++ {
+			phi := 2 * math.Pi * float64(j) / 24
+			u := [3]float64{math.Sin(theta) * math.Cos(phi), math.Sin(theta) * math.Sin(phi), math.Cos(theta)}
+			var p [3]float64
+			for k := range p {
+				p[k] = center[k] + s[k][0]*u[0] + s[k][1]*u[1] + s[k][2]*u[2]
+			}
+			pts = append(pts, p)
+		}
+	}
+	cal, err := fitEllipsoid(pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range center {
+		if math.Abs(cal.Offset[i]-center[i]) > 1e-9 {
+			t.Fatalf("Offset = %v, want %v", cal.Offset, center)
+		}
+	}
+	if cal.Residual > 1e-9 {
+		t.Fatalf("Residual = %g", cal.Residual)
+	}
+	if cal.Samples != len(pts) {
+		t.Fatalf("Samples = %d", cal.Samples)
+	}
+	// The radius preserves the volume: cbrt(det(s)).
+	det := s[0][0]*(s[1][1]*s[2][2]-s[1][2]*s[2][1]) - s[0][1]*(s[1][0]*s[2][2]-s[1][2]*s[2][0])
+	if want := math.Cbrt(det); math.Abs(cal.Radius-want) > 1e-9 {
+		t.Fatalf("Radius = %g, want %g", cal.Radius, want)
+	}
+
+	// A flat set of samples can't be fitted.
+	flat := make([][3]float64, len(pts))
+	for i, p := range pts {
+		flat[i] = [3]float64{p[0], p[1], 0}
+	}
+	if _, err := fitEllipsoid(flat); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCalibrate_Canceled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := d.Calibrate(ctx, time.Second); err != context.Canceled {
+		t.Fatalf("Calibrate() = %v", err)
+	}
+	if _, err := d.Calibrate(context.Background(), 0); err == nil {
+		t.Fatal("expected error with no samples")
+	}
+}
+
+func TestCalibration_SaveLoad(t *testing.T) {
+	c := &Calibration{
+		Offset:    [3]float64{12, -3.5, 40},
+		Matrix:    [3][3]float64{{1.1, 0, 0.02}, {0, 0.9, 0}, {0.02, 0, 1}},
+		GainCode:  1,
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Samples:   300,
+		Radius:    0.48,
+		Residual:  0.011,
+	}
+	var b bytes.Buffer
+	if err := c.Save(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), `"gain_code": 1`) {
+		t.Fatalf("unexpected encoding:\n%s", b.String())
+	}
+	got, err := LoadCalibration(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *c {
+		t.Fatalf("LoadCalibration() = %+v, want %+v", got, c)
+	}
+
+	for _, s := range []string{
+		`{"version": 2}`,
+		`{"version": 1, "gain_code": 8}`,
+		`{"version": 1, "unknown": 0}`,
+		`garbage`,
+	} {
+		if _, err := LoadCalibration(strings.NewReader(s)); err == nil {
+			t.Fatalf("LoadCalibration(%q) succeeded", s)
+		}
+	}
+}
+
+func TestSetOffset(t *testing.T) {
+	data := func(x, y, z int16) []byte {
+		return []byte{byte(x >> 8), byte(x), byte(z >> 8), byte(z), byte(y >> 8), byte(y)}
+	}
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(1470, -1270, 1430)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x20}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.SetOffset(100, 100, 100)
+	var f Field
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	// X: 1 Gauss; Y: -1 Gauss; Z: 1 Gauss.
+	want := Field{X: 100 * physic.MicroTesla, Y: -100 * physic.MicroTesla, Z: 100 * physic.MicroTesla}
+	if f != want {
+		t.Fatalf("SenseField() = %+v, want %+v", f, want)
+	}
+	if err := d.SetGain(1); err != nil {
+		t.Fatal(err)
+	}
+	x, y, z := d.GetOffset()
+	if math.Abs(x-100*1090./1370) > 1e-9 || math.Abs(y-x) > 1e-9 || math.Abs(z-100*980./1330) > 1e-9 {
+		t.Fatalf("GetOffset() = %g, %g, %g", x, y, z)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"periph.io/x/devices/v3/sensor"
+)
+
+// Sample is a timestamped magnetic field measurement.
+type Sample struct {
+	// Field is the scaled field, as returned by SenseField.
+	Field
+	// Raw is the raw counts the field was computed from, in X,Y,Z order.
+	Raw [3]int16
+	// Timestamp is the time at which the sample was read from the device. It
+	// holds a monotonic clock reading.
+	Timestamp time.Time
+	// Overflow reports that an axis exceeded the range of the gain; Field
+	// must then be discarded.
+	Overflow bool
+}
+
+// SenseInto reads one sample into s, like SenseField, and timestamps it.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection.
+func (d *Dev) SenseInto(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.senseSample(context.Background(), s)
+}
+
+func (d *Dev) senseSample(ctx context.Context, s *Sample) error {
+	g, raw, err := d.measureGauss(ctx)
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return err
+	}
+	s.X = gaussToFlux(g[0])
+	s.Y = gaussToFlux(g[1])
+	s.Z = gaussToFlux(g[2])
+	s.Raw = raw
+	s.Timestamp = d.readAt
+	s.Overflow = err != nil
+	return err
+}
+
+// SenseContinuous puts the device in continuous measurement mode and returns
+// a channel delivering samples until Halt is called.
+//
+// Samples are read on each DRDY edge when Opts.DRDY is set, otherwise every
+// interval. An interval of 0 or less uses the configured output data rate.
+// Samples that overflow are skipped. Calling SenseContinuous again stops the
+// previous channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan Sample, error) {
+	c := make(chan Sample)
+	err := d.startContinuous(interval, func(interval time.Duration, stop <-chan struct{}) {
+		defer close(c)
+		d.sensingContinuous(interval, stop, func(s Sample) bool {
+			select {
+			case c <- s:
+				return true
+			case <-stop:
+				return false
+			}
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SenseMagnetic reads one measurement like SenseField. It implements
+// sensor.Magnetometer.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	return d.SenseField((*Field)(f))
+}
+
+// SenseMagneticContinuous is SenseContinuous without the sample metadata. It
+// implements sensor.Magnetometer.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	c := make(chan sensor.Field)
+	err := d.startContinuous(interval, func(interval time.Duration, stop <-chan struct{}) {
+		defer close(c)
+		d.sensingContinuous(interval, stop, func(s Sample) bool {
+			select {
+			case c <- sensor.Field(s.Field):
+				return true
+			case <-stop:
+				return false
+			}
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// startContinuous stops the previous continuous goroutine, switches to
+// continuous mode and runs run on a new goroutine, until Halt or the next
+// call.
+func (d *Dev) startContinuous(interval time.Duration, run func(interval time.Duration, stop <-chan struct{})) error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	interval, err := d.enterContinuous(interval)
+	if err != nil {
+		return err
+	}
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		run(interval, stop)
+	}(d.stop)
+	return nil
+}
+
+// enterContinuous switches the device to continuous mode and returns the
+// interval between samples, defaulting to the output data rate.
+func (d *Dev) enterContinuous(interval time.Duration) (time.Duration, error) {
+	if d.halted {
+		return 0, ErrHalted
+	}
+	if d.mode != modeContinuous {
+		if err := d.writeMode(modeContinuous); err != nil {
+			return 0, err
+		}
+		d.mode = modeContinuous
+	}
+	if interval <= 0 {
+		interval = odrPeriods[(d.cra>>2)&0b111]
+	}
+	return interval, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, emit func(Sample) bool) {
+	if err := d.sensingLoop(interval, stop, emit); err != nil {
+		log.Printf("%s: failed to sense: %v", d, err)
+	}
+}
+
+// sensingLoop reads a sample on each DRDY edge or tick and passes it to emit,
+// until stop is closed, emit returns false or reading fails.
+func (d *Dev) sensingLoop(interval time.Duration, stop <-chan struct{}, emit func(Sample) bool) error {
+	var tick <-chan time.Time
+	if d.drdy == nil {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	wd := watchdog{limit: d.stuckLimit}
+	for {
+		if d.drdy != nil {
+			// Poll the stop channel between edges.
+			if !d.drdy.WaitForEdge(interval) {
+				select {
+				case <-stop:
+					return nil
+				default:
+					continue
+				}
+			}
+		} else {
+			select {
+			case <-stop:
+				return nil
+			case <-tick:
+			}
+		}
+		s := Sample{}
+		d.mu.Lock()
+		err := d.senseSample(context.Background(), &s)
+		if err == nil {
+			d.history.add(s)
+		}
+		d.mu.Unlock()
+		if errors.Is(err, ErrOverflow) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if wd.stuck(s.Raw) {
+			if err := d.recoverStuck(s, wd.limit); err != nil {
+				return err
+			}
+			continue
+		}
+		if !emit(s) {
+			return nil
+		}
+	}
+}
+
+// stopContinuous stops the SenseContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func TestSenseContinuous(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}},
+		// Overflowed samples are skipped.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0xF0, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0xFA, 0xA4, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeIdle}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []physic.MagneticFluxDensity{100146 * physic.NanoTesla, -100146 * physic.NanoTesla} {
+		drdy.EdgesChan <- gpio.Low
+		if want < 0 {
+			// The overflowed sample.
+			drdy.EdgesChan <- gpio.Low
+		}
+		s := <-c
+		if s.X != want || s.Timestamp.IsZero() {
+			t.Fatalf("sample = %+v, want X = %s", s, want)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed")
+	}
+	if _, err := d.SenseContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseContinuous() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeIdle}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m sensor.Magnetometer = d
+	var f sensor.Field
+	if err := m.SenseMagnetic(&f); err != nil || f.X != 100146*physic.NanoTesla {
+		t.Fatalf("SenseMagnetic() = %v, %v", f, err)
+	}
+	c, err := m.SenseMagneticContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drdy.EdgesChan <- gpio.Low
+	if f := <-c; f.X != 100146*physic.NanoTesla {
+		t.Fatalf("field = %v", f)
+	}
+	if err := m.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// sampleConn answers every read with the same sample.
+type sampleConn struct{}
+
+func (sampleConn) String() string { return "sample" }
+
+func (sampleConn) Tx(w, r []byte) error {
+	copy(r, []byte{0x05, 0x5C, 0x00, 0x10, 0xFA, 0xA4})
+	return nil
+}
+
+func (sampleConn) Duplex() conn.Duplex { return conn.Half }
+
+func TestSenseInto(t *testing.T) {
+	d, err := newDev(context.Background(), sampleConn{}, false, Opts{SkipIDCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.SenseInto(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.X != 100146*physic.NanoTesla || s.Raw != [3]int16{1372, -1372, 16} || s.Overflow || s.Timestamp.IsZero() {
+		t.Fatalf("SenseInto() = %+v", s)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = d.SenseInto(&s) }); n != 0 {
+		t.Fatalf("SenseInto() allocates %g times", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { _, _, _, _ = d.SenseRaw() }); n != 0 {
+		t.Fatalf("SenseRaw() allocates %g times", n)
+	}
+
+	bus := &i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0xF0, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	)}
+	if d, err = New(bus, Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SenseInto(&s); !errors.Is(err, ErrOverflow) || !s.Overflow || s.Raw[0] != overflowCount {
+		t.Fatalf("SenseInto() = %+v, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+//
+// Failures of the underlying bus are wrapped with the operation that failed
+// and match none of these, except ErrRetriesExhausted when Opts.Retry is set.
+var (
+	// ErrBadID is returned by New when the identification registers don't
+	// read "H43".
+	ErrBadID = errors.New("hmc5983: bad chip ID")
+	// ErrWrongChipQMC5883L is returned by New when the chip on the bus is a
+	// QMC5883L, sold on many boards labeled HMC5883L. It has a different
+	// register map and is supported by the qmc5883l package.
+	ErrWrongChipQMC5883L = errors.New("hmc5983: wrong chip, found a QMC5883L")
+	// ErrReadback is returned when Opts.VerifyWrites is set and a
+	// configuration register doesn't read back the value written, as caused
+	// by another device at the same address, a clone or bus corruption.
+	ErrReadback = errors.New("hmc5983: register readback mismatch")
+	// ErrNotReady is returned when no new measurement became available in
+	// time.
+	ErrNotReady = errors.New("hmc5983: data not ready")
+	// ErrOverflow is matched by *OverflowError.
+	ErrOverflow = errors.New("hmc5983: measurement overflow")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("hmc5983: device halted")
+	// ErrRetriesExhausted is matched by bus failures that persisted through
+	// all the attempts of Opts.Retry. The last bus error is wrapped too.
+	ErrRetriesExhausted = errors.New("hmc5983: retries exhausted")
+	// ErrInvalidOpts is returned for options or arguments that are out of
+	// range or not supported by the chip found.
+	ErrInvalidOpts = errors.New("hmc5983: invalid options")
+)
+
+// overflowCount is the value reported by the device on an axis whose field
+// exceeds the selected range.
+const overflowCount = -4096
+
+// OverflowError is returned when one or more axes exceeded the range of the
+// selected gain. Switch to a lower gain (higher GainCode) or discard the
+// sample.
+type OverflowError struct {
+	X, Y, Z bool
+}
+
+func (e *OverflowError) Error() string {
+	var axes []string
+	if e.X {
+		axes = append(axes, "X")
+	}
+	if e.Y {
+		axes = append(axes, "Y")
+	}
+	if e.Z {
+		axes = append(axes, "Z")
+	}
+	return ErrOverflow.Error() + " on " + strings.Join(axes, ",")
+}
+
+// Is implements errors.Is.
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// checkOverflow returns an *OverflowError if any raw count is the overflow
+// sentinel.
+func checkOverflow(x, y, z int16) error {
+	if x != overflowCount && y != overflowCount && z != overflowCount {
+		return nil
+	}
+	return &OverflowError{X: x == overflowCount, Y: y == overflowCount, Z: z == overflowCount}
+}
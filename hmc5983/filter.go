@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// lowPass is a single pole IIR low-pass filter, per axis.
+type lowPass struct {
+	alpha float64 // 0 when disabled
+	init  bool
+	y     [3]float64
+}
+
+// newLowPass returns a filter with the given -3dB cutoff for samples taken
+// every period. A cutoff of 0 disables the filter.
+func newLowPass(cutoffHz float64, period time.Duration) lowPass {
+	if cutoffHz == 0 {
+		return lowPass{}
+	}
+	rc := 1 / (2 * math.Pi * cutoffHz)
+	dt := period.Seconds()
+	return lowPass{alpha: dt / (rc + dt)}
+}
+
+// apply adds a sample and returns the filtered value. The first sample
+// initializes the output.
+func (l *lowPass) apply(v [3]float64) [3]float64 {
+	if l.alpha == 0 {
+		return v
+	}
+	if !l.init {
+		l.y = v
+		l.init = true
+		return v
+	}
+	for i := range v {
+		l.y[i] += l.alpha * (v[i] - l.y[i])
+	}
+	return l.y
+}
+
+// Smoothing selects how SetSmoothing combines the samples of its window.
+type Smoothing int
+
+const (
+	// Mean averages the window, reducing noise.
+	Mean Smoothing = iota
+	// Median takes the per axis median of the window, rejecting isolated
+	// spikes such as those caused by nearby PWM switching.
+	Median
+)
+
+func (s Smoothing) String() string {
+	switch s {
+	case Mean:
+		return "Mean"
+	case Median:
+		return "Median"
+	default:
+		return fmt.Sprintf("Smoothing(%d)", int(s))
+	}
+}
+
+// SetSmoothing makes the scaled sense methods return the mean or median of
+// the last window samples, per axis, instead of the latest one.
+//
+// Until window samples have been read, the samples read so far are used.
+// Overflowed samples are not added to the window. It is applied after
+// Opts.Rotation and before Opts.LowPassCutoffHz. A window of 1 or less
+// disables smoothing; calling SetSmoothing again starts a new window.
+func (d *Dev) SetSmoothing(window int, mode Smoothing) error {
+	if mode != Mean && mode != Median {
+		return fmt.Errorf("%w: %s", ErrInvalidOpts, mode)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.smoother = newSmoother(window, mode)
+	return nil
+}
+
+// smoother is a moving mean or median over a window of samples.
+type smoother struct {
+	mode    Smoothing
+	buf     [][3]float64 // ring buffer, nil when disabled
+	n, next int
+	scratch []float64 // to compute the median without allocating
+}
+
+func newSmoother(window int, mode Smoothing) smoother {
+	if window <= 1 {
+		return smoother{}
+	}
+	return smoother{mode: mode, buf: make([][3]float64, window), scratch: make([]float64, window)}
+}
+
+// apply adds a sample and returns the smoothed value.
+func (s *smoother) apply(v [3]float64) [3]float64 {
+	if s.buf == nil {
+		return v
+	}
+	s.buf[s.next] = v
+	s.next = (s.next + 1) % len(s.buf)
+	if s.n < len(s.buf) {
+		s.n++
+	}
+	var out [3]float64
+	for i := range out {
+		if s.mode == Mean {
+			for _, b := range s.buf[:s.n] {
+				out[i] += b[i]
+			}
+			out[i] /= float64(s.n)
+			continue
+		}
+		x := s.scratch[:s.n]
+		for j, b := range s.buf[:s.n] {
+			x[j] = b[i]
+		}
+		slices.Sort(x)
+		if m := s.n / 2; s.n%2 == 1 {
+			out[i] = x[m]
+		} else {
+			out[i] = (x[m-1] + x[m]) / 2
+		}
+	}
+	return out
+}
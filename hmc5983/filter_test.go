@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestLowPass(t *testing.T) {
+	l := newLowPass(0, time.Second)
+	if got := l.apply([3]float64{1, 2, 3}); got != [3]float64{1, 2, 3} {
+		t.Fatalf("disabled apply() = %v", got)
+	}
+
+	// RC = dt gives alpha = 1/2.
+	l = newLowPass(1/(2*math.Pi), time.Second)
+	want := []float64{1, 0.5, 0.25, 0.125}
+	for i, v := range []float64{1, 0, 0, 0} {
+		got := l.apply([3]float64{v, -v, 2 * v})
+		if math.Abs(got[0]-want[i]) > 1e-12 || math.Abs(got[1]+want[i]) > 1e-12 || math.Abs(got[2]-2*want[i]) > 1e-12 {
+			t.Fatalf("#%d: apply() = %v, want %g", i, got, want[i])
+		}
+	}
+}
+
+func TestLowPassCutoffHz(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}},
+		// Overflows don't update the filter.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0xF0, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	// 15Hz output data rate.
+	d, err := New(bus, Opts{LowPassCutoffHz: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Field
+	if err := d.SenseField(&f); err != nil || f.X != 100146*physic.NanoTesla {
+		t.Fatalf("SenseField() = %v, %v", f, err)
+	}
+	if err := d.SenseField(&f); err == nil {
+		t.Fatal("expected overflow")
+	}
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	rc := 1 / (2 * math.Pi)
+	dt := odrPeriods[0b100].Seconds()
+	if want := 100146 * (1 - dt/(rc+dt)); math.Abs(float64(f.X)-want) > 1 {
+		t.Fatalf("SenseField() = %v, want X = %gnT", f, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSmoother(t *testing.T) {
+	s := newSmoother(1, Median)
+	if got := s.apply([3]float64{1, 2, 3}); got != [3]float64{1, 2, 3} {
+		t.Fatalf("disabled apply() = %v", got)
+	}
+
+	data := []struct {
+		mode Smoothing
+		want []float64
+	}{
+		{Mean, []float64{1, 1.5, 11 / 3., 14 / 3., 6}},
+		{Median, []float64{1, 1.5, 2, 4, 6}},
+	}
+	for _, line := range data {
+		s := newSmoother(3, line.mode)
+		for i, v := range []float64{1, 2, 8, 4, 6} {
+			got := s.apply([3]float64{v, -v, 0})
+			if math.Abs(got[0]-line.want[i]) > 1e-12 || math.Abs(got[1]+line.want[i]) > 1e-12 || got[2] != 0 {
+				t.Fatalf("%s #%d: apply() = %v, want %g", line.mode, i, got, line.want[i])
+			}
+		}
+		if n := testing.AllocsPerRun(10, func() { s.apply([3]float64{}) }); n != 0 {
+			t.Fatalf("%s: apply() allocates %g times", line.mode, n)
+		}
+	}
+}
+
+func TestSetSmoothing(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x7F, 0xFF, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetSmoothing(3, Smoothing(2)); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.SetSmoothing(3, Median); err != nil {
+		t.Fatal(err)
+	}
+	var f Field
+	for range 3 {
+		if err := d.SenseField(&f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The spike is rejected.
+	if f.X != 100146*physic.NanoTesla {
+		t.Fatalf("SenseField() = %v", f)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
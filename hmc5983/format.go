@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"fmt"
+	"strings"
+)
+
+// gainRanges are the full-scale ranges by gain code.
+var gainRanges = []string{"±0.88Ga", "±1.3Ga", "±1.9Ga", "±2.5Ga", "±4.0Ga", "±4.7Ga", "±5.6Ga", "±8.1Ga"}
+
+// odrNames are the output data rates by CRA bits 4..2.
+var odrNames = []string{"0.75Hz", "1.5Hz", "3Hz", "7.5Hz", "15Hz", "30Hz", "75Hz", "220Hz"}
+
+// describe formats the configuration held by the gain code, CRA and MODE.
+func describe(gainCode int, cra, mode byte) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s, %s, %d sample", gainRanges[gainCode], odrNames[(cra>>2)&0b111], 1<<((cra>>5)&0b11))
+	if cra&(0b11<<5) != 0 {
+		b.WriteByte('s')
+	}
+	switch mode & 0b11 {
+	case modeContinuous:
+		b.WriteString(", continuous")
+	case modeSingle:
+		b.WriteString(", single")
+	default:
+		b.WriteString(", idle")
+	}
+	switch Bias(cra & 0b11) {
+	case PositiveBias:
+		b.WriteString(", positive bias")
+	case NegativeBias:
+		b.WriteString(", negative bias")
+	}
+	if cra&(1<<7) != 0 {
+		b.WriteString(", temperature")
+	}
+	return b.String()
+}
+
+// String returns the configuration selected by o, with the defaults applied,
+// e.g. "Opts{0x1e, ±1.3Ga, 75Hz, 8 samples, single}".
+func (o Opts) String() string {
+	addr := o.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	cra, mode := o.registers()
+	s := describe(o.gainCode(), cra, mode)
+	if o.AutoRange {
+		s += ", auto range"
+	}
+	return fmt.Sprintf("Opts{%#x, %s}", addr, s)
+}
+
+// String returns the field in µT, e.g. "X=20.500µT Y=-3.120µT Z=41µT".
+func (f Field) String() string {
+	return fmt.Sprintf("X=%s Y=%s Z=%s", f.X, f.Y, f.Z)
+}
+
+// String returns the field in µT, flagging overflowed samples.
+func (s Sample) String() string {
+	if s.Overflow {
+		return s.Field.String() + " (overflow)"
+	}
+	return s.Field.String()
+}
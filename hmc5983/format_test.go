@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestOpts_String(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, "Opts{0x1e, ±0.88Ga, 15Hz, 1 sample, continuous}"},
+		{Opts{Addr: 0x1F, GainCode: 1, ODRHz: 75, AvgSamples: 8, Mode: "single"}, "Opts{0x1f, ±1.3Ga, 75Hz, 8 samples, single}"},
+		{Opts{ODRHz: 7, TempCompensation: true, AutoRange: true}, "Opts{0x1e, ±0.88Ga, 7.5Hz, 1 sample, continuous, temperature, auto range}"},
+		{Opts{Bias: NegativeBias}, "Opts{0x1e, ±0.88Ga, 15Hz, 1 sample, continuous, negative bias}"},
+	}
+	for i, line := range data {
+		if s := line.opts.String(); s != line.want {
+			t.Errorf("#%d: String() = %q, want %q", i, s, line.want)
+		}
+	}
+}
+
+func TestSample_String(t *testing.T) {
+	s := Sample{Field: Field{X: 20500 * physic.NanoTesla, Y: -3120 * physic.NanoTesla, Z: 41 * physic.MicroTesla}}
+	if got := s.String(); got != "X=20.500µT Y=-3.120µT Z=41µT" {
+		t.Fatalf("String() = %q", got)
+	}
+	s.Overflow = true
+	if got := s.String(); got != "X=20.500µT Y=-3.120µT Z=41µT (overflow)" {
+		t.Fatalf("String() = %q", got)
+	}
+}
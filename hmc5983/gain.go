@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// gainFullScale is the full-scale range by gain code, per datasheet.
+var gainFullScale = []physic.MagneticFluxDensity{
+	88 * physic.MicroTesla,
+	130 * physic.MicroTesla,
+	190 * physic.MicroTesla,
+	250 * physic.MicroTesla,
+	400 * physic.MicroTesla,
+	470 * physic.MicroTesla,
+	560 * physic.MicroTesla,
+	810 * physic.MicroTesla,
+}
+
+// GainInfo describes a gain code.
+type GainInfo struct {
+	Gain Gain
+	// Range is the recommended full-scale range, ±Range.
+	Range physic.MagneticFluxDensity
+	// LSBPerGaussXY and LSBPerGaussZ are the typical sensitivities.
+	LSBPerGaussXY int
+	LSBPerGaussZ  int
+	// Resolution is the field of one count on X and Y, rounded to the
+	// nearest nT.
+	Resolution physic.MagneticFluxDensity
+}
+
+// Gains returns the description of all the gain codes, from the most to the
+// least sensitive, so a gain can be chosen for the expected field.
+func Gains() []GainInfo {
+	g := make([]GainInfo, len(gainXY))
+	for i := range g {
+		g[i] = gainInfo(i)
+	}
+	return g
+}
+
+func gainInfo(code int) GainInfo {
+	return GainInfo{
+		Gain:          Gain(code),
+		Range:         gainFullScale[code],
+		LSBPerGaussXY: gainXY[code],
+		LSBPerGaussZ:  gainZ[code],
+		Resolution:    physic.MagneticFluxDensity(math.Round(100000 / float64(gainXY[code]))),
+	}
+}
+
+// Range returns the full-scale range of the gain currently in use, ±Range.
+func (d *Dev) Range() physic.MagneticFluxDensity {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return gainFullScale[d.gainCode]
+}
+
+// Resolution returns the field of one count on X and Y at the gain currently
+// in use.
+func (d *Dev) Resolution() physic.MagneticFluxDensity {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return gainInfo(d.gainCode).Resolution
+}
+
+// LSBPerGauss returns the typical sensitivities of the gain g, in counts per
+// Gauss on X and Y and on Z, or 0 for an invalid gain.
+func LSBPerGauss(g Gain) (xy, z int) {
+	if g < 0 || int(g) >= len(gainXY) {
+		return 0, 0
+	}
+	return gainXY[g], gainZ[g]
+}
+
+// LSBPerGauss returns the sensitivities of the gain currently in use, to
+// scale the counts returned by SenseRaw.
+func (d *Dev) LSBPerGauss() (xy, z int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.lsbPerGaXY, d.lsbPerGaZ
+}
+
+// CountsToMicroTesla10Float is CountsToMicroTesla10 without truncating the
+// result.
+func CountsToMicroTesla10Float(counts int16, lsbPerGauss int) float64 {
+	return float64(counts) * 1000 / float64(lsbPerGauss)
+}
+
+// RawToMicroTesla10 converts raw counts in X,Y,Z order, as returned by
+// SenseRaw, using the sensitivity of each axis at the gain g. It returns zeros
+// for an invalid gain.
+func RawToMicroTesla10(raw [3]int16, g Gain) [3]float64 {
+	xy, z := LSBPerGauss(g)
+	if xy == 0 {
+		return [3]float64{}
+	}
+	return [3]float64{
+		CountsToMicroTesla10Float(raw[0], xy),
+		CountsToMicroTesla10Float(raw[1], xy),
+		CountsToMicroTesla10Float(raw[2], z),
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestGains(t *testing.T) {
+	g := Gains()
+	if len(g) != 8 {
+		t.Fatalf("len(Gains()) = %d", len(g))
+	}
+	want := GainInfo{Gain: Gain1_3Gauss, Range: 130 * physic.MicroTesla, LSBPerGaussXY: 1090, LSBPerGaussZ: 980, Resolution: 92 * physic.NanoTesla}
+	if g[1] != want {
+		t.Fatalf("Gains()[1] = %+v, want %+v", g[1], want)
+	}
+	for i := 1; i < len(g); i++ {
+		if g[i].Range <= g[i-1].Range || g[i].Resolution <= g[i-1].Resolution {
+			t.Fatalf("Gains() not sorted at %d", i)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0xE0}},
+	)}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := d.Range(); r != 88*physic.MicroTesla {
+		t.Fatalf("Range() = %s", r)
+	}
+	if r := d.Resolution(); r != 73*physic.NanoTesla {
+		t.Fatalf("Resolution() = %s", r)
+	}
+	if err := d.SetGain(int(Gain8_1Gauss)); err != nil {
+		t.Fatal(err)
+	}
+	if r := d.Range(); r != 810*physic.MicroTesla {
+		t.Fatalf("Range() = %s", r)
+	}
+	if r := d.Resolution(); r != 435*physic.NanoTesla {
+		t.Fatalf("Resolution() = %s", r)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLSBPerGauss(t *testing.T) {
+	if xy, z := LSBPerGauss(Gain0_88Gauss); xy != 1370 || z != 1330 {
+		t.Fatalf("LSBPerGauss() = %d, %d", xy, z)
+	}
+	if xy, z := LSBPerGauss(Gain(8)); xy != 0 || z != 0 {
+		t.Fatalf("LSBPerGauss() = %d, %d", xy, z)
+	}
+	d := &Dev{lsbPerGaXY: gainXY[7], lsbPerGaZ: gainZ[7]}
+	if xy, z := d.LSBPerGauss(); xy != 230 || z != 205 {
+		t.Fatalf("LSBPerGauss() = %d, %d", xy, z)
+	}
+}
+
+func TestRawToMicroTesla10(t *testing.T) {
+	if v := CountsToMicroTesla10Float(1, 1090); math.Abs(v-0.9174) > 1e-4 {
+		t.Fatalf("CountsToMicroTesla10Float() = %g", v)
+	}
+	got := RawToMicroTesla10([3]int16{1090, -545, 980}, Gain1_3Gauss)
+	if got != [3]float64{1000, -500, 1000} {
+		t.Fatalf("RawToMicroTesla10() = %v", got)
+	}
+	if got := RawToMicroTesla10([3]int16{1, 1, 1}, Gain(-1)); got != [3]float64{} {
+		t.Fatalf("RawToMicroTesla10() = %v", got)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"errors"
+	"math"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Heading returns the compass bearing of the X axis relative to magnetic
+// north, clockwise in [0, 360)°.
+//
+// The board is assumed level, with the chip's axes in their right-handed
+// orientation (Z up, Y 90° counterclockwise from X), which makes the bearing
+// atan2(Y, X). Only the horizontal components are used.
+func (f Field) Heading() physic.Angle {
+	return headingOf(float64(f.Y), float64(f.X))
+}
+
+// Heading measures the field and returns the compass bearing, see
+// Field.Heading.
+//
+// The calibration set with SetCalibration or ApplyCalibration is applied
+// first; without one, nearby ferrous material typically causes errors of tens
+// of degrees.
+func (d *Dev) Heading() (physic.Angle, error) {
+	var f Field
+	if err := d.SenseField(&f); err != nil {
+		return 0, err
+	}
+	return f.Heading(), nil
+}
+
+// TrueHeading returns the compass bearing of the X axis relative to true
+// north, given the local magnetic declination (positive east).
+func (f Field) TrueHeading(declination physic.Angle) physic.Angle {
+	return wrapAngle(f.Heading() + declination)
+}
+
+// SetDeclination sets the magnetic declination (positive east) used by
+// TrueHeading.
+//
+// It can be looked up for a location on the NOAA website, or computed with
+// WMM.Declination.
+func (d *Dev) SetDeclination(declination physic.Angle) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.declination = declination
+}
+
+// TrueHeading measures the field and returns the compass bearing relative to
+// true north, using the declination set with SetDeclination.
+func (d *Dev) TrueHeading() (physic.Angle, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var f Field
+	if err := d.senseField(&f); err != nil {
+		return 0, err
+	}
+	return f.TrueHeading(d.declination), nil
+}
+
+// HeadingTiltCompensated returns the compass bearing of the X axis relative to
+// magnetic north, clockwise in [0, 360)°, for a board that isn't level.
+//
+// accel is an accelerometer reading at rest, in any unit, expressed in the
+// chip's axes: it must read +Z when the board is level. The field is projected
+// on the horizontal plane defined by accel before computing the bearing, so
+// the result matches Field.Heading when the board is level. Returns an error
+// when accel is zero or the field is vertical.
+func HeadingTiltCompensated(mag Sample, accel [3]float64) (physic.Angle, error) {
+	m := [3]float64{float64(mag.X), float64(mag.Y), float64(mag.Z)}
+	n := math.Sqrt(accel[0]*accel[0] + accel[1]*accel[1] + accel[2]*accel[2])
+	if n == 0 {
+		return 0, errors.New("hmc5983: zero acceleration")
+	}
+	u := [3]float64{accel[0] / n, accel[1] / n, accel[2] / n}
+	// Horizontal component of the field, which points to magnetic north.
+	dot := m[0]*u[0] + m[1]*u[1] + m[2]*u[2]
+	north := [3]float64{m[0] - dot*u[0], m[1] - dot*u[1], m[2] - dot*u[2]}
+	// east = north × up; only the X components are needed.
+	eastX := north[1]*u[2] - north[2]*u[1]
+	if north[0] == 0 && eastX == 0 {
+		return 0, errors.New("hmc5983: no horizontal field component")
+	}
+	return headingOf(eastX, north[0]), nil
+}
+
+// wrapAngle wraps a into [0, 2π).
+func wrapAngle(a physic.Angle) physic.Angle {
+	a %= 2 * physic.Pi
+	if a < 0 {
+		a += 2 * physic.Pi
+	}
+	return a
+}
+
+// headingOf returns atan2(y, x) as an angle in [0, 2π).
+func headingOf(y, x float64) physic.Angle {
+	a := math.Atan2(y, x)
+	if a < 0 {
+		a += 2 * math.Pi
+	}
+	return wrapAngle(physic.Angle(math.Round(a * float64(physic.Radian))))
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestField_Heading(t *testing.T) {
+	data := []struct {
+		x, y physic.MagneticFluxDensity
+		want physic.Angle
+	}{
+		{20 * physic.MicroTesla, 0, 0},
+		{0, 20 * physic.MicroTesla, 90 * physic.Degree},
+		{-20 * physic.MicroTesla, 0, physic.Pi},
+		{0, -20 * physic.MicroTesla, 3 * physic.Pi / 2},
+		{20 * physic.MicroTesla, -20 * physic.MicroTesla, 7 * physic.Pi / 4},
+		{-20 * physic.MicroTesla, 20 * physic.MicroTesla, 3 * physic.Pi / 4},
+	}
+	for _, line := range data {
+		f := Field{X: line.x, Y: line.y, Z: -40 * physic.MicroTesla}
+		got := f.Heading()
+		if diff := got - line.want; diff < -physic.MicroRadian || diff > physic.MicroRadian {
+			t.Errorf("Heading(%s, %s) = %s, want %s", line.x, line.y, got, line.want)
+		}
+	}
+}
+
+func TestHeading(t *testing.T) {
+	ops := append(initOps(),
+		// 100 counts of hard iron bias on X.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, 0x64, 0x00, 0x00, 0x01, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.SetCalibration([3]float64{100, 0, 0}, [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}})
+	h, err := d.Heading()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := h - physic.Pi/2; diff < -physic.MicroRadian || diff > physic.MicroRadian {
+		t.Fatalf("Heading() = %s", h)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHeadingTiltCompensated(t *testing.T) {
+	// World frame is north, west, up; the field dips 60° down.
+	world := [3]float64{20, 0, -35}
+	rot := func(axis int, a float64) [3][3]float64 {
+		c, s := math.Cos(a), math.Sin(a)
+		i, j := (axis+1)%3, (axis+2)%3
+		var r [3][3]float64
+		r[axis][axis] = 1
+		r[i][i], r[i][j], r[j][i], r[j][j] = c, -s, s, c
+		return r
+	}
+	mul := func(a, b [3][3]float64) (r [3][3]float64) {
+		for i := range 3 {
+			for j := range 3 {
+				for k := range 3 {
+					r[i][j] += a[i][k] * b[k][j]
+				}
+			}
+		}
+		return r
+	}
+	// toBoard returns Rᵀ·v.
+	toBoard := func(r [3][3]float64, v [3]float64) (o [3]float64) {
+		for i := range 3 {
+			for k := range 3 {
+				o[i] += r[k][i] * v[k]
+			}
+		}
+		return o
+	}
+	for _, heading := range []float64{0, 30, 135, 270} {
+		for _, tilt := range [][2]float64{{0, 0}, {20, 0}, {0, -25}, {15, 30}} {
+			// Yaw clockwise, then pitch, then roll.
+			r := mul(rot(2, -heading*math.Pi/180), mul(rot(1, tilt[0]*math.Pi/180), rot(0, tilt[1]*math.Pi/180)))
+			m := toBoard(r, world)
+			accel := toBoard(r, [3]float64{0, 0, 9.81})
+			s := Sample{Field: Field{
+				X: physic.MagneticFluxDensity(m[0] * float64(physic.MicroTesla)),
+				Y: physic.MagneticFluxDensity(m[1] * float64(physic.MicroTesla)),
+				Z: physic.MagneticFluxDensity(m[2] * float64(physic.MicroTesla)),
+			}}
+			got, err := HeadingTiltCompensated(s, accel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := wrapAngle(physic.Angle(heading * float64(physic.Degree)))
+			diff := wrapAngle(got-want+physic.Pi) - physic.Pi
+			if diff < -physic.Degree/100 || diff > physic.Degree/100 {
+				t.Errorf("heading %g tilt %v: got %s, want %s", heading, tilt, got, want)
+			}
+		}
+	}
+
+	if _, err := HeadingTiltCompensated(Sample{}, [3]float64{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := HeadingTiltCompensated(Sample{Field: Field{Z: physic.MicroTesla}}, [3]float64{0, 0, 1}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+// Last returns up to the n most recent samples read by SenseContinuous and
+// Stream, oldest first.
+//
+// Only the last Opts.HistorySize samples are kept; overflowed samples are not
+// recorded. It returns nil when the history is disabled or empty.
+func (d *Dev) Last(n int) []Sample {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.history.last(n)
+}
+
+// history is a fixed-size ring buffer of samples.
+type history struct {
+	s    []Sample
+	next int // index of the next sample to write
+	n    int // number of valid samples
+}
+
+func (h *history) add(s Sample) {
+	if len(h.s) == 0 {
+		return
+	}
+	h.s[h.next] = s
+	h.next = (h.next + 1) % len(h.s)
+	if h.n < len(h.s) {
+		h.n++
+	}
+}
+
+func (h *history) last(n int) []Sample {
+	if n > h.n {
+		n = h.n
+	}
+	if n <= 0 {
+		return nil
+	}
+	out := make([]Sample, n)
+	start := h.next - n
+	if start < 0 {
+		start += len(h.s)
+	}
+	for i := range out {
+		out[i] = h.s[(start+i)%len(h.s)]
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestHistory(t *testing.T) {
+	h := history{s: make([]Sample, 3)}
+	if got := h.last(2); got != nil {
+		t.Fatalf("last() = %v", got)
+	}
+	for i := int16(1); i <= 5; i++ {
+		h.add(Sample{Raw: [3]int16{i}})
+	}
+	data := []struct {
+		n    int
+		want []int16
+	}{
+		{-1, nil},
+		{0, nil},
+		{1, []int16{5}},
+		{2, []int16{4, 5}},
+		{10, []int16{3, 4, 5}},
+	}
+	for _, line := range data {
+		got := h.last(line.n)
+		if len(got) != len(line.want) {
+			t.Fatalf("last(%d) = %v", line.n, got)
+		}
+		for i := range got {
+			if got[i].Raw[0] != line.want[i] {
+				t.Fatalf("last(%d) = %v", line.n, got)
+			}
+		}
+	}
+
+	// Disabled.
+	h = history{}
+	h.add(Sample{})
+	if got := h.last(1); got != nil {
+		t.Fatalf("last() = %v", got)
+	}
+}
+
+func TestLast(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := initOps()
+	for i := byte(1); i <= 3; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, i, 0x00, 0x00, 0x00, 0x00}})
+	}
+	ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeIdle}})
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DRDY: drdy, HistorySize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		drdy.EdgesChan <- gpio.Low
+		<-c
+	}
+	got := d.Last(5)
+	if len(got) != 2 || got[0].Raw[0] != 2 || got[1].Raw[0] != 3 {
+		t.Fatalf("Last() = %v", got)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
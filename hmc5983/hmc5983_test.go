@@ -0,0 +1,790 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+// idOps are the bus transactions issued by New to check the chip identity.
+func idOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regIDA}, R: []byte("H43")},
+	}
+}
+
+// initOps are the bus transactions issued by New with zero Opts, for an
+// HMC5983.
+func initOps() []i2ctest.IO {
+	return append(probeOps(true), configOps()...)
+}
+
+// configOps are the register writes issued by New with zero Opts, after
+// the identity check and detection: CRA, CRB and MODE.
+func configOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regCRA, 0x10, 0x00, 0x00}},
+	}
+}
+
+// probeOps are the bus transactions issued by New to check the chip identity
+// and tell an HMC5983 from an HMC5883L.
+func probeOps(is5983 bool) []i2ctest.IO {
+	return append(idOps(), detectOps(is5983)...)
+}
+
+// detectOps are the bus transactions issued by New to tell an HMC5983 from an
+// HMC5883L.
+func detectOps(is5983 bool) []i2ctest.IO {
+	cra := byte(0x10)
+	if is5983 {
+		cra |= 0x80
+	}
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regCRA, 0x90}},
+		{Addr: DefaultAddr, W: []byte{regCRA}, R: []byte{cra}},
+	}
+}
+
+func TestNew_I2C(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x01, 0x11, 0xFF, 0x00, 0x00, 0x89}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, y, z, err := d.SenseRaw()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != 0x0111 || y != 0x0089 || z != -256 {
+		t.Fatalf("SenseRaw() = %d, %d, %d", x, y, z)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regIDA}, R: []byte{0xFF, 0xFF, 0xFF}},
+		// Not a QMC5883L either.
+		{Addr: qmcAddr, W: []byte{qmcRegID}, R: []byte{0x00}},
+	}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	bus = &i2ctest.Playback{Ops: append(detectOps(true), configOps()...)}
+	if _, err := New(bus, Opts{SkipIDCheck: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_QMC5883L(t *testing.T) {
+	// Nothing answers at 0x1e.
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: qmcAddr, W: []byte{qmcRegID}, R: []byte{qmcChipID}},
+	}, DontPanic: true}
+	_, err := New(bus, Opts{})
+	if !errors.Is(err, ErrWrongChipQMC5883L) || err.Error() != "hmc5983: wrong chip, found a QMC5883L at 0xd; it has a different register map" {
+		t.Fatalf("New() = %v, want ErrWrongChipQMC5883L", err)
+	}
+}
+
+func TestVerifyWrites(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA}, R: []byte{0x10, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x20}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB}, R: []byte{0x20}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x40}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB}, R: []byte{0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{VerifyWrites: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetGain(1); err != nil {
+		t.Fatal(err)
+	}
+	err = d.SetGain(2)
+	if !errors.Is(err, ErrReadback) || err.Error() != "hmc5983: register readback mismatch: register 0x01 reads 0x00 after writing 0x40" {
+		t.Fatalf("SetGain() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	bus = &i2ctest.Playback{Ops: append(initOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA}, R: []byte{0x00, 0x00}})}
+	if _, err := New(bus, Opts{VerifyWrites: true}); !errors.Is(err, ErrReadback) {
+		t.Fatalf("New() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{GainCode: 7, ODRHz: 220, AvgSamples: 8, Mode: "single"}, ""},
+		{Opts{GainCode: 8}, "hmc5983: invalid options: GainCode 8, want 0 to 7"},
+		{Opts{ODRHz: 10}, "hmc5983: invalid options: ODRHz 10, want 1 (1.5Hz), 3, 7 (7.5Hz), 15, 30, 75 or 220"},
+		{Opts{AvgSamples: 3}, "hmc5983: invalid options: AvgSamples 3, want 1, 2, 4 or 8"},
+		{Opts{Mode: "idle"}, `hmc5983: invalid options: Mode "idle", want "continuous" or "single"`},
+		{Opts{Bias: 3}, "hmc5983: invalid options: Bias Bias(3), want Normal, PositiveBias or NegativeBias"},
+		{Opts{LowPassCutoffHz: -1}, "hmc5983: invalid options: LowPassCutoffHz -1 is negative"},
+		{Opts{HistorySize: -1}, "hmc5983: invalid options: HistorySize -1 is negative"},
+		{Opts{StuckLimit: 1}, "hmc5983: invalid options: StuckLimit 1, want 0 or at least 2"},
+		{Opts{Oversample: 33}, "hmc5983: invalid options: Oversample 33, want 0 to 32"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+
+	if _, err := New(&i2ctest.Playback{}, Opts{Strict: true, ODRHz: 10}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+	// Without Strict, the default is used.
+	bus := &i2ctest.Playback{Ops: initOps()}
+	if _, err := New(bus, Opts{ODRHz: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	port := spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				{W: []byte{regIDA | spiRead | spiAutoInc, 0, 0, 0}, R: []byte{0, 'H', '4', '3'}},
+				// Multi-byte write sets the auto-increment bit.
+				{W: []byte{regCRA | spiAutoInc, 0x10, 0x00, 0x00}},
+				// Multi-byte read sets both the read and auto-increment bits.
+				{
+					W: []byte{regDATA | spiRead | spiAutoInc, 0, 0, 0, 0, 0, 0},
+					R: []byte{0x00, 0x01, 0x11, 0xFF, 0x00, 0x00, 0x89},
+				},
+				// Single byte read only sets the read bit.
+				{
+					W: []byte{regSTATUS | spiRead, 0},
+					R: []byte{0x00, 0x01},
+				},
+			},
+		},
+	}
+	d, err := NewSPI(&port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, y, z, err := d.SenseRaw()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != 0x0111 || y != 0x0089 || z != -256 {
+		t.Fatalf("SenseRaw() = %d, %d, %d", x, y, z)
+	}
+	s, err := d.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != 0x01 {
+		t.Fatalf("Status() = %#x", s)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	ops := append(probeOps(true), configOps()...)
+	ops[3].W[1] |= 0x80
+	ops = append(ops,
+		// 0x0C80 = 3200 -> 3200/128 + 25 = 50°C
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regTEMP}, R: []byte{0x0C, 0x80}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{TempSensor: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := d.Temperature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := physic.ZeroCelsius + 50*physic.Kelvin; temp != want {
+		t.Fatalf("Temperature() = %s, want %s", temp, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemperature_Disabled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Temperature(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTempCompensation_HMC5883L(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: probeOps(false)}
+	if _, err := New(bus, Opts{TempCompensation: true}); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestODR220(t *testing.T) {
+	ops := append(probeOps(true), configOps()...)
+	ops[3].W[1] = 0x1C
+	bus := &i2ctest.Playback{Ops: ops}
+	if _, err := New(bus, Opts{ODRHz: 220}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	bus = &i2ctest.Playback{Ops: probeOps(false)}
+	if _, err := New(bus, Opts{ODRHz: 220}); err == nil {
+		t.Fatal("expected error on HMC5883L")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// speedBus records the speed requested from the bus.
+type speedBus struct {
+	*i2ctest.Playback
+	speed physic.Frequency
+	err   error
+}
+
+func (b *speedBus) SetSpeed(f physic.Frequency) error {
+	b.speed = f
+	return b.err
+}
+
+func TestHighSpeedI2C(t *testing.T) {
+	ops := initOps()
+	ops[3].W[3] = 0x80
+	ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, 0x81}})
+	bus := &speedBus{Playback: &i2ctest.Playback{Ops: ops}}
+	d, err := New(bus, Opts{HighSpeedI2C: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bus.speed != MaxI2CFrequency {
+		t.Fatalf("speed = %s", bus.speed)
+	}
+	// The HS bit is kept when changing the mode.
+	if err := d.TriggerSingle(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ops = initOps()
+	ops[3].W[3] = 0x80
+	bus = &speedBus{Playback: &i2ctest.Playback{Ops: ops}, err: errors.New("unsupported")}
+	if _, err := New(bus, Opts{HighSpeedI2C: true}); err == nil || err.Error() != "hmc5983: setting high-speed I2C: unsupported" {
+		t.Fatalf("New() = %v", err)
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	data := func(x, y, z int16) []byte {
+		return []byte{byte(x >> 8), byte(x), byte(z >> 8), byte(z), byte(y >> 8), byte(y)}
+	}
+	ops := append(probeOps(true),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA, 0x70, 0xA0, 0x01}},
+		// Positive bias.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA, 0x71}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(0, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(400, 500, 100)},
+		// Negative bias.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA, 0x72}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(0, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(-400, -500, -300)},
+		// Restore.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA, 0x70}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, 0x01}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 15, AvgSamples: 8, GainCode: 5, Mode: "single"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := d.SelfTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Low != 243 || r.High != 575 {
+		t.Fatalf("limits = %d..%d", r.Low, r.High)
+	}
+	if want := [3]bool{true, true, false}; r.Pass != want {
+		t.Fatalf("Pass = %v, want %v", r.Pass, want)
+	}
+	if r.Passed() {
+		t.Fatal("expected failure")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+func TestSenseOnInterrupt(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level, 1)}
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0xFA, 0xA4}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.SenseOnInterrupt(time.Millisecond); !errors.Is(err, ErrNotReady) {
+		t.Fatalf("SenseOnInterrupt() = %v, want ErrNotReady", err)
+	}
+	drdy.EdgesChan <- gpio.Low
+	x, y, z, err := d.SenseOnInterrupt(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ±1372 counts at 1370 LSB/Gauss.
+	if x != 1001 || y != -1001 || z != 0 {
+		t.Fatalf("SenseOnInterrupt() = %d, %d, %d", x, y, z)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseSingle(t *testing.T) {
+	ops := initOps()
+	ops[3].W[3] = modeSingle
+	ops = append(ops,
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeSingle}},
+		// The data and STATUS are read together until RDY is set.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0xFA, 0xA4, statusRDY}},
+		// Cancelled before completion.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeSingle}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{Mode: "single"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, y, z, err := d.SenseSingle(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != 1001 || y != -1001 || z != 0 {
+		t.Fatalf("SenseSingle() = %d, %d, %d", x, y, z)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, _, err := d.SenseSingle(ctx); err != context.Canceled {
+		t.Fatalf("SenseSingle() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeIdle}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "HMC5983{playback(30), ±0.88Ga, 15Hz, 1 sample, continuous}" {
+		t.Fatalf("String() = %q", s)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "HMC5983{playback(30), ±0.88Ga, 15Hz, 1 sample, idle}" {
+		t.Fatalf("String() = %q", s)
+	}
+	if _, _, _, err := d.Sense(); err != ErrHalted {
+		t.Fatalf("Sense() = %v", err)
+	}
+	if err := d.TriggerSingle(); err != ErrHalted {
+		t.Fatalf("TriggerSingle() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_Overflow(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0xF0, 0x00, 0x00, 0x10, 0xF0, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, z, err := d.Sense()
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Sense() = %v", err)
+	}
+	var oe *OverflowError
+	if !errors.As(err, &oe) || !oe.X || !oe.Y || oe.Z {
+		t.Fatalf("Sense() = %#v", err)
+	}
+	if s := err.Error(); s != "hmc5983: measurement overflow on X,Y" {
+		t.Fatalf("Error() = %q", s)
+	}
+	if z != 12 {
+		t.Fatalf("z = %d", z)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForData(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{0x00}},
+		// A partial read left the registers locked.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{statusLOCK | statusRDY}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: make([]byte, 6)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{statusRDY}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WaitForData(context.Background(), time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForData_Timeout(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level, 1)}
+	bus := &i2ctest.Playback{Ops: initOps()}
+	d, err := New(bus, Opts{DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WaitForData(context.Background(), 5*time.Millisecond); err != context.DeadlineExceeded {
+		t.Fatalf("WaitForData() = %v", err)
+	}
+	drdy.EdgesChan <- gpio.Low
+	if err := d.WaitForData(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_AutoRange(t *testing.T) {
+	data := func(x, y, z int16) []byte {
+		return []byte{byte(x >> 8), byte(x), byte(z >> 8), byte(z), byte(y >> 8), byte(y)}
+	}
+	ops := append(initOps(),
+		// Saturated: decrease sensitivity, discard one sample then measure again.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(-4096, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x20}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(-4096, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(1500, 0, 0)},
+		// Small reading: increase sensitivity for the next call.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(100, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x00}},
+		// The conversion using the previous gain is discarded.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(100, 0, 0)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(137, 0, 0)},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{AutoRange: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, _, _, err := d.Sense()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != 1376 || d.GainCode() != 1 {
+		t.Fatalf("x = %d, gain = %d", x, d.GainCode())
+	}
+	if x, _, _, err = d.Sense(); err != nil {
+		t.Fatal(err)
+	}
+	if x != 91 || d.GainCode() != 0 {
+		t.Fatalf("x = %d, gain = %d", x, d.GainCode())
+	}
+	if x, _, _, err = d.Sense(); err != nil {
+		t.Fatal(err)
+	}
+	if x != 100 {
+		t.Fatalf("x = %d", x)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseField(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x10, 0xFA, 0xA4}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Field
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	// 1372/1370 Gauss, 16/1330 Gauss.
+	want := Field{X: 100146 * physic.NanoTesla, Y: -100146 * physic.NanoTesla, Z: 1203 * physic.NanoTesla}
+	if f != want {
+		t.Fatalf("SenseField() = %+v, want %+v", f, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMicroTesla(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x10, 0xFA, 0xA4}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, y, z, err := d.SenseMicroTesla()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range [][2]float64{{x, 137200. / 1370}, {y, -137200. / 1370}, {z, 1600. / 1330}} {
+		if math.Abs(v[0]-v[1]) > 1e-9 {
+			t.Errorf("axis %d = %g, want %g", i, v[0], v[1])
+		}
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps(), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetGain(8); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("SetGain(8) = %v, want ErrInvalidOpts", err)
+	}
+	if _, err := d.Temperature(); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("Temperature() = %v, want ErrInvalidOpts", err)
+	}
+	if _, _, _, err := d.SenseOnInterrupt(time.Second); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("SenseOnInterrupt() = %v, want ErrInvalidOpts", err)
+	}
+	// The playback is exhausted: a bus fault is none of the sentinels.
+	_, _, _, err = d.Sense()
+	if err == nil {
+		t.Fatal("expected bus error")
+	}
+	for _, e := range []error{ErrBadID, ErrNotReady, ErrOverflow, ErrHalted, ErrInvalidOpts} {
+		if errors.Is(err, e) {
+			t.Fatalf("Sense() = %v, matches %v", err, e)
+		}
+	}
+	if errors.Unwrap(err) == nil {
+		t.Fatalf("Sense() = %v, want a wrapped bus error", err)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	const n = 8
+	ops := initOps()
+	for range n {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0xFA, 0xA4}})
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, _, _, err := d.Sense(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			d.SetCalibration([3]float64{}, [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}})
+			d.SetDeclination(physic.Degree)
+		}()
+	}
+	wg.Wait()
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReinitialize(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRB, 0x40}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA, 0x10, 0x40, 0x00}},
+		// The first conversion is discarded.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x03, 0x34, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeIdle}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetGain(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Reinitialize(); err != nil {
+		t.Fatal(err)
+	}
+	// 820 counts at 820 LSB/Gauss.
+	if x, _, _, err := d.Sense(); err != nil || x != 1000 {
+		t.Fatalf("Sense() = %d, %v", x, err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Reinitialize(); err != ErrHalted {
+		t.Fatalf("Reinitialize() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// flakyConn fails the next fail transactions.
+type flakyConn struct {
+	conn.Conn
+	fail int
+}
+
+func (f *flakyConn) Tx(w, r []byte) error {
+	if f.fail > 0 {
+		f.fail--
+		return errors.New("nak")
+	}
+	return f.Conn.Tx(w, r)
+}
+
+func TestRetry(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{statusRDY}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	c := &flakyConn{Conn: &i2c.Dev{Addr: DefaultAddr, Bus: bus}, fail: 2}
+	d, err := newDev(context.Background(), c, false, Opts{Retry: Retry{Attempts: 3, Backoff: time.Millisecond}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.fail = 2
+	if s, err := d.Status(); err != nil || s != statusRDY {
+		t.Fatalf("Status() = %d, %v", s, err)
+	}
+	c.fail = 3
+	_, err = d.Status()
+	if !errors.Is(err, ErrRetriesExhausted) || err.Error() != "hmc5983: reading register 0x09: hmc5983: retries exhausted after 3 attempts: nak" {
+		t.Fatalf("Status() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bus := &i2ctest.Playback{}
+	if _, err := NewContext(ctx, bus, Opts{}); err != context.Canceled {
+		t.Fatalf("NewContext() = %v", err)
+	}
+
+	bus = &i2ctest.Playback{Ops: initOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.SenseContext(ctx); err != context.Canceled {
+		t.Fatalf("SenseContext() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+// Package hmc5983test provides a simulated HMC5983 to test applications
+// using the hmc5983 driver without hardware.
+package hmc5983test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/hmc5983"
+)
+
+// Register addresses.
+const (
+	regCRA    = 0x00
+	regCRB    = 0x01
+	regMODE   = 0x02
+	regDATA   = 0x03
+	regSTATUS = 0x09
+	regTEMP   = 0x31
+)
+
+// ErrNAK is returned by Tx when Dev.NAK is set or the address doesn't match.
+var ErrNAK = errors.New("hmc5983test: NAK")
+
+// Dev simulates an HMC5983, or an HMC5883L, on an I2C bus.
+//
+// It models the register map, the address pointer auto-increment, the
+// conversion timing in continuous and single measurement modes, the data
+// output register lock, the X,Z,Y data order, the gains, saturation and the
+// self test bias field. Averaging is not modeled.
+//
+// Modify its exported members to simulate conditions; grab the Mutex first
+// when the driver is in use concurrently.
+type Dev struct {
+	sync.Mutex
+	// Addr is the I2C address, hmc5983.DefaultAddr when 0.
+	Addr uint16
+	// HMC5883L simulates an HMC5883L instead of an HMC5983: there is no
+	// temperature sensor and CRA bit 7 reads 0.
+	HMC5883L bool
+	// Field is the simulated field, in X,Y,Z order.
+	Field [3]physic.MagneticFluxDensity
+	// Temperature is the simulated temperature, on the HMC5983.
+	Temperature physic.Temperature
+	// Overflow makes the next conversions saturate on all axes.
+	Overflow bool
+	// NAK makes every transaction fail with ErrNAK.
+	NAK bool
+	// Stuck freezes the values of the data output registers while still
+	// signaling new conversions, as seen with locked up chips.
+	Stuck bool
+	// Now returns the current time, for the conversion timing. time.Now is
+	// used when nil.
+	Now func() time.Time
+
+	init     bool
+	regs     [13]byte
+	ptr      byte
+	locked   bool
+	lastConv time.Time // last conversion in continuous mode
+	single   time.Time // start of the pending single conversion
+}
+
+// String implements i2c.Bus.
+func (d *Dev) String() string {
+	return "hmc5983test"
+}
+
+// SetSpeed implements i2c.Bus.
+func (d *Dev) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// Tx implements i2c.Bus.
+func (d *Dev) Tx(addr uint16, w, r []byte) error {
+	d.Lock()
+	defer d.Unlock()
+	want := d.Addr
+	if want == 0 {
+		want = hmc5983.DefaultAddr
+	}
+	if d.NAK || addr != want {
+		return ErrNAK
+	}
+	if !d.init {
+		d.reset()
+	}
+	now := d.now()
+	d.convert(now)
+	if len(w) != 0 {
+		d.ptr = w[0]
+		for _, b := range w[1:] {
+			if err := d.write(b, now); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range r {
+		r[i] = d.read()
+	}
+	return nil
+}
+
+// reset sets the power-on register values.
+func (d *Dev) reset() {
+	d.init = true
+	d.regs = [13]byte{0x10, 0x20, 0x03, 0, 0, 0, 0, 0, 0, 0, 'H', '4', '3'}
+	if d.Temperature == 0 {
+		d.Temperature = physic.ZeroCelsius + 25*physic.Kelvin
+	}
+}
+
+func (d *Dev) now() time.Time {
+	if d.Now != nil {
+		return d.Now()
+	}
+	return time.Now()
+}
+
+// write writes b at the address pointer and increments it.
+func (d *Dev) write(b byte, now time.Time) error {
+	switch d.ptr {
+	case regCRA:
+		if d.HMC5883L {
+			b &^= 0x80
+		}
+		d.regs[regCRA] = b
+	case regCRB:
+		d.regs[regCRB] = b & 0xE0
+	case regMODE:
+		d.regs[regMODE] = b & 0x83
+		d.locked = false
+		switch b & 0x03 {
+		case 0x00:
+			d.lastConv = now
+		case 0x01:
+			d.single = now
+			// Cleared while the conversion runs.
+			d.regs[regSTATUS] &^= 0x01
+		}
+	default:
+		return fmt.Errorf("hmc5983test: register 0x%02x is read only", d.ptr)
+	}
+	d.ptr++
+	return nil
+}
+
+// read returns the register at the address pointer and increments it.
+func (d *Dev) read() byte {
+	var b byte
+	switch {
+	case d.ptr < byte(len(d.regs)):
+		b = d.regs[d.ptr]
+		if d.ptr >= regDATA && d.ptr < regSTATUS {
+			// The registers stay locked until the last one is read. RDY stays
+			// set until the next conversion is written.
+			d.locked = d.ptr != regSTATUS-1
+		}
+		if d.ptr == regSTATUS {
+			b &^= 0x02
+			if d.locked {
+				b |= 0x02
+			}
+		}
+	case d.ptr == regTEMP || d.ptr == regTEMP+1:
+		if d.HMC5883L {
+			break
+		}
+		raw := int16((d.Temperature - physic.ZeroCelsius - 25*physic.Kelvin) * 128 / physic.Kelvin)
+		b = byte(raw >> 8)
+		if d.ptr == regTEMP+1 {
+			b = byte(raw)
+		}
+	}
+	d.ptr++
+	if d.ptr == byte(len(d.regs)) {
+		d.ptr = 0
+	}
+	return b
+}
+
+// singleTime is the duration of a single conversion.
+const singleTime = 6 * time.Millisecond
+
+// odrPeriods are the conversion periods by CRA bits 4..2.
+var odrPeriods = []time.Duration{
+	1333 * time.Millisecond,
+	667 * time.Millisecond,
+	333 * time.Millisecond,
+	133 * time.Millisecond,
+	67 * time.Millisecond,
+	33 * time.Millisecond,
+	13333 * time.Microsecond,
+	4545 * time.Microsecond,
+}
+
+// convert completes the conversions due at now.
+func (d *Dev) convert(now time.Time) {
+	switch d.regs[regMODE] & 0x03 {
+	case 0x00:
+		period := odrPeriods[(d.regs[regCRA]>>2)&0x07]
+		if now.Sub(d.lastConv) >= period {
+			d.lastConv = now
+			d.latch()
+		}
+	case 0x01:
+		if now.Sub(d.single) >= singleTime {
+			d.regs[regMODE] = d.regs[regMODE]&^0x03 | 0x03
+			d.latch()
+		}
+	}
+}
+
+// latch places a new conversion in the data output registers.
+func (d *Dev) latch() {
+	if d.Stuck {
+		// New data is signaled, but the values don't change.
+		d.regs[regSTATUS] |= 0x01
+		return
+	}
+	if d.locked {
+		return
+	}
+	code := int(d.regs[regCRB] >> 5)
+	g := hmc5983.Gains()[code]
+	// Self test bias field, in Gauss.
+	var bias [3]float64
+	switch d.regs[regCRA] & 0x03 {
+	case 0x01:
+		bias = [3]float64{1.16, 1.16, 1.08}
+	case 0x02:
+		bias = [3]float64{-1.16, -1.16, -1.08}
+	}
+	var c [3]int16
+	for i, f := range d.Field {
+		lsb := g.LSBPerGaussXY
+		if i == 2 {
+			lsb = g.LSBPerGaussZ
+		}
+		v := (float64(f)/float64(100*physic.MicroTesla) + bias[i]) * float64(lsb)
+		if d.Overflow || v < -2048 || v > 2047 {
+			c[i] = -4096
+		} else {
+			c[i] = int16(v)
+		}
+	}
+	// X, Z, Y order.
+	for i, v := range []int16{c[0], c[2], c[1]} {
+		d.regs[regDATA+2*i] = byte(uint16(v) >> 8)
+		d.regs[regDATA+2*i+1] = byte(v)
+	}
+	d.regs[regSTATUS] |= 0x01
+}
+
+var _ i2c.Bus = &Dev{}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/hmc5983"
+)
+
+func TestDev(t *testing.T) {
+	now := time.Now()
+	sim := &Dev{Now: func() time.Time { return now }}
+	d, err := hmc5983.New(sim, hmc5983.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Variant(); v != hmc5983.HMC5983 {
+		t.Fatalf("Variant() = %s", v)
+	}
+	sim.Field = [3]physic.MagneticFluxDensity{20 * physic.MicroTesla, -10 * physic.MicroTesla, 40 * physic.MicroTesla}
+	now = now.Add(100 * time.Millisecond)
+	var f hmc5983.Field
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := (hmc5983.Field{X: 20 * physic.MicroTesla, Y: -10 * physic.MicroTesla, Z: 40 * physic.MicroTesla}); f != want {
+		t.Fatalf("SenseField() = %s, want %s", f, want)
+	}
+
+	// The data isn't updated before the next conversion.
+	sim.Field[0] = 0
+	if err := d.SenseField(&f); err != nil || f.X != 20*physic.MicroTesla {
+		t.Fatalf("SenseField() = %s, %v", f, err)
+	}
+	now = now.Add(100 * time.Millisecond)
+	if err := d.SenseField(&f); err != nil || f.X != 0 {
+		t.Fatalf("SenseField() = %s, %v", f, err)
+	}
+
+	sim.Stuck = true
+	sim.Field[0] = 30 * physic.MicroTesla
+	now = now.Add(100 * time.Millisecond)
+	if err := d.SenseField(&f); err != nil || f.X != 0 {
+		t.Fatalf("stuck SenseField() = %s, %v", f, err)
+	}
+	sim.Stuck = false
+
+	sim.Overflow = true
+	now = now.Add(100 * time.Millisecond)
+	if err := d.SenseField(&f); !errors.Is(err, hmc5983.ErrOverflow) {
+		t.Fatalf("SenseField() = %v, want ErrOverflow", err)
+	}
+	sim.Overflow = false
+
+	sim.NAK = true
+	if err := d.SenseField(&f); !errors.Is(err, ErrNAK) {
+		t.Fatalf("SenseField() = %v, want ErrNAK", err)
+	}
+	sim.NAK = false
+}
+
+func TestDev_Temperature(t *testing.T) {
+	sim := &Dev{Temperature: physic.ZeroCelsius + 40*physic.Kelvin}
+	d, err := hmc5983.New(sim, hmc5983.Opts{TempSensor: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if temp, err := d.Temperature(); err != nil || temp != sim.Temperature {
+		t.Fatalf("Temperature() = %s, %v", temp, err)
+	}
+
+	sim = &Dev{HMC5883L: true}
+	if _, err := hmc5983.New(sim, hmc5983.Opts{TempSensor: true}); !errors.Is(err, hmc5983.ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+	d, err = hmc5983.New(sim, hmc5983.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Variant(); v != hmc5983.HMC5883L {
+		t.Fatalf("Variant() = %s", v)
+	}
+}
+
+func TestDev_Single(t *testing.T) {
+	sim := &Dev{Field: [3]physic.MagneticFluxDensity{50 * physic.MicroTesla}}
+	d, err := hmc5983.New(sim, hmc5983.Opts{Mode: "single", VerifyWrites: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// 685 counts at 1370 LSB/Gauss.
+	if x, _, _, err := d.SenseSingle(ctx); err != nil || x != 500 {
+		t.Fatalf("SenseSingle() = %d, %v", x, err)
+	}
+}
+
+func TestDev_SelfTest(t *testing.T) {
+	d, err := hmc5983.New(&Dev{}, hmc5983.Opts{GainCode: 5, ODRHz: 75})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := d.SelfTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Passed() {
+		t.Fatalf("SelfTest() = %+v", r)
+	}
+	// The simulated sensitivity is the typical one.
+	trim, err := d.TrimFromSelfTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range trim {
+		if math.Abs(v-1) > 0.01 {
+			t.Fatalf("TrimFromSelfTest()[%d] = %g", i, v)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Transaction is one recorded I2C transaction.
+type Transaction struct {
+	Time time.Time `json:"time"`
+	Addr uint16    `json:"addr"`
+	W    hexBytes  `json:"w,omitempty"`
+	R    hexBytes  `json:"r,omitempty"`
+	// Err is the error message returned by the bus, if any.
+	Err string `json:"err,omitempty"`
+}
+
+// hexBytes is encoded as a hex string in JSON, to keep recordings readable.
+type hexBytes []byte
+
+func (h hexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(h)), nil
+}
+
+func (h *hexBytes) UnmarshalText(b []byte) error {
+	var err error
+	*h, err = hex.DecodeString(string(b))
+	return err
+}
+
+// Record wraps an i2c.Bus and writes every transaction to W, one JSON
+// object per line, so a run on real hardware can be replayed with NewReplay.
+type Record struct {
+	Bus i2c.Bus
+	W   io.Writer
+
+	mu  sync.Mutex
+	err error
+}
+
+// String implements i2c.Bus.
+func (r *Record) String() string {
+	return r.Bus.String()
+}
+
+// SetSpeed implements i2c.Bus.
+func (r *Record) SetSpeed(f physic.Frequency) error {
+	return r.Bus.SetSpeed(f)
+}
+
+// Tx implements i2c.Bus.
+//
+// Failing to write the recording doesn't fail the transaction; it is
+// reported by Err.
+func (r *Record) Tx(addr uint16, w, read []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err := r.Bus.Tx(addr, w, read)
+	t := Transaction{Time: time.Now(), Addr: addr, W: bytes.Clone(w), R: bytes.Clone(read)}
+	if err != nil {
+		t.Err = err.Error()
+	}
+	b, err2 := json.Marshal(&t)
+	if err2 == nil {
+		_, err2 = r.W.Write(append(b, '\n'))
+	}
+	if r.err == nil {
+		r.err = err2
+	}
+	return err
+}
+
+// Err returns the first error encountered while writing the recording.
+func (r *Record) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Replay is an i2c.Bus replaying a recording made with Record.
+//
+// Each transaction must write the same bytes, to the same address, as
+// recorded. The recorded reads and errors are returned.
+type Replay struct {
+	mu  sync.Mutex
+	ops []Transaction
+	i   int
+}
+
+// NewReplay reads a recording made with Record.
+func NewReplay(r io.Reader) (*Replay, error) {
+	p := &Replay{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var t Transaction
+		if err := json.Unmarshal(s.Bytes(), &t); err != nil {
+			return nil, fmt.Errorf("hmc5983test: transaction %d: %w", len(p.ops)+1, err)
+		}
+		p.ops = append(p.ops, t)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("hmc5983test: reading recording: %w", err)
+	}
+	return p, nil
+}
+
+// Transactions returns the recorded transactions.
+func (p *Replay) Transactions() []Transaction {
+	return p.ops
+}
+
+// String implements i2c.Bus.
+func (p *Replay) String() string {
+	return "replay"
+}
+
+// SetSpeed implements i2c.Bus.
+func (p *Replay) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// Tx implements i2c.Bus.
+func (p *Replay) Tx(addr uint16, w, r []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.i >= len(p.ops) {
+		return fmt.Errorf("hmc5983test: unexpected Tx(0x%02x, %x) after the recording", addr, w)
+	}
+	t := &p.ops[p.i]
+	if addr != t.Addr || !bytes.Equal(w, t.W) || len(r) != len(t.R) {
+		return fmt.Errorf("hmc5983test: transaction %d: Tx(0x%02x, %x, %d bytes), recorded Tx(0x%02x, %x, %d bytes)", p.i+1, addr, w, len(r), t.Addr, []byte(t.W), len(t.R))
+	}
+	p.i++
+	copy(r, t.R)
+	if t.Err != "" {
+		return errors.New(t.Err)
+	}
+	return nil
+}
+
+// Close returns an error if transactions of the recording were not
+// replayed.
+func (p *Replay) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := len(p.ops) - p.i; n != 0 {
+		return fmt.Errorf("hmc5983test: %d transactions not replayed", n)
+	}
+	return nil
+}
+
+var _ i2c.Bus = &Record{}
+var _ i2c.Bus = &Replay{}
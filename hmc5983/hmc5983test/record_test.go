@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/hmc5983"
+)
+
+func TestRecordReplay(t *testing.T) {
+	sim := &Dev{Field: [3]physic.MagneticFluxDensity{20 * physic.MicroTesla, -10 * physic.MicroTesla, 40 * physic.MicroTesla}}
+	var buf bytes.Buffer
+	rec := &Record{Bus: sim, W: &buf}
+	d, err := hmc5983.New(rec, hmc5983.Opts{ODRHz: 75})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := d.DumpRegisters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim.NAK = true
+	if _, err := d.Status(); err == nil {
+		t.Fatal("expected NAK")
+	}
+	if err := rec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"w":"0a","r":"483433"`) {
+		t.Fatalf("recording = %s", buf.String())
+	}
+
+	p, err := NewReplay(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err = hmc5983.New(p, hmc5983.Opts{ODRHz: 75}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.DumpRegisters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("DumpRegisters() = %s, want %s", &got, &want)
+	}
+	if _, err := d.Status(); err == nil || !strings.Contains(err.Error(), ErrNAK.Error()) {
+		t.Fatalf("Status() = %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Status(); err == nil {
+		t.Fatal("expected error after the recording")
+	}
+}
+
+func TestReplay_Mismatch(t *testing.T) {
+	p, err := NewReplay(strings.NewReader(`{"time":"2026-01-01T00:00:00Z","addr":30,"w":"0b","r":"000000"}` + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hmc5983.New(p, hmc5983.Opts{}); err == nil || errors.Is(err, hmc5983.ErrBadID) {
+		t.Fatalf("New() = %v, want a mismatch", err)
+	}
+	if err := p.Close(); err == nil {
+		t.Fatal("expected unreplayed transaction")
+	}
+	if _, err := NewReplay(strings.NewReader("{")); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"time"
+)
+
+// SenseNext waits for the next conversion and returns it, so successive calls
+// return unique samples paced by the output data rate.
+//
+// In continuous mode it waits for the DRDY edge when Opts.DRDY is set, and
+// ignores edges less than half a period after the previous read, as they are
+// stale. Otherwise it waits one period after the previous read, which
+// ensures a new conversion, and polls the RDY status bit. In
+// single-measurement mode it triggers a new conversion, like SenseSingle.
+func (d *Dev) SenseNext(ctx context.Context) (Sample, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.senseNext(ctx, &s)
+	return s, err
+}
+
+func (d *Dev) senseNext(ctx context.Context, s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	if err := d.waitConversion(ctx); err != nil {
+		return err
+	}
+	return d.senseSample(ctx, s)
+}
+
+// waitNext waits for a conversion completed after the last read of the data
+// registers.
+func (d *Dev) waitNext(ctx context.Context) error {
+	period := odrPeriods[(d.cra>>2)&0b111]
+	if d.drdy == nil {
+		// RDY stays set until the next conversion is written, so wait for a
+		// full period after the previous read.
+		var delay time.Duration
+		if !d.readAt.IsZero() {
+			delay = period - time.Since(d.readAt)
+		}
+		return d.readReady(ctx, delay)
+	}
+	minAge := period / 2
+	for {
+		if err := d.waitReady(ctx, 0); err != nil {
+			return err
+		}
+		if d.readAt.IsZero() || time.Since(d.readAt) >= minAge {
+			return nil
+		}
+		// The edge was queued before the previous read.
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// odr220Ops initializes a device at 220Hz and then returns the samples with X
+// = 1..n, followed by STATUS reporting RDY when polled is set.
+func odr220Ops(n int, polled bool) []i2ctest.IO {
+	ops := initOps()
+	ops[3].W[1] = 0x1C
+	for i := byte(1); i <= byte(n); i++ {
+		r := []byte{0x00, i, 0x00, 0x00, 0x00, 0x00}
+		if polled {
+			r = append(r, statusRDY)
+		}
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: r})
+	}
+	return ops
+}
+
+func TestSenseNext(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: odr220Ops(2, true)}
+	d, err := New(bus, Opts{ODRHz: 220})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1, err := d.SenseNext(context.Background())
+	if err != nil || s1.Raw[0] != 1 {
+		t.Fatalf("SenseNext() = %v, %v", s1, err)
+	}
+	s2, err := d.SenseNext(context.Background())
+	if err != nil || s2.Raw[0] != 2 {
+		t.Fatalf("SenseNext() = %v, %v", s2, err)
+	}
+	if dt := s2.Timestamp.Sub(s1.Timestamp); dt < odrPeriods[7] {
+		t.Fatalf("samples %s apart", dt)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseNext_DRDY(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	bus := &i2ctest.Playback{Ops: odr220Ops(2, false)}
+	d, err := New(bus, Opts{ODRHz: 220, DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		drdy.EdgesChan <- gpio.Low
+		// Accepted right after the first read, so it is ignored.
+		drdy.EdgesChan <- gpio.Low
+		time.Sleep(odrPeriods[7])
+		drdy.EdgesChan <- gpio.Low
+	}()
+	for i := int16(1); i <= 2; i++ {
+		s, err := d.SenseNext(context.Background())
+		if err != nil || s.Raw[0] != i {
+			t.Fatalf("SenseNext() = %v, %v", s, err)
+		}
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the stale edge was used")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseNext_Single(t *testing.T) {
+	ops := initOps()
+	ops[3].W[3] = modeSingle
+	ops = append(ops,
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeSingle}},
+		// Not ready yet.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, 0x07, 0x00, 0x00, 0x00, 0x00, statusRDY}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{Mode: "single"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := d.SenseNext(context.Background()); err != nil || s.Raw[0] != 7 {
+		t.Fatalf("SenseNext() = %v, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+	d.halted = true
+	if _, err := d.SenseNext(context.Background()); err != ErrHalted {
+		t.Fatalf("SenseNext() = %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Noise is the result of MeasureNoise, per axis in X, Y, Z order and in µT.
+type Noise struct {
+	Mean       [3]float64
+	StdDev     [3]float64
+	PeakToPeak [3]float64
+	// Samples is the number of samples used.
+	Samples int
+}
+
+// MeasureNoise collects n samples, at the output data rate, while the board
+// is kept still and returns their statistics.
+//
+// It is meant to validate the PCB layout and supply noise when commissioning.
+// The samples are scaled but neither corrected nor filtered. Overflowed
+// samples are skipped and not counted.
+func (d *Dev) MeasureNoise(ctx context.Context, n int) (*Noise, error) {
+	if n < 2 {
+		return nil, fmt.Errorf("%w: MeasureNoise needs at least 2 samples, got %d", ErrInvalidOpts, n)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// Welford's online algorithm.
+	r := &Noise{}
+	var m2, lo, hi [3]float64
+	for r.Samples < n {
+		g, err := d.nextUncorrected(ctx)
+		if errors.Is(err, ErrOverflow) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.Samples++
+		for i, v := range g {
+			v *= 100 // Gauss to µT.
+			delta := v - r.Mean[i]
+			r.Mean[i] += delta / float64(r.Samples)
+			m2[i] += delta * (v - r.Mean[i])
+			if r.Samples == 1 || v < lo[i] {
+				lo[i] = v
+			}
+			if r.Samples == 1 || v > hi[i] {
+				hi[i] = v
+			}
+		}
+	}
+	for i := range m2 {
+		r.StdDev[i] = math.Sqrt(m2[i] / float64(r.Samples-1))
+		r.PeakToPeak[i] = hi[i] - lo[i]
+	}
+	return r, nil
+}
+
+// nextUncorrected waits for the next conversion and returns it in Gauss,
+// without offset, calibration, rotation or filtering.
+func (d *Dev) nextUncorrected(ctx context.Context) ([3]float64, error) {
+	if d.mode == modeSingle {
+		if err := d.triggerSingle(); err != nil {
+			return [3]float64{}, err
+		}
+		if err := d.waitReady(ctx, singleMeasurementTime); err != nil {
+			return [3]float64{}, err
+		}
+	} else if err := sleepContext(ctx, odrPeriods[(d.cra>>2)&0b111]); err != nil {
+		return [3]float64{}, err
+	}
+	c, lsbXY, lsbZ, err := d.measure(ctx)
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return [3]float64{}, err
+	}
+	return countsToGauss(c, lsbXY, lsbZ), err
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestMeasureNoise(t *testing.T) {
+	data := func(x, y, z int16) []byte {
+		return []byte{byte(x >> 8), byte(x), byte(z >> 8), byte(z), byte(y >> 8), byte(y)}
+	}
+	ops := initOps()
+	for _, x := range []int16{1370, 1372, overflowCount, 1368, 1370} {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: data(x, 0, 1330)})
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.MeasureNoise(context.Background(), 1); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("MeasureNoise(1) = %v", err)
+	}
+	n, err := d.MeasureNoise(context.Background(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lsb := 100. / 1370 // µT
+	if n.Samples != 4 || math.Abs(n.Mean[0]-100) > 1e-9 || n.Mean[1] != 0 || math.Abs(n.Mean[2]-100) > 1e-9 {
+		t.Fatalf("MeasureNoise() = %+v", n)
+	}
+	if want := math.Sqrt(8 * lsb * lsb / 3); math.Abs(n.StdDev[0]-want) > 1e-9 || n.StdDev[1] != 0 {
+		t.Fatalf("StdDev = %v, want %g", n.StdDev, want)
+	}
+	if math.Abs(n.PeakToPeak[0]-4*lsb) > 1e-9 || n.PeakToPeak[2] != 0 {
+		t.Fatalf("PeakToPeak = %v", n.PeakToPeak)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
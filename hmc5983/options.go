@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"fmt"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+)
+
+// Gain is a gain code (CRB bits 7..5), named after its full-scale range.
+type Gain int
+
+// Gain codes.
+const (
+	Gain0_88Gauss Gain = 0
+	Gain1_3Gauss  Gain = 1
+	Gain1_9Gauss  Gain = 2
+	Gain2_5Gauss  Gain = 3
+	Gain4_0Gauss  Gain = 4
+	Gain4_7Gauss  Gain = 5
+	Gain5_6Gauss  Gain = 6
+	Gain8_1Gauss  Gain = 7
+)
+
+// ODR is an output data rate, with the values accepted by Opts.ODRHz.
+type ODR int
+
+// Output data rates.
+const (
+	ODR1_5Hz ODR = 1
+	ODR3Hz   ODR = 3
+	ODR7_5Hz ODR = 7
+	ODR15Hz  ODR = 15
+	ODR30Hz  ODR = 30
+	ODR75Hz  ODR = 75
+	ODR220Hz ODR = 220 // HMC5983 only
+)
+
+// Averaging is the number of samples averaged per measurement.
+type Averaging int
+
+// Averaging settings.
+const (
+	Average1 Averaging = 1
+	Average2 Averaging = 2
+	Average4 Averaging = 4
+	Average8 Averaging = 8
+)
+
+// Mode is a measurement mode, with the values accepted by Opts.Mode.
+type Mode string
+
+// Measurement modes.
+const (
+	Continuous Mode = "continuous"
+	Single     Mode = "single"
+)
+
+// Bias is a measurement bias configuration (CRA bits 1..0), which drives a
+// current through the offset strap to excite the sensors.
+type Bias byte
+
+// Measurement biases.
+const (
+	Normal       Bias = 0b00
+	PositiveBias Bias = 0b01 // adds about +1.1Ga on each axis
+	NegativeBias Bias = 0b10 // adds about -1.1Ga on each axis
+)
+
+func (b Bias) String() string {
+	switch b {
+	case Normal:
+		return "Normal"
+	case PositiveBias:
+		return "PositiveBias"
+	case NegativeBias:
+		return "NegativeBias"
+	default:
+		return fmt.Sprintf("Bias(%d)", byte(b))
+	}
+}
+
+// Option configures the device in NewWith.
+type Option func(*Opts)
+
+// WithAddr sets the I2C address.
+func WithAddr(addr uint16) Option {
+	return func(o *Opts) { o.Addr = addr }
+}
+
+// WithGain sets the gain.
+func WithGain(g Gain) Option {
+	return func(o *Opts) { o.GainCode = int(g) }
+}
+
+// WithODR sets the output data rate.
+func WithODR(r ODR) Option {
+	return func(o *Opts) { o.ODRHz = int(r) }
+}
+
+// WithAveraging sets the number of samples averaged per measurement.
+func WithAveraging(a Averaging) Option {
+	return func(o *Opts) { o.AvgSamples = int(a) }
+}
+
+// WithMode sets the measurement mode.
+func WithMode(m Mode) Option {
+	return func(o *Opts) { o.Mode = string(m) }
+}
+
+// WithDRDY sets the pin connected to the DRDY output.
+func WithDRDY(p gpio.PinIn) Option {
+	return func(o *Opts) { o.DRDY = p }
+}
+
+// WithTempCompensation enables the temperature sensor and the temperature
+// compensation of the sensitivity (HMC5983 only).
+func WithTempCompensation() Option {
+	return func(o *Opts) { o.TempCompensation = true }
+}
+
+// WithAutoRange enables auto ranging, see Opts.AutoRange.
+func WithAutoRange() Option {
+	return func(o *Opts) { o.AutoRange = true }
+}
+
+// WithBias sets the measurement bias.
+func WithBias(b Bias) Option {
+	return func(o *Opts) { o.Bias = b }
+}
+
+// NewWith initializes the device on an I2C bus, configured with functional
+// options instead of Opts:
+//
+//	d, err := hmc5983.NewWith(bus, hmc5983.WithODR(hmc5983.ODR75Hz), hmc5983.WithGain(hmc5983.Gain1_3Gauss))
+//
+// Unset options use the same defaults as the zero Opts. Values are validated
+// as with Opts.Strict.
+func NewWith(bus i2c.Bus, opts ...Option) (*Dev, error) {
+	o := Opts{Strict: true}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return New(bus, o)
+}
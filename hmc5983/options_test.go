@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestNewWith(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: 0x1F, W: []byte{regIDA}, R: []byte("H43")},
+		{Addr: 0x1F, W: []byte{regCRA, 0x90}},
+		{Addr: 0x1F, W: []byte{regCRA}, R: []byte{0x90}},
+		// 8 samples averaged, 75Hz, positive bias.
+		{Addr: 0x1F, W: []byte{regCRA, 0x79, 0x20, modeSingle}},
+	}}
+	d, err := NewWith(bus, WithAddr(0x1F), WithODR(ODR75Hz), WithGain(Gain1_3Gauss), WithAveraging(Average8), WithMode(Single), WithBias(PositiveBias))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := d.GainCode(); g != int(Gain1_3Gauss) {
+		t.Fatalf("GainCode() = %d", g)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewWith(&i2ctest.Playback{}, WithGain(Gain(8))); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("NewWith() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestBias_String(t *testing.T) {
+	if s := PositiveBias.String(); s != "PositiveBias" {
+		t.Fatal(s)
+	}
+	if s := Bias(3).String(); s != "Bias(3)" {
+		t.Fatal(s)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+)
+
+// MaxOversample is the highest Opts.Oversample.
+const MaxOversample = 32
+
+// measureOversampled reads d.oversample conversions with measure and returns
+// their mean in Gauss, without trim or offset, with the counts of the last
+// one.
+//
+// Each conversion after the first is a new one, see waitConversion. The
+// samples are converted to Gauss one by one, as auto ranging may change the
+// gain in between. On error, including an overflow, the sample read last is
+// returned instead of the mean.
+func (d *Dev) measureOversampled(ctx context.Context) ([3]float64, [3]int16, error) {
+	n := max(d.oversample, 1)
+	var sum [3]float64
+	var c [3]int16
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			if err := d.waitConversion(ctx); err != nil {
+				return [3]float64{}, c, err
+			}
+		}
+		var lsbXY, lsbZ int
+		var err error
+		c, lsbXY, lsbZ, err = d.measure(ctx)
+		if err != nil && !errors.Is(err, ErrOverflow) {
+			return [3]float64{}, c, err
+		}
+		g := countsToGauss(c, lsbXY, lsbZ)
+		if err != nil {
+			return g, c, err
+		}
+		for j := range sum {
+			sum[j] += g[j]
+		}
+	}
+	for j := range sum {
+		sum[j] /= float64(n)
+	}
+	return sum, c, nil
+}
+
+// waitConversion waits for a conversion completed after the last read, or
+// triggers one in single-measurement mode.
+func (d *Dev) waitConversion(ctx context.Context) error {
+	if d.mode == modeSingle {
+		if err := d.triggerSingle(); err != nil {
+			return err
+		}
+		return d.readReady(ctx, singleMeasurementTime)
+	}
+	return d.waitNext(ctx)
+}
+
+// countsToGauss converts counts in X,Y,Z order to Gauss.
+func countsToGauss(c [3]int16, lsbXY, lsbZ int) [3]float64 {
+	return [3]float64{
+		float64(c[0]) / float64(lsbXY),
+		float64(c[1]) / float64(lsbXY),
+		float64(c[2]) / float64(lsbZ),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// oversampleOps initializes a device and returns the samples with the given
+// X: the first one is read directly, the next ones are polled along with
+// STATUS.
+func oversampleOps(x ...int16) []i2ctest.IO {
+	ops := initOps()
+	for i, v := range x {
+		r := []byte{byte(uint16(v) >> 8), byte(v), 0x00, 0x00, 0x00, 0x00}
+		if i > 0 {
+			r = append(r, statusRDY)
+		}
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: r})
+	}
+	return ops
+}
+
+func TestOversample(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: oversampleOps(0, 137, 274, 412)}
+	d, err := New(bus, Opts{Oversample: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.SenseInto(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 205.75 counts at 1370 LSB/Gauss, below the resolution of one sample.
+	if s.X != 15018 || s.Raw[0] != 412 {
+		t.Fatalf("SenseInto() = %v", s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOversample_Overflow(t *testing.T) {
+	// The remaining conversions aren't read.
+	bus := &i2ctest.Playback{Ops: oversampleOps(137, -4096)}
+	d, err := New(bus, Opts{Oversample: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.SenseInto(&s); !errors.Is(err, ErrOverflow) || !s.Overflow {
+		t.Fatalf("SenseInto() = %v, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOversample_Invalid(t *testing.T) {
+	if _, err := New(&i2ctest.Playback{}, Opts{Oversample: -1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Registers is a snapshot of the 13 registers at addresses 0x00 to 0x0C.
+type Registers [13]byte
+
+// registerNames are the datasheet names of Registers, by address.
+var registerNames = [13]string{
+	"CRA", "CRB", "MR",
+	"DXRA", "DXRB", "DZRA", "DZRB", "DYRA", "DYRB",
+	"SR", "IRA", "IRB", "IRC",
+}
+
+// String returns one "NAME(addr)=value" entry per register.
+func (r *Registers) String() string {
+	var b strings.Builder
+	for i, v := range r {
+		if i != 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%s(0x%02X)=0x%02X", registerNames[i], i, v)
+	}
+	return b.String()
+}
+
+// DumpRegisters reads all the configuration, data, status and identification
+// registers, for diagnostics.
+//
+// It reads the data output registers like SenseRaw, which releases the data
+// lock; the sample read is lost for Sense.
+func (d *Dev) DumpRegisters() (Registers, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var r Registers
+	if err := d.readRegBlock(regCRA, r[:]); err != nil {
+		return Registers{}, err
+	}
+	return r, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestDumpRegisters(t *testing.T) {
+	raw := []byte{0x10, 0x20, 0x00, 0x01, 0x11, 0xFF, 0x00, 0x00, 0x89, 0x01, 'H', '4', '3'}
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCRA}, R: raw},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := d.DumpRegisters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(r[:]) != string(raw) {
+		t.Fatalf("DumpRegisters() = %v", r)
+	}
+	want := "CRA(0x00)=0x10 CRB(0x01)=0x20 MR(0x02)=0x00 DXRA(0x03)=0x01 DXRB(0x04)=0x11 DZRA(0x05)=0xFF DZRB(0x06)=0x00 DYRA(0x07)=0x00 DYRB(0x08)=0x89 SR(0x09)=0x01 IRA(0x0A)=0x48 IRB(0x0B)=0x34 IRC(0x0C)=0x33"
+	if s := r.String(); s != want {
+		t.Fatalf("String() = %q, want %q", s, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
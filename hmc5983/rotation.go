@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+// Rotation maps the chip's axes onto the application's body frame:
+//
+//	body = Rotation × chip
+//
+// Each row selects the chip axis, with its sign, that becomes the body X, Y
+// and Z axis respectively. It must be a signed permutation matrix, one of the
+// 24 right-handed orientations or their 24 mirror images. The zero value
+// leaves the axes unchanged.
+//
+// For example a board with the chip rotated 90° clockwise around Z, seen from
+// above, is corrected with:
+//
+//	Rotation{{0, 1, 0}, {-1, 0, 0}, {0, 0, 1}}
+type Rotation [3][3]int8
+
+// valid returns true if r is the zero value or a signed permutation matrix.
+func (r *Rotation) valid() bool {
+	if *r == (Rotation{}) {
+		return true
+	}
+	var cols [3]bool
+	for i := range r {
+		n := 0
+		for j, v := range r[i] {
+			switch v {
+			case 0:
+			case 1, -1:
+				if cols[j] {
+					return false
+				}
+				cols[j] = true
+				n++
+			default:
+				return false
+			}
+		}
+		if n != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// apply returns r × v.
+func (r *Rotation) apply(v [3]float64) [3]float64 {
+	if *r == (Rotation{}) {
+		return v
+	}
+	var out [3]float64
+	for i := range out {
+		out[i] = float64(r[i][0])*v[0] + float64(r[i][1])*v[1] + float64(r[i][2])*v[2]
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestRotation_valid(t *testing.T) {
+	data := []struct {
+		r    Rotation
+		want bool
+	}{
+		{Rotation{}, true},
+		{Rotation{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, true},
+		{Rotation{{0, 1, 0}, {-1, 0, 0}, {0, 0, 1}}, true},
+		{Rotation{{0, 0, -1}, {0, -1, 0}, {-1, 0, 0}}, true},
+		{Rotation{{1, 0, 0}, {1, 0, 0}, {0, 0, 1}}, false},
+		{Rotation{{1, 1, 0}, {0, 0, 0}, {0, 0, 1}}, false},
+		{Rotation{{2, 0, 0}, {0, 1, 0}, {0, 0, 1}}, false},
+		{Rotation{{1, 0, 0}, {0, 1, 0}, {0, 0, 0}}, false},
+	}
+	for i, line := range data {
+		if got := line.r.valid(); got != line.want {
+			t.Errorf("#%d: valid() = %t", i, got)
+		}
+	}
+}
+
+func TestRotation(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x10, 0xFA, 0xA4}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{Rotation: Rotation{{0, 1, 0}, {-1, 0, 0}, {0, 0, -1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Field
+	if err := d.SenseField(&f); err != nil {
+		t.Fatal(err)
+	}
+	// Chip axes are X = 100146, Y = -100146, Z = 1203.
+	want := Field{X: -100146 * physic.NanoTesla, Y: -100146 * physic.NanoTesla, Z: -1203 * physic.NanoTesla}
+	if f != want {
+		t.Fatalf("SenseField() = %+v, want %+v", f, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := New(&i2ctest.Playback{}, Opts{Rotation: Rotation{{1, 1, 0}}}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
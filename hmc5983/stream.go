@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// DropPolicy selects what Stream does when the callback is slower than the
+// output data rate and its buffer is full.
+type DropPolicy int
+
+const (
+	// Block stops reading until the callback catches up. No sample is
+	// dropped by the driver but the device overwrites unread conversions, so
+	// the samples are no longer evenly spaced.
+	Block DropPolicy = iota
+	// DropOldest discards the oldest buffered sample to make room for the
+	// new one, favoring latency.
+	DropOldest
+	// DropNewest discards the new sample, favoring the samples already
+	// buffered.
+	DropNewest
+)
+
+func (p DropPolicy) String() string {
+	switch p {
+	case Block:
+		return "Block"
+	case DropOldest:
+		return "DropOldest"
+	case DropNewest:
+		return "DropNewest"
+	default:
+		return fmt.Sprintf("DropPolicy(%d)", int(p))
+	}
+}
+
+// StreamOpts configures Stream.
+type StreamOpts struct {
+	// Policy applies when Buffer samples are pending.
+	Policy DropPolicy
+	// Buffer is the number of samples queued between the device and the
+	// callback. Defaults to 1.
+	Buffer int
+	// Interval between samples when Opts.DRDY isn't set. Defaults to the
+	// output data rate.
+	Interval time.Duration
+}
+
+// StreamStats counts the samples handled by Stream.
+type StreamStats struct {
+	// Delivered is the number of samples for which the callback returned nil.
+	Delivered uint64
+	// Dropped is the number of samples discarded because of the DropPolicy.
+	Dropped uint64
+}
+
+// Stream puts the device in continuous measurement mode and calls fn with
+// each sample, from the calling goroutine, until ctx is done or fn returns an
+// error.
+//
+// Samples are read like SenseContinuous, on a separate goroutine, so that fn
+// can take longer than one conversion; opts selects what happens to samples
+// then. Stream returns the counters along with the error from ctx, fn or the
+// device.
+func (d *Dev) Stream(ctx context.Context, fn func(Sample) error, opts StreamOpts) (StreamStats, error) {
+	var stats StreamStats
+	d.mu.Lock()
+	interval, err := d.enterContinuous(opts.Interval)
+	d.mu.Unlock()
+	if err != nil {
+		return stats, err
+	}
+	size := opts.Buffer
+	if size <= 0 {
+		size = 1
+	}
+
+	c := make(chan Sample, size)
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	var dropped atomic.Uint64
+	go func() {
+		defer close(c)
+		done <- d.sensingLoop(interval, stop, func(s Sample) bool {
+			switch opts.Policy {
+			case DropOldest:
+				for {
+					select {
+					case c <- s:
+						return true
+					default:
+					}
+					select {
+					case <-c:
+						dropped.Add(1)
+					default:
+					}
+				}
+			case DropNewest:
+				select {
+				case c <- s:
+				default:
+					dropped.Add(1)
+				}
+				return true
+			default:
+				select {
+				case c <- s:
+					return true
+				case <-stop:
+					return false
+				}
+			}
+		})
+	}()
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break loop
+		case s, ok := <-c:
+			if !ok {
+				err = <-done
+				break loop
+			}
+			if err = fn(s); err != nil {
+				break loop
+			}
+			stats.Delivered++
+		}
+	}
+	close(stop)
+	for range c {
+	}
+	stats.Dropped = dropped.Load()
+	return stats, err
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// stream feeds n samples with X = 1..n to Stream while the callback is stuck
+// on the first one, then returns the X of the first two delivered samples.
+func stream(t *testing.T, policy DropPolicy, n int) ([]int16, StreamStats) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := initOps()
+	for i := byte(1); i <= byte(n); i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x00, i, 0x00, 0x00, 0x00, 0x00}})
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errDone := errors.New("done")
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	var got []int16
+	go func() {
+		drdy.EdgesChan <- gpio.Low
+		<-entered
+		// Each edge is only accepted once the previous sample was handled.
+		for i := 1; i < n; i++ {
+			drdy.EdgesChan <- gpio.Low
+		}
+		close(release)
+	}()
+	stats, err := d.Stream(context.Background(), func(s Sample) error {
+		got = append(got, s.Raw[0])
+		if len(got) == 1 {
+			close(entered)
+			<-release
+			return nil
+		}
+		return errDone
+	}, StreamOpts{Policy: policy})
+	if err != errDone {
+		t.Fatalf("Stream() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return got, stats
+}
+
+func TestStream_Block(t *testing.T) {
+	// Sample 3 is read while sample 2 waits in the buffer, and then blocks.
+	got, stats := stream(t, Block, 3)
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("got %v", got)
+	}
+	if stats.Dropped != 0 || stats.Delivered != 1 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestStream_DropNewest(t *testing.T) {
+	got, stats := stream(t, DropNewest, 4)
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("got %v", got)
+	}
+	// Sample 3 is dropped, sample 4 is dropped if read before the callback
+	// returned.
+	if stats.Dropped < 1 || stats.Dropped > 2 || stats.Delivered != 1 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestStream_DropOldest(t *testing.T) {
+	got, stats := stream(t, DropOldest, 4)
+	if len(got) != 2 || got[0] != 1 || got[1] < 3 {
+		t.Fatalf("got %v", got)
+	}
+	if stats.Dropped < 1 || stats.Dropped > 2 || stats.Delivered != 1 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestStream_Context(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps()}
+	d, err := New(bus, Opts{DRDY: &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := d.Stream(ctx, func(Sample) error { return nil }, StreamOpts{}); err != context.Canceled {
+		t.Fatalf("Stream() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+	d.halted = true
+	if _, err := d.Stream(ctx, func(Sample) error { return nil }, StreamOpts{}); err != ErrHalted {
+		t.Fatalf("Stream() = %v", err)
+	}
+}
+
+func TestDropPolicy_String(t *testing.T) {
+	if s := DropOldest.String(); s != "DropOldest" {
+		t.Fatal(s)
+	}
+	if s := DropPolicy(9).String(); s != "DropPolicy(9)" {
+		t.Fatal(s)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"errors"
+	"fmt"
+)
+
+// selfTestField is the field added by the bias current on each axis, in
+// Gauss, per datasheet.
+var selfTestField = [3]float64{1.16, 1.16, 1.08}
+
+// Trim returns the per axis sensitivity correction factors derived from the
+// self-test readings, as suggested by the datasheet: the known bias field
+// divided by the field measured with the typical sensitivities.
+//
+// The measured field is half the difference between the positive and negative
+// readings, which cancels the ambient field. It returns an error when the
+// test didn't pass.
+func (r *SelfTestResult) Trim() ([3]float64, error) {
+	if !r.Passed() {
+		return [3]float64{}, errors.New("hmc5983: self test failed, can't derive the trim")
+	}
+	if r.GainCode < 0 || r.GainCode >= len(gainXY) {
+		return [3]float64{}, fmt.Errorf("%w: gain code %d", ErrInvalidOpts, r.GainCode)
+	}
+	lsb := [3]int{gainXY[r.GainCode], gainXY[r.GainCode], gainZ[r.GainCode]}
+	var t [3]float64
+	for i := range t {
+		measured := float64(int(r.Positive[i])-int(r.Negative[i])) / 2
+		t[i] = selfTestField[i] * float64(lsb[i]) / measured
+	}
+	return t, nil
+}
+
+// SetTrim sets the per axis factors applied to the scaled readings, before
+// the offset and calibration. Use SelfTestResult.Trim or TrimFromSelfTest to
+// derive them and set them before calibrating; {1, 1, 1} disables the trim.
+func (d *Dev) SetTrim(t [3]float64) error {
+	for i, v := range t {
+		if v <= 0 {
+			return fmt.Errorf("%w: trim %g on axis %d", ErrInvalidOpts, v, i)
+		}
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.trim = t
+	return nil
+}
+
+// GetTrim returns the factors set with SetTrim, {1, 1, 1} by default.
+func (d *Dev) GetTrim() [3]float64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.trimFactors()
+}
+
+// TrimFromSelfTest runs SelfTest and sets the trim it derives.
+//
+// The sensitivity varies between units and with temperature, so running it
+// again when the temperature changes keeps the scaling accurate. The same
+// gain constraints as SelfTest apply.
+func (d *Dev) TrimFromSelfTest() ([3]float64, error) {
+	r, err := d.SelfTest()
+	if err != nil {
+		return [3]float64{}, err
+	}
+	t, err := r.Trim()
+	if err != nil {
+		return t, err
+	}
+	return t, d.SetTrim(t)
+}
+
+func (d *Dev) trimFactors() [3]float64 {
+	if d.trim == [3]float64{} {
+		return [3]float64{1, 1, 1}
+	}
+	return d.trim
+}
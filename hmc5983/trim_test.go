@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSelfTestResult_Trim(t *testing.T) {
+	r := SelfTestResult{
+		Positive: [3]int16{500, 400, 400},
+		Negative: [3]int16{-400, -500, -366},
+		Pass:     [3]bool{true, true, true},
+		GainCode: 5,
+	}
+	got, err := r.Trim()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1.16Ga * 390 LSB/Ga / 450 and 1.08Ga * 355 LSB/Ga / 383.
+	want := [3]float64{1.16 * 390 / 450, 1.16 * 390 / 450, 1.08 * 355 / 383}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Trim() = %v, want %v", got, want)
+		}
+	}
+	r.Pass[2] = false
+	if _, err := r.Trim(); err == nil {
+		t.Fatal("expected error for a failed test")
+	}
+}
+
+func TestSetTrim(t *testing.T) {
+	d, err := newDev(context.Background(), sampleConn{}, false, Opts{SkipIDCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.GetTrim(); got != [3]float64{1, 1, 1} {
+		t.Fatalf("GetTrim() = %v", got)
+	}
+	x, _, _, err := d.SenseMicroTesla()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetTrim([3]float64{2, 1, 1}); err != nil {
+		t.Fatal(err)
+	}
+	x2, _, _, err := d.SenseMicroTesla()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x2 != 2*x {
+		t.Fatalf("SenseMicroTesla() = %g, want %g", x2, 2*x)
+	}
+	if err := d.SetTrim([3]float64{1, 0, 1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("SetTrim() = %v", err)
+	}
+}
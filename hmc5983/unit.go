@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Unit is the unit of the values returned by SenseUnit.
+type Unit int
+
+// Supported units. The zero value matches Sense.
+const (
+	MicroTesla10 Unit = iota // µT×10, equal to milliGauss
+	NanoTesla
+	MicroTesla
+	MilliGauss
+)
+
+func (u Unit) String() string {
+	switch u {
+	case MicroTesla10:
+		return "µT×10"
+	case NanoTesla:
+		return "nT"
+	case MicroTesla:
+		return "µT"
+	case MilliGauss:
+		return "mG"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+// perGauss returns the value of 1 Gauss in u.
+func (u Unit) perGauss() float64 {
+	switch u {
+	case NanoTesla:
+		return 100000
+	case MicroTesla:
+		return 100
+	default: // MicroTesla10, MilliGauss
+		return 1000
+	}
+}
+
+// SenseUnit reads and scales X,Y,Z to the unit selected by Opts.Unit.
+//
+// Unlike Sense, the values are not truncated. Overflow, auto ranging and
+// calibration are handled as in Sense.
+func (d *Dev) SenseUnit() (float64, float64, float64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	g, _, err := d.measureGauss(context.Background())
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return 0, 0, 0, err
+	}
+	k := d.unit.perGauss()
+	return g[0] * k, g[1] * k, g[2] * k, err
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestSenseUnit(t *testing.T) {
+	data := []struct {
+		unit Unit
+		want float64 // for 1 Gauss
+	}{
+		{MicroTesla10, 1000},
+		{NanoTesla, 100000},
+		{MicroTesla, 100},
+		{MilliGauss, 1000},
+	}
+	for _, line := range data {
+		ops := append(initOps(),
+			// 1370 counts on X and Y, 1330 on Z, at 1370/1330 LSB/Gauss.
+			i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5A, 0x05, 0x32, 0xFA, 0xA6}},
+		)
+		bus := &i2ctest.Playback{Ops: ops}
+		d, err := New(bus, Opts{Unit: line.unit})
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, y, z, err := d.SenseUnit()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(x-line.want) > 1e-9 || math.Abs(y+line.want) > 1e-9 || math.Abs(z-line.want) > 1e-9 {
+			t.Errorf("%s: SenseUnit() = %g, %g, %g, want ±%g", line.unit, x, y, z, line.want)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := Unit(4).String(); s != "Unit(4)" {
+		t.Fatalf("String() = %q", s)
+	}
+}
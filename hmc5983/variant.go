@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+)
+
+// Variant is a chip supported by the driver.
+type Variant int
+
+// Supported chips. They share the register map; the HMC5883L lacks the
+// temperature sensor, the temperature compensation, the 220Hz output data rate
+// and the SPI interface.
+const (
+	HMC5983 Variant = iota
+	HMC5883L
+)
+
+func (v Variant) String() string {
+	switch v {
+	case HMC5983:
+		return "HMC5983"
+	case HMC5883L:
+		return "HMC5883L"
+	default:
+		return "Variant(?)"
+	}
+}
+
+// Variant returns the chip detected by New.
+//
+// Devices on SPI are always HMC5983.
+func (d *Dev) Variant() Variant {
+	return d.variant
+}
+
+// QMC5883L fixed I2C address and chip ID register, which reads 0xFF.
+const (
+	qmcAddr   = 0x0D
+	qmcRegID  = 0x0D
+	qmcChipID = 0xFF
+)
+
+// isQMC5883L reports whether a QMC5883L answers on the bus of c, when c is
+// an I2C device. Bus errors mean no.
+func isQMC5883L(c conn.Conn) bool {
+	dev, ok := c.(*i2c.Dev)
+	if !ok {
+		return false
+	}
+	id := [1]byte{}
+	q := i2c.Dev{Addr: qmcAddr, Bus: dev.Bus}
+	return q.Tx([]byte{qmcRegID}, id[:]) == nil && id[0] == qmcChipID
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"strings"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestVariant(t *testing.T) {
+	for _, is5983 := range []bool{true, false} {
+		bus := &i2ctest.Playback{Ops: append(probeOps(is5983), configOps()...)}
+		d, err := New(bus, Opts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := HMC5983
+		if !is5983 {
+			want = HMC5883L
+		}
+		if v := d.Variant(); v != want {
+			t.Fatalf("Variant() = %s, want %s", v, want)
+		}
+		if s := d.String(); !strings.HasPrefix(s, want.String()+"{") {
+			t.Fatalf("String() = %q", s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := Variant(2).String(); s != "Variant(?)" {
+		t.Fatalf("String() = %q", s)
+	}
+}
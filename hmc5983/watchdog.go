@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import "time"
+
+// HealthEvent reports that the output registers stopped changing in
+// continuous mode and that the device was re-initialized, see
+// Opts.StuckLimit.
+type HealthEvent struct {
+	// Timestamp is the time of the last identical sample.
+	Timestamp time.Time
+	// Raw is the value the registers were stuck at, in X,Y,Z order.
+	Raw [3]int16
+	// Count is the number of identical consecutive samples.
+	Count int
+	// Err is the error from re-initializing the device, if any. The sensing
+	// goroutine stops after reporting it.
+	Err error
+}
+
+// watchdog detects identical consecutive raw samples.
+type watchdog struct {
+	limit int // 0 disables it
+	last  [3]int16
+	n     int
+}
+
+// stuck returns true when raw is the limit-th identical sample in a row, and
+// then starts counting again.
+func (w *watchdog) stuck(raw [3]int16) bool {
+	if w.limit == 0 {
+		return false
+	}
+	if w.n == 0 || raw != w.last {
+		w.last = raw
+		w.n = 1
+		return false
+	}
+	w.n++
+	if w.n < w.limit {
+		return false
+	}
+	w.n = 0
+	return true
+}
+
+// recoverStuck re-initializes the device after s was read count times and
+// reports it to Opts.OnHealth.
+func (d *Dev) recoverStuck(s Sample, count int) error {
+	d.mu.Lock()
+	err := d.reinitialize()
+	d.mu.Unlock()
+	if d.onHealth != nil {
+		d.onHealth(HealthEvent{Timestamp: s.Timestamp, Raw: s.Raw, Count: count, Err: err})
+	}
+	return err
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestWatchdog(t *testing.T) {
+	w := watchdog{limit: 3}
+	data := []struct {
+		raw  int16
+		want bool
+	}{
+		{1, false}, {1, false}, {2, false}, {2, false}, {2, true},
+		// Counting starts again.
+		{2, false}, {2, false}, {2, true},
+	}
+	for i, line := range data {
+		if got := w.stuck([3]int16{line.raw}); got != line.want {
+			t.Fatalf("#%d: stuck() = %t", i, got)
+		}
+	}
+	w = watchdog{}
+	for i := 0; i < 10; i++ {
+		if w.stuck([3]int16{}) {
+			t.Fatal("disabled watchdog triggered")
+		}
+	}
+}
+
+func TestStuckLimit(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	sample := i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{0x05, 0x5C, 0x00, 0x00, 0x00, 0x00}}
+	ops := append(initOps(), sample, sample, sample)
+	ops = append(ops, configOps()...)
+	ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regMODE, modeIdle}})
+	bus := &i2ctest.Playback{Ops: ops}
+	events := make(chan HealthEvent, 1)
+	d, err := New(bus, Opts{DRDY: drdy, StuckLimit: 3, OnHealth: func(e HealthEvent) { events <- e }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		drdy.EdgesChan <- gpio.Low
+		<-c
+	}
+	// The third sample triggers the watchdog instead of being delivered.
+	drdy.EdgesChan <- gpio.Low
+	e := <-events
+	if e.Count != 3 || e.Raw != [3]int16{0x55C, 0, 0} || e.Err != nil || e.Timestamp.IsZero() {
+		t.Fatalf("event = %+v", e)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// wmmMaxDegree is the highest degree of the World Magnetic Model.
+const wmmMaxDegree = 12
+
+// WMM is a World Magnetic Model, used to compute the magnetic declination.
+//
+// The coefficients are published by NOAA and the British Geological Survey
+// every 5 years as a WMM.COF file, which ParseWMM reads. The model is only
+// valid for 5 years after its epoch.
+type WMM struct {
+	// Name of the model, e.g. "WMM-2025".
+	Name string
+	// Epoch is the reference time of the coefficients, as a decimal year.
+	Epoch float64
+
+	nmax             int
+	g, h, gDot, hDot [wmmMaxDegree + 1][wmmMaxDegree + 1]float64
+}
+
+// ParseWMM reads a model in the WMM.COF format:
+//
+//	    2025.0            WMM-2025     11/13/2024
+//	  1  0  -29351.8       0.0       12.0        0.0
+//	  1  1   -1410.8    4545.4        9.7      -21.5
+//	...
+//	999999999999999999999999999999999999999999999999
+//
+// The header is followed by one line per degree n and order m with the Gauss
+// coefficients g and h in nT and their secular variation in nT/year.
+func ParseWMM(r io.Reader) (*WMM, error) {
+	s := bufio.NewScanner(r)
+	w := &WMM{}
+	header := false
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) == 0 {
+			continue
+		}
+		if strings.HasPrefix(f[0], "9999") {
+			break
+		}
+		if !header {
+			e, err := strconv.ParseFloat(f[0], 64)
+			if err != nil || len(f) < 2 {
+				return nil, fmt.Errorf("hmc5983: invalid WMM header %q", s.Text())
+			}
+			w.Epoch = e
+			w.Name = f[1]
+			header = true
+			continue
+		}
+		if len(f) != 6 {
+			return nil, fmt.Errorf("hmc5983: invalid WMM line %q", s.Text())
+		}
+		n, err1 := strconv.Atoi(f[0])
+		m, err2 := strconv.Atoi(f[1])
+		if err1 != nil || err2 != nil || n < 1 || n > wmmMaxDegree || m < 0 || m > n {
+			return nil, fmt.Errorf("hmc5983: invalid WMM line %q", s.Text())
+		}
+		var v [4]float64
+		for i := range v {
+			var err error
+			if v[i], err = strconv.ParseFloat(f[2+i], 64); err != nil {
+				return nil, fmt.Errorf("hmc5983: invalid WMM line %q", s.Text())
+			}
+		}
+		w.g[n][m], w.h[n][m], w.gDot[n][m], w.hDot[n][m] = v[0], v[1], v[2], v[3]
+		if n > w.nmax {
+			w.nmax = n
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("hmc5983: reading WMM: %w", err)
+	}
+	if w.nmax == 0 {
+		return nil, errors.New("hmc5983: WMM has no coefficients")
+	}
+	return w, nil
+}
+
+// WGS84 ellipsoid and WMM reference radius, in km.
+const (
+	wgs84A       = 6378.137
+	wgs84F       = 1 / 298.257223563
+	wmmRefRadius = 6371.2
+)
+
+// Declination returns the angle between true north and magnetic north,
+// positive when magnetic north is east of true north.
+//
+// lat and lon are geodetic (WGS84), alt is the height above the ellipsoid.
+// Returns an error outside the 5 years validity of the model and at the
+// geographic poles, where the declination is undefined.
+func (w *WMM) Declination(lat, lon physic.Angle, alt physic.Distance, t time.Time) (physic.Angle, error) {
+	dt := decimalYear(t) - w.Epoch
+	if dt < 0 || dt > 5 {
+		return 0, fmt.Errorf("hmc5983: %s is only valid from %.1f to %.1f", w.Name, w.Epoch, w.Epoch+5)
+	}
+	phi := float64(lat) / float64(physic.Radian)
+	lambda := float64(lon) / float64(physic.Radian)
+	h := float64(alt) / float64(physic.KiloMetre)
+
+	// Geodetic to geocentric spherical coordinates.
+	e2 := wgs84F * (2 - wgs84F)
+	rc := wgs84A / math.Sqrt(1-e2*math.Sin(phi)*math.Sin(phi))
+	p := (rc + h) * math.Cos(phi)
+	z := (rc*(1-e2) + h) * math.Sin(phi)
+	r := math.Hypot(p, z)
+	phiC := math.Asin(z / r)
+
+	// Schmidt semi-normalized associated Legendre functions of cos(θ), with
+	// θ the geocentric colatitude, and their derivative relative to θ.
+	x, st := math.Sin(phiC), math.Cos(phiC)
+	if st < 1e-6 {
+		return 0, errors.New("hmc5983: declination is undefined at the poles")
+	}
+	var pnm, dp [wmmMaxDegree + 1][wmmMaxDegree + 1]float64
+	pnm[0][0] = 1
+	for n := 1; n <= w.nmax; n++ {
+		for m := 0; m <= n; m++ {
+			switch {
+			case n == m && n == 1:
+				pnm[1][1] = st
+				dp[1][1] = x
+			case n == m:
+				k := math.Sqrt(float64(2*n-1) / float64(2*n))
+				pnm[n][n] = k * st * pnm[n-1][n-1]
+				dp[n][n] = k * (x*pnm[n-1][n-1] + st*dp[n-1][n-1])
+			default:
+				a := float64(2*n - 1)
+				b := math.Sqrt(float64((n-1)*(n-1) - m*m))
+				c := math.Sqrt(float64(n*n - m*m))
+				pnm[n][m] = a * x * pnm[n-1][m]
+				dp[n][m] = a * (x*dp[n-1][m] - st*pnm[n-1][m])
+				if n >= 2 {
+					pnm[n][m] -= b * pnm[n-2][m]
+					dp[n][m] -= b * dp[n-2][m]
+				}
+				pnm[n][m] /= c
+				dp[n][m] /= c
+			}
+		}
+	}
+
+	// Field in the geocentric frame: north, east and down.
+	var bx, by, bz float64
+	ratio := wmmRefRadius / r
+	k := ratio * ratio
+	for n := 1; n <= w.nmax; n++ {
+		k *= ratio
+		for m := 0; m <= n; m++ {
+			g := w.g[n][m] + dt*w.gDot[n][m]
+			hh := w.h[n][m] + dt*w.hDot[n][m]
+			cm, sm := math.Cos(float64(m)*lambda), math.Sin(float64(m)*lambda)
+			bx += k * (g*cm + hh*sm) * dp[n][m]
+			by += k * float64(m) * (g*sm - hh*cm) * pnm[n][m] / st
+			bz -= k * float64(n+1) * (g*cm + hh*sm) * pnm[n][m]
+		}
+	}
+	// Rotate north into the geodetic frame; east is unchanged.
+	psi := phiC - phi
+	bx = bx*math.Cos(psi) - bz*math.Sin(psi)
+	d := math.Atan2(by, bx)
+	return physic.Angle(math.Round(d * float64(physic.Radian))), nil
+}
+
+// decimalYear converts t to a fractional year, e.g. 2025.5.
+func decimalYear(t time.Time) float64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	return float64(t.Year()) + float64(t.Sub(start))/float64(end.Sub(start))
+}
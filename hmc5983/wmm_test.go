@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Daniel Alarcon Rubio / Relabs Tech
+// SPDX-License-Identifier: MIT
+// See LICENSE file for full license text
+
+package hmc5983
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// dipoleCOF is the degree 1 part of WMM-2025.
+const dipoleCOF = `    2025.0            WMM-2025     11/13/2024
+  1  0  -29351.8       0.0       12.0        0.0
+  1  1   -1410.8    4545.4        9.7      -21.5
+999999999999999999999999999999999999999999999999
+`
+
+func TestWMM_Dipole(t *testing.T) {
+	w, err := ParseWMM(strings.NewReader(dipoleCOF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Name != "WMM-2025" || w.Epoch != 2025 {
+		t.Fatalf("ParseWMM() = %q %g", w.Name, w.Epoch)
+	}
+	for _, year := range []float64{0, 2.5} {
+		g10 := -29351.8 + year*12
+		g11 := -1410.8 + year*9.7
+		h11 := 4545.4 - year*21.5
+		ts := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(year * 365 * 24 * float64(time.Hour)))
+		for _, lon := range []float64{0, 45, -120, 170} {
+			// On the equator, north is -g10 and east g11·sin(λ) - h11·cos(λ).
+			l := lon * math.Pi / 180
+			want := math.Atan2(g11*math.Sin(l)-h11*math.Cos(l), -g10)
+			got, err := w.Declination(0, physic.Angle(lon*float64(physic.Degree)), 0, ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := float64(got)/float64(physic.Radian) - want; math.Abs(diff) > 1e-4 {
+				t.Errorf("Declination(0, %g) @%g = %s, want %g rad", lon, year, got, want)
+			}
+		}
+	}
+
+	if _, err := w.Declination(0, 0, 0, time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Fatal("expected error past the model validity")
+	}
+	if _, err := w.Declination(90*physic.Degree, 0, 0, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Fatal("expected error at the pole")
+	}
+}
+
+func TestParseWMM_Errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"garbage\n",
+		"2025.0 WMM-2025\n 13 0 1 2 3 4\n",
+		"2025.0 WMM-2025\n 1 2 1 2 3 4\n",
+		"2025.0 WMM-2025\n 1 0 a 2 3 4\n",
+		"2025.0 WMM-2025\n 1 0 1 2 3\n",
+		"2025.0 WMM-2025\n9999999\n",
+	} {
+		if _, err := ParseWMM(strings.NewReader(s)); err == nil {
+			t.Errorf("ParseWMM(%q) succeeded", s)
+		}
+	}
+}
+
+func TestTrueHeading(t *testing.T) {
+	f := Field{X: 20 * physic.MicroTesla}
+	if h := f.TrueHeading(-10 * physic.Degree); h != 2*physic.Pi-10*physic.Degree {
+		t.Fatalf("TrueHeading() = %s", h)
+	}
+	if h := f.TrueHeading(370 * physic.Degree); h != 370*physic.Degree-2*physic.Pi {
+		t.Fatalf("TrueHeading() = %s", h)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package htu21d controls a TE Connectivity HTU21D or a Silicon Labs Si7021
+// temperature and humidity sensor over I²C.
+//
+// # More details
+//
+// Both devices share the same commands. In hold master mode the device
+// stretches the clock until the end of each measurement, which not all I²C
+// controllers support; in the default no hold master mode the driver waits
+// for the maximum measurement time instead.
+//
+// The HTU21D datasheet documents a compensation of the temperature
+// coefficient of the humidity, applied with Opts.Compensate. The Si7021
+// compensates internally.
+//
+// # Datasheet
+//
+// https://www.te.com/commerce/DocumentDelivery/DDEController?Action=showdoc&DocId=Data+Sheet%7FHPC199_6%7FA6%7Fpdf%7FEnglish%7FENG_DS_HPC199_6_A6.pdf
+//
+// https://www.silabs.com/documents/public/data-sheets/Si7021-A20.pdf
+package htu21d
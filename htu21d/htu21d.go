@@ -0,0 +1,365 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package htu21d
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Addr is the I²C address of the device.
+const Addr = 0x40
+
+// Commands.
+const (
+	cmdMeasureHumidityHold      = 0xE5
+	cmdMeasureTemperatureHold   = 0xE3
+	cmdMeasureHumidityNoHold    = 0xF5
+	cmdMeasureTemperatureNoHold = 0xF3
+	cmdWriteUserReg             = 0xE6
+	cmdReadUserReg              = 0xE7
+	cmdSoftReset                = 0xFE
+	crc8Polynomial              = 0x31
+	resetTime                   = 15 * time.Millisecond
+)
+
+// User register bits.
+const (
+	userRegRes1   = 0x80
+	userRegHeater = 0x04
+	userRegRes0   = 0x01
+	userRegRes    = userRegRes1 | userRegRes0
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrCRC is returned when a measurement fails its CRC, usually because of
+	// noise on the bus.
+	ErrCRC = errors.New("htu21d: CRC mismatch")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("htu21d: invalid options")
+)
+
+// Resolution is the resolution of the humidity and temperature
+// measurements. A lower resolution shortens the measurements.
+type Resolution uint8
+
+// Possible resolutions, in bits.
+const (
+	RH12T14 Resolution = 0
+	RH8T12  Resolution = 1
+	RH10T13 Resolution = 2
+	RH11T11 Resolution = 3
+)
+
+// resolutions holds the characteristics of each resolution: the bits of the
+// user register, then the resolution in bits, the maximum measurement time
+// and the LSB of the humidity and the temperature.
+var resolutions = [...]struct {
+	userReg              byte
+	humidityBits         uint8
+	temperatureBits      uint8
+	humidityTime         time.Duration
+	temperatureTime      time.Duration
+	humidityPrecision    physic.RelativeHumidity
+	temperaturePrecision physic.Temperature
+}{
+	{0x00, 12, 14, 16 * time.Millisecond, 50 * time.Millisecond, 305 * physic.MicroRH, 11 * physic.MilliKelvin},
+	{0x01, 8, 12, 3 * time.Millisecond, 13 * time.Millisecond, 4883 * physic.MicroRH, 43 * physic.MilliKelvin},
+	{0x80, 10, 13, 5 * time.Millisecond, 25 * time.Millisecond, 1221 * physic.MicroRH, 21 * physic.MilliKelvin},
+	{0x81, 11, 11, 8 * time.Millisecond, 7 * time.Millisecond, 610 * physic.MicroRH, 86 * physic.MilliKelvin},
+}
+
+func (r Resolution) String() string {
+	if r <= RH11T11 {
+		return fmt.Sprintf("RH%dT%d", resolutions[r].humidityBits, resolutions[r].temperatureBits)
+	}
+	return fmt.Sprintf("Resolution(%d)", uint8(r))
+}
+
+// DefaultOpts are the options for the highest resolution in no hold master
+// mode.
+var DefaultOpts = Opts{}
+
+// Opts holds initialization options.
+//
+// Resolution: resolution of the measurements, RH12T14 by default.
+// Hold: use the hold master mode, where the device stretches the clock
+// during the measurements, instead of waiting for their maximum duration.
+// Compensate: apply the compensation of the temperature coefficient of the
+// humidity of the HTU21D datasheet. Leave false with a Si7021.
+type Opts struct {
+	Resolution Resolution
+	Hold       bool
+	Compensate bool
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Resolution > RH11T11 {
+		return fmt.Errorf("%w: Resolution %s, want RH12T14, RH8T12, RH10T13 or RH11T11", ErrInvalidOpts, o.Resolution)
+	}
+	return nil
+}
+
+// measDuration returns the maximum duration of both measurements.
+func (o *Opts) measDuration() time.Duration {
+	r := &resolutions[o.Resolution]
+	return r.humidityTime + r.temperatureTime
+}
+
+// Dev is a handle to an initialized HTU21D or Si7021 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets a device on an I²C bus, sets its resolution and turns off its
+// heater.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: Addr}, opts: opts}
+	if err := d.c.Tx([]byte{cmdSoftReset}, nil); err != nil {
+		return nil, fmt.Errorf("htu21d: reset: %w", err)
+	}
+	doSleep(resetTime)
+	if err := d.updateUserReg(userRegRes|userRegHeater, resolutions[opts.Resolution].userReg); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("HTU21D{%s}", d.c)
+}
+
+// Sense measures the humidity then the temperature. It implements
+// physic.SenseEnv.
+//
+// The pressure is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("htu21d: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseContinuous returns measurements of the temperature and humidity on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than the duration of both measurements.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if m := d.opts.measDuration(); interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	r := &resolutions[d.opts.Resolution]
+	e.Temperature = r.temperaturePrecision
+	e.Humidity = r.humidityPrecision
+}
+
+// SetHeater turns on or off the heater, to evaporate condensation or check
+// the sensor. It warms the sensor by a few degrees.
+func (d *Dev) SetHeater(on bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var v byte
+	if on {
+		v = userRegHeater
+	}
+	return d.updateUserReg(userRegHeater, v)
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device idles between measurements.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// updateUserReg sets the bits of mask in the user register to v, keeping
+// the reserved bits.
+func (d *Dev) updateUserReg(mask, v byte) error {
+	var b [1]byte
+	if err := d.c.Tx([]byte{cmdReadUserReg}, b[:]); err != nil {
+		return fmt.Errorf("htu21d: reading user register: %w", err)
+	}
+	if err := d.c.Tx([]byte{cmdWriteUserReg, b[0]&^mask | v}, nil); err != nil {
+		return fmt.Errorf("htu21d: writing user register: %w", err)
+	}
+	return nil
+}
+
+// sense measures and converts.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	r := &resolutions[d.opts.Resolution]
+	cmdH, cmdT := byte(cmdMeasureHumidityNoHold), byte(cmdMeasureTemperatureNoHold)
+	if d.opts.Hold {
+		cmdH, cmdT = cmdMeasureHumidityHold, cmdMeasureTemperatureHold
+	}
+	h, err := d.measure(cmdH, r.humidityTime)
+	if err != nil {
+		return err
+	}
+	t, err := d.measure(cmdT, r.temperatureTime)
+	if err != nil {
+		return err
+	}
+	e.Temperature = temperature(t)
+	e.Humidity = humidity(h)
+	if d.opts.Compensate {
+		e.Humidity = compensate(e.Humidity, e.Temperature)
+	}
+	return nil
+}
+
+// measure runs the measurement cmd, lasting up to wait in no hold master
+// mode, and returns its raw value without the status bits.
+func (d *Dev) measure(cmd byte, wait time.Duration) (uint16, error) {
+	var b [3]byte
+	if d.opts.Hold {
+		if err := d.c.Tx([]byte{cmd}, b[:]); err != nil {
+			return 0, fmt.Errorf("htu21d: command 0x%02x: %w", cmd, err)
+		}
+	} else {
+		if err := d.c.Tx([]byte{cmd}, nil); err != nil {
+			return 0, fmt.Errorf("htu21d: command 0x%02x: %w", cmd, err)
+		}
+		doSleep(wait)
+		if err := d.c.Tx(nil, b[:]); err != nil {
+			return 0, fmt.Errorf("htu21d: reading command 0x%02x: %w", cmd, err)
+		}
+	}
+	if got, want := crc8(b[:2]), b[2]; got != want {
+		return 0, fmt.Errorf("%w: 0x%02x, want 0x%02x", ErrCRC, got, want)
+	}
+	// The 2 LSBs are status bits.
+	return (uint16(b[0])<<8 | uint16(b[1])) &^ 0x03, nil
+}
+
+// temperature converts a raw temperature, as -46.85 + 175.72*raw/2^16 °C.
+func temperature(raw uint16) physic.Temperature {
+	return physic.ZeroCelsius - 46850*physic.MilliCelsius + physic.Temperature(int64(raw)*int64(175720*physic.MilliCelsius)>>16)
+}
+
+// humidity converts a raw humidity, as -6 + 125*raw/2^16 %RH. The values
+// out of 0 to 100%RH are cropped.
+func humidity(raw uint16) physic.RelativeHumidity {
+	return crop(physic.RelativeHumidity(int64(raw)*int64(125*physic.PercentRH)>>16) - 6*physic.PercentRH)
+}
+
+// compensate returns the humidity compensated for the temperature
+// coefficient of -0.15%RH/°C, as h + (25 - t) * -0.15.
+func compensate(h physic.RelativeHumidity, t physic.Temperature) physic.RelativeHumidity {
+	dt := int64(t - physic.ZeroCelsius - 25*physic.Celsius)
+	return crop(h + physic.RelativeHumidity(dt*int64(15*physic.PercentRH/100)/int64(physic.Celsius)))
+}
+
+// crop limits h to 0 to 100%RH.
+func crop(h physic.RelativeHumidity) physic.RelativeHumidity {
+	if h < 0 {
+		return 0
+	}
+	if h > 100*physic.PercentRH {
+		return 100 * physic.PercentRH
+	}
+	return h
+}
+
+// crc8 returns the CRC-8 of b, as computed by the device.
+func crc8(b []byte) byte {
+	var crc byte
+	for _, v := range b {
+		crc ^= v
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ crc8Polynomial
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,312 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package htu21d
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New with DefaultOpts, the user
+// register reading as its reset value.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: Addr, W: []byte{cmdSoftReset}},
+		{Addr: Addr, W: []byte{cmdReadUserReg}, R: []byte{0x02}},
+		{Addr: Addr, W: []byte{cmdWriteUserReg, 0x02}},
+	}
+}
+
+// senseOps are the bus transactions of a measurement in no hold master mode,
+// of 54.79%RH and 23.43°C.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: Addr, W: []byte{0xF5}},
+		{Addr: Addr, R: []byte{0x7C, 0x82, 0x97}},
+		{Addr: Addr, W: []byte{0xF3}},
+		{Addr: Addr, R: []byte{0x66, 0x64, 0x70}},
+	}
+}
+
+// testEnv is the measurement of senseOps.
+var testEnv = physic.Env{
+	Temperature: 296581564941 * physic.NanoKelvin,
+	Humidity:    5479101 * physic.TenthMicroRH,
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "HTU21D{playback(64)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_resolution(t *testing.T) {
+	data := []struct {
+		r    Resolution
+		want byte
+	}{
+		{RH12T14, 0x3A},
+		{RH8T12, 0x3B},
+		{RH10T13, 0xBA},
+		{RH11T11, 0xBB},
+	}
+	for i, line := range data {
+		// The heater is turned off and the reserved bits are kept.
+		bus := i2ctest.Playback{Ops: []i2ctest.IO{
+			{Addr: Addr, W: []byte{cmdSoftReset}},
+			{Addr: Addr, W: []byte{cmdReadUserReg}, R: []byte{0xBF}},
+			{Addr: Addr, W: []byte{cmdWriteUserReg, line.want}},
+		}}
+		if _, err := New(&bus, Opts{Resolution: line.r}); err != nil {
+			t.Fatal(i, err)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(initOps()); i++ {
+		bus := i2ctest.Playback{Ops: initOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	o := Opts{Resolution: 4}
+	want := "htu21d: invalid options: Resolution Resolution(4), want RH12T14, RH8T12, RH10T13 or RH11T11"
+	if err := o.Validate(); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %s", err, want)
+	}
+	if _, err := New(&i2ctest.Playback{}, o); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_hold(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: Addr, W: []byte{0xE5}, R: []byte{0x7C, 0x82, 0x97}},
+		i2ctest.IO{Addr: Addr, W: []byte{0xE3}, R: []byte{0x66, 0x64, 0x70}},
+	)}
+	d, err := New(&bus, Opts{Hold: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_compensate(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, Opts{Compensate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	// 54.79%RH + (25 - 23.43°C) * -0.15%RH/°C.
+	want := physic.Env{Temperature: testEnv.Temperature, Humidity: 5455575 * physic.TenthMicroRH}
+	if e != want {
+		t.Fatalf("%#v, want %#v", e, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_crc(t *testing.T) {
+	ops := append(initOps(), senseOps()...)
+	ops[4].R = []byte{0x7C, 0x82, 0x98}
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	want := "htu21d: CRC mismatch: 0x97, want 0x98"
+	if err := d.Sense(&e); !errors.Is(err, ErrCRC) || err.Error() != want {
+		t.Fatalf("%v, want %s", err, want)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(initOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(50 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(70 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetHeater(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: Addr, W: []byte{cmdReadUserReg}, R: []byte{0x02}},
+		i2ctest.IO{Addr: Addr, W: []byte{cmdWriteUserReg, 0x06}},
+		i2ctest.IO{Addr: Addr, W: []byte{cmdReadUserReg}, R: []byte{0x06}},
+		i2ctest.IO{Addr: Addr, W: []byte{cmdWriteUserReg, 0x02}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{opts: Opts{Resolution: RH8T12}}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 43*physic.MilliKelvin || e.Humidity != 4883*physic.MicroRH {
+		t.Fatal(e)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	if v := temperature(0); v != physic.ZeroCelsius-46850*physic.MilliCelsius {
+		t.Fatal(v)
+	}
+	data := []struct {
+		raw  uint16
+		want physic.RelativeHumidity
+	}{
+		{0, 0},
+		{0x8000, 565 * physic.PercentRH / 10},
+		{0xFFFC, 100 * physic.PercentRH},
+	}
+	for _, line := range data {
+		if v := humidity(line.raw); v != line.want {
+			t.Fatalf("0x%04x: %s, want %s", line.raw, v, line.want)
+		}
+	}
+	if v := compensate(99*physic.PercentRH, physic.ZeroCelsius+45*physic.Celsius); v != 100*physic.PercentRH {
+		t.Fatal(v)
+	}
+}
+
+func TestCRC8(t *testing.T) {
+	// Examples of the HTU21D datasheet.
+	data := []struct {
+		b    []byte
+		want byte
+	}{
+		{[]byte{0xDC}, 0x79},
+		{[]byte{0x68, 0x3A}, 0x7C},
+		{[]byte{0x4E, 0x85}, 0x6B},
+	}
+	for _, line := range data {
+		if c := crc8(line.b); c != line.want {
+			t.Fatalf("%#v: 0x%02x, want 0x%02x", line.b, c, line.want)
+		}
+	}
+}
+
+func TestResolution_String(t *testing.T) {
+	data := []struct {
+		r Resolution
+		s string
+	}{
+		{RH12T14, "RH12T14"},
+		{RH11T11, "RH11T11"},
+		{4, "Resolution(4)"},
+	}
+	for _, line := range data {
+		if s := line.r.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
@@ -5,6 +5,13 @@
 // Package hx711 implements an interface to the 24-bits HX711 analog to digital
 // converter.
 //
+// # More details
+//
+// The HX711 converts at 10Hz or 80Hz, as selected by its RATE pin. The channel
+// and gain are set with SetInputMode. For a load cell, Tare and Calibrate
+// record the empty scale and a known mass, then ReadMass returns the mass on
+// the scale.
+//
 // # Datasheet
 //
 // http://www.aviaic.com/Download/hx711F_EN.pdf.pdf
@@ -12,11 +19,13 @@ package hx711
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"periph.io/x/conn/v3/analog"
 	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
 	"periph.io/x/conn/v3/pin"
 )
 
@@ -24,8 +33,43 @@ var (
 	// ErrTimeout is returned from Read and ReadAveraged when the ADC took too
 	// long to indicate data was available.
 	ErrTimeout = errors.New("timed out waiting for HX711 to become ready")
+	// ErrInvalidOpts is returned when the options are not supported.
+	ErrInvalidOpts = errors.New("hx711: invalid options")
+)
+
+// Data rates, selected by the RATE pin.
+const (
+	Rate10Hz = 10 * physic.Hertz
+	Rate80Hz = 80 * physic.Hertz
 )
 
+// DefaultOpts are the options of the common breakout boards, with RATE tied
+// low.
+var DefaultOpts = Opts{
+	Rate: Rate10Hz,
+}
+
+// Opts holds the configuration options.
+//
+// Rate: data rate, Rate10Hz or Rate80Hz. The 10Hz rate rejects the 50Hz and
+// 60Hz mains noise.
+//
+// RatePin: optional pin connected to RATE, driven to select Rate. When nil,
+// Rate must match the level RATE is tied to, low for 10Hz.
+type Opts struct {
+	Rate    physic.Frequency
+	RatePin gpio.PinOut
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Rate != Rate10Hz && o.Rate != Rate80Hz {
+		return fmt.Errorf("%w: Rate %s, want 10Hz or 80Hz", ErrInvalidOpts, o.Rate)
+	}
+	return nil
+}
+
 // InputMode controls the voltage gain and the channel multiplexer on the HX711.
 // Channel A can be used with a gain of 128 or 64, and Channel B can be used
 // with a gain of 32.
@@ -44,18 +88,36 @@ type Dev struct {
 	name string
 	clk  gpio.PinOut
 	data gpio.PinIn
+	rate physic.Frequency
 
 	// Mutable.
 	mu        sync.Mutex
 	inputMode InputMode
+	cal       Calibration
 	done      chan struct{}
+	wg        sync.WaitGroup
 }
 
-// New creates a new HX711 device.
+// New creates a new HX711 device with DefaultOpts.
 //
 // The data pin must support edge detection. If your pin doesn't natively
 // support edge detection you can use PollEdge from gpioutil.
 func New(clk gpio.PinOut, data gpio.PinIn) (*Dev, error) {
+	return NewOpts(clk, data, DefaultOpts)
+}
+
+// NewOpts creates a new HX711 device.
+//
+// The data pin must support edge detection, as with New.
+func NewOpts(clk gpio.PinOut, data gpio.PinIn, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.RatePin != nil {
+		if err := opts.RatePin.Out(opts.Rate == Rate80Hz); err != nil {
+			return nil, err
+		}
+	}
 	if err := data.In(gpio.PullDown, gpio.FallingEdge); err != nil {
 		return nil, err
 	}
@@ -67,10 +129,22 @@ func New(clk gpio.PinOut, data gpio.PinIn) (*Dev, error) {
 		inputMode: CHANNEL_A_GAIN_128,
 		clk:       clk,
 		data:      data,
+		rate:      opts.Rate,
 		done:      nil,
 	}, nil
 }
 
+// Rate returns the data rate.
+func (d *Dev) Rate() physic.Frequency {
+	return d.rate
+}
+
+// timeout returns the longest wait for a conversion, the settling time of 4
+// conversions after a change of the input mode, plus a margin.
+func (d *Dev) timeout() time.Duration {
+	return 5 * d.rate.Period()
+}
+
 // String implements analog.PinADC.
 func (d *Dev) String() string {
 	return d.name
@@ -131,8 +205,8 @@ func (d *Dev) Read() (analog.Sample, error) {
 	return analog.Sample{Raw: raw}, err
 }
 
-// ReadContinuous starts reading values continuously from the ADC. It
-// returns a channel that you can use to receive these values.
+// ReadContinuous starts reading values continuously from the ADC, at the data
+// rate. It returns a channel that you can use to receive these values.
 //
 // You must call Halt to stop reading.
 //
@@ -147,16 +221,23 @@ func (d *Dev) ReadContinuous() <-chan analog.Sample {
 	done := make(chan struct{})
 	ret := make(chan analog.Sample)
 
+	d.wg.Add(1)
 	go func() {
+		defer d.wg.Done()
+		defer close(ret)
 		for {
 			select {
 			case <-done:
-				close(ret)
 				return
 			default:
-				value, err := d.ReadTimeout(time.Second)
-				if err == nil {
-					ret <- analog.Sample{Raw: value}
+				value, err := d.ReadTimeout(d.timeout())
+				if err != nil {
+					continue
+				}
+				select {
+				case ret <- analog.Sample{Raw: value}:
+				case <-done:
+					return
 				}
 			}
 		}
@@ -171,10 +252,12 @@ func (d *Dev) ReadContinuous() <-chan analog.Sample {
 // This will close the channel that was returned by ReadContinuous.
 func (d *Dev) Halt() error {
 	d.mu.Lock()
-	defer d.mu.Unlock()
-	if d.done != nil {
-		close(d.done)
-		d.done = nil
+	done := d.done
+	d.done = nil
+	d.mu.Unlock()
+	if done != nil {
+		close(done)
+		d.wg.Wait()
 	}
 	return nil
 }
@@ -190,9 +273,14 @@ func (d *Dev) IsReady() bool {
 // ADC doesn't pull its Data pin low to indicate there is data ready before the
 // timeout is reached, ErrTimeout is returned.
 func (d *Dev) ReadTimeout(timeout time.Duration) (int32, error) {
-	// Wait for the falling edge that indicates the ADC has data.
 	d.mu.Lock()
 	defer d.mu.Unlock()
+	return d.readTimeout(timeout)
+}
+
+// readTimeout must be called with d.mu held.
+func (d *Dev) readTimeout(timeout time.Duration) (int32, error) {
+	// Wait for the falling edge that indicates the ADC has data.
 	if !d.IsReady() {
 		if !d.data.WaitForEdge(timeout) {
 			return 0, ErrTimeout
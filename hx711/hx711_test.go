@@ -13,6 +13,7 @@ import (
 	"periph.io/x/conn/v3/analog"
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
 	"periph.io/x/conn/v3/pin"
 )
 
@@ -128,6 +129,69 @@ func TestReadContinuous(t *testing.T) {
 	}
 }
 
+func TestReadContinuous_values(t *testing.T) {
+	d := newFake(t, 1, 2, 3)
+	c := d.ReadContinuous()
+	for _, want := range []int32{1, 2} {
+		if s := <-c; s.Raw != want {
+			t.Fatalf("%d, want %d", s.Raw, want)
+		}
+	}
+	if d.ReadContinuous() != nil {
+		t.Fatal("expected nil")
+	}
+	// The third value is not consumed.
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	for range c {
+	}
+}
+
+func TestNewOpts(t *testing.T) {
+	clk := gpiotest.Pin{N: "clk"}
+	data := gpiotest.Pin{N: "data", EdgesChan: make(chan gpio.Level)}
+	rate := gpiotest.Pin{N: "rate"}
+	d, err := NewOpts(&clk, &data, Opts{Rate: Rate80Hz, RatePin: &rate})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate.L != gpio.High {
+		t.Fatal("RATE is low")
+	}
+	if r := d.Rate(); r != Rate80Hz {
+		t.Fatal(r)
+	}
+	if _, err := NewOpts(&clk, &data, Opts{Rate: Rate10Hz, RatePin: &rate}); err != nil {
+		t.Fatal(err)
+	}
+	if rate.L != gpio.Low {
+		t.Fatal("RATE is high")
+	}
+	if _, err := NewOpts(&clk, &data, Opts{Rate: Rate10Hz, RatePin: &failPin{}}); err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	o := Opts{Rate: 20 * physic.Hertz}
+	err := o.Validate()
+	if err == nil || err.Error() != "hx711: invalid options: Rate 20Hz, want 10Hz or 80Hz" {
+		t.Fatal(err)
+	}
+	if !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	clk := gpiotest.Pin{N: "clk"}
+	data := gpiotest.Pin{N: "data", EdgesChan: make(chan gpio.Level)}
+	if _, err := NewOpts(&clk, &data, o); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := DefaultOpts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 //
 
 type failPin struct {
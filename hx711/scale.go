@@ -0,0 +1,116 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hx711
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// ErrNotCalibrated is returned by ReadMass before the scale is calibrated.
+var ErrNotCalibrated = errors.New("hx711: scale not calibrated")
+
+// Calibration converts the raw values of a load cell to a mass.
+type Calibration struct {
+	// Offset is the raw value of the empty scale.
+	Offset int32
+	// Scale is the raw value per gram, above Offset.
+	Scale float64
+}
+
+func (c Calibration) String() string {
+	return fmt.Sprintf("Calibration{Offset:%d Scale:%g/g}", c.Offset, c.Scale)
+}
+
+// ReadAveraged reads the average of samples values, rounded.
+//
+// It waits for each conversion at the data rate, taking 100ms per sample at
+// 10Hz.
+func (d *Dev) ReadAveraged(samples int) (int32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readAveraged(samples)
+}
+
+// readAveraged must be called with d.mu held.
+func (d *Dev) readAveraged(samples int) (int32, error) {
+	if samples < 1 {
+		return 0, fmt.Errorf("hx711: %d samples, want at least 1", samples)
+	}
+	var sum int64
+	for i := 0; i < samples; i++ {
+		v, err := d.readTimeout(d.timeout())
+		if err != nil {
+			return 0, err
+		}
+		sum += int64(v)
+	}
+	return int32(math.Round(float64(sum) / float64(samples))), nil
+}
+
+// Tare sets the offset to the average of samples values, to read a mass of 0
+// with the scale empty.
+func (d *Dev) Tare(samples int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readAveraged(samples)
+	if err != nil {
+		return err
+	}
+	d.cal.Offset = v
+	return nil
+}
+
+// Calibrate sets the scale from the average of samples values, with a known
+// mass on the scale. Tare must be called first, with the scale empty.
+func (d *Dev) Calibrate(known physic.Mass, samples int) error {
+	if known <= 0 {
+		return fmt.Errorf("hx711: known mass %s, want more than 0", known)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readAveraged(samples)
+	if err != nil {
+		return err
+	}
+	if v == d.cal.Offset {
+		return fmt.Errorf("hx711: raw value %d unchanged with %s", v, known)
+	}
+	d.cal.Scale = float64(v-d.cal.Offset) * float64(physic.Gram) / float64(known)
+	return nil
+}
+
+// Calibration returns the calibration set by Tare and Calibrate, e.g. to
+// save it.
+func (d *Dev) Calibration() Calibration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.cal
+}
+
+// SetCalibration sets the calibration, e.g. as saved from Calibration.
+func (d *Dev) SetCalibration(c Calibration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cal = c
+}
+
+// ReadMass reads the mass on the scale, from the average of samples values.
+func (d *Dev) ReadMass(samples int) (physic.Mass, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cal.Scale == 0 {
+		return 0, ErrNotCalibrated
+	}
+	v, err := d.readAveraged(samples)
+	if err != nil {
+		return 0, err
+	}
+	g := float64(v-d.cal.Offset) / d.cal.Scale
+	return physic.Mass(math.Round(g * float64(physic.Gram))), nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hx711
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestReadAveraged(t *testing.T) {
+	d := newFake(t, 100, 101, 103, -5)
+	v, err := d.ReadAveraged(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 101 {
+		t.Fatal(v)
+	}
+	if v, err = d.ReadAveraged(1); err != nil || v != -5 {
+		t.Fatal(v, err)
+	}
+	if _, err := d.ReadAveraged(1); !errors.Is(err, ErrTimeout) {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadAveraged(0); err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	d := newFake(t, 1000, 1002, 999, 21000, 21001, 20999, 11000, 1000)
+	if _, err := d.ReadMass(1); !errors.Is(err, ErrNotCalibrated) {
+		t.Fatal(err)
+	}
+	if err := d.Tare(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Calibrate(100*physic.Gram, 3); err != nil {
+		t.Fatal(err)
+	}
+	c := d.Calibration()
+	if want := (Calibration{Offset: 1000, Scale: 200}); c != want {
+		t.Fatalf("%s, want %s", c, want)
+	}
+	if s := c.String(); s != "Calibration{Offset:1000 Scale:200/g}" {
+		t.Fatal(s)
+	}
+	m, err := d.ReadMass(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != 50*physic.Gram {
+		t.Fatal(m)
+	}
+	if err := d.Calibrate(100*physic.Gram, 1); err == nil {
+		t.Fatal("expected failure")
+	}
+	if err := d.Calibrate(0, 1); err == nil {
+		t.Fatal("expected failure")
+	}
+
+	d.SetCalibration(Calibration{Offset: -100, Scale: -2.5})
+	if c := d.Calibration(); c != (Calibration{Offset: -100, Scale: -2.5}) {
+		t.Fatal(c)
+	}
+}
+
+//
+
+// newFake returns a device reading the values, at 80Hz to time out quickly
+// once all are read.
+func newFake(t *testing.T, values ...int32) *Dev {
+	data := &fakeData{Pin: gpiotest.Pin{N: "data", EdgesChan: make(chan gpio.Level)}, values: values, extra: int(CHANNEL_A_GAIN_128)}
+	clk := &fakeClk{Pin: gpiotest.Pin{N: "clk"}, data: data}
+	d, err := NewOpts(clk, data, Opts{Rate: Rate80Hz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// fakeData shifts out the values, MSB first, on the rising edges of the
+// clock.
+type fakeData struct {
+	gpiotest.Pin
+	mu     sync.Mutex
+	values []int32
+	extra  int // the number of pulses after the 24 bits
+	edges  int // the number of rising edges since the start of the value
+}
+
+// Read returns low when a value is ready, then the bits of the value.
+func (f *fakeData) Read() gpio.Level {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case f.edges == 0:
+		return len(f.values) == 0
+	case f.edges <= 24:
+		return f.values[0]>>(24-f.edges)&1 != 0
+	default:
+		return gpio.Low
+	}
+}
+
+func (f *fakeData) edge() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.values) == 0 {
+		return
+	}
+	if f.edges++; f.edges == 24+f.extra {
+		f.edges = 0
+		f.values = f.values[1:]
+	}
+}
+
+type fakeClk struct {
+	gpiotest.Pin
+	data *fakeData
+}
+
+func (f *fakeClk) Out(l gpio.Level) error {
+	if l == gpio.High {
+		f.data.edge()
+	}
+	return f.Pin.Out(l)
+}
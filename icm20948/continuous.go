@@ -0,0 +1,115 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"periph.io/x/devices/v3/sensor"
+)
+
+// SenseContinuous returns a channel delivering samples every interval, until
+// Halt is called.
+//
+// An interval of 0 or less uses the magnetometer period of 10ms, or the
+// sample period with Opts.SkipMag. Samples where the magnetometer saturated
+// are skipped. At high rates, prefer Opts.FIFO and ReadFIFO. Calling
+// SenseContinuous or SenseMagneticContinuous again stops the previous channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan Sample, error) {
+	c := make(chan Sample)
+	err := d.startContinuous(interval, func(stop <-chan struct{}, s Sample) bool {
+		select {
+		case c <- s:
+			return true
+		case <-stop:
+			return false
+		}
+	}, func() { close(c) })
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SenseMagneticContinuous returns a channel delivering the magnetic field
+// every interval, like SenseContinuous. It implements sensor.Magnetometer.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	if !d.mag {
+		return nil, errNoMag
+	}
+	c := make(chan sensor.Field)
+	err := d.startContinuous(interval, func(stop <-chan struct{}, s Sample) bool {
+		select {
+		case c <- s.Mag:
+			return true
+		case <-stop:
+			return false
+		}
+	}, func() { close(c) })
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (d *Dev) startContinuous(interval time.Duration, emit func(<-chan struct{}, Sample) bool, done func()) error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	if interval <= 0 {
+		interval = d.rate.Period()
+		if d.mag {
+			interval = 10 * time.Millisecond
+		}
+	}
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer done()
+		d.sensingContinuous(interval, stop, emit)
+	}(d.stop)
+	return nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, emit func(<-chan struct{}, Sample) bool) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var s Sample
+		if err := d.Sense(&s); err != nil {
+			if errors.Is(err, ErrMagOverflow) {
+				continue
+			}
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		if !emit(stop, s) {
+			return
+		}
+	}
+}
+
+// stopContinuous stops the continuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
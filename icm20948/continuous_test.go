@@ -0,0 +1,81 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	o := newOps(config{mag: true})
+	o.read(regACCELXOUTH, data([3]int16{}, [3]int16{}, 0, []int16{1, 0, 0}, magHOFL)...)
+	o.read(regACCELXOUTH, data([3]int16{}, [3]int16{}, 0, []int16{100, 0, 0}, 0)...)
+	o.write(regPWRMGMT1, pwrSleep)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The overflowed sample is skipped.
+	if f := <-c; f.X != 15*physic.MicroTesla {
+		t.Fatalf("got %s", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	o := newOps(config{})
+	o.read(regACCELXOUTH, data([3]int16{1, 2, 3}, [3]int16{}, 0, nil, 0)...)
+	o.read(regACCELXOUTH, data([3]int16{4, 5, 6}, [3]int16{}, 0, nil, 0)...)
+	o.write(regPWRMGMT1, pwrSleep)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{SkipMag: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-c; s.RawAccel != [3]int16{1, 2, 3} {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	// Restarting stops the previous channel.
+	c2, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("previous channel not closed")
+	}
+	if s := <-c2; s.RawAccel != [3]int16{4, 5, 6} {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c2; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
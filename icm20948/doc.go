@@ -0,0 +1,34 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package icm20948 controls a TDK InvenSense ICM-20948 9-axis IMU over I²C.
+//
+// # More details
+//
+// The ICM-20948 is the successor of the MPU-9250. It combines a 3-axis
+// accelerometer, a 3-axis gyroscope and an AK09916 magnetometer on an
+// auxiliary I²C bus. Its registers are split in four banks selected by
+// REG_BANK_SEL; the driver tracks the selected bank and only switches when
+// needed.
+//
+// New configures the I²C master of the ICM-20948 to read the AK09916
+// continuously at 100Hz into its external sensor data registers, so Sense
+// returns the accelerometer, gyroscope, temperature and magnetometer data in
+// a single burst. Set Opts.SkipMag to leave the I²C master disabled, e.g. to
+// use the ak09916 package in bypass mode instead.
+//
+// The magnetometer axes are those of the AK09916: X is aligned with the
+// accelerometer X axis, Y and Z are opposite to the accelerometer Y and Z
+// axes.
+//
+// With Opts.FIFO, the accelerometer and gyroscope samples are also queued in
+// the FIFO, which ReadFIFO drains in batches.
+//
+// The digital motion processor isn't used: the driver doesn't load its
+// firmware and all the processing happens on the host.
+//
+// # Datasheet
+//
+// https://invensense.tdk.com/wp-content/uploads/2016/06/DS-000189-ICM-20948-v1.3.pdf
+package icm20948
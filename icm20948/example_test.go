@@ -0,0 +1,40 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/icm20948"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := icm20948.New(bus, icm20948.Opts{AccelRange: 4, GyroRange: 500, GyroBandwidthHz: 51, SampleRateDivider: 10})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	var s icm20948.Sample
+	if err := d.Sense(&s); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("accel %v m/s², gyro %v rad/s, mag %s, %s\n", s.Accel, s.Gyro, s.Mag, s.Temperature)
+}
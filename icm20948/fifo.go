@@ -0,0 +1,107 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"errors"
+	"time"
+
+	"periph.io/x/devices/v3/sensor"
+)
+
+// FIFOLen returns the number of complete samples queued in the FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	return d.fifoLen()
+}
+
+// ReadFIFO reads up to len(s) samples from the FIFO, oldest first, and
+// returns the number read. It requires Opts.FIFO.
+//
+// The samples have no temperature nor magnetic field. Their timestamps are
+// estimated from the sample rate, the newest one being the time of the read.
+//
+// When the FIFO overflowed since the last call, it is reset and ReadFIFO
+// returns ErrFIFOOverflow instead, as the oldest samples were lost.
+func (d *Dev) ReadFIFO(s []Sample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	var st [1]byte
+	if err := d.readRegs(regINTSTATUS2, st[:]); err != nil {
+		return 0, err
+	}
+	if st[0]&intFIFO0 != 0 {
+		if err := d.resetFIFO(); err != nil {
+			return 0, err
+		}
+		return 0, ErrFIFOOverflow
+	}
+	n, err := d.fifoLen()
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	n = min(n, len(s))
+	b := d.fifoBuf[:n*fifoSampleLen]
+	if err := d.readRegs(regFIFORW, b); err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	period := d.rate.Period()
+	for i := range s[:n] {
+		f := b[i*fifoSampleLen:]
+		d.decode(&s[i], f[:6], f[6:12])
+		s[i].Temperature = 0
+		s[i].Mag = sensor.Field{}
+		s[i].RawMag = [3]int16{}
+		s[i].Timestamp = now.Add(-time.Duration(n-1-i) * period)
+	}
+	return n, nil
+}
+
+// ResetFIFO discards the samples queued in the FIFO. It requires Opts.FIFO.
+func (d *Dev) ResetFIFO() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return err
+	}
+	return d.resetFIFO()
+}
+
+// resetFIFO asserts then releases the reset of the FIFO.
+func (d *Dev) resetFIFO() error {
+	if err := d.writeRegs(regFIFORST, fifoRstAll); err != nil {
+		return err
+	}
+	return d.writeRegs(regFIFORST, 0)
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+func (d *Dev) fifoLen() (int, error) {
+	var b [2]byte
+	if err := d.readRegs(regFIFOCOUNTH, b[:]); err != nil {
+		return 0, err
+	}
+	// FIFO_CNT is 13 bits.
+	return (int(b[0]&0x1F)<<8 | int(b[1])) / fifoSampleLen, nil
+}
+
+var errFIFODisabled = errors.New("icm20948: FIFO not enabled in Opts")
@@ -0,0 +1,82 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestReadFIFO(t *testing.T) {
+	o := newOps(config{div: 10, gyro: fchoice, fifo: true})
+	// 25 bytes queued, so 2 complete samples.
+	o.read(regFIFOCOUNTH, 0xE0, 25)
+	o.read(regINTSTATUS2, 0)
+	o.read(regFIFOCOUNTH, 0, 25)
+	s0 := data([3]int16{16384, 0, 0}, [3]int16{131, 0, 0}, 0, nil, 0)[:12]
+	s1 := data([3]int16{0, -16384, 0}, [3]int16{0, 0, -131}, 0, nil, 0)[:12]
+	o.read(regFIFORW, append(s0, s1...)...)
+	o.read(regINTSTATUS2, 0)
+	o.read(regFIFOCOUNTH, 0, 0)
+	o.read(regINTSTATUS2, intFIFO0)
+	o.write(regFIFORST, fifoRstAll)
+	o.write(regFIFORST, 0)
+	o.write(regFIFORST, fifoRstAll)
+	o.write(regFIFORST, 0)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{GyroBandwidthHz: 197, SampleRateDivider: 10, FIFO: true, SkipMag: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 2 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]Sample, 4)
+	n, err := d.ReadFIFO(s)
+	if err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if s[0].RawAccel != [3]int16{16384, 0, 0} || s[1].RawGyro != [3]int16{0, 0, -131} {
+		t.Fatalf("ReadFIFO() = %v", s[:n])
+	}
+	if diff := s[1].Timestamp.Sub(s[0].Timestamp); diff != d.rate.Period() {
+		t.Fatalf("timestamps %s apart, want %s", diff, d.rate.Period())
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if _, err := d.ReadFIFO(s); !errors.Is(err, ErrFIFOOverflow) {
+		t.Fatalf("ReadFIFO() = %v, want ErrFIFOOverflow", err)
+	}
+	if err := d.ResetFIFO(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	o := newOps(config{})
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{SkipMag: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	if _, err := d.FIFOLen(); err != errFIFODisabled {
+		t.Fatalf("FIFOLen() = %v", err)
+	}
+	if err := d.ResetFIFO(); err != errFIFODisabled {
+		t.Fatalf("ResetFIFO() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
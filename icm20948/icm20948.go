@@ -0,0 +1,470 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address with AD0 low; AltAddr is used with AD0
+// high.
+const (
+	DefaultAddr = 0x68
+	AltAddr     = 0x69
+)
+
+// register is a register address in a user bank, bank<<8 | address.
+type register uint16
+
+func (r register) bank() byte { return byte(r >> 8) }
+func (r register) addr() byte { return byte(r) }
+
+// Register map.
+const (
+	// User bank 0.
+	regWHOAMI     register = 0x000
+	regUSERCTRL   register = 0x003
+	regPWRMGMT1   register = 0x006
+	regINTENABLE2 register = 0x012
+	regMSTSTATUS  register = 0x017 // I2C_MST_STATUS
+	regINTSTATUS2 register = 0x01B // FIFO_OVERFLOW_INT bits 4..0
+	regACCELXOUTH register = 0x02D // accelerometer, gyroscope, temperature, MSB first
+	regFIFOEN2    register = 0x067
+	regFIFORST    register = 0x068
+	regFIFOCOUNTH register = 0x070
+	regFIFORW     register = 0x072
+	regREGBANKSEL          = 0x7F // in all the banks
+	// User bank 2.
+	regGYROSMPLRTDIV  register = 0x200 // followed by GYRO_CONFIG_1
+	regODRALIGNEN     register = 0x209
+	regACCELSMPLRTDIV register = 0x210 // ACCEL_SMPLRT_DIV_1 and _2
+	regACCELCONFIG    register = 0x214
+	// User bank 3.
+	regMSTODRCONFIG register = 0x300 // followed by I2C_MST_CTRL
+	regSLV0ADDR     register = 0x303 // followed by I2C_SLV0_REG and I2C_SLV0_CTRL
+	regSLV4ADDR     register = 0x313 // followed by I2C_SLV4_REG and I2C_SLV4_CTRL
+	regSLV4DO       register = 0x316
+	regSLV4DI       register = 0x317
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0xEA
+
+// Register values.
+const (
+	userFIFOEN     = 0x40
+	userI2CMSTEN   = 0x20
+	pwrDeviceReset = 0x80
+	pwrSleep       = 0x40
+	pwrClkAuto     = 0x01 // best available clock
+	fifoAccelGyro  = 0x1E // ACCEL and GYRO_X/Y/Z
+	fifoRstAll     = 0x1F
+	intFIFO0       = 0x01 // FIFO 0 overflow
+	fchoice        = 0x01 // enables the DLPF
+)
+
+// Timings, rounded up.
+const (
+	resetTime = 100 * time.Millisecond
+)
+
+// Sizes of the data blocks.
+const (
+	imuLen        = 14 // accelerometer, gyroscope and temperature
+	magLen        = 9  // AK09916 ST1 to ST2
+	fifoSampleLen = 12
+	fifoSize      = 4096
+)
+
+// Nominal output rates of the gyroscope and accelerometer with the DLPF
+// enabled.
+const (
+	gyroRate  = 1100 * physic.Hertz
+	accelRate = 1125 * physic.Hertz
+)
+
+// standardGravity is in m/s² per g.
+const standardGravity = 9.80665
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when WHO_AM_I doesn't read 0xEA, or the
+	// AK09916 doesn't identify itself.
+	ErrBadID = errors.New("icm20948: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("icm20948: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("icm20948: invalid options")
+	// ErrMagOverflow is returned along with the sample when the magnetometer
+	// saturated; the magnetic field must be discarded.
+	ErrMagOverflow = errors.New("icm20948: magnetometer overflow")
+	// ErrFIFOOverflow is returned by ReadFIFO when samples were lost because
+	// the FIFO was full. The FIFO is reset.
+	ErrFIFOOverflow = errors.New("icm20948: FIFO overflow")
+	// ErrAuxNAK is returned when the AK09916 didn't acknowledge a transfer on
+	// the auxiliary bus.
+	ErrAuxNAK = errors.New("icm20948: NAK on the auxiliary bus")
+)
+
+// Opts holds initialization options.
+//
+// AccelRange: accelerometer full scale in g, 2 (default), 4, 8 or 16.
+// GyroRange: gyroscope full scale in °/s, 250 (default), 500, 1000 or 2000.
+// AccelBandwidthHz: accelerometer DLPF 3dB bandwidth, 473, 246, 111, 50, 24,
+// 12 or 6Hz. 0 (default) bypasses the DLPF, sampling at 4.5kHz.
+// GyroBandwidthHz: gyroscope DLPF 3dB bandwidth, 361, 197, 152, 120, 51, 24,
+// 12 or 6Hz. 0 (default) bypasses the DLPF, sampling at 9kHz.
+// SampleRateDivider: with the DLPF enabled, the accelerometer samples at
+// 1.125kHz and the gyroscope at 1.1kHz divided by 1 + SampleRateDivider.
+// FIFO: queue the accelerometer and gyroscope samples in the FIFO, read with
+// ReadFIFO.
+// SkipMag: don't set up the I²C master to read the AK09916.
+// Addr: I²C address, DefaultAddr by default.
+type Opts struct {
+	AccelRange        int
+	GyroRange         int
+	AccelBandwidthHz  int
+	GyroBandwidthHz   int
+	SampleRateDivider uint8
+	FIFO              bool
+	SkipMag           bool
+	Addr              uint16
+}
+
+// accelDLPF and gyroDLPF are the bandwidths by DLPFCFG value.
+var (
+	accelDLPF = []int{0, 246, 111, 50, 24, 12, 6, 473} // 0 duplicates 1
+	gyroDLPF  = []int{197, 152, 120, 51, 24, 12, 6, 361}
+)
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if fullScale(o.AccelRange, 2) < 0 {
+		return fmt.Errorf("%w: AccelRange %d, want 2, 4, 8 or 16", ErrInvalidOpts, o.AccelRange)
+	}
+	if fullScale(o.GyroRange, 250) < 0 {
+		return fmt.Errorf("%w: GyroRange %d, want 250, 500, 1000 or 2000", ErrInvalidOpts, o.GyroRange)
+	}
+	if dlpfConfig(o.AccelBandwidthHz, accelDLPF) < 0 {
+		return fmt.Errorf("%w: AccelBandwidthHz %d, want 473, 246, 111, 50, 24, 12 or 6", ErrInvalidOpts, o.AccelBandwidthHz)
+	}
+	if dlpfConfig(o.GyroBandwidthHz, gyroDLPF) < 0 {
+		return fmt.Errorf("%w: GyroBandwidthHz %d, want 361, 197, 152, 120, 51, 24, 12 or 6", ErrInvalidOpts, o.GyroBandwidthHz)
+	}
+	return nil
+}
+
+// fullScale returns FS_SEL for a range that is min times a power of 2 up to
+// 8, or -1. 0 selects min.
+func fullScale(v, min int) int {
+	if v == 0 {
+		return 0
+	}
+	for fs := 0; fs < 4; fs++ {
+		if v == min<<fs {
+			return fs
+		}
+	}
+	return -1
+}
+
+// dlpfConfig returns the value of the DLPF configuration bits for bw, with
+// FCHOICE, or -1. 0 bypasses the DLPF.
+func dlpfConfig(bw int, table []int) int {
+	if bw == 0 {
+		return 0
+	}
+	for i, v := range table {
+		if v == bw {
+			return i<<3 | fchoice
+		}
+	}
+	return -1
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Gyro is the angular rate in rad/s, in X,Y,Z order.
+	Gyro [3]float64
+	// Mag is the magnetic field, in the axes of the AK09916. It is zero with
+	// Opts.SkipMag and for samples read from the FIFO.
+	Mag sensor.Field
+	// Temperature is the die temperature. It is 0 for samples read from the
+	// FIFO, which doesn't hold it.
+	Temperature physic.Temperature
+	// RawAccel, RawGyro and RawMag are the counts the values were computed
+	// from.
+	RawAccel, RawGyro, RawMag [3]int16
+	// Timestamp is the time at which the sample was read from the device, or
+	// estimated from the sample rate for samples read from the FIFO.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s² gyro=%.4f,%.4f,%.4frad/s mag=%s",
+		s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2], s.Mag)
+}
+
+// Dev represents an ICM-20948 device.
+// Sense returns values in m/s², rad/s and nT.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c          conn.Conn
+	bank       int // selected user bank, -1 when unknown
+	accelRange int
+	gyroRange  int
+	accelLSB   float64 // LSB per m/s²
+	gyroLSB    float64 // LSB per rad/s
+	rate       physic.Frequency
+	mag        bool
+	fifo       bool
+	halted     bool
+	data       [imuLen + magLen]byte
+	fifoBuf    [fifoSize]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	afs, gfs := fullScale(opts.AccelRange, 2), fullScale(opts.GyroRange, 250)
+	acfg := dlpfConfig(opts.AccelBandwidthHz, accelDLPF)
+	gcfg := dlpfConfig(opts.GyroBandwidthHz, gyroDLPF)
+	rate := 9 * physic.KiloHertz
+	if gcfg != 0 {
+		rate = gyroRate / physic.Frequency(1+int(opts.SampleRateDivider))
+	}
+	d := &Dev{
+		c:          &i2c.Dev{Addr: addr, Bus: bus},
+		bank:       -1,
+		accelRange: 2 << afs,
+		gyroRange:  250 << gfs,
+		accelLSB:   float64(int(16384)>>afs) / standardGravity,
+		// 131 LSB/(°/s) at ±250°/s.
+		gyroLSB: 131 / float64(int(1)<<gfs) * 180 / math.Pi,
+		rate:    rate,
+		mag:     !opts.SkipMag,
+		fifo:    opts.FIFO,
+	}
+	var id [1]byte
+	if err := d.readRegs(regWHOAMI, id[:]); err != nil {
+		return nil, err
+	}
+	if id[0] != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id[0], whoAmI)
+	}
+	if err := d.writeRegs(regPWRMGMT1, pwrDeviceReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// The reset selected bank 0.
+	d.bank = 0
+	if err := d.writeRegs(regPWRMGMT1, pwrClkAuto); err != nil {
+		return nil, err
+	}
+	div := opts.SampleRateDivider
+	if err := d.writeRegs(regGYROSMPLRTDIV, div, byte(gcfg|gfs<<1)); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regODRALIGNEN, 0x01); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regACCELSMPLRTDIV, 0, div); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regACCELCONFIG, byte(acfg|afs<<1)); err != nil {
+		return nil, err
+	}
+	var user byte
+	if opts.FIFO {
+		user |= userFIFOEN
+		if err := d.writeRegs(regINTENABLE2, intFIFO0); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regFIFOEN2, fifoAccelGyro); err != nil {
+			return nil, err
+		}
+		if err := d.resetFIFO(); err != nil {
+			return nil, err
+		}
+	}
+	if d.mag {
+		user |= userI2CMSTEN
+	}
+	if err := d.writeRegs(regUSERCTRL, user); err != nil {
+		return nil, err
+	}
+	if d.mag {
+		if err := d.initMag(); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("ICM20948{%s, ±%dg, ±%d°/s, %s}", d.c, d.accelRange, d.gyroRange, d.rate)
+}
+
+// SampleRate returns the rate at which the gyroscope samples.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.rate
+}
+
+// Halt stops SenseContinuous and puts the device to sleep. It implements
+// conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil
+	}
+	if err := d.writeRegs(regPWRMGMT1, pwrSleep); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns the latest accelerometer, gyroscope and magnetometer
+// counts, in X,Y,Z order. The magnetometer counts are 0 with Opts.SkipMag.
+func (d *Dev) SenseRaw() ([3]int16, [3]int16, [3]int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.sense(&s)
+	return s.RawAccel, s.RawGyro, s.RawMag, err
+}
+
+// Sense reads the latest sample into s.
+//
+// When the magnetometer saturated, s is filled in and ErrMagOverflow is
+// returned. It doesn't allocate memory, except to report errors, so it can be
+// called at high rates without causing garbage collection.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(s)
+}
+
+func (d *Dev) sense(s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	b := d.data[:imuLen]
+	if d.mag {
+		b = d.data[:]
+	}
+	if err := d.readRegs(regACCELXOUTH, b); err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	d.decode(s, b[:6], b[6:12])
+	t := int16(b[12])<<8 | int16(b[13])
+	// °C = raw / 333.87 + 21
+	s.Temperature = physic.ZeroCelsius + physic.Temperature(math.Round((float64(t)/333.87+21)*float64(physic.Kelvin)))
+	s.RawMag = [3]int16{}
+	s.Mag = sensor.Field{}
+	if !d.mag {
+		return nil
+	}
+	return decodeMag(s, b[imuLen:])
+}
+
+// decode scales the big-endian accelerometer and gyroscope counts into s.
+func (d *Dev) decode(s *Sample, accel, gyro []byte) {
+	for i := 0; i < 3; i++ {
+		s.RawAccel[i] = int16(accel[2*i])<<8 | int16(accel[2*i+1])
+		s.RawGyro[i] = int16(gyro[2*i])<<8 | int16(gyro[2*i+1])
+		s.Accel[i] = float64(s.RawAccel[i]) / d.accelLSB
+		s.Gyro[i] = float64(s.RawGyro[i]) / d.gyroLSB
+	}
+}
+
+// SenseMagnetic reads the latest magnetic field. It implements
+// sensor.Magnetometer.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	if !d.mag {
+		return errNoMag
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		return err
+	}
+	*f = s.Mag
+	return nil
+}
+
+// selectBank writes REG_BANK_SEL when the bank of r isn't selected.
+func (d *Dev) selectBank(r register) error {
+	if d.bank == int(r.bank()) {
+		return nil
+	}
+	if err := d.c.Tx([]byte{regREGBANKSEL, r.bank() << 4}, nil); err != nil {
+		d.bank = -1
+		return fmt.Errorf("icm20948: selecting bank %d: %w", r.bank(), err)
+	}
+	d.bank = int(r.bank())
+	return nil
+}
+
+// readRegs reads consecutive registers starting at r.
+func (d *Dev) readRegs(r register, out []byte) error {
+	if err := d.selectBank(r); err != nil {
+		return err
+	}
+	if err := d.c.Tx([]byte{r.addr()}, out); err != nil {
+		return fmt.Errorf("icm20948: reading register %d:0x%02x: %w", r.bank(), r.addr(), err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at r.
+func (d *Dev) writeRegs(r register, v ...byte) error {
+	if err := d.selectBank(r); err != nil {
+		return err
+	}
+	if err := d.c.Tx(append([]byte{r.addr()}, v...), nil); err != nil {
+		return fmt.Errorf("icm20948: writing register %d:0x%02x: %w", r.bank(), r.addr(), err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var errNoMag = errors.New("icm20948: magnetometer disabled by Opts.SkipMag")
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
@@ -0,0 +1,323 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// ops builds the expected bus transactions, tracking the selected bank.
+type ops struct {
+	io   []i2ctest.IO
+	bank int
+}
+
+func (o *ops) sel(r register) {
+	if o.bank != int(r.bank()) {
+		o.io = append(o.io, i2ctest.IO{Addr: DefaultAddr, W: []byte{regREGBANKSEL, r.bank() << 4}})
+		o.bank = int(r.bank())
+	}
+}
+
+func (o *ops) write(r register, v ...byte) {
+	o.sel(r)
+	o.io = append(o.io, i2ctest.IO{Addr: DefaultAddr, W: append([]byte{r.addr()}, v...)})
+}
+
+func (o *ops) read(r register, v ...byte) {
+	o.sel(r)
+	o.io = append(o.io, i2ctest.IO{Addr: DefaultAddr, W: []byte{r.addr()}, R: v})
+}
+
+// config holds the values written by New.
+type config struct {
+	div, gyro, accel byte
+	fifo, mag        bool
+}
+
+// newOps returns the bus transactions issued by New.
+func newOps(c config) *ops {
+	o := &ops{bank: -1}
+	o.read(regWHOAMI, whoAmI)
+	o.write(regPWRMGMT1, pwrDeviceReset)
+	o.write(regPWRMGMT1, pwrClkAuto)
+	o.write(regGYROSMPLRTDIV, c.div, c.gyro)
+	o.write(regODRALIGNEN, 0x01)
+	o.write(regACCELSMPLRTDIV, 0, c.div)
+	o.write(regACCELCONFIG, c.accel)
+	var user byte
+	if c.fifo {
+		user |= userFIFOEN
+		o.write(regINTENABLE2, intFIFO0)
+		o.write(regFIFOEN2, fifoAccelGyro)
+		o.write(regFIFORST, fifoRstAll)
+		o.write(regFIFORST, 0)
+	}
+	if c.mag {
+		user |= userI2CMSTEN
+	}
+	o.write(regUSERCTRL, user)
+	if c.mag {
+		o.write(regMSTODRCONFIG, mstODR137Hz, mstClk345kHz)
+		o.auxWrite(magCNTL3, magSRST)
+		o.auxRead(magWIA2, magDeviceID)
+		o.auxWrite(magCNTL2, magMode100Hz)
+		o.write(regSLV0ADDR, magAddr|auxRead, magST1, auxEnable|magLen)
+	}
+	return o
+}
+
+func (o *ops) auxWrite(reg, v byte) {
+	o.write(regSLV4DO, v)
+	o.write(regSLV4ADDR, magAddr, reg, auxEnable)
+	o.read(regMSTSTATUS, mstSLV4Done)
+}
+
+func (o *ops) auxRead(reg, v byte) {
+	o.write(regSLV4ADDR, magAddr|auxRead, reg, auxEnable)
+	o.read(regMSTSTATUS, mstSLV4Done)
+	o.read(regSLV4DI, v)
+}
+
+// data returns the data registers, with the magnetometer block when mag is
+// not nil.
+func data(accel [3]int16, gyro [3]int16, temp int16, mag []int16, st2 byte) []byte {
+	var b []byte
+	for _, v := range append(append(accel[:], gyro[:]...), temp) {
+		b = append(b, byte(uint16(v)>>8), byte(v))
+	}
+	if mag != nil {
+		b = append(b, 0x01)
+		for _, v := range mag {
+			b = append(b, byte(v), byte(uint16(v)>>8))
+		}
+		b = append(b, 0, st2)
+	}
+	return b
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		c    config
+		s    string
+	}{
+		{Opts{SkipMag: true}, config{}, "ICM20948{playback(104), ±2g, ±250°/s, 9kHz}"},
+		{Opts{}, config{mag: true}, "ICM20948{playback(104), ±2g, ±250°/s, 9kHz}"},
+		{
+			Opts{AccelRange: 8, GyroRange: 1000, AccelBandwidthHz: 50, GyroBandwidthHz: 51, SampleRateDivider: 10, SkipMag: true},
+			config{div: 10, gyro: 3<<3 | 2<<1 | fchoice, accel: 3<<3 | 2<<1 | fchoice},
+			"ICM20948{playback(104), ±8g, ±1000°/s, 100Hz}",
+		},
+		{Opts{GyroBandwidthHz: 197, FIFO: true}, config{gyro: fchoice, fifo: true, mag: true}, "ICM20948{playback(104), ±2g, ±250°/s, 1.100kHz}"},
+	}
+	for i, line := range data {
+		o := newOps(line.c)
+		bus := &i2ctest.Playback{Ops: o.io}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	o := &ops{bank: -1}
+	o.read(regWHOAMI, 0x71)
+	if _, err := New(&i2ctest.Playback{Ops: o.io}, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+
+	// The AK09916 doesn't answer as expected.
+	o = newOps(config{})
+	o.io = o.io[:len(o.io)-1]
+	o.write(regUSERCTRL, userI2CMSTEN)
+	o.write(regMSTODRCONFIG, mstODR137Hz, mstClk345kHz)
+	o.auxWrite(magCNTL3, magSRST)
+	o.auxRead(magWIA2, 0x48)
+	if _, err := New(&i2ctest.Playback{Ops: o.io}, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{AccelRange: 16, GyroRange: 2000, AccelBandwidthHz: 473, GyroBandwidthHz: 6}, ""},
+		{Opts{AccelRange: 32}, "icm20948: invalid options: AccelRange 32, want 2, 4, 8 or 16"},
+		{Opts{GyroRange: 125}, "icm20948: invalid options: GyroRange 125, want 250, 500, 1000 or 2000"},
+		{Opts{AccelBandwidthHz: 197}, "icm20948: invalid options: AccelBandwidthHz 197, want 473, 246, 111, 50, 24, 12 or 6"},
+		{Opts{GyroBandwidthHz: 246}, "icm20948: invalid options: GyroBandwidthHz 246, want 361, 197, 152, 120, 51, 24, 12 or 6"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{GyroRange: 125}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	o := newOps(config{mag: true})
+	o.read(regACCELXOUTH, data([3]int16{16384, -8192, 0}, [3]int16{131, -262, 0}, 3339, []int16{100, -200, 300}, 0)...)
+	o.read(regACCELXOUTH, data([3]int16{1, 2, 3}, [3]int16{-4, -5, -6}, 0, []int16{7, 8, 9}, 0)...)
+	o.read(regACCELXOUTH, data([3]int16{}, [3]int16{}, 0, []int16{-10, 0, 0}, 0)...)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 16384 LSB/g and 131 LSB/(°/s).
+	want := [6]float64{standardGravity, -standardGravity / 2, 0, math.Pi / 180, -math.Pi / 90, 0}
+	got := [6]float64{s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2]}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Sense() = %s, want %v", s, want)
+		}
+	}
+	// 0.15µT/LSB.
+	if want := (sensor.Field{X: 15 * physic.MicroTesla, Y: -30 * physic.MicroTesla, Z: 45 * physic.MicroTesla}); s.Mag != want {
+		t.Fatalf("Mag = %s, want %s", s.Mag, want)
+	}
+	// 3339 / 333.87 + 21
+	if want := physic.ZeroCelsius + 31001*physic.MilliKelvin; math.Abs(float64(s.Temperature-want)) > float64(physic.MilliKelvin) {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	a, g, m, err := d.SenseRaw()
+	if err != nil || a != [3]int16{1, 2, 3} || g != [3]int16{-4, -5, -6} || m != [3]int16{7, 8, 9} {
+		t.Fatalf("SenseRaw() = %v, %v, %v, %v", a, g, m, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil || f.X != -1500*physic.NanoTesla {
+		t.Fatalf("SenseMagnetic() = %s, %v", f, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_SkipMag(t *testing.T) {
+	o := newOps(config{accel: 1<<3 | 3<<1 | fchoice, gyro: 3 << 1})
+	o.read(regACCELXOUTH, data([3]int16{2048, 0, 0}, [3]int16{-164, 0, 0}, 0, nil, 0)...)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{AccelRange: 16, GyroRange: 2000, AccelBandwidthHz: 246, SkipMag: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 2048 LSB/g and 16.375 LSB/(°/s).
+	if math.Abs(s.Accel[0]-standardGravity) > 1e-9 {
+		t.Fatalf("Accel = %v", s.Accel)
+	}
+	if want := -164 / 16.375 * math.Pi / 180; math.Abs(s.Gyro[0]-want) > 1e-9 {
+		t.Fatalf("Gyro = %v, want %g", s.Gyro, want)
+	}
+	if s.Mag != (sensor.Field{}) {
+		t.Fatalf("Mag = %s", s.Mag)
+	}
+	if err := d.SenseMagnetic(&sensor.Field{}); err != errNoMag {
+		t.Fatalf("SenseMagnetic() = %v", err)
+	}
+	if _, err := d.SenseMagneticContinuous(0); err != errNoMag {
+		t.Fatalf("SenseMagneticContinuous() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_MagOverflow(t *testing.T) {
+	o := newOps(config{mag: true})
+	o.read(regACCELXOUTH, data([3]int16{}, [3]int16{}, 0, []int16{4900, 0, 0}, magHOFL)...)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); !errors.Is(err, ErrMagOverflow) || s.RawMag[0] != 4900 {
+		t.Fatalf("Sense() = %v, %v, want ErrMagOverflow", s.RawMag, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	o := newOps(config{mag: true})
+	o.write(regPWRMGMT1, pwrSleep)
+	bus := &i2ctest.Playback{Ops: o.io}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	o := newOps(config{})
+	bus = &i2ctest.Playback{Ops: o.io, DontPanic: true}
+	d, err := New(bus, Opts{SkipMag: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
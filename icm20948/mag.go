@@ -0,0 +1,107 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// AK09916 on the auxiliary bus.
+const (
+	magAddr        = 0x0C
+	magWIA2        = 0x01
+	magST1         = 0x10 // ST1, HXL to HZH, TMPS, ST2
+	magCNTL2       = 0x31
+	magCNTL3       = 0x32
+	magDeviceID    = 0x09
+	magMode100Hz   = 0x08
+	magSRST        = 0x01
+	magHOFL        = 0x08 // in ST2
+	magNanoTesla   = 150  // per LSB
+	magResetTime   = time.Millisecond
+	auxRead        = 0x80 // in I2C_SLVx_ADDR
+	auxEnable      = 0x80 // in I2C_SLVx_CTRL
+	mstSLV4Done    = 0x40 // in I2C_MST_STATUS
+	mstSLV4NACK    = 0x10
+	mstODR137Hz    = 0x03 // 1.1kHz / 2^3
+	mstClk345kHz   = 0x07 // recommended I2C_MST_CLK
+	auxPollRetries = 10
+)
+
+// initMag configures the I²C master for the AK09916, starts its 100Hz
+// continuous mode and has slave 0 read it into EXT_SLV_SENS_DATA_00.
+func (d *Dev) initMag() error {
+	if err := d.writeRegs(regMSTODRCONFIG, mstODR137Hz, mstClk345kHz); err != nil {
+		return err
+	}
+	if _, err := d.auxTx(magAddr, magCNTL3, magSRST); err != nil {
+		return err
+	}
+	doSleep(magResetTime)
+	id, err := d.auxTx(magAddr|auxRead, magWIA2, 0)
+	if err != nil {
+		return err
+	}
+	if id != magDeviceID {
+		return fmt.Errorf("%w: AK09916 WIA2 read %#02x, want %#02x", ErrBadID, id, magDeviceID)
+	}
+	if _, err := d.auxTx(magAddr, magCNTL2, magMode100Hz); err != nil {
+		return err
+	}
+	return d.writeRegs(regSLV0ADDR, magAddr|auxRead, magST1, auxEnable|magLen)
+}
+
+// auxTx runs a single byte transfer on the auxiliary bus with slave 4. addr
+// has auxRead set for reads, which return the byte read.
+func (d *Dev) auxTx(addr, reg, v byte) (byte, error) {
+	if addr&auxRead == 0 {
+		if err := d.writeRegs(regSLV4DO, v); err != nil {
+			return 0, err
+		}
+	}
+	if err := d.writeRegs(regSLV4ADDR, addr, reg, auxEnable); err != nil {
+		return 0, err
+	}
+	var s [1]byte
+	for i := 0; ; i++ {
+		if err := d.readRegs(regMSTSTATUS, s[:]); err != nil {
+			return 0, err
+		}
+		if s[0]&mstSLV4NACK != 0 {
+			return 0, fmt.Errorf("%w: register 0x%02x", ErrAuxNAK, reg)
+		}
+		if s[0]&mstSLV4Done != 0 {
+			break
+		}
+		if i == auxPollRetries {
+			return 0, fmt.Errorf("icm20948: auxiliary transfer of register 0x%02x timed out", reg)
+		}
+		doSleep(time.Millisecond)
+	}
+	if addr&auxRead == 0 {
+		return 0, nil
+	}
+	if err := d.readRegs(regSLV4DI, s[:]); err != nil {
+		return 0, err
+	}
+	return s[0], nil
+}
+
+// decodeMag decodes ST1 to ST2 of the AK09916 into s.
+func decodeMag(s *Sample, b []byte) error {
+	for i := 0; i < 3; i++ {
+		s.RawMag[i] = int16(b[2+2*i])<<8 | int16(b[1+2*i])
+	}
+	s.Mag.X = physic.MagneticFluxDensity(s.RawMag[0]) * magNanoTesla * physic.NanoTesla
+	s.Mag.Y = physic.MagneticFluxDensity(s.RawMag[1]) * magNanoTesla * physic.NanoTesla
+	s.Mag.Z = physic.MagneticFluxDensity(s.RawMag[2]) * magNanoTesla * physic.NanoTesla
+	if b[8]&magHOFL != 0 {
+		return ErrMagOverflow
+	}
+	return nil
+}
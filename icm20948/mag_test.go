@@ -0,0 +1,64 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm20948
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// magInitOps returns the bus transactions of New up to the reset of the
+// AK09916, which is left to the caller.
+func magInitOps() *ops {
+	o := newOps(config{})
+	o.io = o.io[:len(o.io)-1]
+	o.write(regUSERCTRL, userI2CMSTEN)
+	o.write(regMSTODRCONFIG, mstODR137Hz, mstClk345kHz)
+	o.write(regSLV4DO, magSRST)
+	o.write(regSLV4ADDR, magAddr, magCNTL3, auxEnable)
+	return o
+}
+
+func TestAuxTx_NAK(t *testing.T) {
+	o := magInitOps()
+	o.read(regMSTSTATUS, 0)
+	o.read(regMSTSTATUS, mstSLV4NACK)
+	bus := &i2ctest.Playback{Ops: o.io}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrAuxNAK) || err.Error() != "icm20948: NAK on the auxiliary bus: register 0x32" {
+		t.Fatalf("New() = %v, want ErrAuxNAK", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuxTx_Timeout(t *testing.T) {
+	o := magInitOps()
+	for i := 0; i <= auxPollRetries; i++ {
+		o.read(regMSTSTATUS, 0)
+	}
+	bus := &i2ctest.Playback{Ops: o.io}
+	if _, err := New(bus, Opts{}); err == nil || err.Error() != "icm20948: auxiliary transfer of register 0x32 timed out" {
+		t.Fatalf("New() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeMag(t *testing.T) {
+	var s Sample
+	if err := decodeMag(&s, []byte{0x01, 0x10, 0x00, 0xF0, 0xFF, 0x00, 0x80, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if s.RawMag != [3]int16{16, -16, -32768} {
+		t.Fatalf("RawMag = %v", s.RawMag)
+	}
+	if err := decodeMag(&s, []byte{0x01, 0, 0, 0, 0, 0, 0, 0, magHOFL}); err != ErrMagOverflow {
+		t.Fatalf("decodeMag() = %v, want ErrMagOverflow", err)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+// aaf is a configuration of an anti-alias filter.
+type aaf struct {
+	bw       int // 3dB bandwidth in Hz
+	deltSqr  uint16
+	bitShift byte
+}
+
+// aafs are the anti-alias filter configurations of the datasheet, by AAF_DELT
+// minus 1.
+var aafs = [...]aaf{
+	{42, 1, 15}, {84, 4, 13}, {126, 9, 12}, {170, 16, 11},
+	{213, 25, 10}, {258, 36, 10}, {303, 49, 9}, {348, 64, 9},
+	{394, 81, 9}, {441, 100, 8}, {488, 122, 8}, {536, 144, 8},
+	{585, 170, 8}, {634, 196, 7}, {684, 224, 7}, {734, 256, 7},
+	{785, 288, 7}, {837, 324, 7}, {890, 360, 6}, {943, 400, 6},
+	{997, 440, 6}, {1051, 488, 6}, {1107, 528, 6}, {1163, 576, 6},
+	{1220, 624, 6}, {1277, 680, 6}, {1336, 736, 5}, {1395, 784, 5},
+	{1454, 848, 5}, {1515, 896, 5}, {1577, 960, 5}, {1639, 1024, 5},
+	{1702, 1088, 5}, {1766, 1152, 5}, {1830, 1232, 5}, {1896, 1296, 5},
+	{1962, 1376, 4}, {2029, 1440, 4}, {2097, 1536, 4}, {2166, 1600, 4},
+	{2235, 1696, 4}, {2306, 1760, 4}, {2377, 1856, 4}, {2449, 1952, 4},
+	{2522, 2016, 4}, {2596, 2112, 4}, {2671, 2208, 4}, {2747, 2304, 4},
+	{2823, 2400, 4}, {2900, 2496, 4}, {2978, 2592, 4}, {3057, 2720, 4},
+	{3136, 2816, 3}, {3217, 2944, 3}, {3299, 3008, 3}, {3381, 3136, 3},
+	{3464, 3264, 3}, {3548, 3392, 3}, {3633, 3456, 3}, {3718, 3584, 3},
+	{3805, 3712, 3}, {3892, 3840, 3}, {3979, 3968, 3},
+}
+
+// aafIndex returns the index in aafs of the bandwidth nearest to bw, or -1
+// when bw is out of range.
+func aafIndex(bw int) int {
+	if bw < aafs[0].bw || bw > aafs[len(aafs)-1].bw {
+		return -1
+	}
+	best := 0
+	for i, a := range aafs {
+		if abs(a.bw-bw) < abs(aafs[best].bw-bw) {
+			best = i
+		}
+	}
+	return best
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// configureAAF writes the anti-alias filter configurations of opts. Both
+// filters are left untouched when none is set.
+func (d *Dev) configureAAF(opts Opts) error {
+	if opts.DisableAAF {
+		if err := d.updateReg(regGYROCONFIGSTATIC2, gyroAAFDisable, gyroAAFDisable); err != nil {
+			return err
+		}
+		return d.updateReg(regACCELCONFIGSTATIC2, accelAAFDisable, accelAAFDisable)
+	}
+	if opts.GyroAAFHz != 0 {
+		i := aafIndex(opts.GyroAAFHz)
+		a := aafs[i]
+		if err := d.updateReg(regGYROCONFIGSTATIC2, gyroAAFDisable, 0); err != nil {
+			return err
+		}
+		// GYRO_AAF_DELT, GYRO_AAF_DELTSQR[7:0], then GYRO_AAF_BITSHIFT and
+		// GYRO_AAF_DELTSQR[11:8].
+		if err := d.writeRegs(regGYROCONFIGSTATIC2+1, byte(i+1), byte(a.deltSqr), a.bitShift<<4|byte(a.deltSqr>>8)); err != nil {
+			return err
+		}
+	}
+	if opts.AccelAAFHz != 0 {
+		i := aafIndex(opts.AccelAAFHz)
+		a := aafs[i]
+		// ACCEL_AAF_DELT and ACCEL_AAF_DIS cleared, ACCEL_AAF_DELTSQR[7:0],
+		// then ACCEL_AAF_BITSHIFT and ACCEL_AAF_DELTSQR[11:8].
+		if err := d.writeRegs(regACCELCONFIGSTATIC2, byte(i+1)<<1, byte(a.deltSqr), a.bitShift<<4|byte(a.deltSqr>>8)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateReg sets the bits of mask in r to those of v.
+func (d *Dev) updateReg(r register, mask, v byte) error {
+	var b [1]byte
+	if err := d.readRegs(r, b[:]); err != nil {
+		return err
+	}
+	return d.writeRegs(r, b[0]&^mask|v&mask)
+}
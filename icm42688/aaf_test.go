@@ -0,0 +1,52 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import "testing"
+
+func TestAAFIndex(t *testing.T) {
+	data := []struct {
+		bw, want int
+	}{
+		{42, 0}, {60, 0}, {70, 1}, {585, 12}, {1000, 20}, {3979, 62}, {41, -1}, {3980, -1},
+	}
+	for _, line := range data {
+		if got := aafIndex(line.bw); got != line.want {
+			t.Errorf("aafIndex(%d) = %d, want %d", line.bw, got, line.want)
+		}
+	}
+}
+
+func TestNewSPI_AAF(t *testing.T) {
+	o := newOps(true, 0x06, 0x06)
+	o.read(regGYROCONFIGSTATIC2, 0xA2)
+	o.write(regGYROCONFIGSTATIC2, 0xA0)
+	// 585Hz: AAF_DELT 13, AAF_DELTSQR 170, AAF_BITSHIFT 8.
+	o.write(regGYROCONFIGSTATIC2+1, 13, 170, 0x80)
+	// 997Hz: AAF_DELT 21, AAF_DELTSQR 440, AAF_BITSHIFT 6.
+	o.write(regACCELCONFIGSTATIC2, 21<<1, 0xB8, 0x61)
+	port := o.start(false).port()
+	if _, err := NewSPI(port, Opts{GyroAAFHz: 585, AccelAAFHz: 1000}); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSPI_DisableAAF(t *testing.T) {
+	o := newOps(true, 0x06, 0x06)
+	o.read(regGYROCONFIGSTATIC2, 0xA0)
+	o.write(regGYROCONFIGSTATIC2, 0xA2)
+	o.read(regACCELCONFIGSTATIC2, 0x30)
+	o.write(regACCELCONFIGSTATIC2, 0x31)
+	port := o.start(false).port()
+	if _, err := NewSPI(port, Opts{DisableAAF: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
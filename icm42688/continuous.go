@@ -0,0 +1,71 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import (
+	"log"
+	"time"
+)
+
+// SenseContinuous returns a channel delivering samples every interval, until
+// Halt is called.
+//
+// An interval of 0 or less uses the sample period, but no less than 1ms. At
+// higher rates, use Opts.FIFO and ReadFIFO. Calling SenseContinuous again
+// stops the previous channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan Sample, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = max(d.odr.Period(), time.Millisecond)
+	}
+	c := make(chan Sample)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- Sample) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var s Sample
+		if err := d.Sense(&s); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- s:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
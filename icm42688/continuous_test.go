@@ -0,0 +1,49 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSenseContinuous(t *testing.T) {
+	o := newOps(true, 0x06, 0x06).start(false)
+	o.read(regTEMPDATA1, data(0, [3]int16{1, 2, 3}, [3]int16{})...)
+	o.read(regTEMPDATA1, data(0, [3]int16{4, 5, 6}, [3]int16{})...)
+	o.write(regPWRMGMT0, 0)
+	port := o.port()
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-c; s.RawAccel != [3]int16{1, 2, 3} {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	// Restarting stops the previous channel.
+	c2, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("previous channel not closed")
+	}
+	if s := <-c2; s.RawAccel != [3]int16{4, 5, 6} {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c2; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package icm42688 controls a TDK InvenSense ICM-42688-P 6-axis IMU over SPI
+// or I²C.
+//
+// # More details
+//
+// The ICM-42688-P combines a low noise 3-axis accelerometer and 3-axis
+// gyroscope sampling up to 32kHz. SPI is preferred: at the highest rates a
+// 400kHz I²C bus can't keep up with the data, while the SPI port runs at up
+// to 24MHz. NewSPI also disables the I²C interface of the device, so that
+// traffic for other devices on a shared bus can't corrupt it.
+//
+// Both sensors run in low noise mode at Opts.ODR. The anti-alias filters
+// (AAF) of the signal path can be tuned with Opts.GyroAAFHz and
+// Opts.AccelAAFHz, or bypassed with Opts.DisableAAF for the lowest latency.
+// The UI filters keep their power-on configuration.
+//
+// With Opts.FIFO, the samples are queued in the 2KiB FIFO with the
+// temperature and the 1µs timestamp of the device, which ReadFIFO uses to
+// time the samples of a batch.
+//
+// The APEX motion features (pedometer, tilt, tap, raise to wake and
+// significant motion detection) are disabled.
+//
+// # Datasheet
+//
+// https://invensense.tdk.com/wp-content/uploads/2020/04/ds-000347_icm-42688-p-datasheet.pdf
+package icm42688
@@ -0,0 +1,49 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/icm42688"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default SPI port.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open SPI: %v", err)
+	}
+	defer p.Close()
+
+	d, err := icm42688.NewSPI(p, icm42688.Opts{ODR: 8 * physic.KiloHertz, GyroAAFHz: 1000, FIFO: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	// Drain the FIFO in batches of at most 10ms.
+	s := make([]icm42688.Sample, 80)
+	for {
+		time.Sleep(5 * time.Millisecond)
+		n, err := d.ReadFIFO(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, v := range s[:n] {
+			fmt.Println(v.Timestamp, v)
+		}
+	}
+}
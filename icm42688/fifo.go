@@ -0,0 +1,119 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import (
+	"errors"
+	"time"
+)
+
+// FIFO packet header bits.
+const (
+	headerEmpty   = 0x80
+	headerPacket3 = 0x68 // accelerometer, gyroscope and ODR timestamp
+	headerMask    = 0xFC // excludes the ODR change bits
+)
+
+// FIFOLen returns the number of complete samples queued in the FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	return d.fifoLen()
+}
+
+// ReadFIFO reads up to len(s) samples from the FIFO, oldest first, and
+// returns the number read. It requires Opts.FIFO.
+//
+// The newest sample is timestamped with the time of the read and the others
+// from the timestamps of the device, so the intervals between samples don't
+// suffer from the latency of the host.
+//
+// When the FIFO filled up since the last call, it is flushed and ReadFIFO
+// returns ErrFIFOOverflow instead, as the newest samples were dropped.
+func (d *Dev) ReadFIFO(s []Sample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	// INT_STATUS is cleared on read.
+	var st [1]byte
+	if err := d.readRegs(regINTSTATUS, st[:]); err != nil {
+		return 0, err
+	}
+	if st[0]&intFIFOFull != 0 {
+		if err := d.writeRegs(regSIGNALPATHRESET, fifoFlush); err != nil {
+			return 0, err
+		}
+		return 0, ErrFIFOOverflow
+	}
+	n, err := d.fifoLen()
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	n = min(n, len(s))
+	b, err := d.readBlock(regFIFODATA, n*fifoSampleLen)
+	if err != nil {
+		return 0, err
+	}
+	for i := range s[:n] {
+		p := b[i*fifoSampleLen:]
+		if p[0]&headerEmpty != 0 {
+			n = i
+			break
+		}
+		if p[0]&headerMask != headerPacket3 {
+			return i, errors.New("icm42688: unexpected FIFO packet header")
+		}
+		d.decode(&s[i], p[1:7], p[7:13])
+		// °C = raw / 2.07 + 25
+		s[i].Temperature = celsius(float64(int8(p[13]))/2.07 + 25)
+		s[i].Tick = uint16(p[14])<<8 | uint16(p[15])
+	}
+	now := time.Now()
+	for i := n - 1; i >= 0; i-- {
+		if i == n-1 {
+			s[i].Timestamp = now
+			continue
+		}
+		// The subtraction handles the wrap around of the ticks.
+		s[i].Timestamp = s[i+1].Timestamp.Add(-time.Duration(s[i+1].Tick-s[i].Tick) * time.Microsecond)
+	}
+	return n, nil
+}
+
+// ResetFIFO discards the samples queued in the FIFO. It requires Opts.FIFO.
+func (d *Dev) ResetFIFO() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return err
+	}
+	return d.writeRegs(regSIGNALPATHRESET, fifoFlush)
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+func (d *Dev) fifoLen() (int, error) {
+	var b [2]byte
+	if err := d.readRegs(regFIFOCOUNTH, b[:]); err != nil {
+		return 0, err
+	}
+	// FIFO_COUNT is in bytes, big endian.
+	return (int(b[0])<<8 | int(b[1])) / fifoSampleLen, nil
+}
+
+var errFIFODisabled = errors.New("icm42688: FIFO not enabled in Opts")
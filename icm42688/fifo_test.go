@@ -0,0 +1,119 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// packet returns a FIFO packet 3.
+func packet(header byte, accel, gyro [3]int16, temp int8, tick uint16) []byte {
+	b := []byte{header}
+	for _, v := range append(accel[:], gyro[:]...) {
+		b = append(b, byte(uint16(v)>>8), byte(v))
+	}
+	return append(b, byte(temp), byte(tick>>8), byte(tick))
+}
+
+func TestReadFIFO(t *testing.T) {
+	o := newOps(true, 0x05, 0x05).start(true)
+	// 40 bytes queued, so 2 complete samples.
+	o.read(regFIFOCOUNTH, 0, 40)
+	o.read(regINTSTATUS, 0)
+	o.read(regFIFOCOUNTH, 0, 40)
+	// The ticks wrap around.
+	p := append(packet(0x68, [3]int16{2048, 0, 0}, [3]int16{}, 0, 0xFF00),
+		packet(0x6B, [3]int16{}, [3]int16{0, 0, -164}, 21, 0x00F4)...)
+	o.read(regFIFODATA, p...)
+	o.read(regINTSTATUS, 0)
+	o.read(regFIFOCOUNTH, 0, 0)
+	o.read(regINTSTATUS, intFIFOFull)
+	o.write(regSIGNALPATHRESET, fifoFlush)
+	o.write(regSIGNALPATHRESET, fifoFlush)
+	port := o.port()
+	d, err := NewSPI(port, Opts{ODR: 2 * physic.KiloHertz, FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 2 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]Sample, 4)
+	n, err := d.ReadFIFO(s)
+	if err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if s[0].RawAccel != [3]int16{2048, 0, 0} || s[1].RawGyro != [3]int16{0, 0, -164} || s[1].Tick != 0x00F4 {
+		t.Fatalf("ReadFIFO() = %v", s[:n])
+	}
+	if diff := s[1].Timestamp.Sub(s[0].Timestamp); diff != 500*time.Microsecond {
+		t.Fatalf("timestamps %s apart, want 500µs", diff)
+	}
+	// 21 / 2.07 + 25
+	if want := physic.ZeroCelsius + 35145*physic.MilliKelvin; s[1].Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s[1].Temperature, want)
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if _, err := d.ReadFIFO(s); !errors.Is(err, ErrFIFOOverflow) {
+		t.Fatalf("ReadFIFO() = %v, want ErrFIFOOverflow", err)
+	}
+	if err := d.ResetFIFO(); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Empty(t *testing.T) {
+	o := newOps(true, 0x06, 0x06).start(true)
+	o.read(regINTSTATUS, 0)
+	o.read(regFIFOCOUNTH, 0, 32)
+	o.read(regFIFODATA, append(packet(0x68, [3]int16{1, 2, 3}, [3]int16{}, 0, 10), packet(0x80, [3]int16{}, [3]int16{}, 0, 0)...)...)
+	o.read(regINTSTATUS, 0)
+	o.read(regFIFOCOUNTH, 0, 16)
+	o.read(regFIFODATA, packet(0x10, [3]int16{}, [3]int16{}, 0, 0)...)
+	port := o.port()
+	d, err := NewSPI(port, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]Sample, 2)
+	if n, err := d.ReadFIFO(s); err != nil || n != 1 || s[0].RawAccel != [3]int16{1, 2, 3} {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if n, err := d.ReadFIFO(s); err == nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v, want an error", n, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	port := newOps(true, 0x06, 0x06).start(false).port()
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	if _, err := d.FIFOLen(); err != errFIFODisabled {
+		t.Fatalf("FIFOLen() = %v", err)
+	}
+	if err := d.ResetFIFO(); err != errFIFODisabled {
+		t.Fatalf("ResetFIFO() = %v", err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,482 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// DefaultAddr is the I²C address with AP_AD0 low; AltAddr is used with
+// AP_AD0 high.
+const (
+	DefaultAddr = 0x68
+	AltAddr     = 0x69
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the ICM-42688-P.
+const MaxSPIFrequency = 24 * physic.MegaHertz
+
+// register is a register address in a user bank, bank<<8 | address.
+type register uint16
+
+func (r register) bank() byte { return byte(r >> 8) }
+func (r register) addr() byte { return byte(r) }
+
+// Register map.
+const (
+	// User bank 0.
+	regDEVICECONFIG    register = 0x011
+	regFIFOCONFIG      register = 0x016
+	regTEMPDATA1       register = 0x01D // temperature, accelerometer, gyroscope, MSB first
+	regINTSTATUS       register = 0x02D
+	regFIFOCOUNTH      register = 0x02E
+	regFIFODATA        register = 0x030
+	regSIGNALPATHRESET register = 0x04B
+	regINTFCONFIG0     register = 0x04C
+	regPWRMGMT0        register = 0x04E
+	regGYROCONFIG0     register = 0x04F // followed by ACCEL_CONFIG0
+	regAPEXCONFIG0     register = 0x056
+	regFIFOCONFIG1     register = 0x05F
+	regWHOAMI          register = 0x075
+	regREGBANKSEL               = 0x76 // in all the banks
+	// User bank 1.
+	regGYROCONFIGSTATIC2 register = 0x10B // followed by GYRO_CONFIG_STATIC3 to 5
+	// User bank 2.
+	regACCELCONFIGSTATIC2 register = 0x203 // followed by ACCEL_CONFIG_STATIC3 and 4
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0x47
+
+// Register values.
+const (
+	softReset       = 0x01
+	intfSPIOnly     = 0x33 // big endian data and FIFO count, I²C disabled
+	pwrLowNoise     = 0x0F // gyroscope and accelerometer in low noise mode
+	apexOff         = 0x82 // DMP power save at 50Hz, all the features off
+	fifoStopOnFull  = 0x80
+	fifoFlush       = 0x02
+	fifoPacket3     = 0x07 // temperature, gyroscope and accelerometer
+	intFIFOFull     = 0x02
+	gyroAAFDisable  = 0x02
+	accelAAFDisable = 0x01
+	spiRead         = 0x80 // bit 7 of the address; it auto-increments
+)
+
+// Timings, rounded up.
+const (
+	resetTime = time.Millisecond
+	// The gyroscope starts in 30ms and must then stay on for 45ms.
+	startTime = 45 * time.Millisecond
+)
+
+// Sizes of the data blocks.
+const (
+	dataLen       = 14 // temperature, accelerometer and gyroscope
+	fifoSampleLen = 16 // packet 3
+	fifoSize      = 2048
+)
+
+// standardGravity is in m/s² per g.
+const standardGravity = 9.80665
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when WHO_AM_I doesn't read 0x47.
+	ErrBadID = errors.New("icm42688: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("icm42688: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("icm42688: invalid options")
+	// ErrFIFOOverflow is returned by ReadFIFO when samples were lost because
+	// the FIFO was full. The FIFO is flushed.
+	ErrFIFOOverflow = errors.New("icm42688: FIFO overflow")
+)
+
+// odrs are the output data rates by GYRO_ODR and ACCEL_ODR value.
+var odrs = map[physic.Frequency]byte{
+	32 * physic.KiloHertz:     0x01,
+	16 * physic.KiloHertz:     0x02,
+	8 * physic.KiloHertz:      0x03,
+	4 * physic.KiloHertz:      0x04,
+	2 * physic.KiloHertz:      0x05,
+	physic.KiloHertz:          0x06,
+	200 * physic.Hertz:        0x07,
+	100 * physic.Hertz:        0x08,
+	50 * physic.Hertz:         0x09,
+	25 * physic.Hertz:         0x0A,
+	12500 * physic.MilliHertz: 0x0B,
+	500 * physic.Hertz:        0x0F,
+}
+
+// Opts holds initialization options.
+//
+// AccelRange: accelerometer full scale in g, 2, 4, 8 or 16 (default).
+// GyroRange: gyroscope full scale in °/s, 125, 250, 500, 1000 or 2000
+// (default).
+// ODR: output data rate of both sensors, 32kHz, 16kHz, 8kHz, 4kHz, 2kHz,
+// 1kHz (default), 500Hz, 200Hz, 100Hz, 50Hz, 25Hz or 12.5Hz.
+// GyroAAFHz, AccelAAFHz: 3dB bandwidth of the anti-alias filters, 42 to
+// 3979Hz, rounded to the nearest supported value. 0 (default) keeps the
+// power-on configuration.
+// DisableAAF: bypass both anti-alias filters.
+// FIFO: queue the samples in the FIFO, read with ReadFIFO.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	AccelRange int
+	GyroRange  int
+	ODR        physic.Frequency
+	GyroAAFHz  int
+	AccelAAFHz int
+	DisableAAF bool
+	FIFO       bool
+	Addr       uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if accelFullScale(o.AccelRange) < 0 {
+		return fmt.Errorf("%w: AccelRange %d, want 2, 4, 8 or 16", ErrInvalidOpts, o.AccelRange)
+	}
+	if gyroFullScale(o.GyroRange) < 0 {
+		return fmt.Errorf("%w: GyroRange %d, want 125, 250, 500, 1000 or 2000", ErrInvalidOpts, o.GyroRange)
+	}
+	if _, ok := odrs[o.ODR]; o.ODR != 0 && !ok {
+		return fmt.Errorf("%w: ODR %s, want 32kHz, 16kHz, 8kHz, 4kHz, 2kHz, 1kHz, 500Hz, 200Hz, 100Hz, 50Hz, 25Hz or 12.5Hz", ErrInvalidOpts, o.ODR)
+	}
+	if o.GyroAAFHz != 0 && aafIndex(o.GyroAAFHz) < 0 {
+		return fmt.Errorf("%w: GyroAAFHz %d, want %d to %d", ErrInvalidOpts, o.GyroAAFHz, aafs[0].bw, aafs[len(aafs)-1].bw)
+	}
+	if o.AccelAAFHz != 0 && aafIndex(o.AccelAAFHz) < 0 {
+		return fmt.Errorf("%w: AccelAAFHz %d, want %d to %d", ErrInvalidOpts, o.AccelAAFHz, aafs[0].bw, aafs[len(aafs)-1].bw)
+	}
+	if o.DisableAAF && (o.GyroAAFHz != 0 || o.AccelAAFHz != 0) {
+		return fmt.Errorf("%w: DisableAAF with GyroAAFHz or AccelAAFHz", ErrInvalidOpts)
+	}
+	return nil
+}
+
+// accelFullScale returns ACCEL_FS_SEL for a range in g, or -1. 0 selects
+// ±16g.
+func accelFullScale(g int) int {
+	if g == 0 {
+		return 0
+	}
+	for fs := 0; fs < 4; fs++ {
+		if g == 16>>fs {
+			return fs
+		}
+	}
+	return -1
+}
+
+// gyroFullScale returns GYRO_FS_SEL for a range in °/s, or -1. 0 selects
+// ±2000°/s.
+func gyroFullScale(dps int) int {
+	if dps == 0 {
+		return 0
+	}
+	for fs := 0; fs < 5; fs++ {
+		if dps == 2000>>fs {
+			return fs
+		}
+	}
+	return -1
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Gyro is the angular rate in rad/s, in X,Y,Z order.
+	Gyro [3]float64
+	// Temperature is the die temperature. For samples read from the FIFO, it
+	// has a resolution of about 0.5°C.
+	Temperature physic.Temperature
+	// RawAccel and RawGyro are the counts the values were computed from.
+	RawAccel, RawGyro [3]int16
+	// Tick is the timestamp of the device in µs, wrapping every 65.536ms. It
+	// is only set for samples read from the FIFO.
+	Tick uint16
+	// Timestamp is the time at which the sample was read from the device. For
+	// samples read from the FIFO, it is derived from Tick, the newest sample
+	// being the time of the read.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s² gyro=%.4f,%.4f,%.4frad/s %s",
+		s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2], s.Temperature)
+}
+
+// Dev represents an ICM-42688-P device.
+// Sense returns values in m/s² and rad/s.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c          conn.Conn
+	isSPI      bool
+	bank       int // selected user bank, -1 when unknown
+	accelRange int
+	gyroRange  int
+	accelLSB   float64 // LSB per m/s²
+	gyroLSB    float64 // LSB per rad/s
+	odr        physic.Frequency
+	fifo       bool
+	halted     bool
+
+	// Preallocated bus buffers, one byte longer than the largest read for the
+	// SPI address byte.
+	w, r [fifoSize + 1]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI resets and configures a device on a 4-wire SPI port.
+//
+// The port is connected in mode 0 at MaxSPIFrequency (24 MHz). The I²C
+// interface of the device is disabled.
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("icm42688: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	afs, gfs := accelFullScale(opts.AccelRange), gyroFullScale(opts.GyroRange)
+	odr := opts.ODR
+	if odr == 0 {
+		odr = physic.KiloHertz
+	}
+	d := &Dev{
+		c:          c,
+		isSPI:      isSPI,
+		bank:       -1,
+		accelRange: 16 >> afs,
+		gyroRange:  2000 >> gfs,
+		// The full scale spans the 16 bits.
+		accelLSB: float64(int(2048)<<afs) / standardGravity,
+		gyroLSB:  32768 / float64(int(2000)>>gfs) * 180 / math.Pi,
+		odr:      odr,
+		fifo:     opts.FIFO,
+	}
+	var id [1]byte
+	if err := d.readRegs(regWHOAMI, id[:]); err != nil {
+		return nil, err
+	}
+	if id[0] != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id[0], whoAmI)
+	}
+	if err := d.writeRegs(regDEVICECONFIG, softReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// The reset selected bank 0.
+	d.bank = 0
+	if isSPI {
+		if err := d.writeRegs(regINTFCONFIG0, intfSPIOnly); err != nil {
+			return nil, err
+		}
+	}
+	code := odrs[odr]
+	if err := d.writeRegs(regGYROCONFIG0, byte(gfs<<5)|code, byte(afs<<5)|code); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regAPEXCONFIG0, apexOff); err != nil {
+		return nil, err
+	}
+	if err := d.configureAAF(opts); err != nil {
+		return nil, err
+	}
+	if opts.FIFO {
+		if err := d.writeRegs(regFIFOCONFIG1, fifoPacket3); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regFIFOCONFIG, fifoStopOnFull); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regSIGNALPATHRESET, fifoFlush); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.writeRegs(regPWRMGMT0, pwrLowNoise); err != nil {
+		return nil, err
+	}
+	doSleep(startTime)
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("ICM42688{%s, ±%dg, ±%d°/s, %s}", d.c, d.accelRange, d.gyroRange, d.odr)
+}
+
+// SampleRate returns the output data rate of both sensors.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.odr
+}
+
+// Halt stops SenseContinuous and turns the sensors off. It implements
+// conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil
+	}
+	if err := d.writeRegs(regPWRMGMT0, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns the latest accelerometer and gyroscope counts, in X,Y,Z
+// order.
+func (d *Dev) SenseRaw() ([3]int16, [3]int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.sense(&s)
+	return s.RawAccel, s.RawGyro, err
+}
+
+// Sense reads the latest sample into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(s)
+}
+
+func (d *Dev) sense(s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	b, err := d.readBlock(regTEMPDATA1, dataLen)
+	if err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	s.Tick = 0
+	d.decode(s, b[2:8], b[8:14])
+	t := int16(b[0])<<8 | int16(b[1])
+	// °C = raw / 132.48 + 25
+	s.Temperature = celsius(float64(t)/132.48 + 25)
+	return nil
+}
+
+// decode scales the big-endian accelerometer and gyroscope counts into s.
+func (d *Dev) decode(s *Sample, accel, gyro []byte) {
+	for i := 0; i < 3; i++ {
+		s.RawAccel[i] = int16(accel[2*i])<<8 | int16(accel[2*i+1])
+		s.RawGyro[i] = int16(gyro[2*i])<<8 | int16(gyro[2*i+1])
+		s.Accel[i] = float64(s.RawAccel[i]) / d.accelLSB
+		s.Gyro[i] = float64(s.RawGyro[i]) / d.gyroLSB
+	}
+}
+
+// celsius converts °C to a temperature, rounded to the nearest mK.
+func celsius(c float64) physic.Temperature {
+	return physic.ZeroCelsius + physic.Temperature(math.Round(c*1000))*physic.MilliKelvin
+}
+
+// selectBank writes REG_BANK_SEL when the bank of r isn't selected.
+func (d *Dev) selectBank(r register) error {
+	if d.bank == int(r.bank()) {
+		return nil
+	}
+	if err := d.c.Tx([]byte{regREGBANKSEL, r.bank()}, nil); err != nil {
+		d.bank = -1
+		return fmt.Errorf("icm42688: selecting bank %d: %w", r.bank(), err)
+	}
+	d.bank = int(r.bank())
+	return nil
+}
+
+// readRegs reads consecutive registers starting at r into out.
+func (d *Dev) readRegs(r register, out []byte) error {
+	b, err := d.readBlock(r, len(out))
+	if err != nil {
+		return err
+	}
+	copy(out, b)
+	return nil
+}
+
+// readBlock reads n consecutive registers starting at r. The returned slice
+// is only valid until the next transaction.
+func (d *Dev) readBlock(r register, n int) ([]byte, error) {
+	if err := d.selectBank(r); err != nil {
+		return nil, err
+	}
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, rd := d.w[:n+1], d.r[:n+1]
+		clear(w)
+		w[0] = r.addr() | spiRead
+		if err := d.c.Tx(w, rd); err != nil {
+			return nil, fmt.Errorf("icm42688: reading register %d:0x%02x: %w", r.bank(), r.addr(), err)
+		}
+		return rd[1:], nil
+	}
+	w, rd := append(d.w[:0], r.addr()), d.r[:n]
+	if err := d.c.Tx(w, rd); err != nil {
+		return nil, fmt.Errorf("icm42688: reading register %d:0x%02x: %w", r.bank(), r.addr(), err)
+	}
+	return rd, nil
+}
+
+// writeRegs writes consecutive registers starting at r in one transaction.
+func (d *Dev) writeRegs(r register, v ...byte) error {
+	if err := d.selectBank(r); err != nil {
+		return err
+	}
+	w := append(append(d.w[:0], r.addr()), v...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("icm42688: writing register %d:0x%02x: %w", r.bank(), r.addr(), err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
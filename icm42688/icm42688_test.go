@@ -0,0 +1,269 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package icm42688
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// ops builds the expected bus transactions, tracking the selected bank.
+type ops struct {
+	io    []conntest.IO
+	isSPI bool
+	bank  int
+}
+
+func (o *ops) sel(r register) {
+	if o.bank != int(r.bank()) {
+		o.io = append(o.io, conntest.IO{W: []byte{regREGBANKSEL, r.bank()}})
+		o.bank = int(r.bank())
+	}
+}
+
+func (o *ops) write(r register, v ...byte) {
+	o.sel(r)
+	o.io = append(o.io, conntest.IO{W: append([]byte{r.addr()}, v...)})
+}
+
+func (o *ops) read(r register, v ...byte) {
+	o.sel(r)
+	if !o.isSPI {
+		o.io = append(o.io, conntest.IO{W: []byte{r.addr()}, R: v})
+		return
+	}
+	w := make([]byte, len(v)+1)
+	w[0] = r.addr() | spiRead
+	o.io = append(o.io, conntest.IO{W: w, R: append([]byte{0}, v...)})
+}
+
+// port returns an SPI port replaying the transactions.
+func (o *ops) port() *spitest.Playback {
+	return &spitest.Playback{Playback: conntest.Playback{Ops: o.io}}
+}
+
+// bus returns an I²C bus replaying the transactions.
+func (o *ops) bus() *i2ctest.Playback {
+	b := &i2ctest.Playback{}
+	for _, io := range o.io {
+		b.Ops = append(b.Ops, i2ctest.IO{Addr: DefaultAddr, W: io.W, R: io.R})
+	}
+	return b
+}
+
+// newOps returns the bus transactions issued by New up to the AAF
+// configuration, for the GYRO_CONFIG0 and ACCEL_CONFIG0 values.
+func newOps(isSPI bool, gyro, accel byte) *ops {
+	o := &ops{isSPI: isSPI, bank: -1}
+	o.read(regWHOAMI, whoAmI)
+	o.write(regDEVICECONFIG, softReset)
+	if isSPI {
+		o.write(regINTFCONFIG0, intfSPIOnly)
+	}
+	o.write(regGYROCONFIG0, gyro, accel)
+	o.write(regAPEXCONFIG0, apexOff)
+	return o
+}
+
+// start appends the bus transactions ending New.
+func (o *ops) start(fifo bool) *ops {
+	if fifo {
+		o.write(regFIFOCONFIG1, fifoPacket3)
+		o.write(regFIFOCONFIG, fifoStopOnFull)
+		o.write(regSIGNALPATHRESET, fifoFlush)
+	}
+	o.write(regPWRMGMT0, pwrLowNoise)
+	return o
+}
+
+// data returns the data registers.
+func data(temp int16, accel, gyro [3]int16) []byte {
+	var b []byte
+	for _, v := range append(append([]int16{temp}, accel[:]...), gyro[:]...) {
+		b = append(b, byte(uint16(v)>>8), byte(v))
+	}
+	return b
+}
+
+func TestNewSPI(t *testing.T) {
+	data := []struct {
+		opts        Opts
+		gyro, accel byte
+		s           string
+	}{
+		{Opts{}, 0x06, 0x06, "ICM42688{playback, ±16g, ±2000°/s, 1kHz}"},
+		{Opts{AccelRange: 2, GyroRange: 125, ODR: 32 * physic.KiloHertz}, 0x81, 0x61, "ICM42688{playback, ±2g, ±125°/s, 32kHz}"},
+		{Opts{AccelRange: 8, GyroRange: 500, ODR: 12500 * physic.MilliHertz}, 0x4B, 0x2B, "ICM42688{playback, ±8g, ±500°/s, 12.500Hz}"},
+	}
+	for i, line := range data {
+		port := newOps(true, line.gyro, line.accel).start(false).port()
+		d, err := NewSPI(port, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := port.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	o := &ops{isSPI: true, bank: -1}
+	o.read(regWHOAMI, 0x98)
+	if _, err := NewSPI(o.port(), Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("NewSPI() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{AccelRange: 4, GyroRange: 250, ODR: 500 * physic.Hertz, GyroAAFHz: 42, AccelAAFHz: 3979}, ""},
+		{Opts{DisableAAF: true}, ""},
+		{Opts{AccelRange: 32}, "icm42688: invalid options: AccelRange 32, want 2, 4, 8 or 16"},
+		{Opts{GyroRange: 4000}, "icm42688: invalid options: GyroRange 4000, want 125, 250, 500, 1000 or 2000"},
+		{Opts{ODR: 400 * physic.Hertz}, "icm42688: invalid options: ODR 400Hz, want 32kHz, 16kHz, 8kHz, 4kHz, 2kHz, 1kHz, 500Hz, 200Hz, 100Hz, 50Hz, 25Hz or 12.5Hz"},
+		{Opts{GyroAAFHz: 4000}, "icm42688: invalid options: GyroAAFHz 4000, want 42 to 3979"},
+		{Opts{AccelAAFHz: 10}, "icm42688: invalid options: AccelAAFHz 10, want 42 to 3979"},
+		{Opts{DisableAAF: true, GyroAAFHz: 585}, "icm42688: invalid options: DisableAAF with GyroAAFHz or AccelAAFHz"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := NewSPI(&spitest.Playback{}, Opts{AccelRange: 1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("NewSPI() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	o := newOps(true, 0x06, 0x06).start(false)
+	o.read(regTEMPDATA1, data(1325, [3]int16{2048, -1024, 0}, [3]int16{328, 0, -328})...)
+	o.read(regTEMPDATA1, data(0, [3]int16{1, 2, 3}, [3]int16{-4, -5, -6})...)
+	port := o.port()
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 2048 LSB/g and 16.384 LSB/(°/s).
+	want := [6]float64{standardGravity, -standardGravity / 2, 0, 20.01953125 * math.Pi / 180, 0, -20.01953125 * math.Pi / 180}
+	got := [6]float64{s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2]}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Sense() = %s, want %v", s, want)
+		}
+	}
+	// 1325 / 132.48 + 25
+	if want := physic.ZeroCelsius + 35002*physic.MilliKelvin; s.Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	a, g, err := d.SenseRaw()
+	if err != nil || a != [3]int16{1, 2, 3} || g != [3]int16{-4, -5, -6} {
+		t.Fatalf("SenseRaw() = %v, %v, %v", a, g, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_I2C(t *testing.T) {
+	o := newOps(false, 0x86, 0x66).start(false)
+	o.read(regTEMPDATA1, data(0, [3]int16{16384, 0, 0}, [3]int16{0, 262, 0})...)
+	bus := o.bus()
+	d, err := New(bus, Opts{AccelRange: 2, GyroRange: 125})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "ICM42688{playback(104), ±2g, ±125°/s, 1kHz}" {
+		t.Fatalf("String() = %q", s)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 16384 LSB/g and 262.144 LSB/(°/s).
+	if math.Abs(s.Accel[0]-standardGravity) > 1e-9 || math.Abs(s.Gyro[1]-262/262.144*math.Pi/180) > 1e-9 {
+		t.Fatalf("Sense() = %s", s)
+	}
+	if s.Temperature != physic.ZeroCelsius+25*physic.Celsius {
+		t.Fatalf("Temperature = %s", s.Temperature)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	o := newOps(true, 0x06, 0x06).start(false)
+	o.write(regPWRMGMT0, 0)
+	port := o.port()
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseContinuous() = %v, want ErrHalted", err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != ErrHalted {
+		t.Fatalf("ReadFIFO() = %v, want ErrHalted", err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = newOps(false, 0x06, 0x06).start(false).bus()
+	bus.DontPanic = true
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
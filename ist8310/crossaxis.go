@@ -0,0 +1,78 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ist8310
+
+// otpSensitivity is the nominal diagonal of the cross-axis matrix, in the
+// units of the OTP values.
+const otpSensitivity = 330
+
+var identity = [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+// CrossAxis returns the cross-axis compensation matrix applied to the
+// scaled values, the identity when the OTP memory isn't programmed or
+// Opts.SkipCrossAxis is set.
+func (d *Dev) CrossAxis() [3][3]float64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.comp
+}
+
+// readCrossAxis reads the cross-axis matrix from OTP memory and returns its
+// inverse, normalized to the nominal sensitivity.
+func (d *Dev) readCrossAxis() ([3][3]float64, error) {
+	var b [18]byte
+	if err := d.readRegBlock(regXXCROSS, b[:]); err != nil {
+		return identity, err
+	}
+	var m [3][3]float64
+	blank := true
+	for i := 0; i < 9; i++ {
+		v := int16(b[2*i+1])<<8 | int16(b[2*i])
+		if v != 0 && v != -1 {
+			blank = false
+		}
+		m[i/3][i%3] = float64(v)
+	}
+	if blank {
+		return identity, nil
+	}
+	inv, ok := invert(m)
+	if !ok {
+		return identity, nil
+	}
+	for i := range inv {
+		for j := range inv[i] {
+			inv[i][j] *= otpSensitivity
+		}
+	}
+	return inv, nil
+}
+
+// invert returns the inverse of m, or false if it is singular.
+func invert(m [3][3]float64) ([3][3]float64, bool) {
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	if det == 0 {
+		return [3][3]float64{}, false
+	}
+	return [3][3]float64{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / det,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / det,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / det,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / det,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / det,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / det,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / det,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / det,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / det,
+		},
+	}, true
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ist8310
+
+import (
+	"math"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestInvert(t *testing.T) {
+	m := [3][3]float64{{330, 12, -5}, {-8, 341, 3}, {20, 7, 318}}
+	inv, ok := invert(m)
+	if !ok {
+		t.Fatal("invert() failed")
+	}
+	for i := range m {
+		for j := range m {
+			var v float64
+			for k := range m {
+				v += m[i][k] * inv[k][j]
+			}
+			if math.Abs(v-identity[i][j]) > 1e-12 {
+				t.Fatalf("m × invert(m) [%d][%d] = %g", i, j, v)
+			}
+		}
+	}
+	if _, ok := invert([3][3]float64{{1, 2, 3}, {2, 4, 6}, {0, 0, 1}}); ok {
+		t.Fatal("invert() of a singular matrix succeeded")
+	}
+}
+
+func TestReadCrossAxis(t *testing.T) {
+	// All ones reads as unprogrammed.
+	b := make([]byte, 18)
+	for i := range b {
+		b[i] = 0xFF
+	}
+	d, bus := newWithOTP(t, b)
+	if c := d.CrossAxis(); c != identity {
+		t.Fatalf("CrossAxis() = %v", c)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Singular.
+	d, bus = newWithOTP(t, otpBlock([9]int16{330, 330, 330, 330, 330, 330, 0, 0, 330}))
+	if c := d.CrossAxis(); c != identity {
+		t.Fatalf("CrossAxis() = %v", c)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	d, bus = newWithOTP(t, otpBlock([9]int16{330, 0, 0, 0, 165, 0, 0, 0, 330}))
+	if c := d.CrossAxis(); c[1][1] != 2 || c[0][0] != 1 || c[0][1] != 0 {
+		t.Fatalf("CrossAxis() = %v", c)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newWithOTP(t *testing.T, otp []byte) (*Dev, *i2ctest.Playback) {
+	bus := &i2ctest.Playback{Ops: initOps(0x24, otp)}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d, bus
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ist8310 controls an iSentek IST8310 3-axis magnetometer over I²C.
+//
+// # More details
+//
+// The IST8310 is found on many flight controllers and GPS modules. It
+// measures ±1600µT at 0.3µT/LSB; each measurement is started on demand and
+// signaled by the DRDY status bit, and can average up to 16 conversions.
+//
+// Each chip stores a cross-axis sensitivity matrix in OTP memory. New reads
+// it and applies its inverse to the scaled values, as the reference driver of
+// iSentek does, so that each axis only reports its own component of the
+// field. SenseRaw returns the counts, without the compensation, and Sense
+// returns µT×10 with it.
+package ist8310
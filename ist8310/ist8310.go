@@ -0,0 +1,357 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ist8310
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address with CAD0 and CAD1 low. The address is 0x0C
+// to 0x0F depending on these pins; modules often use 0x0E.
+const DefaultAddr = 0x0C
+
+// Register map.
+const (
+	regWAI     = 0x00
+	regSTAT1   = 0x02 // DRDY bit 0, DOR bit 1
+	regDATAX   = 0x03 // X L, X H, Y L, Y H, Z L, Z H
+	regCNTL1   = 0x0A // MODE bits 3..0
+	regCNTL2   = 0x0B // SRST bit 0
+	regAVGCNTL = 0x41 // Y averaging bits 5..3, X and Z averaging bits 2..0
+	regPDCNTL  = 0x42
+	regXXCROSS = 0x9C // cross-axis matrix, 9 int16 LSB first, row major
+)
+
+// wai is the value of the who am I register.
+const wai = 0x10
+
+// Register values.
+const (
+	stat1DRDY   = 0x01
+	cntl1Single = 0x01
+	cntl2SRST   = 0x01
+	pdNormal    = 0xC0 // pulse duration recommended by the datasheet
+)
+
+// Timings from the datasheet, rounded up.
+const (
+	resetTime  = time.Millisecond
+	singleTime = 6 * time.Millisecond // with 16 times averaging
+)
+
+// nanoTeslaPerLSB is the sensitivity of the output.
+const nanoTeslaPerLSB = 300
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the who am I register doesn't read
+	// 0x10.
+	ErrBadID = errors.New("ist8310: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("ist8310: device halted")
+	// ErrNotReady is returned when a measurement didn't complete in time.
+	ErrNotReady = errors.New("ist8310: measurement not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("ist8310: invalid options")
+)
+
+// Opts holds initialization options.
+//
+// Averaging: number of conversions averaged per measurement, 1, 2, 4, 8 or
+// 16 (default). Less averaging is noisier but shortens the measurement.
+// Addr: I²C address, DefaultAddr by default.
+// SkipCrossAxis: don't apply the cross-axis compensation read from OTP.
+type Opts struct {
+	Averaging     int
+	Addr          uint16
+	SkipCrossAxis bool
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if o.avg() < 0 {
+		return fmt.Errorf("%w: Averaging %d, want 1, 2, 4, 8 or 16", ErrInvalidOpts, o.Averaging)
+	}
+	return nil
+}
+
+// avg returns the averaging bits of one field of AVGCNTL, or -1.
+func (o *Opts) avg() int {
+	switch o.Averaging {
+	case 0, 16:
+		return 4
+	case 1:
+		return 0
+	case 2:
+		return 1
+	case 4:
+		return 2
+	case 8:
+		return 3
+	}
+	return -1
+}
+
+// Dev represents an IST8310 device.
+// Sense returns X,Y,Z values in µT×10.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c      conn.Conn
+	comp   [3][3]float64 // cross-axis compensation
+	halted bool
+	data   [6]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets the device on an I²C bus, configures the averaging and reads
+// the cross-axis compensation.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Addr: addr, Bus: bus}, comp: identity}
+	id, err := d.readReg(regWAI)
+	if err != nil {
+		return nil, err
+	}
+	if id != wai {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, wai)
+	}
+	if err := d.writeReg(regCNTL2, cntl2SRST); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	a := byte(opts.avg())
+	if err := d.writeReg(regAVGCNTL, a<<3|a); err != nil {
+		return nil, err
+	}
+	if err := d.writeReg(regPDCNTL, pdNormal); err != nil {
+		return nil, err
+	}
+	if !opts.SkipCrossAxis {
+		if d.comp, err = d.readCrossAxis(); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("IST8310{%s}", d.c)
+}
+
+// Halt stops SenseMagneticContinuous. It implements conn.Resource.
+//
+// The device is in standby between measurements, so there is nothing else to
+// stop.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.halted = true
+	return nil
+}
+
+// SenseRaw takes a measurement and returns X,Y,Z as int16 counts, at
+// 0.3µT/LSB, without the cross-axis compensation.
+func (d *Dev) SenseRaw() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	return c[0], c[1], c[2], err
+}
+
+func (d *Dev) senseRaw() ([3]int16, error) {
+	if d.halted {
+		return [3]int16{}, ErrHalted
+	}
+	if err := d.writeReg(regCNTL1, cntl1Single); err != nil {
+		return [3]int16{}, err
+	}
+	if err := d.waitReady(); err != nil {
+		return [3]int16{}, err
+	}
+	if err := d.readRegBlock(regDATAX, d.data[:]); err != nil {
+		return [3]int16{}, err
+	}
+	b := d.data
+	return [3]int16{
+		int16(b[1])<<8 | int16(b[0]),
+		int16(b[3])<<8 | int16(b[2]),
+		int16(b[5])<<8 | int16(b[4]),
+	}, nil
+}
+
+// waitReady waits for the single measurement and polls STAT1 until it
+// completes.
+func (d *Dev) waitReady() error {
+	doSleep(singleTime)
+	for i := 0; i < 10; i++ {
+		s, err := d.readReg(regSTAT1)
+		if err != nil {
+			return err
+		}
+		if s&stat1DRDY != 0 {
+			return nil
+		}
+		doSleep(singleTime / 8)
+	}
+	return ErrNotReady
+}
+
+// sense returns the compensated measurement in nT.
+func (d *Dev) sense() ([3]float64, error) {
+	c, err := d.senseRaw()
+	if err != nil {
+		return [3]float64{}, err
+	}
+	var v [3]float64
+	for i, row := range d.comp {
+		for j, k := range row {
+			v[i] += k * float64(c[j]) * nanoTeslaPerLSB
+		}
+	}
+	return v, nil
+}
+
+// Sense takes a measurement and scales it to µT×10 (int16) for X,Y,Z.
+//
+// The values are truncated to 0.1µT; use SenseMagnetic for full resolution.
+func (d *Dev) Sense() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.sense()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// µT×10 = nT / 100
+	return int16(v[0] / 100), int16(v[1] / 100), int16(v[2] / 100), nil
+}
+
+// SenseMagnetic takes a measurement. It implements sensor.Magnetometer.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.sense()
+	if err != nil {
+		return err
+	}
+	f.X = physic.MagneticFluxDensity(math.Round(v[0]))
+	f.Y = physic.MagneticFluxDensity(math.Round(v[1]))
+	f.Z = physic.MagneticFluxDensity(math.Round(v[2]))
+	return nil
+}
+
+// SenseMagneticContinuous returns a channel delivering measurements every
+// interval, until Halt is called. It implements sensor.Magnetometer.
+//
+// An interval of 0 or less measures back to back. Calling
+// SenseMagneticContinuous again stops the previous channel.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = singleTime
+	}
+	c := make(chan sensor.Field)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- sensor.Field) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var f sensor.Field
+		if err := d.SenseMagnetic(&f); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- f:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseMagneticContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if err := d.c.Tx([]byte{addr}, out); err != nil {
+		return fmt.Errorf("ist8310: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+func (d *Dev) writeReg(addr, v byte) error {
+	if err := d.c.Tx([]byte{addr, v}, nil); err != nil {
+		return fmt.Errorf("ist8310: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
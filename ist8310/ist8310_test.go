@@ -0,0 +1,240 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ist8310
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// blankOTP is the cross-axis block of a chip without calibration.
+var blankOTP = make([]byte, 18)
+
+// initOps are the bus transactions issued by New, with the AVGCNTL value and
+// the cross-axis block, if read.
+func initOps(avg byte, otp []byte) []i2ctest.IO {
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWAI}, R: []byte{wai}},
+		{Addr: DefaultAddr, W: []byte{regCNTL2, cntl2SRST}},
+		{Addr: DefaultAddr, W: []byte{regAVGCNTL, avg}},
+		{Addr: DefaultAddr, W: []byte{regPDCNTL, pdNormal}},
+	}
+	if otp != nil {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regXXCROSS}, R: otp})
+	}
+	return ops
+}
+
+// singleOps are the bus transactions of a single measurement.
+func singleOps(x, y, z int16) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regCNTL1, cntl1Single}},
+		{Addr: DefaultAddr, W: []byte{regSTAT1}, R: []byte{0}},
+		{Addr: DefaultAddr, W: []byte{regSTAT1}, R: []byte{stat1DRDY}},
+		{Addr: DefaultAddr, W: []byte{regDATAX}, R: []byte{
+			byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8),
+		}},
+	}
+}
+
+// otpBlock encodes a cross-axis matrix as stored in OTP memory.
+func otpBlock(m [9]int16) []byte {
+	b := make([]byte, 0, 18)
+	for _, v := range m {
+		b = append(b, byte(v), byte(uint16(v)>>8))
+	}
+	return b
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		avg  byte
+		otp  []byte
+	}{
+		{Opts{}, 0x24, blankOTP},
+		{Opts{Averaging: 1}, 0x00, blankOTP},
+		{Opts{Averaging: 4, SkipCrossAxis: true}, 0x12, nil},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.avg, line.otp)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != "IST8310{playback(12)}" {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if c := d.CrossAxis(); c != identity {
+			t.Errorf("#%d: CrossAxis() = %v", i, c)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regWAI}, R: []byte{0x48}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{Averaging: 8}, ""},
+		{Opts{Averaging: 3}, "ist8310: invalid options: Averaging 3, want 1, 2, 4, 8 or 16"},
+		{Opts{Averaging: 32}, "ist8310: invalid options: Averaging 32, want 1, 2, 4, 8 or 16"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{Averaging: 3}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := initOps(0x24, blankOTP)
+	ops = append(ops, singleOps(100, -200, 301)...)
+	ops = append(ops, singleOps(100, -200, 301)...)
+	ops = append(ops, singleOps(-1, 2, -3)...)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.3µT/LSB.
+	if x, y, z, err := d.Sense(); err != nil || x != 300 || y != -600 || z != 903 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := (sensor.Field{X: 30 * physic.MicroTesla, Y: -60 * physic.MicroTesla, Z: 90300 * physic.NanoTesla}); f != want {
+		t.Fatalf("SenseMagnetic() = %s, want %s", f, want)
+	}
+	if x, y, z, err := d.SenseRaw(); err != nil || x != -1 || y != 2 || z != -3 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_CrossAxis(t *testing.T) {
+	// Y reads twice the nominal sensitivity and X leaks into Z.
+	otp := otpBlock([9]int16{330, 0, 0, 0, 660, 0, 33, 0, 330})
+	ops := initOps(0x24, otp)
+	ops = append(ops, singleOps(100, 200, 10)...)
+	ops = append(ops, singleOps(100, 200, 10)...)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, y, z, err := d.Sense(); err != nil || x != 300 || y != 300 || z != 0 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	// Not compensated.
+	if x, y, z, err := d.SenseRaw(); err != nil || x != 100 || y != 200 || z != 10 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_NotReady(t *testing.T) {
+	ops := append(initOps(0x24, nil), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCNTL1, cntl1Single}})
+	for i := 0; i < 10; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTAT1}, R: []byte{0}})
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{SkipCrossAxis: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrNotReady {
+		t.Fatalf("SenseRaw() = %v, want ErrNotReady", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	ops := append(initOps(0x24, nil), singleOps(50, 0, 0)...)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{SkipCrossAxis: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := <-c; f.X != 15*physic.MicroTesla {
+		t.Fatalf("got %s", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrHalted {
+		t.Fatalf("SenseRaw() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseMagneticContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseMagneticContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(0x24, nil), DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(0x24, nil), DontPanic: true}
+	d, err := New(bus, Opts{SkipCrossAxis: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h
+
+import (
+	"errors"
+	"log"
+	"time"
+)
+
+// SenseContinuous returns a channel delivering samples until Halt is called.
+//
+// Samples are read on each DRDY edge when Opts.DRDY is set, otherwise every
+// interval. An interval of 0 or less uses the output data rate, or the time
+// to queue FIFOWatermark samples with Opts.FIFO. With Opts.FIFO, the FIFO is
+// drained on each wakeup, and a FIFO overflow is logged without stopping.
+// Calling SenseContinuous again stops the previous channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan Sample, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = d.odr.Period()
+		if d.fifo {
+			interval *= FIFOWatermark
+		}
+	}
+	c := make(chan Sample)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		if err := d.sensingContinuous(interval, stop, c); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+		}
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- Sample) error {
+	var tick <-chan time.Time
+	if d.drdy == nil {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	var buf []Sample
+	if d.fifo {
+		buf = make([]Sample, fifoSize)
+	} else {
+		buf = make([]Sample, 1)
+	}
+	for {
+		if d.drdy != nil {
+			// Poll the stop channel between edges.
+			if !d.drdy.WaitForEdge(interval) {
+				select {
+				case <-stop:
+					return nil
+				default:
+					continue
+				}
+			}
+		} else {
+			select {
+			case <-stop:
+				return nil
+			case <-tick:
+			}
+		}
+		n, err := d.senseInto(buf)
+		if errors.Is(err, ErrFIFOOverflow) {
+			log.Printf("%s: %v", d, err)
+		} else if err != nil {
+			return err
+		}
+		for _, s := range buf[:n] {
+			select {
+			case c <- s:
+			case <-stop:
+				return nil
+			}
+		}
+	}
+}
+
+// senseInto reads the FIFO into buf with Opts.FIFO, otherwise the latest
+// sample into buf[0].
+func (d *Dev) senseInto(buf []Sample) (int, error) {
+	if d.fifo {
+		return d.ReadFIFO(buf)
+	}
+	if err := d.Sense(&buf[0]); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// stopContinuous stops the SenseContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
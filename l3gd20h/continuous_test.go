@@ -0,0 +1,75 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestSenseContinuous(t *testing.T) {
+	ops := append(defaultOps(),
+		dataOp(100, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, 0}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-c; s.Raw[0] != 100 {
+		t.Fatalf("got %+v", s)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous_FIFO(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := fifoOps()
+	// INT2_FTH.
+	ops[len(ops)-1].W[3] = ctrl3FTH
+	ops = append(ops,
+		srcOp(0x80|2),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOUTX | i2cAutoInc}, R: append(le(1, 0, 0), le(2, 0, 0)...)},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, 0}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{FIFO: true, DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drdy.EdgesChan <- gpio.High
+	for i := 1; i <= 2; i++ {
+		if s := <-c; s.Raw[0] != int16(i) {
+			t.Fatalf("#%d: got %+v", i, s)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package l3gd20h controls an ST L3GD20H 3-axis gyroscope over I²C or SPI.
+//
+// # More details
+//
+// The L3GD20H measures up to ±2000°/s in 16 bits, at 12.5 to 800Hz. It is
+// often paired with a magnetometer, such as the LIS3MDL of the lis3mdl
+// package, to track the heading between magnetometer updates.
+//
+// The optional high-pass filter removes the bias of the measurements; its
+// cutoff frequency is relative to the output data rate. With Opts.FIFO, the
+// samples are queued in the 32 levels FIFO in stream mode, read in batches
+// with ReadFIFO. The DRDY/INT2 pin signals new data, or the FIFO reaching
+// FIFOWatermark samples, and paces SenseContinuous.
+//
+// # Datasheet
+//
+// https://www.st.com/resource/en/datasheet/l3gd20h.pdf
+package l3gd20h
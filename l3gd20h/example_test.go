@@ -0,0 +1,48 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/l3gd20h"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := l3gd20h.New(bus, l3gd20h.Opts{
+		Range:    l3gd20h.Range500DPS,
+		ODR:      200 * physic.Hertz,
+		HighPass: 500 * physic.MilliHertz,
+		FIFO:     true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		fmt.Println(<-c)
+	}
+}
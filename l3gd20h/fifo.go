@@ -0,0 +1,106 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h
+
+import (
+	"errors"
+	"time"
+)
+
+// FIFOWatermark is the number of samples queued in the FIFO at which the DRDY
+// pin is raised with Opts.FIFO.
+const FIFOWatermark = 16
+
+const (
+	fifoSize  = 32 // samples
+	sampleLen = 6  // bytes
+
+	fifoSrcOverrun = 0x40
+	fifoSrcEmpty   = 0x20
+	fifoSrcLevel   = 0x1F
+)
+
+// FIFOLen returns the number of samples queued in the FIFO. It requires
+// Opts.FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	n, _, err := d.fifoStatus()
+	return n, err
+}
+
+// ReadFIFO reads up to len(s) samples from the FIFO into s, oldest first, and
+// returns the number read. It requires Opts.FIFO.
+//
+// The FIFO holds 32 samples. When it is full, older samples may have been
+// overwritten since the last call and the samples are returned along with
+// ErrFIFOOverflow.
+func (d *Dev) ReadFIFO(s []Sample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	return d.readFIFO(s)
+}
+
+func (d *Dev) readFIFO(s []Sample) (int, error) {
+	n, overrun, err := d.fifoStatus()
+	if err != nil {
+		return 0, err
+	}
+	n = min(n, len(s))
+	if n == 0 {
+		return 0, nil
+	}
+	// The address wraps back from OUT_Z_H to OUT_X_L, so the samples are read
+	// in one transaction.
+	b := d.data[:n*sampleLen]
+	if err := d.readRegBlock(regOUTX, b); err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	period := d.odr.Period()
+	for i := range s[:n] {
+		d.decode(&s[i], b[i*sampleLen:])
+		s[i].Timestamp = now.Add(-time.Duration(n-1-i) * period)
+	}
+	if overrun {
+		return n, ErrFIFOOverflow
+	}
+	return n, nil
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+// fifoStatus returns the number of samples queued in the FIFO and whether it
+// overflowed.
+func (d *Dev) fifoStatus() (int, bool, error) {
+	src, err := d.readReg(regFIFOSRC)
+	if err != nil {
+		return 0, false, err
+	}
+	// FSS counts up to 31; the 32nd sample sets OVRN.
+	switch {
+	case src&fifoSrcOverrun != 0:
+		return fifoSize, true, nil
+	case src&fifoSrcEmpty != 0:
+		return 0, false, nil
+	}
+	return int(src & fifoSrcLevel), false, nil
+}
+
+var errFIFODisabled = errors.New("l3gd20h: FIFO not enabled in Opts")
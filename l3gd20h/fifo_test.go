@@ -0,0 +1,105 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// fifoOps are the bus transactions issued by New with Opts.FIFO.
+func fifoOps() []i2ctest.IO {
+	ops := defaultOps()
+	last := ops[len(ops)-1]
+	last.W = append([]byte{}, last.W...)
+	last.W[len(last.W)-1] = ctrl5FIFOEn
+	ops = append(ops[:len(ops)-1], i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCTRL, fifoStream | FIFOWatermark}})
+	return append(ops, last)
+}
+
+// srcOp returns a read of FIFO_SRC.
+func srcOp(src byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOSRC}, R: []byte{src}}
+}
+
+func TestReadFIFO(t *testing.T) {
+	ops := append(fifoOps(),
+		srcOp(3),
+		srcOp(3),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOUTX | i2cAutoInc}, R: append(le(1, 2, 3), le(4, 5, 6)...)},
+		srcOp(fifoSrcEmpty),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 3 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]Sample, 2)
+	if n, err := d.ReadFIFO(s); err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if s[0].Raw != [3]int16{1, 2, 3} || s[1].Raw != [3]int16{4, 5, 6} {
+		t.Fatalf("got %+v", s)
+	}
+	if diff := s[1].Timestamp.Sub(s[0].Timestamp); diff != d.odr.Period() {
+		t.Errorf("timestamps %s apart, want %s", diff, d.odr.Period())
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Overflow(t *testing.T) {
+	var b []byte
+	for i := 0; i < fifoSize; i++ {
+		b = append(b, le(int16(i), 0, 0)...)
+	}
+	ops := append(fifoOps(),
+		srcOp(0x80|fifoSrcOverrun|0x1F),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOUTX | i2cAutoInc}, R: b},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]Sample, 40)
+	n, err := d.ReadFIFO(s)
+	if !errors.Is(err, ErrFIFOOverflow) || n != fifoSize {
+		t.Fatalf("ReadFIFO() = %d, %v, want ErrFIFOOverflow", n, err)
+	}
+	if s[31].Raw[0] != 31 {
+		t.Fatalf("got %+v", s[31])
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.FIFOLen(); err != errFIFODisabled {
+		t.Fatalf("FIFOLen() = %v", err)
+	}
+	if _, err := d.ReadFIFO(nil); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	d.halted = true
+	if _, err := d.ReadFIFO(nil); err != ErrHalted {
+		t.Fatalf("ReadFIFO() = %v, want ErrHalted", err)
+	}
+}
@@ -0,0 +1,493 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// I²C addresses, selected by the SDO/SA0 pin.
+const (
+	DefaultAddr = 0x6B // SDO/SA0 high
+	AltAddr     = 0x6A // SDO/SA0 low
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the L3GD20H.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Register map.
+const (
+	regWHOAMI    = 0x0F
+	regCTRL1     = 0x20 // DR bits 7..6, BW bits 5..4, PD bit 3, Zen, Yen, Xen bits 2..0
+	regCTRL2     = 0x21 // HPM bits 5..4, HPCF bits 3..0
+	regCTRL3     = 0x22 // INT2_DRDY bit 3, INT2_FTH bit 2
+	regCTRL4     = 0x23 // BDU bit 7, FS bits 5..4
+	regCTRL5     = 0x24 // FIFO_EN bit 6, HPen bit 4, Out_Sel bits 1..0
+	regREFERENCE = 0x25
+	regSTATUS    = 0x27 // ZYXOR bit 7, ZYXDA bit 3
+	regOUTX      = 0x28 // X L, X H, Y L, Y H, Z L, Z H
+	regFIFOCTRL  = 0x2E // FM bits 7..5, FTH bits 4..0
+	regFIFOSRC   = 0x2F // FTH bit 7, OVRN bit 6, EMPTY bit 5, FSS bits 4..0
+	regLOWODR    = 0x39 // DRDY_HL bit 5, I2C_dis bit 3, SW_RES bit 2, Low_ODR bit 0
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0xD7
+
+// Register values.
+const (
+	ctrl1PowerXYZ = 0x0F // normal mode with the 3 axes enabled
+	ctrl3DRDY     = 0x08
+	ctrl3FTH      = 0x04
+	ctrl4BDU      = 0x80 // block data update: the bytes of a sample are read together
+	ctrl5FIFOEn   = 0x40
+	ctrl5HPF      = 0x11 // HPen and Out_Sel: the outputs and FIFO are high-pass filtered
+	fifoStream    = 0x40 // FM in FIFO_CTRL
+	lowODR        = 0x01
+	lowODRI2CDis  = 0x08
+	lowODRSWReset = 0x04
+)
+
+// Address framing bits. On I²C, auto-increment is selected by bit 7 of the
+// register address; on SPI, bit 7 selects a read and bit 6 auto-increment.
+const (
+	i2cAutoInc = 0x80
+	spiRead    = 0x80
+	spiAutoInc = 0x40
+)
+
+// resetTime is the time for the registers to be restored after a soft reset.
+const resetTime = time.Millisecond
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the WHO_AM_I register doesn't read
+	// 0xD7.
+	ErrBadID = errors.New("l3gd20h: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("l3gd20h: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("l3gd20h: invalid options")
+	// ErrFIFOOverflow is returned by ReadFIFO along with the samples when
+	// older samples were overwritten because the FIFO was full.
+	ErrFIFOOverflow = errors.New("l3gd20h: FIFO overflow")
+)
+
+// Range is the full scale of the measurements.
+type Range byte
+
+// Ranges supported by the L3GD20H.
+const (
+	Range245DPS  Range = 0 // ±245°/s, 8.75mdps/LSB
+	Range500DPS  Range = 1 // ±500°/s, 17.5mdps/LSB
+	Range2000DPS Range = 2 // ±2000°/s, 70mdps/LSB
+)
+
+var (
+	fullScales = [...]int{245, 500, 2000}
+	mdpsPerLSB = [...]float64{8.75, 17.5, 70}
+)
+
+func (r Range) String() string {
+	if r > Range2000DPS {
+		return fmt.Sprintf("Range(%d)", byte(r))
+	}
+	return fmt.Sprintf("±%d°/s", fullScales[r])
+}
+
+// odrs are the output data rates selected by the DR bits of CTRL1, with
+// Low_ODR for the first three.
+var odrs = [...]physic.Frequency{
+	12500 * physic.MilliHertz,
+	25 * physic.Hertz,
+	50 * physic.Hertz,
+	100 * physic.Hertz,
+	200 * physic.Hertz,
+	400 * physic.Hertz,
+	800 * physic.Hertz,
+}
+
+// hpCutoffs are the cutoff frequencies of the high-pass filter in mHz, by
+// HPCF value and output data rate.
+var hpCutoffs = [...][len(odrs)]int64{
+	{1000, 2000, 4000, 8000, 15000, 30000, 56000},
+	{500, 1000, 2000, 4000, 8000, 15000, 30000},
+	{200, 500, 1000, 2000, 4000, 8000, 15000},
+	{100, 200, 500, 1000, 2000, 4000, 8000},
+	{50, 100, 200, 500, 1000, 2000, 4000},
+	{20, 50, 100, 200, 500, 1000, 2000},
+	{10, 20, 50, 100, 200, 500, 1000},
+	{5, 10, 20, 50, 100, 200, 500},
+	{2, 5, 10, 20, 50, 100, 200},
+	{1, 2, 5, 10, 20, 50, 100},
+}
+
+// Opts holds initialization options.
+//
+// Range: full scale, Range245DPS by default.
+// ODR: output data rate, 12.5, 25, 50, 100 (default), 200, 400 or 800Hz.
+// HighPass: cutoff frequency of the high-pass filter, 0 (default) to disable
+// it. It is 1/12.5 to 1/12500 of the ODR, see HighPassCutoffs.
+// FIFO: queue the samples in the FIFO, read with ReadFIFO.
+// DRDY: optional pin connected to the DRDY/INT2 output, high on new data or,
+// with FIFO, when it holds FIFOWatermark samples. It paces SenseContinuous.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	Range    Range
+	ODR      physic.Frequency
+	HighPass physic.Frequency
+	FIFO     bool
+	DRDY     gpio.PinIn
+	Addr     uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if o.Range > Range2000DPS {
+		return fmt.Errorf("%w: Range %s, want Range245DPS, Range500DPS or Range2000DPS", ErrInvalidOpts, o.Range)
+	}
+	dr := o.dataRate()
+	if dr < 0 {
+		return fmt.Errorf("%w: ODR %s, want 12.5, 25, 50, 100, 200, 400 or 800Hz", ErrInvalidOpts, o.ODR)
+	}
+	if o.HighPass != 0 && hpcf(dr, o.HighPass) < 0 {
+		return fmt.Errorf("%w: HighPass %s, want one of %v at %s", ErrInvalidOpts, o.HighPass, HighPassCutoffs(odrs[dr]), odrs[dr])
+	}
+	return nil
+}
+
+// dataRate returns the index of the ODR in odrs, or -1.
+func (o *Opts) dataRate() int {
+	odr := o.ODR
+	if odr == 0 {
+		odr = 100 * physic.Hertz
+	}
+	for i, f := range odrs {
+		if f == odr {
+			return i
+		}
+	}
+	return -1
+}
+
+// hpcf returns HPCF for the cutoff frequency f at the output data rate of
+// index dr, or -1.
+func hpcf(dr int, f physic.Frequency) int {
+	for i, c := range hpCutoffs {
+		if physic.Frequency(c[dr])*physic.MilliHertz == f {
+			return i
+		}
+	}
+	return -1
+}
+
+// HighPassCutoffs returns the cutoff frequencies of the high-pass filter
+// supported at an output data rate, highest first. It returns nil for an
+// unsupported rate.
+func HighPassCutoffs(odr physic.Frequency) []physic.Frequency {
+	for dr, f := range odrs {
+		if f != odr {
+			continue
+		}
+		out := make([]physic.Frequency, len(hpCutoffs))
+		for i, c := range hpCutoffs {
+			out[i] = physic.Frequency(c[dr]) * physic.MilliHertz
+		}
+		return out
+	}
+	return nil
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Rate is the angular rate in rad/s, in X,Y,Z order.
+	Rate [3]float64
+	// Raw are the counts Rate was computed from.
+	Raw [3]int16
+	// Timestamp is the time at which the sample was read from the device, or
+	// estimated from the sample rate for samples read from the FIFO.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("gyro=%.4f,%.4f,%.4frad/s", s.Rate[0], s.Rate[1], s.Rate[2])
+}
+
+// Dev represents an L3GD20H device.
+// Sense returns values in rad/s.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c        conn.Conn
+	isSPI    bool
+	rng      Range
+	lsb      float64 // LSB per rad/s
+	odr      physic.Frequency
+	highPass physic.Frequency
+	fifo     bool
+	drdy     gpio.PinIn
+	halted   bool
+
+	// Preallocated bus buffers large enough for a full FIFO, so that
+	// sensing doesn't allocate. w and r are one byte longer for the address.
+	data [fifoSize * sampleLen]byte
+	w, r [fifoSize*sampleLen + 1]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI resets and configures a device on a 4-wire SPI port. The I²C
+// interface of the device is disabled.
+//
+// The port is connected in mode 3 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode3, 8)
+	if err != nil {
+		return nil, fmt.Errorf("l3gd20h: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	dr := opts.dataRate()
+	d := &Dev{
+		c:        c,
+		isSPI:    isSPI,
+		rng:      opts.Range,
+		lsb:      1000 / mdpsPerLSB[opts.Range] * 180 / math.Pi,
+		odr:      odrs[dr],
+		highPass: opts.HighPass,
+		fifo:     opts.FIFO,
+		drdy:     opts.DRDY,
+	}
+	// DRDY is push-pull, active high.
+	if d.drdy != nil {
+		if err := d.drdy.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("l3gd20h: configuring DRDY: %w", err)
+		}
+	}
+	id, err := d.readReg(regWHOAMI)
+	if err != nil {
+		return nil, err
+	}
+	if id != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, whoAmI)
+	}
+	if err := d.writeRegs(regLOWODR, lowODRSWReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	var low byte
+	if dr < 3 {
+		low = lowODR
+	}
+	if isSPI {
+		low |= lowODRI2CDis
+	}
+	if low != 0 {
+		if err := d.writeRegs(regLOWODR, low); err != nil {
+			return nil, err
+		}
+	}
+	var ctrl2, ctrl3, ctrl5 byte
+	if opts.HighPass != 0 {
+		ctrl2 = byte(hpcf(dr, opts.HighPass))
+		ctrl5 |= ctrl5HPF
+	}
+	if opts.FIFO {
+		if err := d.writeRegs(regFIFOCTRL, fifoStream|FIFOWatermark); err != nil {
+			return nil, err
+		}
+		ctrl5 |= ctrl5FIFOEn
+	}
+	if d.drdy != nil {
+		ctrl3 = ctrl3DRDY
+		if opts.FIFO {
+			ctrl3 = ctrl3FTH
+		}
+	}
+	// CTRL1 to CTRL5 in one transaction. With Low_ODR, the DR values 0 to 2
+	// select 12.5 to 50Hz instead of 100 to 400Hz.
+	code := dr - 3
+	if dr < 3 {
+		code = dr
+	}
+	ctrl1 := byte(code)<<6 | ctrl1PowerXYZ
+	if err := d.writeRegs(regCTRL1, ctrl1, ctrl2, ctrl3, ctrl4BDU|byte(d.rng)<<4, ctrl5); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("L3GD20H{%s, %s, %s}", d.c, d.rng, d.odr)
+}
+
+// SampleRate returns the output data rate.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.odr
+}
+
+// Halt stops SenseContinuous and powers the device down. It implements
+// conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regCTRL1, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw reads the latest measurement and returns X,Y,Z as int16 counts.
+func (d *Dev) SenseRaw() (int16, int16, int16, error) {
+	var s Sample
+	err := d.Sense(&s)
+	return s.Raw[0], s.Raw[1], s.Raw[2], err
+}
+
+// Sense reads the latest sample into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection. With Opts.FIFO, it pops the
+// oldest sample of the FIFO instead.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	b := d.data[:sampleLen]
+	if err := d.readRegBlock(regOUTX, b); err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	d.decode(s, b)
+	return nil
+}
+
+// decode scales the little-endian counts of b into s.
+func (d *Dev) decode(s *Sample, b []byte) {
+	for i := 0; i < 3; i++ {
+		s.Raw[i] = int16(b[2*i+1])<<8 | int16(b[2*i])
+		s.Rate[i] = float64(s.Raw[i]) / d.lsb
+	}
+}
+
+// ResetHighPass resets the high-pass filter to the current angular rate, so
+// that the following measurements are relative to it.
+func (d *Dev) ResetHighPass() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	if d.highPass == 0 {
+		return errNoHighPass
+	}
+	// In the normal mode of the filter, reading REFERENCE resets it.
+	_, err := d.readReg(regREFERENCE)
+	return err
+}
+
+// Status reads the status register. Bits 3..0 report new data on all axes,
+// Z, Y and X, and bits 7..4 data overwritten before being read.
+func (d *Dev) Status() (byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readReg(regSTATUS)
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, r := d.w[:len(out)+1], d.r[:len(out)+1]
+		clear(w)
+		w[0] = addr | spiRead
+		if len(out) > 1 {
+			w[0] |= spiAutoInc
+		}
+		if err := d.c.Tx(w, r); err != nil {
+			return fmt.Errorf("l3gd20h: reading register 0x%02x: %w", addr, err)
+		}
+		copy(out, r[1:])
+		return nil
+	}
+	w := append(d.w[:0], addr)
+	if len(out) > 1 {
+		w[0] |= i2cAutoInc
+	}
+	if err := d.c.Tx(w, out); err != nil {
+		return fmt.Errorf("l3gd20h: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, vals ...byte) error {
+	w := append(d.w[:0], addr)
+	if len(vals) > 1 {
+		if d.isSPI {
+			w[0] |= spiAutoInc
+		} else {
+			w[0] |= i2cAutoInc
+		}
+	}
+	w = append(w, vals...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("l3gd20h: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var errNoHighPass = errors.New("l3gd20h: high-pass filter not enabled in Opts")
+
+var _ conn.Resource = &Dev{}
@@ -0,0 +1,268 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package l3gd20h
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given LOW_ODR and
+// CTRL1 to CTRL5, LOW_ODR being skipped when 0.
+func initOps(low byte, ctrl ...byte) []i2ctest.IO {
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{whoAmI}},
+		{Addr: DefaultAddr, W: []byte{regLOWODR, lowODRSWReset}},
+	}
+	if low != 0 {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regLOWODR, low}})
+	}
+	return append(ops, i2ctest.IO{Addr: DefaultAddr, W: append([]byte{regCTRL1 | i2cAutoInc}, ctrl...)})
+}
+
+// defaultOps are the bus transactions issued by New with zero Opts.
+func defaultOps() []i2ctest.IO {
+	return initOps(0, 0x0F, 0x00, 0x00, ctrl4BDU, 0x00)
+}
+
+// le encodes X, Y and Z as little-endian counts.
+func le(x, y, z int16) []byte {
+	return []byte{byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8)}
+}
+
+// dataOp returns a read of the output registers.
+func dataOp(x, y, z int16) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regOUTX | i2cAutoInc}, R: le(x, y, z)}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		low  byte
+		ctrl []byte
+		s    string
+	}{
+		{Opts{}, 0, []byte{0x0F, 0x00, 0x00, 0x80, 0x00}, "L3GD20H{playback(107), ±245°/s, 100Hz}"},
+		{
+			Opts{Range: Range2000DPS, ODR: 800 * physic.Hertz, HighPass: 56 * physic.Hertz},
+			0, []byte{0xCF, 0x00, 0x00, 0xA0, 0x11},
+			"L3GD20H{playback(107), ±2000°/s, 800Hz}",
+		},
+		{
+			Opts{Range: Range500DPS, ODR: 25 * physic.Hertz, HighPass: 2 * physic.MilliHertz},
+			lowODR, []byte{0x4F, 0x09, 0x00, 0x90, 0x11},
+			"L3GD20H{playback(107), ±500°/s, 25Hz}",
+		},
+		{
+			Opts{ODR: 400 * physic.Hertz},
+			0, []byte{0x8F, 0x00, 0x00, 0x80, 0x00},
+			"L3GD20H{playback(107), ±245°/s, 400Hz}",
+		},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.low, line.ctrl...)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_AltAddr(t *testing.T) {
+	ops := defaultOps()
+	for i := range ops {
+		ops[i].Addr = AltAddr
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	if _, err := New(bus, Opts{Addr: AltAddr}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{0xD4}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		{W: []byte{regWHOAMI | spiRead, 0}, R: []byte{0, whoAmI}},
+		{W: []byte{regLOWODR, lowODRSWReset}},
+		{W: []byte{regLOWODR, lowODRI2CDis}},
+		{W: []byte{regCTRL1 | spiAutoInc, 0x0F, 0x00, 0x00, 0x80, 0x00}},
+		{W: []byte{regOUTX | spiRead | spiAutoInc, 0, 0, 0, 0, 0, 0}, R: append([]byte{0}, le(-8, 0, 0)...)},
+	}}}
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, _, _, err := d.SenseRaw(); err != nil || x != -8 {
+		t.Fatalf("SenseRaw() = %d, %v", x, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Range: 3}, "l3gd20h: invalid options: Range Range(3), want Range245DPS, Range500DPS or Range2000DPS"},
+		{Opts{ODR: 1 * physic.KiloHertz}, "l3gd20h: invalid options: ODR 1kHz, want 12.5, 25, 50, 100, 200, 400 or 800Hz"},
+		{Opts{ODR: 12500 * physic.MilliHertz, HighPass: 56 * physic.Hertz}, "l3gd20h: invalid options: HighPass 56Hz, want one of [1Hz 500mHz 200mHz 100mHz 50mHz 20mHz 10mHz 5mHz 2mHz 1mHz] at 12.500Hz"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Errorf("#%d: Validate() = %v", i, err)
+		}
+	}
+}
+
+func TestHighPassCutoffs(t *testing.T) {
+	c := HighPassCutoffs(100 * physic.Hertz)
+	if len(c) != 10 || c[0] != 8*physic.Hertz || c[9] != 10*physic.MilliHertz {
+		t.Fatalf("HighPassCutoffs() = %v", c)
+	}
+	if c := HighPassCutoffs(physic.Hertz); c != nil {
+		t.Fatalf("HighPassCutoffs() = %v", c)
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: append(defaultOps(), dataOp(1000, -2000, 0))}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Raw != [3]int16{1000, -2000, 0} || s.Timestamp.IsZero() {
+		t.Fatalf("got %+v", s)
+	}
+	// 8.75mdps/LSB.
+	if want := 8.75 * math.Pi / 180; math.Abs(s.Rate[0]-want) > 1e-9 || math.Abs(s.Rate[1]+2*want) > 1e-9 {
+		t.Fatalf("Rate = %v", s.Rate)
+	}
+	if got := s.String(); got != "gyro=0.1527,-0.3054,0.0000rad/s" {
+		t.Fatalf("String() = %q", got)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResetHighPass(t *testing.T) {
+	ops := append(initOps(0, 0x0F, 0x03, 0x00, 0x80, 0x11),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regREFERENCE}, R: []byte{0}},
+	)
+	bus := &i2ctest.Playback{Ops: append(ops, defaultOps()...)}
+	d, err := New(bus, Opts{HighPass: physic.Hertz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ResetHighPass(); err != nil {
+		t.Fatal(err)
+	}
+	if d, err = New(bus, Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ResetHighPass(); err != errNoHighPass {
+		t.Fatalf("ResetHighPass() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: append(defaultOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{0x0F}})}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := d.Status(); err != nil || s != 0x0F {
+		t.Fatalf("Status() = %#x, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: append(defaultOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, 0}})}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if err := d.ResetHighPass(); err != ErrHalted {
+		t.Fatalf("ResetHighPass() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: defaultOps(), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRange(t *testing.T) {
+	if s := Range2000DPS.String(); s != "±2000°/s" {
+		t.Fatal(s)
+	}
+	if s := Range(7).String(); s != "Range(7)" {
+		t.Fatal(s)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package lis2dh12 controls an ST LIS2DH12 3-axis accelerometer over I²C or
+// SPI.
+//
+// # More details
+//
+// The LIS2DH12 measures up to ±16g at 1Hz to 5.376kHz. Its three operating
+// modes trade resolution for power: 8 bits in low-power mode, 10 bits in
+// normal mode and 12 bits in high-resolution mode. At 1 or 10Hz in low-power
+// mode it draws a few µA, which suits battery-powered tilt and vibration
+// monitoring.
+//
+// The motion interrupt configured with Opts.Motion signals on INT1 an
+// acceleration above a threshold, with gravity removed by the high-pass
+// filter; read it with WaitForMotion or MotionSource. With Opts.Activity, the
+// device falls back to 10Hz in low-power mode while the acceleration stays
+// below a threshold, and INT2 is high while it sleeps. With Opts.FIFO, the
+// samples are queued in the 32 levels FIFO in stream mode, read in batches
+// with ReadFIFO.
+//
+// # Datasheet
+//
+// https://www.st.com/resource/en/datasheet/lis2dh12.pdf
+package lis2dh12
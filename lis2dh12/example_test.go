@@ -0,0 +1,55 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis2dh12_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/lis2dh12"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// Wake up on vibrations above 250mg, sampling at 10Hz in low-power mode.
+	d, err := lis2dh12.New(bus, lis2dh12.Opts{
+		Mode:   lis2dh12.LowPower,
+		ODR:    10 * physic.Hertz,
+		Motion: &lis2dh12.MotionOpts{Threshold: 250, Duration: 200 * time.Millisecond},
+		INT1:   gpioreg.ByName("GPIO17"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	for {
+		m, err := d.WaitForMotion(-1)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var s lis2dh12.Sample
+		if err := d.Sense(&s); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(m, s)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis2dh12
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// int1CfgHigh enables the events above the threshold on the 3 axes in
+// INT1_CFG, combined with OR.
+const int1CfgHigh = 0x2A
+
+// MotionOpts configures the motion interrupt with Opts.Motion.
+//
+// Motion is an acceleration above Threshold on any axis that lasts at least
+// Duration. Gravity and slow tilts are removed by the high-pass filter, so
+// the interrupt reports vibrations and shocks. It is signaled on INT1 and
+// latched until read by MotionSource or WaitForMotion.
+type MotionOpts struct {
+	Threshold int           // Threshold in mg, from 16mg at ±2g to 23622mg at ±16g, by steps of 16, 32, 62 or 186mg by range.
+	Duration  time.Duration // Minimum duration, up to 127 periods of the ODR. 0 reports the first sample above Threshold.
+}
+
+// ActivityOpts configures the sleep on inactivity with Opts.Activity.
+//
+// When the acceleration stays below Threshold on all axes for Duration, the
+// device falls back to 10Hz in LowPower mode and INT2 goes high. It returns
+// to the configured mode and rate, and INT2 low, on the first sample above
+// Threshold.
+type ActivityOpts struct {
+	Threshold int           // Threshold in mg, with the steps of MotionOpts.Threshold.
+	Duration  time.Duration // Duration of the inactivity before sleeping, 1 to 2041 periods of the ODR, by steps of 8.
+}
+
+// Motion is the source of a motion interrupt.
+type Motion struct {
+	// X, Y and Z report the axes above the threshold.
+	X, Y, Z bool
+	// Active reports that the interrupt is signaled.
+	Active bool
+}
+
+func (m Motion) String() string {
+	return fmt.Sprintf("Motion{X:%t Y:%t Z:%t Active:%t}", m.X, m.Y, m.Z, m.Active)
+}
+
+// regs returns INT1_THS and INT1_DURATION.
+func (m *MotionOpts) regs(r Range, odr physic.Frequency) (byte, byte, error) {
+	ths, err := threshold("Motion", m.Threshold, r)
+	if err != nil {
+		return 0, 0, err
+	}
+	n := periods(m.Duration, odr)
+	if m.Duration < 0 || n > 127 {
+		return 0, 0, fmt.Errorf("%w: Motion.Duration %s, want 0 to %s at %s", ErrInvalidOpts, m.Duration, 127*odr.Period(), odr)
+	}
+	return ths, byte(n), nil
+}
+
+// regs returns ACT_THS and ACT_DUR.
+func (a *ActivityOpts) regs(r Range, odr physic.Frequency) (byte, byte, error) {
+	ths, err := threshold("Activity", a.Threshold, r)
+	if err != nil {
+		return 0, 0, err
+	}
+	// The duration is (8×ACT_DUR+1) periods.
+	n := (periods(a.Duration, odr) - 1 + 4) / 8
+	if a.Duration <= 0 || n > 255 {
+		return 0, 0, fmt.Errorf("%w: Activity.Duration %s, want %s to %s at %s", ErrInvalidOpts, a.Duration, odr.Period(), 2041*odr.Period(), odr)
+	}
+	return ths, byte(n), nil
+}
+
+// threshold returns the 7 bits threshold register value for mg.
+func threshold(field string, mg int, r Range) (byte, error) {
+	step := thsMg[r]
+	n := (mg + step/2) / step
+	if n < 1 || n > 127 {
+		return 0, fmt.Errorf("%w: %s.Threshold %dmg, want %d to %dmg at %s", ErrInvalidOpts, field, mg, step, 127*step, r)
+	}
+	return byte(n), nil
+}
+
+// periods returns d as a rounded number of periods of odr.
+func periods(d time.Duration, odr physic.Frequency) int {
+	return int(math.Round(d.Seconds() * float64(odr) / float64(physic.Hertz)))
+}
+
+// setupMotion writes the threshold and duration of the motion interrupt and
+// enables it.
+func (d *Dev) setupMotion(m *MotionOpts) error {
+	ths, dur, err := m.regs(d.rng, d.odr)
+	if err != nil {
+		return err
+	}
+	if err := d.writeRegs(regINT1THS, ths, dur); err != nil {
+		return err
+	}
+	return d.writeRegs(regINT1CFG, int1CfgHigh)
+}
+
+// MotionSource reads, and so clears, the source of the motion interrupt.
+func (d *Dev) MotionSource() (Motion, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return Motion{}, ErrHalted
+	}
+	if !d.motion {
+		return Motion{}, fmt.Errorf("%w: Motion not set", ErrInvalidOpts)
+	}
+	b, err := d.readReg(regINT1SRC)
+	if err != nil {
+		return Motion{}, err
+	}
+	return Motion{
+		X:      b&0x02 != 0,
+		Y:      b&0x08 != 0,
+		Z:      b&0x20 != 0,
+		Active: b&0x40 != 0,
+	}, nil
+}
+
+// WaitForMotion waits for INT1 to signal the motion interrupt and returns its
+// source.
+//
+// Opts.INT1 and Opts.Motion must have been set. Returns ErrNotReady if no
+// interrupt arrives within timeout; a timeout of -1 waits forever, as with
+// gpio.PinIn. An interrupt still latched from before doesn't signal a new
+// edge; clear it with MotionSource first. With Opts.FIFO, the FIFO watermark
+// also raises INT1, and the Motion returned is then not Active.
+func (d *Dev) WaitForMotion(timeout time.Duration) (Motion, error) {
+	if d.int1 == nil {
+		return Motion{}, fmt.Errorf("%w: INT1 pin not set", ErrInvalidOpts)
+	}
+	if !d.int1.WaitForEdge(timeout) {
+		return Motion{}, ErrNotReady
+	}
+	return d.MotionSource()
+}
+
+// Inactive reports whether the device sleeps after a period of inactivity,
+// as signaled on INT2.
+//
+// Opts.INT2 and Opts.Activity must have been set.
+func (d *Dev) Inactive() (bool, error) {
+	if d.int2 == nil {
+		return false, fmt.Errorf("%w: INT2 pin not set", ErrInvalidOpts)
+	}
+	if !d.activity {
+		return false, fmt.Errorf("%w: Activity not set", ErrInvalidOpts)
+	}
+	return d.int2.Read() == gpio.High, nil
+}
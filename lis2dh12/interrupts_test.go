@@ -0,0 +1,108 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis2dh12
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// motionOps are the bus transactions issued by New with Opts.Motion of
+// 100mg for 20ms at 50Hz.
+func motionOps() []i2ctest.IO {
+	return initOps([2]byte{}, []byte{0x47, ctrl2HPIA1, ctrl3IA1, 0x80, ctrl5LIRInt1, 0x00},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINT1THS | i2cAutoInc, 6, 1}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINT1CFG, int1CfgHigh}},
+	)
+}
+
+var motionOpts = Opts{ODR: 50 * physic.Hertz, Motion: &MotionOpts{Threshold: 100, Duration: 20 * time.Millisecond}}
+
+func TestMotionSource(t *testing.T) {
+	ops := append(motionOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regINT1SRC}, R: []byte{0x40 | 0x20 | 0x02 | 0x01}})
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, motionOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := d.MotionSource()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Motion{X: true, Z: true, Active: true}); m != want {
+		t.Fatalf("MotionSource() = %s", m)
+	}
+	if s := m.String(); s != "Motion{X:true Y:false Z:true Active:true}" {
+		t.Fatalf("String() = %q", s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForMotion(t *testing.T) {
+	int1 := &gpiotest.Pin{N: "INT1", EdgesChan: make(chan gpio.Level, 1)}
+	ops := append(motionOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regINT1SRC}, R: []byte{0x40 | 0x08}})
+	bus := &i2ctest.Playback{Ops: ops}
+	opts := motionOpts
+	opts.INT1 = int1
+	d, err := New(bus, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.WaitForMotion(0); err != ErrNotReady {
+		t.Fatalf("WaitForMotion() = %v, want ErrNotReady", err)
+	}
+	int1.EdgesChan <- gpio.High
+	if m, err := d.WaitForMotion(time.Second); err != nil || !m.Y || !m.Active {
+		t.Fatalf("WaitForMotion() = %s, %v", m, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMotion_NotSet(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.MotionSource(); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("MotionSource() = %v, want ErrInvalidOpts", err)
+	}
+	if _, err := d.WaitForMotion(0); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("WaitForMotion() = %v, want ErrInvalidOpts", err)
+	}
+	if _, err := d.Inactive(); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("Inactive() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestInactive(t *testing.T) {
+	int2 := &gpiotest.Pin{N: "INT2", L: gpio.High}
+	// 64mg at ±4g is 2 steps; 5s at 10Hz is 50 periods, ACT_DUR 6.
+	bus := &i2ctest.Playback{Ops: initOps([2]byte{2, 6}, []byte{0x27, 0x00, 0x00, 0x90, 0x00, ctrl6I2Act})}
+	d, err := New(bus, Opts{Range: Range4G, ODR: 10 * physic.Hertz, Activity: &ActivityOpts{Threshold: 64, Duration: 5 * time.Second}, INT2: int2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in, err := d.Inactive(); err != nil || !in {
+		t.Fatalf("Inactive() = %t, %v", in, err)
+	}
+	int2.L = gpio.Low
+	if in, err := d.Inactive(); err != nil || in {
+		t.Fatalf("Inactive() = %t, %v", in, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
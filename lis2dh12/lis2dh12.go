@@ -0,0 +1,528 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis2dh12
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// I²C addresses, selected by the SA0 pin. SA0 has an internal pull-up.
+const (
+	DefaultAddr = 0x19 // SA0 high or unconnected
+	AltAddr     = 0x18 // SA0 low
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the LIS2DH12.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Register map.
+const (
+	regWHOAMI  = 0x0F
+	regCTRL1   = 0x20 // ODR bits 7..4, LPen bit 3, Zen, Yen, Xen bits 2..0
+	regCTRL2   = 0x21 // HPM bits 7..6, HPCF bits 5..4, HP_IA1 bit 0
+	regCTRL3   = 0x22 // I1_IA1 bit 6, I1_WTM bit 2
+	regCTRL4   = 0x23 // BDU bit 7, FS bits 5..4, HR bit 3
+	regCTRL5   = 0x24 // BOOT bit 7, FIFO_EN bit 6, LIR_INT1 bit 3
+	regCTRL6   = 0x25 // I2_ACT bit 3
+	regSTATUS  = 0x27 // ZYXOR bit 7, ZYXDA bit 3
+	regOUTX    = 0x28 // X L, X H, Y L, Y H, Z L, Z H
+	regFIFOCTL = 0x2E // FM bits 7..6, FTH bits 4..0
+	regFIFOSRC = 0x2F // WTM bit 7, OVRN_FIFO bit 6, EMPTY bit 5, FSS bits 4..0
+	regINT1CFG = 0x30
+	regINT1SRC = 0x31
+	regINT1THS = 0x32 // INT1_THS, INT1_DURATION
+	regACTTHS  = 0x3E // ACT_THS, ACT_DUR
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0x33
+
+// Register bits.
+const (
+	ctrl1XYZ     = 0x07
+	ctrl1LPen    = 0x08
+	ctrl2HPIA1   = 0x01 // the motion interrupt sees high-pass filtered data
+	ctrl3IA1     = 0x40
+	ctrl3WTM     = 0x04
+	ctrl4BDU     = 0x80 // block data update: the bytes of a sample are read together
+	ctrl4HR      = 0x08
+	ctrl5Boot    = 0x80
+	ctrl5FIFOEn  = 0x40
+	ctrl5LIRInt1 = 0x08 // the motion interrupt is latched until INT1_SRC is read
+	ctrl6I2Act   = 0x08
+)
+
+// Address framing bits. On I²C, auto-increment is selected by bit 7 of the
+// register address; on SPI, bit 7 selects a read and bit 6 auto-increment.
+const (
+	i2cAutoInc = 0x80
+	spiRead    = 0x80
+	spiAutoInc = 0x40
+)
+
+// bootTime is the time to reload the trimming parameters after BOOT.
+const bootTime = 5 * time.Millisecond
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the WHO_AM_I register doesn't read
+	// 0x33.
+	ErrBadID = errors.New("lis2dh12: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("lis2dh12: device halted")
+	// ErrNotReady is returned when no interrupt arrived in time.
+	ErrNotReady = errors.New("lis2dh12: not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("lis2dh12: invalid options")
+	// ErrFIFOOverflow is returned by ReadFIFO along with the samples when
+	// older samples may have been overwritten because the FIFO was full.
+	ErrFIFOOverflow = errors.New("lis2dh12: FIFO overflow")
+)
+
+// Range is the full scale of the measurements.
+type Range byte
+
+// Ranges supported by the LIS2DH12.
+const (
+	Range2G  Range = 0 // ±2g
+	Range4G  Range = 1 // ±4g
+	Range8G  Range = 2 // ±8g
+	Range16G Range = 3 // ±16g
+)
+
+// mgPerLSB is the sensitivity of each range in high-resolution mode. The
+// normal and low-power modes are 4 and 16 times coarser.
+var mgPerLSB = [...]int{1, 2, 4, 12}
+
+// thsMg is the step of the interrupt and activity thresholds of each range.
+var thsMg = [...]int{16, 32, 62, 186}
+
+func (r Range) String() string {
+	if r > Range16G {
+		return fmt.Sprintf("Range(%d)", byte(r))
+	}
+	return fmt.Sprintf("±%dg", 2<<r)
+}
+
+// Mode is the operating mode, which trades resolution for power.
+type Mode byte
+
+// Operating modes.
+const (
+	Normal         Mode = 0 // 10 bits
+	LowPower       Mode = 1 // 8 bits
+	HighResolution Mode = 2 // 12 bits
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Normal:
+		return "Normal"
+	case LowPower:
+		return "LowPower"
+	case HighResolution:
+		return "HighResolution"
+	default:
+		return fmt.Sprintf("Mode(%d)", byte(m))
+	}
+}
+
+// shift is the number of unused low bits of the left-justified samples.
+func (m Mode) shift() uint {
+	switch m {
+	case LowPower:
+		return 8
+	case HighResolution:
+		return 4
+	}
+	return 6
+}
+
+// odrs are the output data rates by the ODR bits of CTRL1. Codes 8 and 9
+// depend on the mode.
+var odrs = [...]physic.Frequency{
+	1: 1 * physic.Hertz,
+	2: 10 * physic.Hertz,
+	3: 25 * physic.Hertz,
+	4: 50 * physic.Hertz,
+	5: 100 * physic.Hertz,
+	6: 200 * physic.Hertz,
+	7: 400 * physic.Hertz,
+}
+
+// Rates selected by the ODR codes 8 and 9 of CTRL1.
+const (
+	odrLP8   = 1620 * physic.Hertz // LowPower only
+	odrLP9   = 5376 * physic.Hertz // LowPower only
+	odrNorm9 = 1344 * physic.Hertz // Normal and HighResolution
+)
+
+// Opts holds initialization options.
+//
+// Range: full scale, Range2G by default.
+// Mode: operating mode, Normal by default. Use LowPower for the lowest power,
+// HighResolution for the lowest noise.
+// ODR: output data rate, 1, 10, 25, 50, 100 (default), 200 or 400Hz, 1.344kHz
+// in Normal and HighResolution, or 1.620kHz and 5.376kHz in LowPower.
+// FIFO: queue the samples in the FIFO, read with ReadFIFO. INT1 is raised
+// when it holds FIFOWatermark samples.
+// Motion: optional motion interrupt, signaled on INT1.
+// Activity: optional sleep on inactivity, signaled on INT2.
+// INT1: optional pin connected to the INT1 output, used by WaitForMotion.
+// INT2: optional pin connected to the INT2 output, used by Inactive.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	Range    Range
+	Mode     Mode
+	ODR      physic.Frequency
+	FIFO     bool
+	Motion   *MotionOpts
+	Activity *ActivityOpts
+	INT1     gpio.PinIn
+	INT2     gpio.PinIn
+	Addr     uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if o.Range > Range16G {
+		return fmt.Errorf("%w: Range %s, want Range2G, Range4G, Range8G or Range16G", ErrInvalidOpts, o.Range)
+	}
+	if o.Mode > HighResolution {
+		return fmt.Errorf("%w: Mode %s, want Normal, LowPower or HighResolution", ErrInvalidOpts, o.Mode)
+	}
+	if _, ok := o.odrCode(); !ok {
+		fast := "1.344kHz"
+		if o.Mode == LowPower {
+			fast = "1.620kHz or 5.376kHz"
+		}
+		return fmt.Errorf("%w: ODR %s, want 1Hz to 400Hz or %s in %s", ErrInvalidOpts, o.ODR, fast, o.Mode)
+	}
+	if m := o.Motion; m != nil {
+		if _, _, err := m.regs(o.Range, o.odr()); err != nil {
+			return err
+		}
+	}
+	if a := o.Activity; a != nil {
+		if _, _, err := a.regs(o.Range, o.odr()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// odr returns the output data rate.
+func (o *Opts) odr() physic.Frequency {
+	if o.ODR == 0 {
+		return 100 * physic.Hertz
+	}
+	return o.ODR
+}
+
+// odrCode returns the ODR bits of CTRL1, or false if the rate isn't supported
+// in the mode.
+func (o *Opts) odrCode() (byte, bool) {
+	odr := o.odr()
+	if o.Mode == LowPower {
+		switch odr {
+		case odrLP8:
+			return 8, true
+		case odrLP9:
+			return 9, true
+		}
+	} else if odr == odrNorm9 {
+		return 9, true
+	}
+	for i, f := range odrs {
+		if f != 0 && f == odr {
+			return byte(i), true
+		}
+	}
+	return 0, false
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Raw are the counts Accel was computed from, right-justified to the
+	// resolution of the mode.
+	Raw [3]int16
+	// Timestamp is the time at which the sample was read from the device, or
+	// estimated from the sample rate for samples read from the FIFO.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s²", s.Accel[0], s.Accel[1], s.Accel[2])
+}
+
+// Dev represents an LIS2DH12 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c        conn.Conn
+	isSPI    bool
+	rng      Range
+	mode     Mode
+	odr      physic.Frequency
+	fifo     bool
+	motion   bool
+	activity bool
+	int1     gpio.PinIn
+	int2     gpio.PinIn
+	halted   bool
+
+	// Preallocated bus buffers large enough for a full FIFO, so that
+	// sensing doesn't allocate. w and r are one byte longer for the address.
+	data [fifoSize * sampleLen]byte
+	w, r [fifoSize*sampleLen + 1]byte
+
+	// mu serializes bus transactions and guards the fields above.
+	mu sync.Mutex
+}
+
+// New initializes the device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI initializes the device on a 4-wire SPI port.
+//
+// The port is connected in mode 3 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode3, 8)
+	if err != nil {
+		return nil, fmt.Errorf("lis2dh12: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	code, _ := opts.odrCode()
+	d := &Dev{
+		c:        c,
+		isSPI:    isSPI,
+		rng:      opts.Range,
+		mode:     opts.Mode,
+		odr:      opts.odr(),
+		fifo:     opts.FIFO,
+		motion:   opts.Motion != nil,
+		activity: opts.Activity != nil,
+		int1:     opts.INT1,
+		int2:     opts.INT2,
+	}
+	// INT1 and INT2 are push-pull, active high.
+	if d.int1 != nil {
+		if err := d.int1.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("lis2dh12: configuring INT1: %w", err)
+		}
+	}
+	if d.int2 != nil {
+		if err := d.int2.In(gpio.PullNoChange, gpio.NoEdge); err != nil {
+			return nil, fmt.Errorf("lis2dh12: configuring INT2: %w", err)
+		}
+	}
+	id, err := d.readReg(regWHOAMI)
+	if err != nil {
+		return nil, err
+	}
+	if id != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, whoAmI)
+	}
+	// The device has no soft reset; BOOT reloads the trimming parameters and
+	// all the registers used are written below.
+	if err := d.writeRegs(regCTRL5, ctrl5Boot); err != nil {
+		return nil, err
+	}
+	doSleep(bootTime)
+	ctrl1 := code<<4 | ctrl1XYZ
+	ctrl4 := ctrl4BDU | byte(d.rng)<<4
+	switch d.mode {
+	case LowPower:
+		ctrl1 |= ctrl1LPen
+	case HighResolution:
+		ctrl4 |= ctrl4HR
+	}
+	var ctrl2, ctrl3, ctrl5, ctrl6 byte
+	if m := opts.Motion; m != nil {
+		if err := d.setupMotion(m); err != nil {
+			return nil, err
+		}
+		ctrl2 |= ctrl2HPIA1
+		ctrl3 |= ctrl3IA1
+		ctrl5 |= ctrl5LIRInt1
+	}
+	if opts.FIFO {
+		if err := d.writeRegs(regFIFOCTL, fifoStream|FIFOWatermark); err != nil {
+			return nil, err
+		}
+		ctrl3 |= ctrl3WTM
+		ctrl5 |= ctrl5FIFOEn
+	}
+	// ACT_THS is written even without Activity, as a threshold left from a
+	// previous configuration would enable it.
+	var actThs, actDur byte
+	if a := opts.Activity; a != nil {
+		actThs, actDur, _ = a.regs(d.rng, d.odr)
+		ctrl6 |= ctrl6I2Act
+	}
+	if err := d.writeRegs(regACTTHS, actThs, actDur); err != nil {
+		return nil, err
+	}
+	// CTRL1 to CTRL6 in one transaction.
+	if err := d.writeRegs(regCTRL1, ctrl1, ctrl2, ctrl3, ctrl4, ctrl5, ctrl6); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("LIS2DH12{%s, %s, %s, %s}", d.c, d.rng, d.mode, d.odr)
+}
+
+// SampleRate returns the output data rate.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.odr
+}
+
+// Halt powers the device down. It implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regCTRL1, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw reads the latest measurement and returns X,Y,Z as int16 counts,
+// right-justified to the resolution of the mode.
+func (d *Dev) SenseRaw() (int16, int16, int16, error) {
+	var s Sample
+	err := d.Sense(&s)
+	return s.Raw[0], s.Raw[1], s.Raw[2], err
+}
+
+// Sense reads the latest sample into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection. With Opts.FIFO, it pops the
+// oldest sample of the FIFO instead.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	b := d.data[:sampleLen]
+	if err := d.readRegBlock(regOUTX, b); err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	d.decode(s, b)
+	return nil
+}
+
+// decode scales the little-endian, left-justified counts of b into s.
+func (d *Dev) decode(s *Sample, b []byte) {
+	shift := d.mode.shift()
+	// Each bit less of resolution doubles the step.
+	mg := mgPerLSB[d.rng] << (shift - 4)
+	for i := 0; i < 3; i++ {
+		s.Raw[i] = (int16(b[2*i+1])<<8 | int16(b[2*i])) >> shift
+		s.Accel[i] = float64(int(s.Raw[i])*mg) * standardGravity / 1000
+	}
+}
+
+// standardGravity is 1g in m/s².
+const standardGravity = 9.80665
+
+// Status reads the status register. Bits 3..0 report new data on all axes,
+// Z, Y and X, and bits 7..4 data overwritten before being read.
+func (d *Dev) Status() (byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readReg(regSTATUS)
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, r := d.w[:len(out)+1], d.r[:len(out)+1]
+		clear(w)
+		w[0] = addr | spiRead
+		if len(out) > 1 {
+			w[0] |= spiAutoInc
+		}
+		if err := d.c.Tx(w, r); err != nil {
+			return fmt.Errorf("lis2dh12: reading register 0x%02x: %w", addr, err)
+		}
+		copy(out, r[1:])
+		return nil
+	}
+	w := append(d.w[:0], addr)
+	if len(out) > 1 {
+		w[0] |= i2cAutoInc
+	}
+	if err := d.c.Tx(w, out); err != nil {
+		return fmt.Errorf("lis2dh12: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, vals ...byte) error {
+	w := append(d.w[:0], addr)
+	if len(vals) > 1 {
+		if d.isSPI {
+			w[0] |= spiAutoInc
+		} else {
+			w[0] |= i2cAutoInc
+		}
+	}
+	w = append(w, vals...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("lis2dh12: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
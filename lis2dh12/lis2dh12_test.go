@@ -0,0 +1,261 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis2dh12
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given ACT_THS,
+// ACT_DUR and CTRL1 to CTRL6, with extra inserted before the CTRL registers.
+func initOps(act [2]byte, ctrl []byte, extra ...i2ctest.IO) []i2ctest.IO {
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{whoAmI}},
+		{Addr: DefaultAddr, W: []byte{regCTRL5, ctrl5Boot}},
+	}
+	ops = append(ops, extra...)
+	return append(ops,
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regACTTHS | i2cAutoInc, act[0], act[1]}},
+		i2ctest.IO{Addr: DefaultAddr, W: append([]byte{regCTRL1 | i2cAutoInc}, ctrl...)},
+	)
+}
+
+// defaultOps are the bus transactions issued by New with zero Opts.
+func defaultOps() []i2ctest.IO {
+	return initOps([2]byte{}, []byte{0x57, 0x00, 0x00, 0x80, 0x00, 0x00})
+}
+
+// le encodes X, Y and Z as little-endian counts.
+func le(x, y, z int16) []byte {
+	return []byte{byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8)}
+}
+
+// dataOp returns a read of the output registers.
+func dataOp(x, y, z int16) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regOUTX | i2cAutoInc}, R: le(x, y, z)}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		ctrl []byte
+		s    string
+	}{
+		{Opts{}, []byte{0x57, 0x00, 0x00, 0x80, 0x00, 0x00}, "LIS2DH12{playback(25), ±2g, Normal, 100Hz}"},
+		{
+			Opts{Range: Range16G, Mode: LowPower, ODR: 10 * physic.Hertz},
+			[]byte{0x2F, 0x00, 0x00, 0xB0, 0x00, 0x00},
+			"LIS2DH12{playback(25), ±16g, LowPower, 10Hz}",
+		},
+		{
+			Opts{Range: Range4G, Mode: HighResolution, ODR: 1344 * physic.Hertz},
+			[]byte{0x97, 0x00, 0x00, 0x98, 0x00, 0x00},
+			"LIS2DH12{playback(25), ±4g, HighResolution, 1.344kHz}",
+		},
+		{
+			Opts{Mode: LowPower, ODR: 5376 * physic.Hertz},
+			[]byte{0x9F, 0x00, 0x00, 0x80, 0x00, 0x00},
+			"LIS2DH12{playback(25), ±2g, LowPower, 5.376kHz}",
+		},
+		{
+			Opts{Mode: LowPower, ODR: 1620 * physic.Hertz},
+			[]byte{0x8F, 0x00, 0x00, 0x80, 0x00, 0x00},
+			"LIS2DH12{playback(25), ±2g, LowPower, 1.620kHz}",
+		},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps([2]byte{}, line.ctrl)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_AltAddr(t *testing.T) {
+	ops := defaultOps()
+	for i := range ops {
+		ops[i].Addr = AltAddr
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	if _, err := New(bus, Opts{Addr: AltAddr}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{0x44}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		{W: []byte{regWHOAMI | spiRead, 0}, R: []byte{0, whoAmI}},
+		{W: []byte{regCTRL5, ctrl5Boot}},
+		{W: []byte{regACTTHS | spiAutoInc, 0, 0}},
+		{W: []byte{regCTRL1 | spiAutoInc, 0x57, 0x00, 0x00, 0x80, 0x00, 0x00}},
+		{W: []byte{regOUTX | spiRead | spiAutoInc, 0, 0, 0, 0, 0, 0}, R: append([]byte{0}, le(-64, 0, 0)...)},
+	}}}
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, _, _, err := d.SenseRaw(); err != nil || x != -1 {
+		t.Fatalf("SenseRaw() = %d, %v", x, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Range: 4}, "lis2dh12: invalid options: Range Range(4), want Range2G, Range4G, Range8G or Range16G"},
+		{Opts{Mode: 3}, "lis2dh12: invalid options: Mode Mode(3), want Normal, LowPower or HighResolution"},
+		{Opts{ODR: 5376 * physic.Hertz}, "lis2dh12: invalid options: ODR 5.376kHz, want 1Hz to 400Hz or 1.344kHz in Normal"},
+		{Opts{Mode: LowPower, ODR: 1344 * physic.Hertz}, "lis2dh12: invalid options: ODR 1.344kHz, want 1Hz to 400Hz or 1.620kHz or 5.376kHz in LowPower"},
+		{Opts{Motion: &MotionOpts{Threshold: 5}}, "lis2dh12: invalid options: Motion.Threshold 5mg, want 16 to 2032mg at ±2g"},
+		{Opts{Motion: &MotionOpts{Threshold: 100, Duration: 2 * time.Second}}, "lis2dh12: invalid options: Motion.Duration 2s, want 0 to 1.27s at 100Hz"},
+		{Opts{Activity: &ActivityOpts{Threshold: 100}}, "lis2dh12: invalid options: Activity.Duration 0s, want 10ms to 20.41s at 100Hz"},
+		{Opts{Range: Range16G, Activity: &ActivityOpts{Threshold: 30000, Duration: time.Second}}, "lis2dh12: invalid options: Activity.Threshold 30000mg, want 186 to 23622mg at ±16g"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Errorf("#%d: Validate() = %v", i, err)
+		}
+	}
+}
+
+func TestSense(t *testing.T) {
+	data := []struct {
+		opts Opts
+		raw  int16
+		want float64 // mg
+	}{
+		{Opts{}, 500, 2000},
+		{Opts{Mode: HighResolution, Range: Range16G}, -1000, -12000},
+		{Opts{Mode: LowPower, Range: Range8G}, 10, 640},
+	}
+	for i, line := range data {
+		d := &Dev{rng: line.opts.Range, mode: line.opts.Mode}
+		var s Sample
+		d.decode(&s, le(line.raw<<d.mode.shift(), 0, 0))
+		if s.Raw[0] != line.raw {
+			t.Errorf("#%d: Raw = %v", i, s.Raw)
+		}
+		if want := line.want * standardGravity / 1000; math.Abs(s.Accel[0]-want) > 1e-9 {
+			t.Errorf("#%d: Accel = %v, want %g", i, s.Accel, want)
+		}
+	}
+	bus := &i2ctest.Playback{Ops: append(defaultOps(), dataOp(250<<6, -250<<6, 0))}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Raw != [3]int16{250, -250, 0} || s.Timestamp.IsZero() {
+		t.Fatalf("got %+v", s)
+	}
+	if got := s.String(); got != "accel=9.807,-9.807,0.000m/s²" {
+		t.Fatalf("String() = %q", got)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: append(defaultOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{0x0F}})}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := d.Status(); err != nil || s != 0x0F {
+		t.Fatalf("Status() = %#x, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: append(defaultOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, 0}})}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: defaultOps(), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&Sample{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestStrings(t *testing.T) {
+	if s := Range8G.String(); s != "±8g" {
+		t.Fatal(s)
+	}
+	if s := Range(7).String(); s != "Range(7)" {
+		t.Fatal(s)
+	}
+	if s := HighResolution.String(); s != "HighResolution" {
+		t.Fatal(s)
+	}
+	if s := Mode(7).String(); s != "Mode(7)" {
+		t.Fatal(s)
+	}
+}
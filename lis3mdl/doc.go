@@ -0,0 +1,22 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package lis3mdl controls an ST LIS3MDL 3-axis magnetometer over I²C or SPI.
+//
+// # More details
+//
+// The LIS3MDL measures up to ±16 Gauss in 16 bits, at 1.25 to 80Hz, or up to
+// 1kHz in fast mode. Four performance modes trade power for noise; the ultra
+// high performance mode is the least noisy.
+//
+// SenseRaw returns the counts, 6842 per Gauss at ±4 Gauss down to 1711 at
+// ±16 Gauss, that is 15nT to 58nT, and Sense returns µT×10. The DRDY pin
+// paces SenseMagneticContinuous and the INT pin signals the threshold
+// interrupt configured with SetThreshold. The temperature sensor is read with
+// Temperature.
+//
+// # Datasheet
+//
+// https://www.st.com/resource/en/datasheet/lis3mdl.pdf
+package lis3mdl
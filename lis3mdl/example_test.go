@@ -0,0 +1,45 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis3mdl_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/lis3mdl"
+	"periph.io/x/devices/v3/sensor"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := lis3mdl.New(bus, lis3mdl.Opts{
+		Range:       lis3mdl.Range4G,
+		Performance: lis3mdl.UltraHighPerformance,
+		ODRHz:       80,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(f)
+}
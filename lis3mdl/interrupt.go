@@ -0,0 +1,121 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis3mdl
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// INT_CFG bits. Bit 3 must be written as 1.
+const (
+	intCfgXIEN     = 0x80
+	intCfgYIEN     = 0x40
+	intCfgZIEN     = 0x20
+	intCfgReserved = 0x08
+	intCfgIEA      = 0x04 // active high
+	intCfgLIR      = 0x02 // latched until INT_SRC is read
+	intCfgIEN      = 0x01
+)
+
+// Threshold configures the threshold interrupt, signaled on the INT pin.
+//
+// The interrupt fires when the field on one of the selected axes exceeds
+// +Level or falls below -Level. It is latched until read by InterruptSource
+// or WaitForThreshold. A Threshold without axes disables the interrupt.
+type Threshold struct {
+	Level   physic.MagneticFluxDensity
+	X, Y, Z bool
+}
+
+// Interrupt is the source of a threshold interrupt.
+type Interrupt struct {
+	// PositiveX, PositiveY and PositiveZ report the axes above +Level.
+	PositiveX, PositiveY, PositiveZ bool
+	// NegativeX, NegativeY and NegativeZ report the axes below -Level.
+	NegativeX, NegativeY, NegativeZ bool
+	// Overflow reports that the internal measurement range overflowed.
+	Overflow bool
+	// Active reports that the interrupt is signaled.
+	Active bool
+}
+
+// SetThreshold configures the threshold interrupt.
+//
+// Level must be positive and within the range of the device.
+func (d *Dev) SetThreshold(t Threshold) error {
+	cfg := byte(intCfgReserved | intCfgIEA | intCfgLIR)
+	if t.X || t.Y || t.Z {
+		cfg |= intCfgIEN
+		if t.X {
+			cfg |= intCfgXIEN
+		}
+		if t.Y {
+			cfg |= intCfgYIEN
+		}
+		if t.Z {
+			cfg |= intCfgZIEN
+		}
+	}
+	// The threshold is an unsigned 15 bits count at the sensitivity of the
+	// range.
+	ths := int64(t.Level) * int64(d.rng.LSBPerGauss()) / 100000
+	if cfg&intCfgIEN != 0 && (t.Level <= 0 || ths > 0x7FFF) {
+		return fmt.Errorf("%w: threshold %s, want above 0 and within %s", ErrInvalidOpts, t.Level, d.rng)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	if err := d.writeRegs(regINTTHSL, byte(ths), byte(ths>>8)); err != nil {
+		return err
+	}
+	return d.writeRegs(regINTCFG, cfg)
+}
+
+// InterruptSource reads, and so clears, the source of the threshold
+// interrupt.
+func (d *Dev) InterruptSource() (Interrupt, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.interruptSource()
+}
+
+func (d *Dev) interruptSource() (Interrupt, error) {
+	b, err := d.readReg(regINTSRC)
+	if err != nil {
+		return Interrupt{}, err
+	}
+	return Interrupt{
+		PositiveX: b&0x80 != 0,
+		PositiveY: b&0x40 != 0,
+		PositiveZ: b&0x20 != 0,
+		NegativeX: b&0x10 != 0,
+		NegativeY: b&0x08 != 0,
+		NegativeZ: b&0x04 != 0,
+		Overflow:  b&0x02 != 0,
+		Active:    b&0x01 != 0,
+	}, nil
+}
+
+// WaitForThreshold waits for the INT pin to signal the threshold interrupt
+// and returns its source.
+//
+// Opts.INT must have been set. Returns ErrNotReady if no interrupt arrives
+// within timeout; a timeout of -1 waits forever, as with gpio.PinIn. An
+// interrupt still latched from before doesn't signal a new edge; clear it with
+// InterruptSource first.
+func (d *Dev) WaitForThreshold(timeout time.Duration) (Interrupt, error) {
+	if d.intPin == nil {
+		return Interrupt{}, fmt.Errorf("%w: INT pin not set", ErrInvalidOpts)
+	}
+	if !d.intPin.WaitForEdge(timeout) {
+		return Interrupt{}, ErrNotReady
+	}
+	return d.InterruptSource()
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis3mdl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestSetThreshold(t *testing.T) {
+	intPin := &gpiotest.Pin{N: "INT", EdgesChan: make(chan gpio.Level, 1)}
+	ops := append(defaultOps(),
+		// 50µT at 6842 LSB/Gauss is 3421 = 0x0D5D.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTTHSL | i2cAutoInc, 0x5D, 0x0D}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTCFG, 0xAF}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSRC}, R: []byte{0x11}},
+		// Disabled.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTTHSL | i2cAutoInc, 0x00, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTCFG, 0x0E}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{INT: intPin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetThreshold(Threshold{Level: 50 * physic.MicroTesla, X: true, Z: true}); err != nil {
+		t.Fatal(err)
+	}
+	intPin.EdgesChan <- gpio.High
+	i, err := d.WaitForThreshold(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Interrupt{NegativeX: true, Active: true}); i != want {
+		t.Fatalf("WaitForThreshold() = %+v", i)
+	}
+	if _, err := d.WaitForThreshold(time.Millisecond); err != ErrNotReady {
+		t.Fatalf("WaitForThreshold() = %v, want ErrNotReady", err)
+	}
+	if err := d.SetThreshold(Threshold{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetThreshold_Invalid(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range []physic.MagneticFluxDensity{0, 500 * physic.MicroTesla} {
+		if err := d.SetThreshold(Threshold{Level: l, Y: true}); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatalf("SetThreshold(%s) = %v, want ErrInvalidOpts", l, err)
+		}
+	}
+	if _, err := d.WaitForThreshold(0); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("WaitForThreshold() = %v, want ErrInvalidOpts", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,553 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis3mdl
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// I²C addresses, selected by the SDO/SA1 pin.
+const (
+	DefaultAddr = 0x1C // SDO/SA1 low
+	AltAddr     = 0x1E // SDO/SA1 high
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the LIS3MDL.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Register map.
+const (
+	regWHOAMI  = 0x0F
+	regCTRL1   = 0x20 // TEMP_EN bit 7, OM bits 6..5, DO bits 4..2, FAST_ODR bit 1, ST bit 0
+	regCTRL2   = 0x21 // FS bits 6..5, REBOOT bit 3, SOFT_RST bit 2
+	regCTRL3   = 0x22 // MD bits 1..0
+	regCTRL4   = 0x23 // OMZ bits 3..2
+	regCTRL5   = 0x24 // BDU bit 6
+	regSTATUS  = 0x27
+	regOUTX    = 0x28 // X L, X H, Y L, Y H, Z L, Z H
+	regTEMP    = 0x2E // TEMP L, TEMP H
+	regINTCFG  = 0x30
+	regINTSRC  = 0x31
+	regINTTHSL = 0x32 // INT_THS L, INT_THS H
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0x3D
+
+// Register bits.
+const (
+	ctrl1TempEn  = 0x80
+	ctrl1FastODR = 0x02
+	ctrl2SoftRst = 0x04
+	ctrl5BDU     = 0x40 // block data update: the bytes of a sample are read together
+)
+
+// MD bits of CTRL3.
+const (
+	modeContinuous = 0x00
+	modePowerDown  = 0x03
+)
+
+// Address framing bits. On I²C, auto-increment is selected by bit 7 of the
+// register address; on SPI, bit 7 selects a read and bit 6 auto-increment.
+const (
+	i2cAutoInc = 0x80
+	spiRead    = 0x80
+	spiAutoInc = 0x40
+)
+
+// resetTime is the time for the registers to be restored after a soft reset.
+const resetTime = time.Millisecond
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the WHO_AM_I register doesn't read
+	// 0x3D.
+	ErrBadID = errors.New("lis3mdl: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("lis3mdl: device halted")
+	// ErrNotReady is returned when no new measurement or interrupt arrived
+	// in time.
+	ErrNotReady = errors.New("lis3mdl: not ready")
+	// ErrInvalidOpts is returned for options or arguments that are out of
+	// range.
+	ErrInvalidOpts = errors.New("lis3mdl: invalid options")
+)
+
+// Range is the full scale of the measurements.
+type Range byte
+
+// Ranges supported by the LIS3MDL.
+const (
+	Range4G  Range = 0 // ±4 Gauss, 6842 LSB/Gauss
+	Range8G  Range = 1 // ±8 Gauss, 3421 LSB/Gauss
+	Range12G Range = 2 // ±12 Gauss, 2281 LSB/Gauss
+	Range16G Range = 3 // ±16 Gauss, 1711 LSB/Gauss
+)
+
+var lsbPerGauss = [...]int{6842, 3421, 2281, 1711}
+
+func (r Range) String() string {
+	if r > Range16G {
+		return fmt.Sprintf("Range(%d)", byte(r))
+	}
+	return fmt.Sprintf("±%dG", 4*(int(r)+1))
+}
+
+// LSBPerGauss returns the typical sensitivity of the range.
+func (r Range) LSBPerGauss() int {
+	if r > Range16G {
+		return 0
+	}
+	return lsbPerGauss[r]
+}
+
+// Performance is the operating mode of the sensor, which trades power for
+// noise. It applies to all axes.
+type Performance byte
+
+// Performance modes, by increasing power consumption and decreasing noise.
+const (
+	LowPower             Performance = 0
+	MediumPerformance    Performance = 1
+	HighPerformance      Performance = 2
+	UltraHighPerformance Performance = 3
+)
+
+// fastODR is the output data rate of each performance mode with FAST_ODR, in
+// Hz.
+var fastODR = [...]int{1000, 560, 300, 155}
+
+func (p Performance) String() string {
+	switch p {
+	case LowPower:
+		return "LowPower"
+	case MediumPerformance:
+		return "MediumPerformance"
+	case HighPerformance:
+		return "HighPerformance"
+	case UltraHighPerformance:
+		return "UltraHighPerformance"
+	default:
+		return fmt.Sprintf("Performance(%d)", byte(p))
+	}
+}
+
+// odrs are the output data rates selected by the DO bits of CTRL1, from 1.
+// DO 0, 0.625Hz, isn't selectable.
+var odrs = [...]struct {
+	hz int
+	f  physic.Frequency
+}{
+	{1, 1250 * physic.MilliHertz},
+	{2, 2500 * physic.MilliHertz},
+	{5, 5 * physic.Hertz},
+	{10, 10 * physic.Hertz},
+	{20, 20 * physic.Hertz},
+	{40, 40 * physic.Hertz},
+	{80, 80 * physic.Hertz},
+}
+
+// Opts holds initialization options.
+//
+// Range: full scale, Range4G by default.
+// Performance: operating mode, LowPower by default as on power up. Use
+// UltraHighPerformance for the lowest noise.
+// ODRHz: output data rate, 1 (1.25Hz), 2 (2.5Hz), 5, 10 (default), 20, 40
+// or 80Hz, or the fast rate of the performance mode: 1000Hz in LowPower, 560Hz
+// in MediumPerformance, 300Hz in HighPerformance and 155Hz in
+// UltraHighPerformance.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+// TempSensor: enable the temperature sensor, read by Temperature.
+// DRDY: optional pin connected to the DRDY output, used to pace
+// SenseMagneticContinuous.
+// INT: optional pin connected to the INT output, used by WaitForThreshold.
+type Opts struct {
+	Range       Range
+	Performance Performance
+	ODRHz       int
+	Addr        uint16
+	TempSensor  bool
+	DRDY        gpio.PinIn
+	INT         gpio.PinIn
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if o.Range > Range16G {
+		return fmt.Errorf("%w: Range %s, want Range4G, Range8G, Range12G or Range16G", ErrInvalidOpts, o.Range)
+	}
+	if o.Performance > UltraHighPerformance {
+		return fmt.Errorf("%w: Performance %s, want LowPower, MediumPerformance, HighPerformance or UltraHighPerformance", ErrInvalidOpts, o.Performance)
+	}
+	if _, _, ok := o.ctrl1(); !ok {
+		return fmt.Errorf("%w: ODRHz %d, want 1, 2, 5, 10, 20, 40, 80 or %d in %s", ErrInvalidOpts, o.ODRHz, fastODR[o.Performance], o.Performance)
+	}
+	return nil
+}
+
+// ctrl1 returns the CTRL1 register value selected by o and the output data
+// rate, or false if the rate isn't supported.
+func (o *Opts) ctrl1() (byte, physic.Frequency, bool) {
+	ctrl1 := byte(o.Performance) << 5
+	if o.TempSensor {
+		ctrl1 |= ctrl1TempEn
+	}
+	hz := o.ODRHz
+	if hz == 0 {
+		hz = 10
+	}
+	if hz == fastODR[o.Performance&3] {
+		return ctrl1 | ctrl1FastODR, physic.Frequency(hz) * physic.Hertz, true
+	}
+	for i, r := range odrs {
+		if r.hz == hz {
+			return ctrl1 | byte(i+1)<<2, r.f, true
+		}
+	}
+	return 0, 0, false
+}
+
+// Dev represents an LIS3MDL device.
+// Sense returns X,Y,Z values in µT×10.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c          conn.Conn
+	isSPI      bool
+	rng        Range
+	perf       Performance
+	odr        physic.Frequency
+	tempSensor bool
+	drdy       gpio.PinIn
+	intPin     gpio.PinIn
+	halted     bool
+
+	// Preallocated bus buffers, so that sensing doesn't allocate. data
+	// holds STATUS and the output registers; w and r are the SPI transfer
+	// buffers, one byte longer for the address.
+	data [regTEMP - regSTATUS]byte
+	w, r [regTEMP - regSTATUS + 1]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New initializes the device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI initializes the device on a 4-wire SPI port.
+//
+// The port is connected in mode 3 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode3, 8)
+	if err != nil {
+		return nil, fmt.Errorf("lis3mdl: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	ctrl1, odr, _ := opts.ctrl1()
+	d := &Dev{
+		c:          c,
+		isSPI:      isSPI,
+		rng:        opts.Range,
+		perf:       opts.Performance,
+		odr:        odr,
+		tempSensor: opts.TempSensor,
+		drdy:       opts.DRDY,
+		intPin:     opts.INT,
+	}
+	// DRDY and INT are push-pull, active high.
+	if d.drdy != nil {
+		if err := d.drdy.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("lis3mdl: configuring DRDY: %w", err)
+		}
+	}
+	if d.intPin != nil {
+		if err := d.intPin.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("lis3mdl: configuring INT: %w", err)
+		}
+	}
+	id, err := d.readReg(regWHOAMI)
+	if err != nil {
+		return nil, err
+	}
+	if id != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, whoAmI)
+	}
+	if err := d.writeRegs(regCTRL2, ctrl2SoftRst); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// CTRL1 to CTRL5 in one transaction; Z uses the same performance mode
+	// as X and Y.
+	if err := d.writeRegs(regCTRL1, ctrl1, byte(d.rng)<<5, modeContinuous, byte(d.perf)<<2, ctrl5BDU); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("LIS3MDL{%s, %s, %s, %s}", d.c, d.rng, d.perf, d.odr)
+}
+
+// Halt stops SenseMagneticContinuous and powers the device down. It
+// implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regCTRL3, modePowerDown); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw reads the latest measurement and returns X,Y,Z as int16 counts.
+func (d *Dev) SenseRaw() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.senseRaw()
+}
+
+func (d *Dev) senseRaw() (int16, int16, int16, error) {
+	if d.halted {
+		return 0, 0, 0, ErrHalted
+	}
+	// STATUS is read along with the output registers but not used: the
+	// latest measurement is returned whether it was read already or not.
+	if err := d.readRegBlock(regSTATUS, d.data[:]); err != nil {
+		return 0, 0, 0, err
+	}
+	b := d.data[1:]
+	x := int16(b[1])<<8 | int16(b[0])
+	y := int16(b[3])<<8 | int16(b[2])
+	z := int16(b[5])<<8 | int16(b[4])
+	return x, y, z, nil
+}
+
+// Sense reads and scales to µT×10 (int16) for X,Y,Z.
+//
+// The values are truncated to 0.1µT; use SenseMagnetic for full resolution.
+func (d *Dev) Sense() (int16, int16, int16, error) {
+	x, y, z, err := d.SenseRaw()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// µT×10 = counts / LSB_per_Gauss * 100 * 10
+	lsb := float64(d.rng.LSBPerGauss())
+	return int16(float64(x) / lsb * 1000), int16(float64(y) / lsb * 1000), int16(float64(z) / lsb * 1000), nil
+}
+
+// SenseMagnetic reads the latest measurement. It implements
+// sensor.Magnetometer.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	x, y, z, err := d.SenseRaw()
+	if err != nil {
+		return err
+	}
+	f.X = d.toFlux(x)
+	f.Y = d.toFlux(y)
+	f.Z = d.toFlux(z)
+	return nil
+}
+
+// toFlux converts counts to flux density, rounded down to the nT.
+func (d *Dev) toFlux(c int16) physic.MagneticFluxDensity {
+	// 1 Gauss = 100000nT.
+	return physic.MagneticFluxDensity(int64(c) * 100000 / int64(d.rng.LSBPerGauss()))
+}
+
+// SenseMagneticContinuous returns a channel delivering measurements until
+// Halt is called. It implements sensor.Magnetometer.
+//
+// Measurements are read on each DRDY edge when Opts.DRDY is set, otherwise
+// every interval. An interval of 0 or less uses the output data rate.
+// Calling SenseMagneticContinuous again stops the previous channel.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = d.odr.Period()
+	}
+	c := make(chan sensor.Field)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		if err := d.sensingContinuous(interval, stop, c); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+		}
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- sensor.Field) error {
+	var tick <-chan time.Time
+	if d.drdy == nil {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		if d.drdy != nil {
+			// Poll the stop channel between edges.
+			if !d.drdy.WaitForEdge(interval) {
+				select {
+				case <-stop:
+					return nil
+				default:
+					continue
+				}
+			}
+		} else {
+			select {
+			case <-stop:
+				return nil
+			case <-tick:
+			}
+		}
+		var f sensor.Field
+		if err := d.SenseMagnetic(&f); err != nil {
+			return err
+		}
+		select {
+		case c <- f:
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// stopContinuous stops the SenseMagneticContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// Temperature reads the temperature sensor.
+//
+// Opts.TempSensor must have been set. The datasheet specifies 8 LSB/°C with
+// 0 at 25°C.
+func (d *Dev) Temperature() (physic.Temperature, error) {
+	if !d.tempSensor {
+		return 0, fmt.Errorf("%w: temperature sensor not enabled", ErrInvalidOpts)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var b [2]byte
+	if err := d.readRegBlock(regTEMP, b[:]); err != nil {
+		return 0, err
+	}
+	raw := int16(b[1])<<8 | int16(b[0])
+	return physic.ZeroCelsius + 25*physic.Kelvin + physic.Temperature(raw)*physic.Kelvin/8, nil
+}
+
+// Status reads the status register. Bits 3..0 report new data on all axes,
+// Z, Y and X, and bits 7..4 data overwritten before being read.
+func (d *Dev) Status() (byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readReg(regSTATUS)
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, r := d.w[:len(out)+1], d.r[:len(out)+1]
+		clear(w)
+		w[0] = addr | spiRead
+		if len(out) > 1 {
+			w[0] |= spiAutoInc
+		}
+		if err := d.c.Tx(w, r); err != nil {
+			return fmt.Errorf("lis3mdl: reading register 0x%02x: %w", addr, err)
+		}
+		copy(out, r[1:])
+		return nil
+	}
+	w := append(d.w[:0], addr)
+	if len(out) > 1 {
+		w[0] |= i2cAutoInc
+	}
+	if err := d.c.Tx(w, out); err != nil {
+		return fmt.Errorf("lis3mdl: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, vals ...byte) error {
+	w := append(d.w[:0], addr)
+	if len(vals) > 1 {
+		if d.isSPI {
+			w[0] |= spiAutoInc
+		} else {
+			w[0] |= i2cAutoInc
+		}
+	}
+	w = append(w, vals...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("lis3mdl: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
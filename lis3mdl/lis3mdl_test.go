@@ -0,0 +1,295 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lis3mdl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given CTRL1 to
+// CTRL5.
+func initOps(ctrl ...byte) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{whoAmI}},
+		{Addr: DefaultAddr, W: []byte{regCTRL2, ctrl2SoftRst}},
+		{Addr: DefaultAddr, W: append([]byte{regCTRL1 | i2cAutoInc}, ctrl...)},
+	}
+}
+
+// defaultOps are the bus transactions issued by New with zero Opts.
+func defaultOps() []i2ctest.IO {
+	return initOps(0x10, 0x00, 0x00, 0x00, ctrl5BDU)
+}
+
+// dataOp returns a read of STATUS and the output registers.
+func dataOp(x, y, z int16) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS | i2cAutoInc}, R: []byte{
+		0x0F, byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8),
+	}}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		ctrl []byte
+		s    string
+	}{
+		{Opts{}, []byte{0x10, 0x00, 0x00, 0x00, 0x40}, "LIS3MDL{playback(28), ±4G, LowPower, 10Hz}"},
+		{
+			Opts{Range: Range16G, Performance: UltraHighPerformance, ODRHz: 80, TempSensor: true},
+			[]byte{0xFC, 0x60, 0x00, 0x0C, 0x40},
+			"LIS3MDL{playback(28), ±16G, UltraHighPerformance, 80Hz}",
+		},
+		{
+			Opts{Range: Range8G, Performance: HighPerformance, ODRHz: 300},
+			[]byte{0x42, 0x20, 0x00, 0x08, 0x40},
+			"LIS3MDL{playback(28), ±8G, HighPerformance, 300Hz}",
+		},
+		{
+			Opts{ODRHz: 1},
+			[]byte{0x04, 0x00, 0x00, 0x00, 0x40},
+			"LIS3MDL{playback(28), ±4G, LowPower, 1.250Hz}",
+		},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.ctrl...)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{0x33}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{Performance: MediumPerformance, ODRHz: 560}, ""},
+		{Opts{Range: 4}, "lis3mdl: invalid options: Range Range(4), want Range4G, Range8G, Range12G or Range16G"},
+		{Opts{Performance: 4}, "lis3mdl: invalid options: Performance Performance(4), want LowPower, MediumPerformance, HighPerformance or UltraHighPerformance"},
+		{Opts{Performance: UltraHighPerformance, ODRHz: 1000}, "lis3mdl: invalid options: ODRHz 1000, want 1, 2, 5, 10, 20, 40, 80 or 155 in UltraHighPerformance"},
+		{Opts{ODRHz: 15}, "lis3mdl: invalid options: ODRHz 15, want 1, 2, 5, 10, 20, 40, 80 or 1000 in LowPower"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{Range: 4}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	port := spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				// Single byte read only sets the read bit.
+				{W: []byte{regWHOAMI | spiRead, 0}, R: []byte{0, whoAmI}},
+				{W: []byte{regCTRL2, ctrl2SoftRst}},
+				// Multi-byte write sets the auto-increment bit.
+				{W: []byte{regCTRL1 | spiAutoInc, 0x10, 0x00, 0x00, 0x00, 0x40}},
+				// Multi-byte read sets both the read and auto-increment bits.
+				{
+					W: []byte{regSTATUS | spiRead | spiAutoInc, 0, 0, 0, 0, 0, 0, 0},
+					R: []byte{0, 0x0F, 0x01, 0x02, 0xFF, 0xFF, 0x00, 0x80},
+				},
+			},
+		},
+	}
+	d, err := NewSPI(&port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, y, z, err := d.SenseRaw(); err != nil || x != 0x0201 || y != -1 || z != -32768 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := append(initOps(0x10, 0x60, 0x00, 0x00, 0x40), dataOp(1711, -3422, 8555), dataOp(1711, -3422, 8555))
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{Range: Range16G})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1711 LSB/Gauss.
+	if x, y, z, err := d.Sense(); err != nil || x != 1000 || y != -2000 || z != 5000 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := (sensor.Field{X: 100 * physic.MicroTesla, Y: -200 * physic.MicroTesla, Z: 500 * physic.MicroTesla}); f != want {
+		t.Fatalf("SenseMagnetic() = %s, want %s", f, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	ops := append(initOps(0x90, 0x00, 0x00, 0x00, 0x40),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regTEMP | i2cAutoInc}, R: []byte{0x28, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{0x0F}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{TempSensor: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 40 LSB at 8 LSB/°C above 25°C.
+	if temp, err := d.Temperature(); err != nil || temp != physic.ZeroCelsius+30*physic.Kelvin {
+		t.Fatalf("Temperature() = %s, %v", temp, err)
+	}
+	if s, err := d.Status(); err != nil || s != 0x0F {
+		t.Fatalf("Status() = %#x, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	bus = &i2ctest.Playback{Ops: defaultOps()}
+	if d, err = New(bus, Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Temperature(); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("Temperature() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	ops := append(defaultOps(),
+		dataOp(684, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL3, modePowerDown}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := <-c; f.X != 9997*physic.NanoTesla {
+		t.Fatalf("got %s", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrHalted {
+		t.Fatalf("SenseRaw() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous_DRDY(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := append(defaultOps(),
+		dataOp(1, 0, 0),
+		dataOp(2, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL3, modePowerDown}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 2; i++ {
+		drdy.EdgesChan <- gpio.High
+		if f := <-c; f.X != physic.MagneticFluxDensity(int64(i)*100000/6842) {
+			t.Fatalf("#%d: got %s", i, f)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: defaultOps(), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.SenseMagnetic(&sensor.Field{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRange(t *testing.T) {
+	if s := Range12G.String(); s != "±12G" {
+		t.Fatal(s)
+	}
+	if s := Range(7).String(); s != "Range(7)" {
+		t.Fatal(s)
+	}
+	if l := Range8G.LSBPerGauss(); l != 3421 {
+		t.Fatal(l)
+	}
+	if s := UltraHighPerformance.String(); s != "UltraHighPerformance" {
+		t.Fatal(s)
+	}
+}
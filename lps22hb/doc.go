@@ -0,0 +1,26 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package lps22hb controls an ST LPS22HB barometer over I²C or SPI.
+//
+// # More details
+//
+// The LPS22HB measures the absolute pressure from 260 to 1260hPa with 24 bits
+// of resolution, and its temperature. The measurements are returned in hPa
+// and °C, already compensated by the device.
+//
+// With the default Opts, the device stays powered down and each Sense runs a
+// one-shot measurement, which suits slow weather logging. With Opts.ODR, it
+// measures continuously at 1 to 75Hz; the low-pass filter of Opts.LowPass
+// then smooths the pressure, and with Opts.FIFO the samples are queued in the
+// 32 levels FIFO in stream mode, read in batches with ReadFIFO.
+//
+// The threshold interrupt configured with Opts.Threshold signals on the
+// INT_DRDY pin a pressure above or below a reference by more than a
+// threshold; read it with WaitForThreshold or ThresholdSource.
+//
+// # Datasheet
+//
+// https://www.st.com/resource/en/datasheet/lps22hb.pdf
+package lps22hb
@@ -0,0 +1,60 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/lps22hb"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// Signal a change of more than 0.5hPa, about 4m of altitude, from the
+	// pressure at start up.
+	d, err := lps22hb.New(bus, lps22hb.Opts{
+		ODR:     10 * physic.Hertz,
+		LowPass: lps22hb.LowPassODR20,
+		Threshold: &lps22hb.ThresholdOpts{
+			Pressure:      50 * physic.Pascal,
+			AutoReference: true,
+			High:          true,
+			Low:           true,
+		},
+		INT: gpioreg.ByName("GPIO17"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	for {
+		th, err := d.WaitForThreshold(-1)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var s lps22hb.Sample
+		if err := d.Sense(&s); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(th, s)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb
+
+import (
+	"errors"
+	"time"
+)
+
+// FIFOWatermark is the number of samples queued in the FIFO at which
+// INT_DRDY is raised with Opts.FIFO.
+const FIFOWatermark = 16
+
+const (
+	fifoSize = 32 // samples
+
+	fifoStream = 0x40 // F_MODE in FIFO_CTRL
+
+	fifoStatusOverrun = 0x40
+	fifoStatusLevel   = 0x3F
+)
+
+// FIFOLen returns the number of samples queued in the FIFO. It requires
+// Opts.FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	n, _, err := d.fifoStatus()
+	return n, err
+}
+
+// ReadFIFO reads up to len(s) samples from the FIFO into s, oldest first, and
+// returns the number read. It requires Opts.FIFO.
+//
+// The FIFO holds 32 samples. When it is full, the oldest samples are
+// overwritten and the samples are returned along with ErrFIFOOverflow.
+func (d *Dev) ReadFIFO(s []Sample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	n, overrun, err := d.fifoStatus()
+	if err != nil {
+		return 0, err
+	}
+	n = min(n, len(s))
+	// Each read of the output registers pops one sample.
+	b := d.data[:]
+	for i := range s[:n] {
+		if err := d.readRegBlock(regPRESSOUT, b); err != nil {
+			return i, err
+		}
+		decode(&s[i], b)
+	}
+	now := time.Now()
+	period := d.odr.Period()
+	for i := range s[:n] {
+		s[i].Timestamp = now.Add(-time.Duration(n-1-i) * period)
+	}
+	if overrun {
+		return n, ErrFIFOOverflow
+	}
+	return n, nil
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+// fifoStatus returns the number of samples queued in the FIFO and whether it
+// overflowed.
+func (d *Dev) fifoStatus() (int, bool, error) {
+	st, err := d.readReg(regFIFOSTATUS)
+	if err != nil {
+		return 0, false, err
+	}
+	return int(st & fifoStatusLevel), st&fifoStatusOverrun != 0, nil
+}
+
+var errFIFODisabled = errors.New("lps22hb: FIFO not enabled in Opts")
@@ -0,0 +1,98 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// fifoOps are the bus transactions issued by New with Opts.FIFO at 10Hz.
+func fifoOps() []i2ctest.IO {
+	return initOps([3]byte{0x22, ctrl2IfAddInc | ctrl2FIFOEn, ctrl3FTH},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCTL, fifoStream | FIFOWatermark}},
+	)
+}
+
+var fifoOpts = Opts{ODR: 10 * physic.Hertz, FIFO: true}
+
+// fifoStatusOp returns a read of FIFO_STATUS.
+func fifoStatusOp(st byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOSTATUS}, R: []byte{st}}
+}
+
+func TestReadFIFO(t *testing.T) {
+	ops := append(fifoOps(),
+		fifoStatusOp(3),
+		fifoStatusOp(3),
+		dataOp(4096, 100),
+		dataOp(8192, 200),
+		fifoStatusOp(0),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, fifoOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 3 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]Sample, 2)
+	if n, err := d.ReadFIFO(s); err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if s[0].Pressure != 1 || s[0].Temperature != 1 || s[1].Pressure != 2 || s[1].Temperature != 2 {
+		t.Fatalf("got %+v", s)
+	}
+	if diff := s[1].Timestamp.Sub(s[0].Timestamp); diff != d.odr.Period() {
+		t.Errorf("timestamps %s apart, want %s", diff, d.odr.Period())
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_overflow(t *testing.T) {
+	ops := append(fifoOps(), fifoStatusOp(fifoStatusOverrun|fifoSize))
+	for i := 0; i < fifoSize; i++ {
+		ops = append(ops, dataOp(int32(i), 0))
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, fifoOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]Sample, 40)
+	n, err := d.ReadFIFO(s)
+	if !errors.Is(err, ErrFIFOOverflow) || n != fifoSize {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if s[31].RawPressure != 31 {
+		t.Fatalf("got %+v", s[31])
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_disabled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err == nil {
+		t.Fatal("ReadFIFO() succeeded without Opts.FIFO")
+	}
+	if _, err := d.FIFOLen(); err == nil {
+		t.Fatal("FIFOLen() succeeded without Opts.FIFO")
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// INTERRUPT_CFG bits.
+const (
+	intCfgAutoRifp = 0x80 // the reference is the first measurement, for the interrupt only
+	intCfgDiffEn   = 0x08
+	intCfgLIR      = 0x04 // the interrupt is latched until INT_SOURCE is read
+	intCfgPLE      = 0x02
+	intCfgPHE      = 0x01
+)
+
+// hPa is one hectopascal.
+const hPa = 100 * physic.Pascal
+
+// maxReference is the largest reference pressure REF_P can hold.
+const maxReference = 2048 * hPa
+
+// ThresholdOpts configures the threshold interrupt with Opts.Threshold.
+//
+// The interrupt fires when the pressure differs from Reference by more than
+// Pressure: with High above Reference+Pressure, with Low below
+// Reference-Pressure. It is signaled on INT_DRDY and latched until read by
+// ThresholdSource or WaitForThreshold.
+//
+// With a Reference of 0 the threshold is absolute, e.g. Pressure 1020hPa with
+// High for a pressure above 1020hPa. With AutoReference, the reference is the
+// first measurement instead, e.g. to detect a change of altitude.
+type ThresholdOpts struct {
+	Pressure      physic.Pressure // Threshold, from 6.25Pa (1/16hPa) to 2047.9hPa.
+	Reference     physic.Pressure // Reference pressure, below 2048hPa. Must be 0 with AutoReference.
+	AutoReference bool            // Use the first measurement as the reference.
+	High, Low     bool            // Events to signal; at least one is required.
+}
+
+// Threshold is the source of a threshold interrupt.
+type Threshold struct {
+	// High and Low report the pressure above or below the threshold.
+	High, Low bool
+	// Active reports that the interrupt is signaled.
+	Active bool
+}
+
+func (t Threshold) String() string {
+	return fmt.Sprintf("Threshold{High:%t Low:%t Active:%t}", t.High, t.Low, t.Active)
+}
+
+// regs returns THS_P at 16 LSB/hPa and REF_P at 4096 LSB/hPa.
+func (t *ThresholdOpts) regs() (uint16, int32, error) {
+	ths := math.Round(float64(t.Pressure) * 16 / float64(hPa))
+	if ths < 1 || ths > 0x7FFF {
+		return 0, 0, fmt.Errorf("%w: Threshold.Pressure %s, want 6.250Pa to 204.794kPa", ErrInvalidOpts, t.Pressure)
+	}
+	if t.Reference < 0 || t.Reference >= maxReference {
+		return 0, 0, fmt.Errorf("%w: Threshold.Reference %s, want 0 to %s", ErrInvalidOpts, t.Reference, maxReference)
+	}
+	if t.AutoReference && t.Reference != 0 {
+		return 0, 0, fmt.Errorf("%w: Threshold.Reference %s with AutoReference, want 0", ErrInvalidOpts, t.Reference)
+	}
+	if !t.High && !t.Low {
+		return 0, 0, fmt.Errorf("%w: Threshold without High nor Low, want at least one", ErrInvalidOpts)
+	}
+	ref := int32(math.Round(float64(t.Reference) * 4096 / float64(hPa)))
+	return uint16(ths), ref, nil
+}
+
+// intCfg returns the INTERRUPT_CFG register value.
+func (t *ThresholdOpts) intCfg() byte {
+	cfg := byte(intCfgDiffEn | intCfgLIR)
+	if t.AutoReference {
+		cfg |= intCfgAutoRifp
+	}
+	if t.High {
+		cfg |= intCfgPHE
+	}
+	if t.Low {
+		cfg |= intCfgPLE
+	}
+	return cfg
+}
+
+// intS returns the INT_S bits of CTRL_REG3, routing the events to INT_DRDY.
+func (t *ThresholdOpts) intS() byte {
+	var s byte
+	if t.High {
+		s |= 0x01
+	}
+	if t.Low {
+		s |= 0x02
+	}
+	return s
+}
+
+// setupThreshold writes the reference and the threshold and enables the
+// interrupt.
+func (d *Dev) setupThreshold(t *ThresholdOpts) error {
+	ths, ref, err := t.regs()
+	if err != nil {
+		return err
+	}
+	if !t.AutoReference {
+		if err := d.writeRegs(regREFP, byte(ref), byte(ref>>8), byte(ref>>16)); err != nil {
+			return err
+		}
+	}
+	// INTERRUPT_CFG is followed by THS_P_L and THS_P_H.
+	return d.writeRegs(regINTCFG, t.intCfg(), byte(ths), byte(ths>>8))
+}
+
+// ThresholdSource reads, and so clears, the source of the threshold
+// interrupt.
+func (d *Dev) ThresholdSource() (Threshold, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return Threshold{}, ErrHalted
+	}
+	if !d.threshold {
+		return Threshold{}, fmt.Errorf("%w: Threshold not set", ErrInvalidOpts)
+	}
+	b, err := d.readReg(regINTSOURCE)
+	if err != nil {
+		return Threshold{}, err
+	}
+	return Threshold{
+		High:   b&0x01 != 0,
+		Low:    b&0x02 != 0,
+		Active: b&0x04 != 0,
+	}, nil
+}
+
+// WaitForThreshold waits for INT_DRDY to signal the threshold interrupt and
+// returns its source.
+//
+// Opts.INT and Opts.Threshold must have been set. Returns ErrNotReady if no
+// interrupt arrives within timeout; a timeout of -1 waits forever, as with
+// gpio.PinIn. An interrupt still latched from before doesn't signal a new
+// edge; clear it with ThresholdSource first.
+func (d *Dev) WaitForThreshold(timeout time.Duration) (Threshold, error) {
+	if d.intPin == nil {
+		return Threshold{}, fmt.Errorf("%w: INT pin not set", ErrInvalidOpts)
+	}
+	if !d.threshold {
+		return Threshold{}, fmt.Errorf("%w: Threshold not set", ErrInvalidOpts)
+	}
+	if !d.intPin.WaitForEdge(timeout) {
+		return Threshold{}, ErrNotReady
+	}
+	return d.ThresholdSource()
+}
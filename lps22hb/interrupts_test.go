@@ -0,0 +1,118 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// thresholdOps are the bus transactions issued by New with a threshold
+// of ±2hPa around 1013.25hPa, at 1Hz.
+func thresholdOps() []i2ctest.IO {
+	return initOps([3]byte{0x12, ctrl2IfAddInc, 0x03},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regREFP, 0x00, 0x54, 0x3F}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTCFG, intCfgDiffEn | intCfgLIR | intCfgPLE | intCfgPHE, 32, 0}},
+	)
+}
+
+var thresholdOpts = Opts{
+	ODR:       physic.Hertz,
+	Threshold: &ThresholdOpts{Pressure: 2 * hPa, Reference: 101325 * physic.Pascal, High: true, Low: true},
+}
+
+func TestThresholdSource(t *testing.T) {
+	ops := append(thresholdOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSOURCE}, R: []byte{0x04 | 0x02}})
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, thresholdOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	th, err := d.ThresholdSource()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Threshold{Low: true, Active: true}); th != want {
+		t.Fatalf("ThresholdSource() = %s", th)
+	}
+	if s := th.String(); s != "Threshold{High:false Low:true Active:true}" {
+		t.Fatalf("String() = %q", s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestThreshold_autoReference(t *testing.T) {
+	// With FIFO, INT_DRDY still signals the threshold rather than the
+	// watermark.
+	ops := initOps([3]byte{0x12, ctrl2IfAddInc | ctrl2FIFOEn, 0x01},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTCFG, intCfgAutoRifp | intCfgDiffEn | intCfgLIR | intCfgPHE, 8, 0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCTL, fifoStream | FIFOWatermark}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	opts := Opts{
+		ODR:       physic.Hertz,
+		FIFO:      true,
+		Threshold: &ThresholdOpts{Pressure: 50 * physic.Pascal, AutoReference: true, High: true},
+	}
+	if _, err := New(bus, opts); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForThreshold(t *testing.T) {
+	pin := &gpiotest.Pin{N: "INT_DRDY", EdgesChan: make(chan gpio.Level, 1)}
+	ops := append(thresholdOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSOURCE}, R: []byte{0x04 | 0x01}})
+	bus := &i2ctest.Playback{Ops: ops}
+	opts := thresholdOpts
+	opts.INT = pin
+	d, err := New(bus, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.WaitForThreshold(0); !errors.Is(err, ErrNotReady) {
+		t.Fatalf("WaitForThreshold() = %v, want ErrNotReady", err)
+	}
+	pin.EdgesChan <- gpio.High
+	th, err := d.WaitForThreshold(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Threshold{High: true, Active: true}); th != want {
+		t.Fatalf("WaitForThreshold() = %s", th)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestThreshold_not_set(t *testing.T) {
+	pin := &gpiotest.Pin{N: "INT_DRDY", EdgesChan: make(chan gpio.Level, 1)}
+	bus := &i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(bus, Opts{INT: pin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ThresholdSource(); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("ThresholdSource() = %v, want ErrInvalidOpts", err)
+	}
+	if _, err := d.WaitForThreshold(0); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("WaitForThreshold() = %v, want ErrInvalidOpts", err)
+	}
+	d.intPin = nil
+	if _, err := d.WaitForThreshold(0); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("WaitForThreshold() = %v, want ErrInvalidOpts", err)
+	}
+}
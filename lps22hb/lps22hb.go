@@ -0,0 +1,468 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// I²C addresses, selected by the SA0 pin.
+const (
+	DefaultAddr = 0x5D // SA0 high
+	AltAddr     = 0x5C // SA0 low
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the LPS22HB.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// Register map.
+const (
+	regINTCFG     = 0x0B // AUTORIFP bit 7, DIFF_EN bit 3, LIR bit 2, PLE bit 1, PHE bit 0
+	regTHSP       = 0x0C // THS_P_L, THS_P_H
+	regWHOAMI     = 0x0F
+	regCTRL1      = 0x10 // ODR bits 6..4, EN_LPFP bit 3, LPFP_CFG bit 2, BDU bit 1
+	regCTRL2      = 0x11 // FIFO_EN bit 6, IF_ADD_INC bit 4, SWRESET bit 2, ONE_SHOT bit 0
+	regCTRL3      = 0x12 // F_FTH bit 4, INT_S bits 1..0
+	regFIFOCTL    = 0x14 // F_MODE bits 7..5, WTM bits 4..0
+	regREFP       = 0x15 // REF_P_XL, REF_P_L, REF_P_H
+	regINTSOURCE  = 0x25 // IA bit 2, PL bit 1, PH bit 0
+	regFIFOSTATUS = 0x26 // FTH_FIFO bit 7, OVR bit 6, FSS bits 5..0
+	regSTATUS     = 0x27 // T_DA bit 1, P_DA bit 0
+	regPRESSOUT   = 0x28 // PRESS_OUT_XL, L, H, TEMP_OUT_L, H
+	regLPFPRES    = 0x33
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0xB1
+
+// Register bits.
+const (
+	ctrl1BDU      = 0x02 // block data update: the bytes of a sample are read together
+	ctrl2FIFOEn   = 0x40
+	ctrl2IfAddInc = 0x10 // the address is incremented on multiple byte accesses, on I²C and SPI
+	ctrl2SWReset  = 0x04
+	ctrl2OneShot  = 0x01
+	ctrl3FTH      = 0x10
+	statusPDA     = 0x01
+	statusTDA     = 0x02
+)
+
+// spiRead is bit 7 of the register address, selecting a read on SPI.
+const spiRead = 0x80
+
+// resetTime is the time to restore the registers after SWRESET.
+const resetTime = time.Millisecond
+
+// oneShotPolls is the number of times the status register is polled for the
+// end of a one-shot measurement, one millisecond apart.
+const oneShotPolls = 50
+
+// sampleLen is the length of a sample in bytes.
+const sampleLen = 5
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the WHO_AM_I register doesn't read
+	// 0xB1.
+	ErrBadID = errors.New("lps22hb: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("lps22hb: device halted")
+	// ErrNotReady is returned when a measurement or an interrupt didn't come
+	// in time.
+	ErrNotReady = errors.New("lps22hb: not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("lps22hb: invalid options")
+	// ErrFIFOOverflow is returned by ReadFIFO along with the samples when
+	// older samples were overwritten because the FIFO was full.
+	ErrFIFOOverflow = errors.New("lps22hb: FIFO overflow")
+)
+
+// odrs are the output data rates by the ODR bits of CTRL_REG1. Code 0 is the
+// one-shot mode.
+var odrs = [...]physic.Frequency{
+	1: 1 * physic.Hertz,
+	2: 10 * physic.Hertz,
+	3: 25 * physic.Hertz,
+	4: 50 * physic.Hertz,
+	5: 75 * physic.Hertz,
+}
+
+// LowPass is the bandwidth of the low-pass filter of the pressure.
+type LowPass byte
+
+// Low-pass filter settings.
+const (
+	LowPassOff   LowPass = 0
+	LowPassODR9  LowPass = 1 // ODR/9
+	LowPassODR20 LowPass = 2 // ODR/20
+)
+
+func (l LowPass) String() string {
+	switch l {
+	case LowPassOff:
+		return "Off"
+	case LowPassODR9:
+		return "ODR/9"
+	case LowPassODR20:
+		return "ODR/20"
+	default:
+		return fmt.Sprintf("LowPass(%d)", byte(l))
+	}
+}
+
+// bits returns the EN_LPFP and LPFP_CFG bits of CTRL_REG1.
+func (l LowPass) bits() byte {
+	switch l {
+	case LowPassODR9:
+		return 0x08
+	case LowPassODR20:
+		return 0x0C
+	}
+	return 0
+}
+
+// Opts holds initialization options.
+//
+// ODR: output data rate of the continuous mode, 1, 10, 25, 50 or 75Hz. 0
+// (default) keeps the device powered down between the one-shot measurements
+// of Sense.
+// LowPass: low-pass filter of the pressure, off by default. It requires the
+// continuous mode.
+// FIFO: queue the samples in the FIFO, read with ReadFIFO. It requires the
+// continuous mode. Without Threshold, INT_DRDY is raised when the FIFO holds
+// FIFOWatermark samples.
+// Threshold: optional pressure threshold interrupt, signaled on INT_DRDY.
+// INT: optional pin connected to the INT_DRDY output, used by
+// WaitForThreshold.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	ODR       physic.Frequency
+	LowPass   LowPass
+	FIFO      bool
+	Threshold *ThresholdOpts
+	INT       gpio.PinIn
+	Addr      uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if _, ok := o.odrCode(); !ok {
+		return fmt.Errorf("%w: ODR %s, want 0, 1Hz, 10Hz, 25Hz, 50Hz or 75Hz", ErrInvalidOpts, o.ODR)
+	}
+	if o.LowPass > LowPassODR20 {
+		return fmt.Errorf("%w: LowPass %s, want LowPassOff, LowPassODR9 or LowPassODR20", ErrInvalidOpts, o.LowPass)
+	}
+	if o.LowPass != LowPassOff && o.ODR == 0 {
+		return fmt.Errorf("%w: LowPass %s with ODR 0, want LowPassOff in one-shot mode", ErrInvalidOpts, o.LowPass)
+	}
+	if o.FIFO && o.ODR == 0 {
+		return fmt.Errorf("%w: FIFO with ODR 0, want a continuous mode ODR", ErrInvalidOpts)
+	}
+	if t := o.Threshold; t != nil {
+		if _, _, err := t.regs(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// odrCode returns the ODR bits of CTRL_REG1, or false if the rate isn't
+// supported.
+func (o *Opts) odrCode() (byte, bool) {
+	for i, f := range odrs {
+		if f == o.ODR {
+			return byte(i), true
+		}
+	}
+	return 0, false
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Pressure is the pressure in hPa.
+	Pressure float64
+	// Temperature is the temperature in °C.
+	Temperature float64
+	// RawPressure and RawTemperature are the counts Pressure and
+	// Temperature were computed from, at 4096 LSB/hPa and 100 LSB/°C.
+	RawPressure    int32
+	RawTemperature int16
+	// Timestamp is the time at which the sample was read from the device, or
+	// estimated from the sample rate for samples read from the FIFO.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("%.2fhPa %.2f°C", s.Pressure, s.Temperature)
+}
+
+// Env returns the sample as physic units.
+func (s Sample) Env() physic.Env {
+	return physic.Env{
+		// 4096 LSB/hPa.
+		Pressure:    physic.Pressure(s.RawPressure) * 100 * physic.Pascal / 4096,
+		Temperature: physic.Temperature(s.RawTemperature)*10*physic.MilliCelsius + physic.ZeroCelsius,
+	}
+}
+
+// Dev represents an LPS22HB device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c         conn.Conn
+	isSPI     bool
+	odr       physic.Frequency
+	lowPass   LowPass
+	fifo      bool
+	threshold bool
+	intPin    gpio.PinIn
+	ctrl2     byte
+	halted    bool
+
+	// Preallocated bus buffers, so that sensing doesn't allocate. w and r
+	// are one byte longer for the address.
+	data [sampleLen]byte
+	w, r [sampleLen + 1]byte
+
+	// mu serializes bus transactions and guards the fields above.
+	mu sync.Mutex
+}
+
+// New initializes the device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI initializes the device on a 4-wire SPI port.
+//
+// The port is connected in mode 3 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode3, 8)
+	if err != nil {
+		return nil, fmt.Errorf("lps22hb: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	code, _ := opts.odrCode()
+	d := &Dev{
+		c:         c,
+		isSPI:     isSPI,
+		odr:       opts.ODR,
+		lowPass:   opts.LowPass,
+		fifo:      opts.FIFO,
+		threshold: opts.Threshold != nil,
+		intPin:    opts.INT,
+		ctrl2:     ctrl2IfAddInc,
+	}
+	// INT_DRDY is push-pull, active high.
+	if d.intPin != nil {
+		if err := d.intPin.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("lps22hb: configuring INT_DRDY: %w", err)
+		}
+	}
+	id, err := d.readReg(regWHOAMI)
+	if err != nil {
+		return nil, err
+	}
+	if id != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, whoAmI)
+	}
+	// SWRESET restores the default of all the registers, so a threshold or
+	// FIFO mode left from a previous configuration is cleared.
+	if err := d.writeRegs(regCTRL2, ctrl2IfAddInc|ctrl2SWReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	var ctrl3 byte
+	if t := opts.Threshold; t != nil {
+		if err := d.setupThreshold(t); err != nil {
+			return nil, err
+		}
+		ctrl3 |= t.intS()
+	}
+	if opts.FIFO {
+		if err := d.writeRegs(regFIFOCTL, fifoStream|FIFOWatermark); err != nil {
+			return nil, err
+		}
+		d.ctrl2 |= ctrl2FIFOEn
+		if opts.Threshold == nil {
+			ctrl3 |= ctrl3FTH
+		}
+	}
+	// CTRL_REG1 to CTRL_REG3 in one transaction; writing CTRL_REG1 starts
+	// the continuous mode.
+	ctrl1 := code<<4 | opts.LowPass.bits() | ctrl1BDU
+	if err := d.writeRegs(regCTRL1, ctrl1, d.ctrl2, ctrl3); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	if d.odr == 0 {
+		return fmt.Sprintf("LPS22HB{%s, one-shot}", d.c)
+	}
+	return fmt.Sprintf("LPS22HB{%s, %s}", d.c, d.odr)
+}
+
+// SampleRate returns the output data rate, 0 in one-shot mode.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.odr
+}
+
+// Halt powers the device down. It implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regCTRL1, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// Sense reads a measurement into s.
+//
+// In one-shot mode, it triggers a measurement and waits for it. In continuous
+// mode, it reads the latest measurement, or with Opts.FIFO pops the oldest
+// sample of the FIFO. It doesn't allocate memory, except to report errors.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	if d.odr == 0 {
+		if err := d.oneShot(); err != nil {
+			return err
+		}
+	}
+	b := d.data[:]
+	if err := d.readRegBlock(regPRESSOUT, b); err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	decode(s, b)
+	return nil
+}
+
+// oneShot triggers a measurement and waits for the new pressure and
+// temperature.
+func (d *Dev) oneShot() error {
+	if err := d.writeRegs(regCTRL2, d.ctrl2|ctrl2OneShot); err != nil {
+		return err
+	}
+	for i := 0; i < oneShotPolls; i++ {
+		doSleep(time.Millisecond)
+		st, err := d.readReg(regSTATUS)
+		if err != nil {
+			return err
+		}
+		if st&(statusPDA|statusTDA) == statusPDA|statusTDA {
+			return nil
+		}
+	}
+	return ErrNotReady
+}
+
+// decode scales the little-endian counts of b into s.
+func decode(s *Sample, b []byte) {
+	// Sign-extend the 24 bits pressure.
+	s.RawPressure = int32(uint32(b[2])<<24|uint32(b[1])<<16|uint32(b[0])<<8) >> 8
+	s.RawTemperature = int16(b[4])<<8 | int16(b[3])
+	s.Pressure = float64(s.RawPressure) / 4096
+	s.Temperature = float64(s.RawTemperature) / 100
+}
+
+// ResetLowPass resets the low-pass filter, so that it doesn't average the
+// measurements from before a sudden change. It requires Opts.LowPass.
+func (d *Dev) ResetLowPass() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return ErrHalted
+	}
+	if d.lowPass == LowPassOff {
+		return fmt.Errorf("%w: LowPass not set", ErrInvalidOpts)
+	}
+	_, err := d.readReg(regLPFPRES)
+	return err
+}
+
+// Status reads the status register. Bits 1 and 0 report a new temperature and
+// pressure, bits 5 and 4 data overwritten before being read.
+func (d *Dev) Status() (byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readReg(regSTATUS)
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// readRegBlock reads consecutive registers starting at addr. IF_ADD_INC is
+// always set, so the address needs no auto-increment bit.
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, r := d.w[:len(out)+1], d.r[:len(out)+1]
+		clear(w)
+		w[0] = addr | spiRead
+		if err := d.c.Tx(w, r); err != nil {
+			return fmt.Errorf("lps22hb: reading register 0x%02x: %w", addr, err)
+		}
+		copy(out, r[1:])
+		return nil
+	}
+	w := append(d.w[:0], addr)
+	if err := d.c.Tx(w, out); err != nil {
+		return fmt.Errorf("lps22hb: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, vals ...byte) error {
+	w := append(append(d.w[:0], addr), vals...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("lps22hb: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
@@ -0,0 +1,266 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lps22hb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given CTRL_REG1 to
+// CTRL_REG3, with extra inserted before the CTRL registers.
+func initOps(ctrl [3]byte, extra ...i2ctest.IO) []i2ctest.IO {
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{whoAmI}},
+		{Addr: DefaultAddr, W: []byte{regCTRL2, ctrl2IfAddInc | ctrl2SWReset}},
+	}
+	ops = append(ops, extra...)
+	return append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, ctrl[0], ctrl[1], ctrl[2]}})
+}
+
+// defaultOps are the bus transactions issued by New with zero Opts.
+func defaultOps() []i2ctest.IO {
+	return initOps([3]byte{ctrl1BDU, ctrl2IfAddInc, 0})
+}
+
+// le encodes the 24 bits pressure and the temperature as little-endian counts.
+func le(p int32, t int16) []byte {
+	return []byte{byte(p), byte(p >> 8), byte(p >> 16), byte(t), byte(uint16(t) >> 8)}
+}
+
+// dataOp returns a read of the output registers.
+func dataOp(p int32, t int16) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regPRESSOUT}, R: le(p, t)}
+}
+
+// statusOp returns a read of STATUS.
+func statusOp(st byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{st}}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		ctrl [3]byte
+		s    string
+	}{
+		{Opts{}, [3]byte{0x02, 0x10, 0x00}, "LPS22HB{playback(93), one-shot}"},
+		{Opts{ODR: physic.Hertz}, [3]byte{0x12, 0x10, 0x00}, "LPS22HB{playback(93), 1Hz}"},
+		{Opts{ODR: 25 * physic.Hertz, LowPass: LowPassODR9}, [3]byte{0x3A, 0x10, 0x00}, "LPS22HB{playback(93), 25Hz}"},
+		{Opts{ODR: 75 * physic.Hertz, LowPass: LowPassODR20}, [3]byte{0x5E, 0x10, 0x00}, "LPS22HB{playback(93), 75Hz}"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.ctrl)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q, want %q", i, s, line.s)
+		}
+		if r := d.SampleRate(); r != line.opts.ODR {
+			t.Errorf("#%d: SampleRate() = %s", i, r)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestNew_addr(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: AltAddr, W: []byte{regWHOAMI}, R: []byte{0xB3}}}}
+	if _, err := New(bus, Opts{Addr: AltAddr}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(defaultOps()); i++ {
+		bus := &i2ctest.Playback{Ops: defaultOps()[:i], DontPanic: true}
+		if _, err := New(bus, Opts{}); err == nil {
+			t.Fatalf("#%d: New() succeeded", i)
+		}
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		{W: []byte{regWHOAMI | spiRead, 0}, R: []byte{0, whoAmI}},
+		{W: []byte{regCTRL2, ctrl2IfAddInc | ctrl2SWReset}},
+		{W: []byte{regCTRL1, 0x22, 0x10, 0x00}},
+		{W: []byte{regPRESSOUT | spiRead, 0, 0, 0, 0, 0}, R: append([]byte{0}, le(4150272, 2345)...)},
+	}}}
+	d, err := NewSPI(port, Opts{ODR: 10 * physic.Hertz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Pressure != 1013.25 || s.Temperature != 23.45 {
+		t.Fatalf("Sense() = %s", s)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{ODR: 100 * physic.Hertz}, "lps22hb: invalid options: ODR 100Hz, want 0, 1Hz, 10Hz, 25Hz, 50Hz or 75Hz"},
+		{Opts{ODR: physic.Hertz, LowPass: 3}, "lps22hb: invalid options: LowPass LowPass(3), want LowPassOff, LowPassODR9 or LowPassODR20"},
+		{Opts{LowPass: LowPassODR9}, "lps22hb: invalid options: LowPass ODR/9 with ODR 0, want LowPassOff in one-shot mode"},
+		{Opts{FIFO: true}, "lps22hb: invalid options: FIFO with ODR 0, want a continuous mode ODR"},
+		{Opts{Threshold: &ThresholdOpts{Pressure: physic.Pascal, High: true}}, "lps22hb: invalid options: Threshold.Pressure 1Pa, want 6.250Pa to 204.794kPa"},
+		{Opts{Threshold: &ThresholdOpts{Pressure: 3000 * hPa, High: true}}, "lps22hb: invalid options: Threshold.Pressure 300kPa, want 6.250Pa to 204.794kPa"},
+		{Opts{Threshold: &ThresholdOpts{Pressure: hPa, Reference: 2048 * hPa, High: true}}, "lps22hb: invalid options: Threshold.Reference 204.800kPa, want 0 to 204.800kPa"},
+		{Opts{Threshold: &ThresholdOpts{Pressure: hPa, Reference: 1000 * hPa, AutoReference: true, High: true}}, "lps22hb: invalid options: Threshold.Reference 100kPa with AutoReference, want 0"},
+		{Opts{Threshold: &ThresholdOpts{Pressure: hPa}}, "lps22hb: invalid options: Threshold without High nor Low, want at least one"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Errorf("#%d: Validate() = %v", i, err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Errorf("#%d: New() = %v", i, err)
+		}
+	}
+}
+
+func TestSense_oneShot(t *testing.T) {
+	ops := append(defaultOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL2, ctrl2IfAddInc | ctrl2OneShot}},
+		statusOp(0),
+		statusOp(statusPDA),
+		statusOp(statusPDA|statusTDA),
+		dataOp(-4096, -500),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.RawPressure != -4096 || s.RawTemperature != -500 || s.Pressure != -1 || s.Temperature != -5 {
+		t.Fatalf("Sense() = %+v", s)
+	}
+	if s.Timestamp.IsZero() {
+		t.Error("Timestamp not set")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_oneShot_not_ready(t *testing.T) {
+	ops := append(defaultOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL2, ctrl2IfAddInc | ctrl2OneShot}})
+	for i := 0; i < oneShotPolls; i++ {
+		ops = append(ops, statusOp(statusTDA))
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); !errors.Is(err, ErrNotReady) {
+		t.Fatalf("Sense() = %v, want ErrNotReady", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_continuous(t *testing.T) {
+	ops := append(initOps([3]byte{0x4A, 0x10, 0x00}),
+		dataOp(4150272, 2345),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regLPFPRES}, R: []byte{0}},
+		statusOp(0x33),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, 0}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODR: 50 * physic.Hertz, LowPass: LowPassODR9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.String() != "1013.25hPa 23.45°C" {
+		t.Fatalf("Sense() = %s", s)
+	}
+	want := physic.Env{Pressure: 101325 * physic.Pascal, Temperature: physic.ZeroCelsius + 23450*physic.MilliCelsius}
+	if e := s.Env(); e != want {
+		t.Fatalf("Env() = %#v, want %#v", e, want)
+	}
+	if err := d.ResetLowPass(); err != nil {
+		t.Fatal(err)
+	}
+	if st, err := d.Status(); err != nil || st != 0x33 {
+		t.Fatalf("Status() = %#x, %v", st, err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&s); !errors.Is(err, ErrHalted) {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if err := d.ResetLowPass(); !errors.Is(err, ErrHalted) {
+		t.Fatalf("ResetLowPass() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResetLowPass_disabled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: defaultOps()}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ResetLowPass(); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("ResetLowPass() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestLowPass_String(t *testing.T) {
+	data := []struct {
+		l LowPass
+		s string
+	}{
+		{LowPassOff, "Off"},
+		{LowPassODR9, "ODR/9"},
+		{LowPassODR20, "ODR/20"},
+		{3, "LowPass(3)"},
+	}
+	for _, line := range data {
+		if s := line.l.String(); s != line.s {
+			t.Errorf("String() = %q, want %q", s, line.s)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package lsm6dsox controls an STMicroelectronics LSM6DSOX 6-axis IMU over
+// I²C or SPI.
+//
+// # More details
+//
+// The LSM6DSOX combines a 3-axis accelerometer and a 3-axis gyroscope
+// sampling at up to 6.66kHz, with a 3KiB FIFO.
+//
+// With Opts.FIFO, both sensors are batched in the FIFO at Opts.ODR, which
+// ReadFIFO drains in batches. Each word of the FIFO is tagged with the
+// sensor and a time slot counter, so the samples are returned with the time
+// slot they belong to rather than paired. Opts.Compression enables the
+// compression of the FIFO, where consecutive samples are stored as 8 or 5
+// bits differences, up to three samples per word; ReadFIFO decompresses
+// them.
+//
+// The sensor hub is an I²C master reading up to four external sensors on the
+// auxiliary bus, synchronized to the accelerometer. Opts.Mag configures it
+// to read a magnetometer, which is then returned by Sense and batched in the
+// FIFO. LIS3MDL and LIS2MDL are settings for common magnetometers; see the
+// lis3mdl package to use the LIS3MDL directly instead.
+//
+// # Datasheet
+//
+// https://www.st.com/resource/en/datasheet/lsm6dsox.pdf
+//
+// FIFO compression and sensor hub:
+//
+// https://www.st.com/resource/en/application_note/an5272-lsm6dsox-alwayson-3d-accelerometer-and-3d-gyroscope-stmicroelectronics.pdf
+package lsm6dsox
@@ -0,0 +1,55 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lsm6dsox_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/lsm6dsox"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default SPI port.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open SPI: %v", err)
+	}
+	defer p.Close()
+
+	// Log at 833Hz with a LIS3MDL on the auxiliary bus.
+	d, err := lsm6dsox.NewSPI(p, lsm6dsox.Opts{
+		AccelRange:  8,
+		ODR:         833 * physic.Hertz,
+		FIFO:        true,
+		Compression: true,
+		Mag:         &lsm6dsox.LIS3MDL,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	s := make([]lsm6dsox.FIFOSample, 384)
+	for i := 0; i < 10; i++ {
+		time.Sleep(100 * time.Millisecond)
+		n, err := d.ReadFIFO(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, f := range s[:n] {
+			fmt.Printf("%d %s %v\n", f.Slot, f.Sensor, f.Value)
+		}
+	}
+}
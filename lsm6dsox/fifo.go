@@ -0,0 +1,287 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lsm6dsox
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FIFO tags, in bits 7..3 of FIFO_DATA_OUT_TAG. NC words hold an
+// uncompressed sample of the current time slot, or of one of the two
+// previous ones with NC_T_1 and NC_T_2; 2xC words hold the differences of
+// the samples of the two previous time slots, 3xC words those of the three
+// last time slots.
+const (
+	tagGyroNC     = 0x01
+	tagAccelNC    = 0x02
+	tagAccelNCT2  = 0x06
+	tagAccelNCT1  = 0x07
+	tagAccel2xC   = 0x08
+	tagAccel3xC   = 0x09
+	tagGyroNCT2   = 0x0A
+	tagGyroNCT1   = 0x0B
+	tagGyro2xC    = 0x0C
+	tagGyro3xC    = 0x0D
+	tagSensorHub0 = 0x0E
+)
+
+// Sensor identifies the sensor of a FIFOSample.
+type Sensor uint8
+
+// Sensors batched in the FIFO.
+const (
+	Accelerometer Sensor = iota
+	Gyroscope
+	Magnetometer
+)
+
+func (s Sensor) String() string {
+	switch s {
+	case Accelerometer:
+		return "Accelerometer"
+	case Gyroscope:
+		return "Gyroscope"
+	case Magnetometer:
+		return "Magnetometer"
+	}
+	return fmt.Sprintf("Sensor(%d)", uint8(s))
+}
+
+// FIFOSample is a sample of one sensor read from the FIFO.
+type FIFOSample struct {
+	// Sensor is the sensor that measured the sample.
+	Sensor Sensor
+	// Slot is the time slot of the sample, incremented at the FIFO rate since
+	// it was last reset. The samples of the different sensors measured at the
+	// same time have the same slot.
+	Slot int
+	// Raw are the counts, in X,Y,Z order.
+	Raw [3]int16
+	// Value is the acceleration in m/s², the angular rate in rad/s or the
+	// magnetic field in µT, in X,Y,Z order.
+	Value [3]float64
+	// Timestamp is estimated from the slot and the sample rate, the newest
+	// slot read being the time of the read.
+	Timestamp time.Time
+}
+
+// fifoState is the state of the decoding of the FIFO, which continues across
+// reads.
+type fifoState struct {
+	tagCnt int         // TAG_CNT of the last word, -1 after a reset
+	slot   int         // time slot of the last word
+	last   [2][3]int16 // last accelerometer and gyroscope samples, compressed against
+}
+
+func (f *fifoState) reset() {
+	*f = fifoState{tagCnt: -1}
+}
+
+// FIFOLen returns the number of words queued in the FIFO. Each word holds
+// one sample, or up to three with Opts.Compression.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	n, _, err := d.fifoStatus()
+	return n, err
+}
+
+// ReadFIFO reads samples from the FIFO into s, oldest first, and returns the
+// number read. It requires Opts.FIFO.
+//
+// It reads up to len(s) words, or len(s)/3 with Opts.Compression, and at
+// most 128 in one call. The samples have no temperature.
+//
+// When the FIFO overflowed since the last call, it is reset and ReadFIFO
+// returns ErrFIFOOverflow instead, as the oldest samples were lost.
+func (d *Dev) ReadFIFO(s []FIFOSample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	words := len(s)
+	if d.compression {
+		if words < 3 {
+			return 0, errShortBuffer
+		}
+		words /= 3
+	}
+	n, overflow, err := d.fifoStatus()
+	if err != nil {
+		return 0, err
+	}
+	if overflow {
+		if err := d.resetFIFO(); err != nil {
+			return 0, err
+		}
+		return 0, ErrFIFOOverflow
+	}
+	n = min(n, words, fifoBurst)
+	if n == 0 {
+		return 0, nil
+	}
+	// The address wraps back to FIFO_DATA_OUT_TAG after each word.
+	b, err := d.readBlock(regFIFODATATAG, n*wordLen)
+	if err != nil {
+		return 0, err
+	}
+	m := 0
+	for i := 0; i < n; i++ {
+		m += d.decodeWord(s[m:], b[i*wordLen:(i+1)*wordLen])
+	}
+	now := time.Now()
+	period := d.odr.Period()
+	for i := range s[:m] {
+		s[i].Timestamp = now.Add(-time.Duration(d.slot-s[i].Slot) * period)
+	}
+	return m, nil
+}
+
+// ResetFIFO discards the samples queued in the FIFO and restarts the time
+// slots at 0. It requires Opts.FIFO.
+func (d *Dev) ResetFIFO() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return err
+	}
+	return d.resetFIFO()
+}
+
+// resetFIFO switches the FIFO to bypass mode, which empties it, then back to
+// continuous mode.
+func (d *Dev) resetFIFO() error {
+	if err := d.writeRegs(regFIFOCTRL4, fifoBypass); err != nil {
+		return err
+	}
+	d.fifoState.reset()
+	return d.writeRegs(regFIFOCTRL4, fifoContinuous)
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+// fifoStatus returns the number of words queued in the FIFO and whether it
+// overflowed.
+func (d *Dev) fifoStatus() (int, bool, error) {
+	var b [2]byte
+	if err := d.readRegs(regFIFOSTATUS1, b[:]); err != nil {
+		return 0, false, err
+	}
+	// DIFF_FIFO is 10 bits, little endian.
+	return int(b[1]&0x03)<<8 | int(b[0]), b[1]&fifoOverflow != 0, nil
+}
+
+// decodeWord decodes the FIFO word w into s, which has room for 3 samples
+// with compression, and returns the number of samples decoded.
+func (d *Dev) decodeWord(s []FIFOSample, w []byte) int {
+	cnt := int(w[0]>>1) & 0x03
+	if d.tagCnt >= 0 {
+		d.slot += (cnt - d.tagCnt) & 0x03
+	}
+	d.tagCnt = cnt
+	data := w[1:]
+	switch tag := w[0] >> 3; tag {
+	case tagAccelNC, tagAccelNCT1, tagAccelNCT2:
+		d.last[Accelerometer] = le3(data)
+		return d.emit(s, Accelerometer, d.slot-ncAge(tag, tagAccelNC, tagAccelNCT1), d.last[Accelerometer])
+	case tagGyroNC, tagGyroNCT1, tagGyroNCT2:
+		d.last[Gyroscope] = le3(data)
+		return d.emit(s, Gyroscope, d.slot-ncAge(tag, tagGyroNC, tagGyroNCT1), d.last[Gyroscope])
+	case tagAccel2xC:
+		return d.decode2xC(s, Accelerometer, data)
+	case tagGyro2xC:
+		return d.decode2xC(s, Gyroscope, data)
+	case tagAccel3xC:
+		return d.decode3xC(s, Accelerometer, data)
+	case tagGyro3xC:
+		return d.decode3xC(s, Gyroscope, data)
+	case tagSensorHub0:
+		if d.mag == nil {
+			return 0
+		}
+		return d.emit(s, Magnetometer, d.slot, le3(data))
+	}
+	// Temperature, timestamp, configuration change and the sensors not
+	// enabled.
+	return 0
+}
+
+// ncAge returns how many time slots ago the sample of an NC word was
+// measured.
+func ncAge(tag, nc, nct1 byte) int {
+	switch tag {
+	case nc:
+		return 0
+	case nct1:
+		return 1
+	}
+	return 2
+}
+
+// decode2xC adds the two 8 bits differences of a 2xC word to the last
+// sample.
+func (d *Dev) decode2xC(s []FIFOSample, sen Sensor, data []byte) int {
+	n := 0
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			d.last[sen][j] += int16(int8(data[3*i+j]))
+		}
+		n += d.emit(s[n:], sen, d.slot-2+i, d.last[sen])
+	}
+	return n
+}
+
+// decode3xC adds the three 5 bits differences of a 3xC word, packed in 16
+// bits little-endian words, to the last sample.
+func (d *Dev) decode3xC(s []FIFOSample, sen Sensor, data []byte) int {
+	n := 0
+	for i := 0; i < 3; i++ {
+		v := uint16(data[2*i]) | uint16(data[2*i+1])<<8
+		for j := 0; j < 3; j++ {
+			// Sign extend the 5 bits.
+			d.last[sen][j] += int16(v>>(5*j)&0x1F<<11) >> 11
+		}
+		n += d.emit(s[n:], sen, d.slot-2+i, d.last[sen])
+	}
+	return n
+}
+
+// emit scales raw into s[0] and returns 1, or 0 for a slot before the reset
+// of the FIFO.
+func (d *Dev) emit(s []FIFOSample, sen Sensor, slot int, raw [3]int16) int {
+	if slot < 0 {
+		return 0
+	}
+	f := &s[0]
+	f.Sensor, f.Slot, f.Raw = sen, slot, raw
+	switch sen {
+	case Accelerometer:
+		f.Value = d.scale(raw, d.accelLSB)
+	case Gyroscope:
+		f.Value = d.scale(raw, d.gyroLSB)
+	case Magnetometer:
+		f.Value = d.scale(raw, 1000/d.mag.NanoTeslaPerLSB)
+	}
+	return 1
+}
+
+var (
+	errFIFODisabled = errors.New("lsm6dsox: FIFO not enabled in Opts")
+	errShortBuffer  = errors.New("lsm6dsox: ReadFIFO needs room for 3 samples with compression")
+)
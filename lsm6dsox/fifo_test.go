@@ -0,0 +1,201 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lsm6dsox
+
+import (
+	"errors"
+	"testing"
+)
+
+// word returns a FIFO word of the given tag and time slot counter.
+func word(tag byte, cnt int, v [3]int16) []byte {
+	return append([]byte{tag<<3 | byte(cnt&3)<<1}, le(v)...)
+}
+
+// le encodes three little-endian 16 bits counts.
+func le(v [3]int16) []byte {
+	var b []byte
+	for _, x := range v {
+		b = append(b, byte(x), byte(uint16(x)>>8))
+	}
+	return b
+}
+
+// packed3xC returns the data of a 3xC word from three samples of 5 bits
+// differences.
+func packed3xC(diffs [3][3]int16) [3]int16 {
+	var v [3]int16
+	for i, d := range diffs {
+		v[i] = d[0]&0x1F | (d[1]&0x1F)<<5 | (d[2]&0x1F)<<10
+	}
+	return v
+}
+
+// fifoOpts are the options of the FIFO tests.
+var fifoOpts = Opts{FIFO: true}
+
+func TestReadFIFO(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl, fifo: []byte{0, 0x44, fifoContinuous}})
+	o.read(regFIFOSTATUS1, 5, 0)
+	o.read(regFIFOSTATUS1, 5, 0)
+	var b []byte
+	b = append(b, word(tagAccelNC, 0, [3]int16{16393, 0, 0})...)
+	b = append(b, word(tagGyroNC, 0, [3]int16{0, 1000, 0})...)
+	b = append(b, word(0x03, 0, [3]int16{256, 0, 0})...) // temperature
+	b = append(b, word(tagAccelNC, 1, [3]int16{0, 16393, 0})...)
+	o.read(regFIFODATATAG, b...)
+	o.read(regFIFOSTATUS1, 1, 0)
+	o.read(regFIFODATATAG, word(tagGyroNC, 1, [3]int16{0, 0, -1000})...)
+	o.read(regFIFOSTATUS1, 0, 0)
+	bus := o.bus()
+	d, err := New(bus, fifoOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 5 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]FIFOSample, 4)
+	n, err := d.ReadFIFO(s)
+	if err != nil || n != 3 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	want := []struct {
+		sensor Sensor
+		slot   int
+		raw    [3]int16
+	}{
+		{Accelerometer, 0, [3]int16{16393, 0, 0}},
+		{Gyroscope, 0, [3]int16{0, 1000, 0}},
+		{Accelerometer, 1, [3]int16{0, 16393, 0}},
+	}
+	for i, w := range want {
+		if s[i].Sensor != w.sensor || s[i].Slot != w.slot || s[i].Raw != w.raw {
+			t.Errorf("#%d: got %+v", i, s[i])
+		}
+	}
+	if v := s[2].Value[1]; v < 9.806 || v > 9.808 {
+		t.Errorf("Value = %v", s[2].Value)
+	}
+	if diff := s[2].Timestamp.Sub(s[0].Timestamp); diff != d.odr.Period() {
+		t.Errorf("timestamps %s apart, want %s", diff, d.odr.Period())
+	}
+	// Decoding continues across reads.
+	if n, err := d.ReadFIFO(s); err != nil || n != 1 || s[0].Slot != 1 || s[0].Sensor != Gyroscope {
+		t.Fatalf("ReadFIFO() = %d, %v, %+v", n, err, s[0])
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Compression(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl, compression: true, fifo: []byte{fifoComprRTEn, 0x44, fifoContinuous}})
+	o.read(regFIFOSTATUS1, 4, 0)
+	var b []byte
+	b = append(b, word(tagAccelNC, 0, [3]int16{100, 200, 300})...)
+	// Slots 1 and 2.
+	b = append(b, append([]byte{tagAccel2xC<<3 | 3<<1}, 1, 0xFF, 2, 3, 0, 0xFC)...)
+	// Slots 3 to 5.
+	b = append(b, word(tagAccel3xC, 1, packed3xC([3][3]int16{{1, 2, -16}, {15, -1, 0}, {0, 0, 0}}))...)
+	b = append(b, word(tagGyroNCT1, 1, [3]int16{7, 8, 9})...)
+	o.read(regFIFODATATAG, b...)
+	bus := o.bus()
+	d, err := New(bus, Opts{FIFO: true, Compression: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]FIFOSample, 12)
+	n, err := d.ReadFIFO(s)
+	if err != nil || n != 7 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	want := []struct {
+		sensor Sensor
+		slot   int
+		raw    [3]int16
+	}{
+		{Accelerometer, 0, [3]int16{100, 200, 300}},
+		{Accelerometer, 1, [3]int16{101, 199, 302}},
+		{Accelerometer, 2, [3]int16{104, 199, 298}},
+		{Accelerometer, 3, [3]int16{105, 201, 282}},
+		{Accelerometer, 4, [3]int16{120, 200, 282}},
+		{Accelerometer, 5, [3]int16{120, 200, 282}},
+		{Gyroscope, 4, [3]int16{7, 8, 9}},
+	}
+	for i, w := range want {
+		if s[i].Sensor != w.sensor || s[i].Slot != w.slot || s[i].Raw != w.raw {
+			t.Errorf("#%d: got %+v", i, s[i])
+		}
+	}
+	if !s[5].Timestamp.After(s[4].Timestamp) || s[4].Timestamp != s[6].Timestamp {
+		t.Errorf("timestamps %v", s[4:7])
+	}
+	if _, err := d.ReadFIFO(s[:2]); err != errShortBuffer {
+		t.Fatalf("ReadFIFO() = %v, want errShortBuffer", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Overflow(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl, fifo: []byte{0, 0x44, fifoContinuous}})
+	o.read(regFIFOSTATUS1, 0xFF, fifoOverflow|0x01)
+	o.write(regFIFOCTRL4, fifoBypass)
+	o.write(regFIFOCTRL4, fifoContinuous)
+	o.write(regFIFOCTRL4, fifoBypass)
+	o.write(regFIFOCTRL4, fifoContinuous)
+	bus := o.bus()
+	d, err := New(bus, fifoOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.slot = 10
+	if _, err := d.ReadFIFO(make([]FIFOSample, 1)); !errors.Is(err, ErrFIFOOverflow) {
+		t.Fatalf("ReadFIFO() = %v, want ErrFIFOOverflow", err)
+	}
+	if d.slot != 0 || d.tagCnt != -1 {
+		t.Fatalf("decoding state not reset: %+v", d.fifoState)
+	}
+	if err := d.ResetFIFO(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	bus := newOps(config{ctrl: defaultCtrl}).bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.FIFOLen(); err != errFIFODisabled {
+		t.Fatalf("FIFOLen() = %v", err)
+	}
+	if _, err := d.ReadFIFO(nil); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	if err := d.ResetFIFO(); err != errFIFODisabled {
+		t.Fatalf("ResetFIFO() = %v", err)
+	}
+	d.halted = true
+	if _, err := d.ReadFIFO(nil); err != ErrHalted {
+		t.Fatalf("ReadFIFO() = %v, want ErrHalted", err)
+	}
+}
+
+func TestSensor_String(t *testing.T) {
+	for s, want := range map[Sensor]string{Accelerometer: "Accelerometer", Gyroscope: "Gyroscope", Magnetometer: "Magnetometer", 7: "Sensor(7)"} {
+		if got := s.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
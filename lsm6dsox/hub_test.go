@@ -0,0 +1,102 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lsm6dsox
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func TestNew_Mag(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl, mag: &LIS2MDL, slv0: magLen})
+	o.read(regOUTTEMPL, data(0, [3]int16{}, [3]int16{})...)
+	o.read(regSENSORHUB1, le([3]int16{100, -200, 0})...)
+	bus := o.bus()
+	d, err := New(bus, Opts{Mag: &LIS2MDL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 150nT/LSB.
+	if want := (sensor.Field{X: 15 * physic.MicroTesla, Y: -30 * physic.MicroTesla}); s.Mag != want || s.RawMag != [3]int16{100, -200, 0} {
+		t.Fatalf("Mag = %s, %v", s.Mag, s.RawMag)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_MagFIFO(t *testing.T) {
+	m := &ExternalMag{Addr: 0x1C, Reg: 0xA8, NanoTeslaPerLSB: 100, PullUp: true}
+	o := newOps(config{ctrl: [2]byte{0x20, 0x2C}, mag: m, slv0: 0x80 | slvBatch | magLen, fifo: []byte{0, 0x22, fifoContinuous}})
+	o.read(regFIFOSTATUS1, 2, 0)
+	o.read(regFIFODATATAG, append(word(tagAccelNC, 2, [3]int16{1, 2, 3}), word(tagSensorHub0, 2, [3]int16{10, 0, -10})...)...)
+	bus := o.bus()
+	d, err := New(bus, Opts{ODR: 26 * physic.Hertz, FIFO: true, Mag: m})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]FIFOSample, 2)
+	if n, err := d.ReadFIFO(s); err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if s[1].Sensor != Magnetometer || s[1].Slot != 0 || s[1].Value != [3]float64{1, 0, -1} {
+		t.Fatalf("got %+v", s[1])
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_MagNAK(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl})
+	o.hubWrite(&LIS3MDL, LIS3MDL.Setup[0][0], LIS3MDL.Setup[0][1], statusSlv0NAK)
+	bus := o.bus()
+	if _, err := New(bus, Opts{Mag: &LIS3MDL}); !errors.Is(err, ErrAuxNAK) {
+		t.Fatalf("New() = %v, want ErrAuxNAK", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_MagTimeout(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl})
+	o.hubWrite(&LIS2MDL, LIS2MDL.Setup[0][0], LIS2MDL.Setup[0][1], 0)
+	for i := 0; i < hubPolls; i++ {
+		o.read(regSTATUSMASTER, 0)
+	}
+	bus := o.bus()
+	if _, err := New(bus, Opts{Mag: &LIS2MDL}); err == nil || err.Error() != "lsm6dsox: sensor hub write of register 0x62 timed out" {
+		t.Fatalf("New() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHubODR(t *testing.T) {
+	data := []struct {
+		odr  physic.Frequency
+		want byte
+	}{
+		{12500 * physic.MilliHertz, 0xC0},
+		{26 * physic.Hertz, 0x80},
+		{52 * physic.Hertz, 0x40},
+		{104 * physic.Hertz, 0x00},
+		{6660 * physic.Hertz, 0x00},
+	}
+	for i, line := range data {
+		if got := hubODR(line.odr); got != line.want {
+			t.Errorf("#%d: hubODR(%s) = %#x, want %#x", i, line.odr, got, line.want)
+		}
+	}
+}
@@ -0,0 +1,506 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lsm6dsox
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address with SDO/SA0 low; AltAddr is used with
+// SDO/SA0 high.
+const (
+	DefaultAddr = 0x6A
+	AltAddr     = 0x6B
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the LSM6DSOX.
+const MaxSPIFrequency = 10 * physic.MegaHertz
+
+// register is a register address, with its page in the high byte.
+type register uint16
+
+func (r register) page() byte { return byte(r >> 8) }
+func (r register) addr() byte { return byte(r) }
+
+// Register pages, selected with FUNC_CFG_ACCESS.
+const (
+	pageMain     = 0
+	pageEmbedded = 1
+	pageHub      = 2
+)
+
+// pageAccess are the FUNC_CFG_ACCESS values by page.
+var pageAccess = [...]byte{0x00, 0x80, 0x40}
+
+// Register map.
+const (
+	regFUNCCFGACCESS = 0x01 // in every page
+	// Main page.
+	regFIFOCTRL2    register = 0x08   // FIFO_COMPR_RT_EN bit 6; followed by FIFO_CTRL3 and FIFO_CTRL4
+	regFIFOCTRL4    register = 0x0A   // FIFO_MODE bits 2..0
+	regWHOAMI       register = 0x0F   // reads whoAmI
+	regCTRL1XL      register = 0x10   // ODR_XL bits 7..4, FS_XL bits 3..2; followed by CTRL2_G
+	regCTRL3C       register = 0x12   // BDU bit 6, IF_INC bit 2, SW_RESET bit 0
+	regCTRL4C       register = 0x13   // I2C_disable bit 2
+	regOUTTEMPL     register = 0x20   // temperature, gyroscope, accelerometer
+	regSTATUSMASTER register = 0x39   // STATUS_MASTER_MAINPAGE
+	regFIFOSTATUS1  register = 0x3A   // DIFF_FIFO[7:0]; followed by FIFO_STATUS2
+	regFIFODATATAG  register = 0x78   // FIFO_DATA_OUT_TAG; followed by the 6 data bytes
+	regEMBFUNCENB   register = 0x0105 // FIFO_COMPR_EN bit 3
+	regEMBFUNCINITB register = 0x0167 // FIFO_COMPR_INIT bit 3
+	regSENSORHUB1   register = 0x0202 // data read by the sensor hub
+	regMASTERCONFIG register = 0x0214
+	regSLV0ADD      register = 0x0215 // followed by SLV0_SUBADD and SLV0_CONFIG
+	regDATAWRITE0   register = 0x0221 // DATAWRITE_SLV0
+)
+
+// whoAmI is the value of the WHO_AM_I register.
+const whoAmI = 0x6C
+
+// Register values.
+const (
+	ctrl3SWReset    = 0x01
+	ctrl3BDUIfInc   = 0x44 // block data update and address auto-increment
+	ctrl4I2CDisable = 0x04
+	fifoComprRTEn   = 0x40 // in FIFO_CTRL2
+	fifoContinuous  = 0x06 // in FIFO_CTRL4
+	fifoBypass      = 0x00
+	fifoOverflow    = 0x40 // FIFO_OVR_IA in FIFO_STATUS2
+	fifoComprEn     = 0x08 // in EMB_FUNC_EN_B and EMB_FUNC_INIT_B
+	spiRead         = 0x80 // bit 7 of the address
+)
+
+// Timings, rounded up.
+const (
+	resetTime = time.Millisecond
+	startTime = 70 * time.Millisecond // gyroscope turn-on
+)
+
+// Sizes of the data blocks.
+const (
+	dataLen   = 14 // OUT_TEMP_L to OUTZ_H_A
+	wordLen   = 7  // tag and data of a FIFO word
+	fifoBurst = 128
+)
+
+// standardGravity is in m/s² per g.
+const standardGravity = 9.80665
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when WHO_AM_I doesn't read 0x6C.
+	ErrBadID = errors.New("lsm6dsox: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("lsm6dsox: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("lsm6dsox: invalid options")
+	// ErrFIFOOverflow is returned by ReadFIFO when samples were lost because
+	// the FIFO was full. The FIFO is reset.
+	ErrFIFOOverflow = errors.New("lsm6dsox: FIFO overflow")
+	// ErrAuxNAK is returned by New when the external sensor didn't
+	// acknowledge a transfer of the sensor hub.
+	ErrAuxNAK = errors.New("lsm6dsox: NAK on the auxiliary bus")
+)
+
+// odrs are the ODR_XL, ODR_G, BDR_XL and BDR_GY values by output data rate.
+var odrs = map[physic.Frequency]byte{
+	12500 * physic.MilliHertz: 0x01,
+	26 * physic.Hertz:         0x02,
+	52 * physic.Hertz:         0x03,
+	104 * physic.Hertz:        0x04,
+	208 * physic.Hertz:        0x05,
+	416 * physic.Hertz:        0x06,
+	833 * physic.Hertz:        0x07,
+	1660 * physic.Hertz:       0x08,
+	3330 * physic.Hertz:       0x09,
+	6660 * physic.Hertz:       0x0A,
+}
+
+// accelRanges are the FS_XL bits of CTRL1_XL and sensitivities in mg/LSB by
+// full scale in g.
+var accelRanges = map[int]struct {
+	fs  byte
+	sen float64
+}{
+	2:  {0x00, 0.061},
+	4:  {0x08, 0.122},
+	8:  {0x0C, 0.244},
+	16: {0x04, 0.488},
+}
+
+// gyroRanges are the FS_G and FS_125 bits of CTRL2_G and sensitivities in
+// mdps/LSB by full scale in °/s.
+var gyroRanges = map[int]struct {
+	fs  byte
+	sen float64
+}{
+	125:  {0x02, 4.375},
+	250:  {0x00, 8.75},
+	500:  {0x04, 17.5},
+	1000: {0x08, 35},
+	2000: {0x0C, 70},
+}
+
+// Opts holds initialization options.
+//
+// AccelRange: accelerometer full scale in g, 2 (default), 4, 8 or 16.
+// GyroRange: gyroscope full scale in °/s, 125, 250, 500, 1000 or 2000
+// (default).
+// ODR: output data rate of both sensors, 12.5, 26, 52, 104 (default), 208,
+// 416, 833, 1660, 3330 or 6660Hz. The FIFO batches them at the same rate.
+// FIFO: queue the samples in the FIFO, read with ReadFIFO.
+// Compression: compress the samples queued in the FIFO, which then holds up
+// to three times more. Requires FIFO.
+// Mag: read an external magnetometer with the sensor hub.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+type Opts struct {
+	AccelRange  int
+	GyroRange   int
+	ODR         physic.Frequency
+	FIFO        bool
+	Compression bool
+	Mag         *ExternalMag
+	Addr        uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if _, ok := accelRanges[o.AccelRange]; o.AccelRange != 0 && !ok {
+		return fmt.Errorf("%w: AccelRange %d, want 2, 4, 8 or 16", ErrInvalidOpts, o.AccelRange)
+	}
+	if _, ok := gyroRanges[o.GyroRange]; o.GyroRange != 0 && !ok {
+		return fmt.Errorf("%w: GyroRange %d, want 125, 250, 500, 1000 or 2000", ErrInvalidOpts, o.GyroRange)
+	}
+	if _, ok := odrs[o.ODR]; o.ODR != 0 && !ok {
+		return fmt.Errorf("%w: ODR %s, want 12.5, 26, 52, 104, 208, 416, 833, 1660, 3330 or 6660Hz", ErrInvalidOpts, o.ODR)
+	}
+	if o.Compression && !o.FIFO {
+		return fmt.Errorf("%w: Compression requires FIFO", ErrInvalidOpts)
+	}
+	if o.Mag != nil {
+		return o.Mag.validate()
+	}
+	return nil
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Gyro is the angular rate in rad/s, in X,Y,Z order.
+	Gyro [3]float64
+	// Mag is the magnetic field, in the axes of the external magnetometer. It
+	// is zero without Opts.Mag.
+	Mag sensor.Field
+	// Temperature is the die temperature.
+	Temperature physic.Temperature
+	// RawAccel, RawGyro and RawMag are the counts the values were computed
+	// from.
+	RawAccel, RawGyro, RawMag [3]int16
+	// Timestamp is the time at which the sample was read from the device.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s² gyro=%.4f,%.4f,%.4frad/s mag=%s",
+		s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2], s.Mag)
+}
+
+// Dev represents a LSM6DSOX device.
+// Sense returns values in m/s² and rad/s.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c           conn.Conn
+	isSPI       bool
+	accelRange  int
+	gyroRange   int
+	accelLSB    float64 // LSB per m/s²
+	gyroLSB     float64 // LSB per rad/s
+	odr         physic.Frequency
+	fifo        bool
+	compression bool
+	mag         *ExternalMag
+	page        int // selected page, -1 when unknown
+	halted      bool
+	fifoState
+
+	// Preallocated bus buffers, one byte longer than the largest read for the
+	// SPI address byte.
+	w, r [fifoBurst*wordLen + 1]byte
+
+	// mu serializes bus transactions and guards the fields above.
+	mu sync.Mutex
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI resets and configures a device on a 4-wire SPI port. The I²C
+// interface of the device is disabled.
+//
+// The port is connected in mode 0 at MaxSPIFrequency (10 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("lsm6dsox: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	ar := opts.AccelRange
+	if ar == 0 {
+		ar = 2
+	}
+	gr := opts.GyroRange
+	if gr == 0 {
+		gr = 2000
+	}
+	odr := opts.ODR
+	if odr == 0 {
+		odr = 104 * physic.Hertz
+	}
+	d := &Dev{
+		c:           c,
+		isSPI:       isSPI,
+		accelRange:  ar,
+		gyroRange:   gr,
+		accelLSB:    1000 / accelRanges[ar].sen / standardGravity,
+		gyroLSB:     1000 / gyroRanges[gr].sen * 180 / math.Pi,
+		odr:         odr,
+		fifo:        opts.FIFO,
+		compression: opts.Compression,
+		mag:         opts.Mag,
+		page:        -1,
+	}
+	d.fifoState.reset()
+	var id [1]byte
+	if err := d.readRegs(regWHOAMI, id[:]); err != nil {
+		return nil, err
+	}
+	if id[0] != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id[0], whoAmI)
+	}
+	if err := d.writeRegs(regCTRL3C, ctrl3SWReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// The reset selects the main page.
+	d.page = pageMain
+	if isSPI {
+		if err := d.writeRegs(regCTRL4C, ctrl4I2CDisable); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.writeRegs(regCTRL3C, ctrl3BDUIfInc); err != nil {
+		return nil, err
+	}
+	if opts.Compression {
+		if err := d.writeRegs(regEMBFUNCENB, fifoComprEn); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regEMBFUNCINITB, fifoComprEn); err != nil {
+			return nil, err
+		}
+	}
+	code := odrs[odr]
+	if err := d.writeRegs(regCTRL1XL, code<<4|accelRanges[ar].fs, code<<4|gyroRanges[gr].fs); err != nil {
+		return nil, err
+	}
+	doSleep(startTime)
+	// The sensor hub is triggered by the accelerometer, so it is configured
+	// once the sensors run.
+	if opts.Mag != nil {
+		if err := d.initHub(); err != nil {
+			return nil, err
+		}
+	}
+	if opts.FIFO {
+		var compr byte
+		if opts.Compression {
+			compr = fifoComprRTEn
+		}
+		if err := d.writeRegs(regFIFOCTRL2, compr, code<<4|code, fifoContinuous); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("LSM6DSOX{%s, ±%dg, ±%d°/s, %s}", d.c, d.accelRange, d.gyroRange, d.odr)
+}
+
+// SampleRate returns the output data rate of both sensors.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.odr
+}
+
+// Halt powers down both sensors, which stops the sensor hub. It implements
+// conn.Resource.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil
+	}
+	if err := d.writeRegs(regCTRL1XL, 0, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns the latest accelerometer, gyroscope and magnetometer
+// counts, in X,Y,Z order. The magnetometer counts are 0 without Opts.Mag.
+func (d *Dev) SenseRaw() ([3]int16, [3]int16, [3]int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.sense(&s)
+	return s.RawAccel, s.RawGyro, s.RawMag, err
+}
+
+// Sense reads the latest sample into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(s)
+}
+
+func (d *Dev) sense(s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	b, err := d.readBlock(regOUTTEMPL, dataLen)
+	if err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	s.RawAccel = le3(b[8:14])
+	s.RawGyro = le3(b[2:8])
+	s.Accel, s.Gyro = d.scale(s.RawAccel, d.accelLSB), d.scale(s.RawGyro, d.gyroLSB)
+	t := int16(b[0]) | int16(b[1])<<8
+	// °C = 25 + raw / 256
+	s.Temperature = physic.ZeroCelsius + physic.Temperature(math.Round((25+float64(t)/256)*1000))*physic.MilliKelvin
+	s.RawMag = [3]int16{}
+	s.Mag = sensor.Field{}
+	if d.mag == nil {
+		return nil
+	}
+	if b, err = d.readBlock(regSENSORHUB1, 6); err != nil {
+		return err
+	}
+	s.RawMag = le3(b)
+	s.Mag = d.mag.field(s.RawMag)
+	return nil
+}
+
+// scale converts counts to a value with lsb counts per unit.
+func (d *Dev) scale(raw [3]int16, lsb float64) [3]float64 {
+	return [3]float64{float64(raw[0]) / lsb, float64(raw[1]) / lsb, float64(raw[2]) / lsb}
+}
+
+// le3 decodes three little-endian 16 bits counts.
+func le3(b []byte) [3]int16 {
+	return [3]int16{
+		int16(b[0]) | int16(b[1])<<8,
+		int16(b[2]) | int16(b[3])<<8,
+		int16(b[4]) | int16(b[5])<<8,
+	}
+}
+
+// selectPage writes FUNC_CFG_ACCESS when the page of r isn't selected.
+func (d *Dev) selectPage(r register) error {
+	if d.page == int(r.page()) {
+		return nil
+	}
+	if err := d.c.Tx([]byte{regFUNCCFGACCESS, pageAccess[r.page()]}, nil); err != nil {
+		d.page = -1
+		return fmt.Errorf("lsm6dsox: selecting page %d: %w", r.page(), err)
+	}
+	d.page = int(r.page())
+	return nil
+}
+
+// readRegs reads consecutive registers starting at r into out.
+func (d *Dev) readRegs(r register, out []byte) error {
+	b, err := d.readBlock(r, len(out))
+	if err != nil {
+		return err
+	}
+	copy(out, b)
+	return nil
+}
+
+// readBlock reads n consecutive registers starting at r. The returned slice
+// is only valid until the next transaction.
+func (d *Dev) readBlock(r register, n int) ([]byte, error) {
+	if err := d.selectPage(r); err != nil {
+		return nil, err
+	}
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, rd := d.w[:n+1], d.r[:n+1]
+		clear(w)
+		w[0] = r.addr() | spiRead
+		if err := d.c.Tx(w, rd); err != nil {
+			return nil, fmt.Errorf("lsm6dsox: reading register %d:0x%02x: %w", r.page(), r.addr(), err)
+		}
+		return rd[1:], nil
+	}
+	w, rd := append(d.w[:0], r.addr()), d.r[:n]
+	if err := d.c.Tx(w, rd); err != nil {
+		return nil, fmt.Errorf("lsm6dsox: reading register %d:0x%02x: %w", r.page(), r.addr(), err)
+	}
+	return rd, nil
+}
+
+// writeRegs writes consecutive registers starting at r in one transaction.
+func (d *Dev) writeRegs(r register, v ...byte) error {
+	if err := d.selectPage(r); err != nil {
+		return err
+	}
+	w := append(append(d.w[:0], r.addr()), v...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("lsm6dsox: writing register %d:0x%02x: %w", r.page(), r.addr(), err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
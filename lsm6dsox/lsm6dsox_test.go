@@ -0,0 +1,298 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lsm6dsox
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// ops builds the expected bus transactions, selecting the pages like the
+// driver.
+type ops struct {
+	io    []conntest.IO
+	isSPI bool
+	page  int
+}
+
+func (o *ops) selectPage(r register) {
+	if o.page != int(r.page()) {
+		o.io = append(o.io, conntest.IO{W: []byte{regFUNCCFGACCESS, pageAccess[r.page()]}})
+		o.page = int(r.page())
+	}
+}
+
+func (o *ops) write(r register, v ...byte) {
+	o.selectPage(r)
+	o.io = append(o.io, conntest.IO{W: append([]byte{r.addr()}, v...)})
+}
+
+func (o *ops) read(r register, v ...byte) {
+	o.selectPage(r)
+	if !o.isSPI {
+		o.io = append(o.io, conntest.IO{W: []byte{r.addr()}, R: v})
+		return
+	}
+	w := make([]byte, len(v)+1)
+	w[0] = r.addr() | spiRead
+	o.io = append(o.io, conntest.IO{W: w, R: append([]byte{0}, v...)})
+}
+
+// port returns an SPI port replaying the transactions.
+func (o *ops) port() *spitest.Playback {
+	return &spitest.Playback{Playback: conntest.Playback{Ops: o.io}}
+}
+
+// bus returns an I²C bus replaying the transactions.
+func (o *ops) bus() *i2ctest.Playback {
+	b := &i2ctest.Playback{}
+	for _, io := range o.io {
+		b.Ops = append(b.Ops, i2ctest.IO{Addr: DefaultAddr, W: io.W, R: io.R})
+	}
+	return b
+}
+
+// config describes the registers written by New.
+type config struct {
+	isSPI       bool
+	ctrl        [2]byte // CTRL1_XL and CTRL2_G
+	compression bool
+	mag         *ExternalMag
+	slv0        byte   // SLV0_CONFIG
+	fifo        []byte // FIFO_CTRL2 to FIFO_CTRL4, nil without FIFO
+}
+
+// defaultCtrl are CTRL1_XL and CTRL2_G with the default options.
+var defaultCtrl = [2]byte{0x40, 0x4C}
+
+// newOps returns the bus transactions issued by New.
+func newOps(c config) *ops {
+	o := &ops{isSPI: c.isSPI, page: -1}
+	o.read(regWHOAMI, whoAmI)
+	o.write(regCTRL3C, ctrl3SWReset)
+	o.page = pageMain
+	if c.isSPI {
+		o.write(regCTRL4C, ctrl4I2CDisable)
+	}
+	o.write(regCTRL3C, ctrl3BDUIfInc)
+	if c.compression {
+		o.write(regEMBFUNCENB, fifoComprEn)
+		o.write(regEMBFUNCINITB, fifoComprEn)
+	}
+	o.write(regCTRL1XL, c.ctrl[:]...)
+	if c.mag != nil {
+		for _, s := range c.mag.Setup {
+			o.hubWrite(c.mag, s[0], s[1], statusWrDone)
+		}
+		o.write(regSLV0ADD, c.mag.Addr<<1|slvRead, c.mag.Reg, c.slv0)
+		o.write(regMASTERCONFIG, masterConfig(c.mag)|masterOn)
+	}
+	if c.fifo != nil {
+		o.write(regFIFOCTRL2, c.fifo...)
+	}
+	return o
+}
+
+// hubWrite adds a write of the sensor hub to m, whose status reads status.
+func (o *ops) hubWrite(m *ExternalMag, reg, v, status byte) {
+	o.write(regDATAWRITE0, v)
+	o.write(regSLV0ADD, m.Addr<<1, reg, 0)
+	o.write(regMASTERCONFIG, masterConfig(m)|masterOn)
+	o.read(regSTATUSMASTER, status)
+	if status == statusWrDone {
+		o.write(regMASTERCONFIG, masterConfig(m))
+	}
+}
+
+// masterConfig returns MASTER_CONFIG for m, with the I²C master off.
+func masterConfig(m *ExternalMag) byte {
+	if m.PullUp {
+		return masterWrOnce | masterPullUp
+	}
+	return masterWrOnce
+}
+
+// data returns the OUT_TEMP_L to OUTZ_H_A registers.
+func data(temp int16, gyro, accel [3]int16) []byte {
+	b := []byte{byte(temp), byte(uint16(temp) >> 8)}
+	for _, v := range append(gyro[:], accel[:]...) {
+		b = append(b, byte(v), byte(uint16(v)>>8))
+	}
+	return b
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		ctrl [2]byte
+		s    string
+	}{
+		{Opts{}, defaultCtrl, "LSM6DSOX{playback(106), ±2g, ±2000°/s, 104Hz}"},
+		{Opts{AccelRange: 16, GyroRange: 125, ODR: 6660 * physic.Hertz}, [2]byte{0xA4, 0xA2}, "LSM6DSOX{playback(106), ±16g, ±125°/s, 6.660kHz}"},
+		{Opts{AccelRange: 8, GyroRange: 500, ODR: 12500 * physic.MilliHertz}, [2]byte{0x1C, 0x14}, "LSM6DSOX{playback(106), ±8g, ±500°/s, 12.500Hz}"},
+		{Opts{AccelRange: 4, GyroRange: 250, ODR: 833 * physic.Hertz}, [2]byte{0x78, 0x70}, "LSM6DSOX{playback(106), ±4g, ±250°/s, 833Hz}"},
+	}
+	for i, line := range data {
+		bus := newOps(config{ctrl: line.ctrl}).bus()
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if r := d.SampleRate(); r != d.odr {
+			t.Errorf("#%d: SampleRate() = %s", i, r)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	o := &ops{page: -1}
+	o.read(regWHOAMI, 0x6B)
+	if _, err := New(o.bus(), Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{AccelRange: 16, GyroRange: 1000, ODR: 3330 * physic.Hertz, FIFO: true, Compression: true, Mag: &LIS3MDL}, ""},
+		{Opts{AccelRange: 6}, "lsm6dsox: invalid options: AccelRange 6, want 2, 4, 8 or 16"},
+		{Opts{GyroRange: 4000}, "lsm6dsox: invalid options: GyroRange 4000, want 125, 250, 500, 1000 or 2000"},
+		{Opts{ODR: 100 * physic.Hertz}, "lsm6dsox: invalid options: ODR 100Hz, want 12.5, 26, 52, 104, 208, 416, 833, 1660, 3330 or 6660Hz"},
+		{Opts{Compression: true}, "lsm6dsox: invalid options: Compression requires FIFO"},
+		{Opts{Mag: &ExternalMag{Addr: 0x80, NanoTeslaPerLSB: 1}}, "lsm6dsox: invalid options: Mag.Addr 0x80, want 0x01 to 0x7f"},
+		{Opts{Mag: &ExternalMag{Addr: 0x1E}}, "lsm6dsox: invalid options: Mag.NanoTeslaPerLSB 0, want positive"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{AccelRange: 1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	o := newOps(config{ctrl: defaultCtrl})
+	// 0.061mg/LSB and 70mdps/LSB.
+	o.read(regOUTTEMPL, data(256, [3]int16{1000, -1000, 0}, [3]int16{16393, 0, -16393})...)
+	o.read(regOUTTEMPL, data(-6400, [3]int16{1, 2, 3}, [3]int16{-1, -2, -3})...)
+	o.write(regCTRL1XL, 0, 0)
+	bus := o.bus()
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(s.Accel[0]-9.806) > 0.001 || s.Accel[1] != 0 || s.Accel[2] != -s.Accel[0] {
+		t.Errorf("Accel = %v", s.Accel)
+	}
+	if math.Abs(s.Gyro[0]-70*math.Pi/180) > 1e-9 || s.Gyro[1] != -s.Gyro[0] || s.Gyro[2] != 0 {
+		t.Errorf("Gyro = %v", s.Gyro)
+	}
+	if want := physic.ZeroCelsius + 26*physic.Celsius; s.Temperature != want {
+		t.Errorf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	if s.Timestamp.IsZero() {
+		t.Error("Timestamp not set")
+	}
+	if got := s.String(); got != "accel=9.806,0.000,-9.806m/s² gyro=1.2217,-1.2217,0.0000rad/s mag=X=0T Y=0T Z=0T" {
+		t.Errorf("String() = %q", got)
+	}
+	a, g, m, err := d.SenseRaw()
+	if err != nil || a != [3]int16{-1, -2, -3} || g != [3]int16{1, 2, 3} || m != [3]int16{} {
+		t.Fatalf("SenseRaw() = %v, %v, %v, %v", a, g, m, err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&s); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	o := newOps(config{isSPI: true, ctrl: defaultCtrl})
+	o.read(regOUTTEMPL, data(0, [3]int16{-1, 0, 1}, [3]int16{100, 200, 300})...)
+	port := o.port()
+	d, err := NewSPI(port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "LSM6DSOX{playback, ±2g, ±2000°/s, 104Hz}" {
+		t.Errorf("String() = %q", s)
+	}
+	a, g, _, err := d.SenseRaw()
+	if err != nil || a != [3]int16{100, 200, 300} || g != [3]int16{-1, 0, 1} {
+		t.Fatalf("SenseRaw() = %v, %v, %v", a, g, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	o := &ops{page: -1}
+	o.read(regWHOAMI, whoAmI)
+	bus = o.bus()
+	bus.DontPanic = true
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = newOps(config{ctrl: defaultCtrl}).bus()
+	bus.DontPanic = true
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package max31855 controls a Maxim MAX31855 cold-junction compensated
+// thermocouple-to-digital converter over SPI.
+//
+// # More details
+//
+// The MAX31855 has no registers nor configuration: it converts continuously,
+// about every 100ms, and a read shifts out a 32 bits frame holding the
+// thermocouple temperature at 0.25°C resolution, the cold junction (internal)
+// temperature at 0.0625°C resolution and the fault bits. Reading faster than
+// the conversion rate returns the same measurement again.
+//
+// A fault, i.e. an open thermocouple or one shorted to GND or VCC, is
+// returned as a *FaultError matching ErrFault and the sentinel of each fault
+// found. The cold junction temperature is still valid then.
+//
+// The device is read-only, so it has no MOSI pin.
+//
+// # Datasheet
+//
+// https://www.analog.com/media/en/technical-documentation/data-sheets/MAX31855.pdf
+package max31855
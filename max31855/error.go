@@ -0,0 +1,64 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrFault is matched by *FaultError.
+	ErrFault = errors.New("max31855: thermocouple fault")
+	// ErrOpenCircuit is matched by a *FaultError with OpenCircuit set.
+	ErrOpenCircuit = errors.New("max31855: thermocouple open circuit")
+	// ErrShortToGND is matched by a *FaultError with ShortToGND set.
+	ErrShortToGND = errors.New("max31855: thermocouple shorted to GND")
+	// ErrShortToVCC is matched by a *FaultError with ShortToVCC set.
+	ErrShortToVCC = errors.New("max31855: thermocouple shorted to VCC")
+	// ErrBadFrame is returned when the reserved bits of a frame are set, as
+	// read with MISO floating high when no device is connected.
+	ErrBadFrame = errors.New("max31855: invalid frame")
+	// ErrInvalidOpts is returned for arguments that are out of range.
+	ErrInvalidOpts = errors.New("max31855: invalid options")
+)
+
+// FaultError is returned when the device reports a thermocouple fault. The
+// thermocouple temperature is not valid then.
+type FaultError struct {
+	OpenCircuit bool
+	ShortToGND  bool
+	ShortToVCC  bool
+}
+
+func (e *FaultError) Error() string {
+	var faults []string
+	if e.OpenCircuit {
+		faults = append(faults, "open circuit")
+	}
+	if e.ShortToGND {
+		faults = append(faults, "short to GND")
+	}
+	if e.ShortToVCC {
+		faults = append(faults, "short to VCC")
+	}
+	return ErrFault.Error() + ": " + strings.Join(faults, ",")
+}
+
+// Is implements errors.Is.
+func (e *FaultError) Is(target error) bool {
+	switch target {
+	case ErrFault:
+		return true
+	case ErrOpenCircuit:
+		return e.OpenCircuit
+	case ErrShortToGND:
+		return e.ShortToGND
+	case ErrShortToVCC:
+		return e.ShortToVCC
+	}
+	return false
+}
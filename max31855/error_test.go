@@ -0,0 +1,56 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFaultError(t *testing.T) {
+	data := []struct {
+		v    uint32
+		want FaultError
+		msg  string
+		is   []error
+		isnt []error
+	}{
+		{
+			0x00010001, FaultError{OpenCircuit: true},
+			"max31855: thermocouple fault: open circuit",
+			[]error{ErrFault, ErrOpenCircuit}, []error{ErrShortToGND, ErrShortToVCC},
+		},
+		{
+			0x00010002, FaultError{ShortToGND: true},
+			"max31855: thermocouple fault: short to GND",
+			[]error{ErrFault, ErrShortToGND}, []error{ErrOpenCircuit, ErrShortToVCC},
+		},
+		{
+			0x00010006, FaultError{ShortToGND: true, ShortToVCC: true},
+			"max31855: thermocouple fault: short to GND,short to VCC",
+			[]error{ErrFault, ErrShortToGND, ErrShortToVCC}, []error{ErrOpenCircuit, ErrBadFrame},
+		},
+	}
+	for i, line := range data {
+		_, err := decode(line.v)
+		var fe *FaultError
+		if !errors.As(err, &fe) || *fe != line.want {
+			t.Fatalf("#%d: %v, want %v", i, err, line.want)
+		}
+		if err.Error() != line.msg {
+			t.Fatalf("#%d: %q, want %q", i, err, line.msg)
+		}
+		for _, target := range line.is {
+			if !errors.Is(err, target) {
+				t.Fatalf("#%d: %v doesn't match %v", i, err, target)
+			}
+		}
+		for _, target := range line.isnt {
+			if errors.Is(err, target) {
+				t.Fatalf("#%d: %v matches %v", i, err, target)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855_test
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/max31855"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use spireg SPI port registry to find the first available SPI bus.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	d, err := max31855.New(p)
+	if err != nil {
+		log.Fatalf("failed to initialize max31855: %v", err)
+	}
+	m, err := d.Read()
+	if errors.Is(err, max31855.ErrOpenCircuit) {
+		log.Fatal("thermocouple not connected")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s (cold junction %s)\n", m.Thermocouple, m.ColdJunction)
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// MaxSPIFrequency is the maximum SPI clock frequency supported.
+const MaxSPIFrequency = 5 * physic.MegaHertz
+
+// convTime is the maximum duration of a conversion.
+const convTime = 100 * time.Millisecond
+
+// Frame bits.
+const (
+	bitFault     = 1 << 16
+	bitShortVCC  = 1 << 2
+	bitShortGND  = 1 << 1
+	bitOpen      = 1 << 0
+	reservedBits = 1<<17 | 1<<3
+)
+
+// Measurement is a read of both temperatures.
+type Measurement struct {
+	// Thermocouple is the temperature at the hot junction.
+	Thermocouple physic.Temperature
+	// ColdJunction is the temperature of the device itself.
+	ColdJunction physic.Temperature
+}
+
+func (m Measurement) String() string {
+	return fmt.Sprintf("Measurement{Thermocouple:%s ColdJunction:%s}", m.Thermocouple, m.ColdJunction)
+}
+
+// Dev is a handle to a MAX31855 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c conn.Conn
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a handle to a device on a SPI port.
+//
+// The port is connected in mode 0 at MaxSPIFrequency (5 MHz).
+func New(p spi.Port) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("max31855: connecting SPI: %w", err)
+	}
+	return &Dev{c: c}, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MAX31855{%s}", d.c)
+}
+
+// Read reads both temperatures.
+//
+// On a fault it returns a *FaultError along with the cold junction
+// temperature, Thermocouple being left to 0.
+func (d *Dev) Read() (Measurement, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return Measurement{}, errors.New("max31855: already sensing continuously")
+	}
+	return d.read()
+}
+
+// Sense reads the thermocouple temperature. It implements physic.SenseEnv.
+//
+// The humidity and the pressure are not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	m, err := d.Read()
+	if err != nil {
+		return err
+	}
+	e.Temperature = m.Thermocouple
+	return nil
+}
+
+// SenseContinuous returns measurements of the thermocouple temperature on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be at least the conversion time, 100ms.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval < convTime {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, convTime)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		d.mu.Lock()
+		m, err := d.read()
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- physic.Env{Temperature: m.Thermocouple}:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 250 * physic.MilliKelvin
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device keeps converting, as it has no shutdown.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// read reads and decodes a frame.
+//
+// It must be called with d.mu held.
+func (d *Dev) read() (Measurement, error) {
+	var b [4]byte
+	if err := d.c.Tx(nil, b[:]); err != nil {
+		return Measurement{}, fmt.Errorf("max31855: reading: %w", err)
+	}
+	return decode(uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3]))
+}
+
+// decode converts a frame: D31-D18 is the thermocouple temperature in
+// 0.25°C, D15-D4 the cold junction temperature in 0.0625°C, both signed.
+func decode(v uint32) (Measurement, error) {
+	if v&reservedBits != 0 {
+		return Measurement{}, fmt.Errorf("%w: %#08x", ErrBadFrame, v)
+	}
+	m := Measurement{
+		ColdJunction: physic.Temperature(int16(v)>>4)*62500*physic.MicroKelvin + physic.ZeroCelsius,
+	}
+	if v&bitFault != 0 {
+		return m, &FaultError{
+			OpenCircuit: v&bitOpen != 0,
+			ShortToGND:  v&bitShortGND != 0,
+			ShortToVCC:  v&bitShortVCC != 0,
+		}
+	}
+	m.Thermocouple = physic.Temperature(int32(v)>>18)*250*physic.MilliKelvin + physic.ZeroCelsius
+	return m, nil
+}
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
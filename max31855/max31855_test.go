@@ -0,0 +1,202 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+// testFrame is 100.75°C at the thermocouple and 25.0625°C at the cold
+// junction.
+var testFrame = []byte{0x06, 0x4C, 0x19, 0x10}
+
+var testMeasurement = Measurement{
+	Thermocouple: physic.ZeroCelsius + 100750*physic.MilliKelvin,
+	ColdJunction: physic.ZeroCelsius + 25062500*physic.MicroKelvin,
+}
+
+func newPort(frames ...[]byte) *spitest.Playback {
+	var ops []conntest.IO
+	for _, f := range frames {
+		ops = append(ops, conntest.IO{R: f})
+	}
+	return &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+}
+
+func TestNew(t *testing.T) {
+	port := newPort()
+	d, err := New(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "MAX31855{playback}" {
+		t.Fatal(s)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead(t *testing.T) {
+	port := newPort(testFrame)
+	d, err := New(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := d.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != testMeasurement {
+		t.Fatalf("%s, want %s", m, testMeasurement)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead_fault(t *testing.T) {
+	fault := []byte{0x06, 0x4D, 0x19, 0x11}
+	port := newPort(fault, fault)
+	d, err := New(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := d.Read()
+	var fe *FaultError
+	if !errors.As(err, &fe) || *fe != (FaultError{OpenCircuit: true}) {
+		t.Fatal(err)
+	}
+	if !errors.Is(err, ErrFault) || !errors.Is(err, ErrOpenCircuit) || errors.Is(err, ErrShortToGND) {
+		t.Fatal(err)
+	}
+	want := Measurement{ColdJunction: testMeasurement.ColdJunction}
+	if m != want {
+		t.Fatalf("%s, want %s", m, want)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); !errors.Is(err, ErrOpenCircuit) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead_fail(t *testing.T) {
+	port := newPort()
+	port.DontPanic = true
+	d, err := New(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Read(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSense(t *testing.T) {
+	port := newPort(testFrame)
+	d, err := New(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if want := (physic.Env{Temperature: testMeasurement.Thermocouple}); e != want {
+		t.Fatalf("%#v, want %#v", e, want)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	port := newPort(testFrame, testFrame)
+	d, err := New(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(10 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(100 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := physic.Env{Temperature: testMeasurement.Thermocouple}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != want {
+			t.Fatalf("#%d: %#v, want %#v", i, e, want)
+		}
+	}
+	if _, err := d.Read(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 250*physic.MilliKelvin {
+		t.Fatal(e)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	data := []struct {
+		v uint32
+		m Measurement
+	}{
+		{0x064C1910, testMeasurement},
+		// Examples of the datasheet.
+		{0x64007F10, Measurement{
+			Thermocouple: physic.ZeroCelsius + 1600*physic.Celsius,
+			ColdJunction: physic.ZeroCelsius + 127062500*physic.MicroKelvin,
+		}},
+		{0xF060C900, Measurement{
+			Thermocouple: physic.ZeroCelsius - 250*physic.Celsius,
+			ColdJunction: physic.ZeroCelsius - 55*physic.Celsius,
+		}},
+		{0x00000000, Measurement{Thermocouple: physic.ZeroCelsius, ColdJunction: physic.ZeroCelsius}},
+	}
+	for i, line := range data {
+		m, err := decode(line.v)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if m != line.m {
+			t.Fatalf("#%d: %s, want %s", i, m, line.m)
+		}
+	}
+}
+
+func TestDecode_bad_frame(t *testing.T) {
+	const want = "max31855: invalid frame: 0xffffffff"
+	if _, err := decode(0xFFFFFFFF); !errors.Is(err, ErrBadFrame) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package max31865 controls a Maxim MAX31865 resistance-to-digital converter
+// for platinum RTDs, e.g. PT100 and PT1000, over SPI.
+//
+// # More details
+//
+// The MAX31865 measures the ratio of the RTD resistance to a reference
+// resistor with a 15 bits ADC. The driver converts it to a resistance with
+// Opts.Reference, then to a temperature with the Callendar-Van Dusen equation
+// of IEC 60751 for Opts.RTD.
+//
+// The RTD is biased only while measuring by default, minimizing its self
+// heating at the cost of 10ms of settling per measurement. Opts.KeepBias
+// keeps it biased between one-shot measurements and Opts.AutoConvert makes
+// the device convert continuously, every 16.7ms with the 60Hz filter.
+//
+// The device flags faults on each conversion, i.e. a resistance outside the
+// thresholds set with SetFaultThresholds or an out of range input, returned
+// as a *FaultError matching ErrFault. DetectFaults runs the fault detection
+// cycle of the device to find an open or shorted RTD.
+//
+// # Datasheet
+//
+// https://www.analog.com/media/en/technical-documentation/data-sheets/MAX31865.pdf
+package max31865
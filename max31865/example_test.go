@@ -0,0 +1,50 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/max31865"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use spireg SPI port registry to find the first available SPI bus.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	// A PT1000 on a 3 wires breakout with a 4.3kΩ reference.
+	opts := max31865.Opts{
+		RTD:       1000 * physic.Ohm,
+		Reference: 4300 * physic.Ohm,
+		Wires:     max31865.ThreeWire,
+	}
+	d, err := max31865.New(p, opts)
+	if err != nil {
+		log.Fatalf("failed to initialize max31865: %v", err)
+	}
+	if f, err := d.DetectFaults(); err != nil {
+		log.Fatal(err)
+	} else if f != 0 {
+		log.Fatalf("RTD wiring fault: %s", f)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", e.Temperature)
+}
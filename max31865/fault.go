@@ -0,0 +1,132 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrFault is matched by *FaultError.
+	ErrFault = errors.New("max31865: RTD fault")
+	// ErrNotReady is returned when the fault detection cycle didn't complete
+	// in time.
+	ErrNotReady = errors.New("max31865: fault detection not complete")
+	// ErrInvalidOpts is returned for options or arguments that are out of
+	// range.
+	ErrInvalidOpts = errors.New("max31865: invalid options")
+)
+
+// Fault is the content of the fault status register.
+type Fault uint8
+
+// Fault bits.
+const (
+	FaultHighThreshold Fault = 1 << 7 // the resistance is above the high threshold
+	FaultLowThreshold  Fault = 1 << 6 // the resistance is below the low threshold
+	FaultRefInHigh     Fault = 1 << 5 // REFIN- > 0.85 x VBIAS
+	FaultRefInLow      Fault = 1 << 4 // REFIN- < 0.85 x VBIAS with FORCE- open
+	FaultRTDInLow      Fault = 1 << 3 // RTDIN- < 0.85 x VBIAS with FORCE- open
+	FaultVoltage       Fault = 1 << 2 // overvoltage or undervoltage on an input
+)
+
+var faultNames = []struct {
+	f    Fault
+	name string
+}{
+	{FaultHighThreshold, "HighThreshold"},
+	{FaultLowThreshold, "LowThreshold"},
+	{FaultRefInHigh, "RefInHigh"},
+	{FaultRefInLow, "RefInLow"},
+	{FaultRTDInLow, "RTDInLow"},
+	{FaultVoltage, "Voltage"},
+}
+
+func (f Fault) String() string {
+	var names []string
+	for _, n := range faultNames {
+		if f&n.f != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return "Fault{" + strings.Join(names, "|") + "}"
+}
+
+// FaultError is returned when the device flagged a conversion. The
+// measurement is not valid then.
+type FaultError struct {
+	Fault Fault
+}
+
+func (e *FaultError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrFault, e.Fault)
+}
+
+// Is implements errors.Is.
+func (e *FaultError) Is(target error) bool {
+	return target == ErrFault
+}
+
+// DetectFaults runs the automatic fault detection cycle and returns the
+// faults found, e.g. FaultRefInLow or FaultRTDInLow for an open RTD or wire
+// and FaultLowThreshold for a shorted RTD with the low threshold set. The
+// faults are cleared afterward.
+//
+// It can't run while sensing continuously.
+func (d *Dev) DetectFaults() (Fault, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return 0, errors.New("max31865: already sensing continuously")
+	}
+	cfg := d.opts.config() &^ cfgAutoConvert
+	if err := d.writeRegs(regConfig, cfg|cfgBias|cfgFaultAuto); err != nil {
+		return 0, err
+	}
+	doSleep(faultCycleTime)
+	var b [1]byte
+	if err := d.readRegs(regConfig, b[:]); err != nil {
+		return 0, err
+	}
+	if b[0]&cfgFaultMask != 0 {
+		return 0, ErrNotReady
+	}
+	return d.clearFaults()
+}
+
+// SetFaultThresholds sets the resistances below and above which a conversion
+// is flagged with FaultLowThreshold or FaultHighThreshold.
+//
+// The thresholds are 0Ω and the reference resistance at power up, i.e.
+// disabled.
+func (d *Dev) SetFaultThresholds(low, high physic.ElectricResistance) error {
+	l, h := code(low, d.opts.Reference), code(high, d.opts.Reference)
+	if l < 0 || h > 0x7FFF || l > h {
+		return fmt.Errorf("%w: thresholds %s to %s, want 0Ω <= low <= high < %s", ErrInvalidOpts, low, high, d.opts.Reference)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// The registers hold the code in their 15 MSBs.
+	return d.writeRegs(regHighThreshold, byte(h>>7), byte(h<<1), byte(l>>7), byte(l<<1))
+}
+
+// clearFaults reads and clears the fault status, restoring the configuration.
+//
+// It must be called with d.mu held.
+func (d *Dev) clearFaults() (Fault, error) {
+	var b [1]byte
+	if err := d.readRegs(regFaultStatus, b[:]); err != nil {
+		return 0, err
+	}
+	if err := d.writeRegs(regConfig, d.opts.config()|cfgFaultClear); err != nil {
+		return 0, err
+	}
+	return Fault(b[0]) &^ 3, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func TestDetectFaults(t *testing.T) {
+	port := newPort(
+		write(0x80, 0x84),
+		read(regConfig, 0x80),
+		read(regFaultStatus, 0x1B),
+		write(0x80, 0x02),
+	)
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := d.DetectFaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != FaultRefInLow|FaultRTDInLow {
+		t.Fatal(f)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDetectFaults_not_ready(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		write(0x80, 0x03),
+		write(0x80, 0x85),
+		read(regConfig, 0x85),
+	}}}
+	d, err := New(port, Opts{RTD: 100 * physic.Ohm, Reference: 430 * physic.Ohm, Wires: FourWire, Filter: Filter50Hz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.DetectFaults(); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetFaultThresholds(t *testing.T) {
+	port := newPort(write(0x83, 0x59, 0x4E, 0x2F, 0xA0))
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetFaultThresholds(80*physic.Ohm, 150*physic.Ohm); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetFaultThresholds(150*physic.Ohm, 80*physic.Ohm); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	const want = "max31865: invalid options: thresholds 0Ω to 430Ω, want 0Ω <= low <= high < 430Ω"
+	if err := d.SetFaultThresholds(0, 430*physic.Ohm); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFaultError(t *testing.T) {
+	err := error(&FaultError{Fault: FaultLowThreshold | FaultVoltage})
+	const want = "max31865: RTD fault: Fault{LowThreshold|Voltage}"
+	if err.Error() != want {
+		t.Fatalf("%q, want %q", err, want)
+	}
+	if !errors.Is(err, ErrFault) || errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+}
+
+func TestFault_String(t *testing.T) {
+	data := []struct {
+		f Fault
+		s string
+	}{
+		{0, "Fault{}"},
+		{FaultHighThreshold, "Fault{HighThreshold}"},
+		{FaultRefInHigh | FaultRefInLow | FaultRTDInLow, "Fault{RefInHigh|RefInLow|RTDInLow}"},
+	}
+	for _, line := range data {
+		if s := line.f.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
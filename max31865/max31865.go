@@ -0,0 +1,411 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// MaxSPIFrequency is the maximum SPI clock frequency supported.
+const MaxSPIFrequency = 5 * physic.MegaHertz
+
+// Registers, read at their address and written at their address | 0x80.
+const (
+	regConfig        = 0x00
+	regRTD           = 0x01 // MSB then LSB, whose bit 0 is the fault bit
+	regHighThreshold = 0x03 // MSB then LSB, followed by the low threshold
+	regFaultStatus   = 0x07
+	regWrite         = 0x80
+)
+
+// Configuration register bits.
+const (
+	cfgBias        = 0x80
+	cfgAutoConvert = 0x40
+	cfgOneShot     = 0x20
+	cfgThreeWire   = 0x10
+	cfgFaultAuto   = 0x04 // D3:D2 01: automatic fault detection
+	cfgFaultMask   = 0x0C
+	cfgFaultClear  = 0x02
+	cfgFilter50Hz  = 0x01
+)
+
+// Timings.
+const (
+	// biasTime is the settling time of the input filter after the bias is
+	// turned on, 10.5 time constants with the recommended 100nF and 4.3kΩ.
+	biasTime = 10 * time.Millisecond
+	// faultCycleTime is the duration of the automatic fault detection.
+	faultCycleTime = time.Millisecond
+)
+
+// Wires is the RTD wiring.
+type Wires uint8
+
+// Supported wirings.
+const (
+	TwoWire   Wires = 2
+	ThreeWire Wires = 3
+	FourWire  Wires = 4
+)
+
+func (w Wires) String() string {
+	switch w {
+	case TwoWire:
+		return "TwoWire"
+	case ThreeWire:
+		return "ThreeWire"
+	case FourWire:
+		return "FourWire"
+	default:
+		return fmt.Sprintf("Wires(%d)", w)
+	}
+}
+
+// Filter is the mains frequency rejected by the ADC filter.
+type Filter uint8
+
+// Supported filters.
+const (
+	Filter60Hz Filter = 0
+	Filter50Hz Filter = 1
+)
+
+func (f Filter) String() string {
+	switch f {
+	case Filter60Hz:
+		return "Filter60Hz"
+	case Filter50Hz:
+		return "Filter50Hz"
+	default:
+		return fmt.Sprintf("Filter(%d)", f)
+	}
+}
+
+// oneShotTime is the duration of a one-shot conversion.
+func (f Filter) oneShotTime() time.Duration {
+	if f == Filter50Hz {
+		return 62500 * time.Microsecond
+	}
+	return 52 * time.Millisecond
+}
+
+// autoTime is the period of the automatic conversions.
+func (f Filter) autoTime() time.Duration {
+	if f == Filter50Hz {
+		return 20 * time.Millisecond
+	}
+	return 16667 * time.Microsecond
+}
+
+// DefaultOpts are the options of a PT100 with a 430Ω reference resistor, as
+// on most breakout boards, wired with 4 wires.
+var DefaultOpts = Opts{
+	RTD:       100 * physic.Ohm,
+	Reference: 430 * physic.Ohm,
+	Wires:     FourWire,
+}
+
+// Opts holds the configuration options.
+//
+// RTD: resistance of the RTD at 0°C, 100Ω for a PT100 and 1kΩ for a PT1000.
+//
+// Reference: resistance of the reference resistor, typically 4 times RTD.
+//
+// KeepBias: keep the RTD biased between one-shot measurements, skipping 10ms
+// of settling per measurement.
+//
+// AutoConvert: convert continuously, the RTD staying biased. Sense returns
+// the latest conversion.
+type Opts struct {
+	RTD         physic.ElectricResistance
+	Reference   physic.ElectricResistance
+	Wires       Wires
+	Filter      Filter
+	KeepBias    bool
+	AutoConvert bool
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.RTD <= 0 {
+		return fmt.Errorf("%w: RTD %s, want more than 0", ErrInvalidOpts, o.RTD)
+	}
+	if o.Reference <= 0 {
+		return fmt.Errorf("%w: Reference %s, want more than 0", ErrInvalidOpts, o.Reference)
+	}
+	if o.Wires < TwoWire || o.Wires > FourWire {
+		return fmt.Errorf("%w: Wires %s, want TwoWire, ThreeWire or FourWire", ErrInvalidOpts, o.Wires)
+	}
+	if o.Filter > Filter50Hz {
+		return fmt.Errorf("%w: Filter %s, want Filter60Hz or Filter50Hz", ErrInvalidOpts, o.Filter)
+	}
+	return nil
+}
+
+// config returns the configuration register value while idle.
+func (o *Opts) config() byte {
+	var c byte
+	if o.Wires == ThreeWire {
+		c |= cfgThreeWire
+	}
+	if o.Filter == Filter50Hz {
+		c |= cfgFilter50Hz
+	}
+	if o.KeepBias || o.AutoConvert {
+		c |= cfgBias
+	}
+	if o.AutoConvert {
+		c |= cfgAutoConvert
+	}
+	return c
+}
+
+// measDuration returns the duration of a measurement.
+func (o *Opts) measDuration() time.Duration {
+	if o.AutoConvert {
+		return o.Filter.autoTime()
+	}
+	if o.KeepBias {
+		return o.Filter.oneShotTime()
+	}
+	return biasTime + o.Filter.oneShotTime()
+}
+
+// Dev is a handle to an initialized MAX31865 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New configures a device on a SPI port and clears its faults.
+//
+// The port is connected in mode 1 at MaxSPIFrequency (5 MHz).
+func New(p spi.Port, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode1, 8)
+	if err != nil {
+		return nil, fmt.Errorf("max31865: connecting SPI: %w", err)
+	}
+	d := &Dev{c: c, opts: opts}
+	if err := d.writeRegs(regConfig, opts.config()|cfgFaultClear); err != nil {
+		return nil, err
+	}
+	if opts.KeepBias || opts.AutoConvert {
+		doSleep(biasTime)
+	}
+	if opts.AutoConvert {
+		doSleep(opts.Filter.autoTime())
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MAX31865{%s}", d.c)
+}
+
+// Sense measures the temperature. It implements physic.SenseEnv.
+//
+// It returns a *FaultError if the device flagged the conversion.
+//
+// The humidity and the pressure are not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	r, err := d.SenseResistance()
+	if err != nil {
+		return err
+	}
+	e.Temperature = temperature(r, d.opts.RTD)
+	return nil
+}
+
+// SenseResistance measures the resistance of the RTD.
+//
+// It returns a *FaultError if the device flagged the conversion.
+func (d *Dev) SenseResistance() (physic.ElectricResistance, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return 0, errors.New("max31865: already sensing continuously")
+	}
+	return d.sense()
+}
+
+// SenseContinuous returns measurements of the temperature on a continuous
+// basis. It implements physic.SenseEnv.
+//
+// The interval must be at least the measurement duration, from 16.7ms with
+// AutoConvert to 72.5ms with the 50Hz filter and the bias turned on for each
+// measurement.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	d.mu.Lock()
+	m := d.opts.measDuration()
+	d.mu.Unlock()
+	if interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		d.mu.Lock()
+		r, err := d.sense()
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- physic.Env{Temperature: temperature(r, d.opts.RTD)}:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+//
+// It is the resolution of the ADC, about 0.03°C.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 31250 * physic.MicroKelvin
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(), the
+// automatic conversions and the bias. Sense triggers one-shot conversions
+// afterward.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	o := d.opts
+	o.AutoConvert = false
+	o.KeepBias = false
+	if err := d.writeRegs(regConfig, o.config()); err != nil {
+		return err
+	}
+	d.opts = o
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense measures the RTD resistance, triggering a one-shot conversion unless
+// converting automatically.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense() (physic.ElectricResistance, error) {
+	if !d.opts.AutoConvert {
+		cfg := d.opts.config()
+		if !d.opts.KeepBias {
+			if err := d.writeRegs(regConfig, cfg|cfgBias); err != nil {
+				return 0, err
+			}
+			doSleep(biasTime)
+		}
+		if err := d.writeRegs(regConfig, cfg|cfgBias|cfgOneShot); err != nil {
+			return 0, err
+		}
+		doSleep(d.opts.Filter.oneShotTime())
+	}
+	var b [2]byte
+	if err := d.readRegs(regRTD, b[:]); err != nil {
+		return 0, err
+	}
+	if b[1]&1 != 0 {
+		// Clearing the faults turns the bias off too.
+		f, err := d.clearFaults()
+		if err != nil {
+			return 0, err
+		}
+		return 0, &FaultError{Fault: f}
+	}
+	if !d.opts.AutoConvert && !d.opts.KeepBias {
+		if err := d.writeRegs(regConfig, d.opts.config()); err != nil {
+			return 0, err
+		}
+	}
+	return resistance(uint16(b[0])<<7|uint16(b[1])>>1, d.opts.Reference), nil
+}
+
+// resistance converts the 15 bits ADC code, the ratio to the reference.
+func resistance(code uint16, ref physic.ElectricResistance) physic.ElectricResistance {
+	return ref * physic.ElectricResistance(code) / 32768
+}
+
+// code converts a resistance to a 15 bits ADC code, rounded.
+func code(r, ref physic.ElectricResistance) int64 {
+	return (int64(r)*32768 + int64(ref)/2) / int64(ref)
+}
+
+func (d *Dev) readRegs(reg byte, r []byte) error {
+	w := make([]byte, len(r)+1)
+	b := make([]byte, len(r)+1)
+	w[0] = reg
+	if err := d.c.Tx(w, b); err != nil {
+		return fmt.Errorf("max31865: reading register %#02x: %w", reg, err)
+	}
+	copy(r, b[1:])
+	return nil
+}
+
+func (d *Dev) writeRegs(reg byte, v ...byte) error {
+	if err := d.c.Tx(append([]byte{reg | regWrite}, v...), nil); err != nil {
+		return fmt.Errorf("max31865: writing register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,306 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// testCode is the code of a PT100 at 100°C with a 430Ω reference.
+const testCode = 10555
+
+func write(v ...byte) conntest.IO {
+	return conntest.IO{W: v}
+}
+
+func read(reg byte, v ...byte) conntest.IO {
+	w := make([]byte, len(v)+1)
+	w[0] = reg
+	return conntest.IO{W: w, R: append([]byte{0}, v...)}
+}
+
+// senseOps are the transactions of a one-shot measurement with DefaultOpts.
+func senseOps() []conntest.IO {
+	return []conntest.IO{
+		write(0x80, 0x80),
+		write(0x80, 0xA0),
+		read(regRTD, byte(testCode>>7), byte(testCode<<1&0xFF)),
+		write(0x80, 0x00),
+	}
+}
+
+func newPort(ops ...conntest.IO) *spitest.Playback {
+	return &spitest.Playback{Playback: conntest.Playback{Ops: append([]conntest.IO{write(0x80, 0x02)}, ops...)}}
+}
+
+func TestNew(t *testing.T) {
+	port := newPort()
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "MAX31865{playback}" {
+		t.Fatal(s)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{DontPanic: true}}
+	if _, err := New(port, DefaultOpts); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Reference: 430 * physic.Ohm, Wires: TwoWire}, "max31865: invalid options: RTD 0Ω, want more than 0"},
+		{Opts{RTD: 100 * physic.Ohm, Wires: TwoWire}, "max31865: invalid options: Reference 0Ω, want more than 0"},
+		{Opts{RTD: 100 * physic.Ohm, Reference: 430 * physic.Ohm}, "max31865: invalid options: Wires Wires(0), want TwoWire, ThreeWire or FourWire"},
+		{Opts{RTD: 100 * physic.Ohm, Reference: 430 * physic.Ohm, Wires: TwoWire, Filter: 2}, "max31865: invalid options: Filter Filter(2), want Filter60Hz or Filter50Hz"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+		if _, err := New(&spitest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestSense(t *testing.T) {
+	port := newPort(senseOps()...)
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	// 138.5086Ω, the code being rounded.
+	if want := physic.ZeroCelsius + 100008*physic.MilliKelvin; e.Temperature < want-physic.MilliKelvin || e.Temperature > want+physic.MilliKelvin {
+		t.Fatalf("%s, want %s", e.Temperature, want)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseResistance(t *testing.T) {
+	port := newPort(senseOps()...)
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := d.SenseResistance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 138508605 * physic.MicroOhm; r/physic.MicroOhm != want/physic.MicroOhm {
+		t.Fatalf("%s, want %s", r, want)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_modes(t *testing.T) {
+	data := []struct {
+		opts Opts
+		init byte
+		ops  []conntest.IO
+		halt byte
+	}{
+		{
+			Opts{RTD: 100 * physic.Ohm, Reference: 430 * physic.Ohm, Wires: ThreeWire, Filter: Filter50Hz, AutoConvert: true},
+			0xD3,
+			[]conntest.IO{read(regRTD, byte(testCode>>7), byte(testCode<<1&0xFF))},
+			0x11,
+		},
+		{
+			Opts{RTD: 100 * physic.Ohm, Reference: 430 * physic.Ohm, Wires: TwoWire, KeepBias: true},
+			0x82,
+			[]conntest.IO{write(0x80, 0xA0), read(regRTD, byte(testCode>>7), byte(testCode<<1&0xFF))},
+			0x00,
+		},
+	}
+	for i, line := range data {
+		ops := append([]conntest.IO{write(0x80, line.init)}, line.ops...)
+		ops = append(ops, write(0x80, line.halt))
+		port := &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+		d, err := New(port, line.opts)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		r, err := d.SenseResistance()
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if want := resistance(testCode, line.opts.Reference); r != want {
+			t.Fatalf("#%d: %s, want %s", i, r, want)
+		}
+		if err := d.Halt(); err != nil {
+			t.Fatal(i, err)
+		}
+		if d.opts.AutoConvert || d.opts.KeepBias {
+			t.Fatal(i, d.opts)
+		}
+		if err := port.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestSense_fault(t *testing.T) {
+	port := newPort(
+		write(0x80, 0x80),
+		write(0x80, 0xA0),
+		read(regRTD, byte(testCode>>7), byte(testCode<<1&0xFF)|1),
+		read(regFaultStatus, 0x80),
+		write(0x80, 0x02),
+	)
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	err = d.Sense(&e)
+	var fe *FaultError
+	if !errors.As(err, &fe) || fe.Fault != FaultHighThreshold || !errors.Is(err, ErrFault) {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		port := newPort(senseOps()[:i]...)
+		port.DontPanic = true
+		d, err := New(port, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	port := newPort(append(append(senseOps(), senseOps()...), write(0x80, 0x00))...)
+	d, err := New(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(50 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(70 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e.Temperature/physic.Kelvin != (physic.ZeroCelsius+100*physic.Celsius)/physic.Kelvin {
+			t.Fatalf("#%d: %s", i, e.Temperature)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.DetectFaults(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 31250*physic.MicroKelvin {
+		t.Fatal(e)
+	}
+}
+
+func TestOpts_measDuration(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want time.Duration
+	}{
+		{Opts{}, 62 * time.Millisecond},
+		{Opts{Filter: Filter50Hz}, 72500 * time.Microsecond},
+		{Opts{KeepBias: true}, 52 * time.Millisecond},
+		{Opts{AutoConvert: true}, 16667 * time.Microsecond},
+		{Opts{AutoConvert: true, Filter: Filter50Hz}, 20 * time.Millisecond},
+	}
+	for i, line := range data {
+		if d := line.opts.measDuration(); d != line.want {
+			t.Fatalf("#%d: %s, want %s", i, d, line.want)
+		}
+	}
+}
+
+func TestWires_String(t *testing.T) {
+	data := []struct {
+		w Wires
+		s string
+	}{
+		{TwoWire, "TwoWire"},
+		{ThreeWire, "ThreeWire"},
+		{FourWire, "FourWire"},
+		{5, "Wires(5)"},
+	}
+	for _, line := range data {
+		if s := line.w.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
+
+func TestFilter_String(t *testing.T) {
+	data := []struct {
+		f Filter
+		s string
+	}{
+		{Filter60Hz, "Filter60Hz"},
+		{Filter50Hz, "Filter50Hz"},
+		{2, "Filter(2)"},
+	}
+	for _, line := range data {
+		if s := line.f.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865
+
+import (
+	"math"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Callendar-Van Dusen coefficients of IEC 60751 for platinum RTDs.
+const (
+	cvdA = 3.9083e-3
+	cvdB = -5.775e-7
+	cvdC = -4.183e-12
+)
+
+// temperature returns the temperature of a RTD of resistance r0 at 0°C
+// measuring r.
+//
+// Above 0°C the Callendar-Van Dusen equation R = R0(1 + A*T + B*T²) is solved
+// directly. Below, the C*(T-100)*T³ term is added and the equation is solved
+// by Newton's method from the quadratic solution.
+func temperature(r, r0 physic.ElectricResistance) physic.Temperature {
+	ratio := float64(r) / float64(r0)
+	t := (-cvdA + math.Sqrt(cvdA*cvdA-4*cvdB*(1-ratio))) / (2 * cvdB)
+	if ratio < 1 {
+		for i := 0; i < 5; i++ {
+			f := 1 + cvdA*t + cvdB*t*t + cvdC*(t-100)*t*t*t - ratio
+			df := cvdA + 2*cvdB*t + cvdC*(4*t-300)*t*t
+			t -= f / df
+		}
+	}
+	return physic.ZeroCelsius + physic.Temperature(math.Round(t*float64(physic.Celsius)))
+}
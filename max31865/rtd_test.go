@@ -0,0 +1,44 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31865
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestTemperature(t *testing.T) {
+	// Values of the IEC 60751 table for a PT100.
+	data := []struct {
+		r physic.ElectricResistance
+		c int64 // m°C
+	}{
+		{18520100 * physic.MicroOhm, -200000},
+		{60255800 * physic.MicroOhm, -100000},
+		{100 * physic.Ohm, 0},
+		{138505500 * physic.MicroOhm, 100000},
+		{175856000 * physic.MicroOhm, 200000},
+		{280977700 * physic.MicroOhm, 500000},
+	}
+	for i, line := range data {
+		want := physic.ZeroCelsius + physic.Temperature(line.c)*physic.MilliKelvin
+		for _, scale := range []physic.ElectricResistance{1, 10} {
+			got := temperature(line.r*scale, 100*scale*physic.Ohm)
+			if got < want-physic.MilliKelvin || got > want+physic.MilliKelvin {
+				t.Fatalf("#%d x%d: %s, want %s", i, scale, got, want)
+			}
+		}
+	}
+}
+
+func TestResistance(t *testing.T) {
+	if r := resistance(0x7FFF, 400*physic.Ohm); r != 399987792968 {
+		t.Fatal(int64(r))
+	}
+	if c := code(100*physic.Ohm, 400*physic.Ohm); c != 8192 {
+		t.Fatal(c)
+	}
+}
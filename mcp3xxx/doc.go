@@ -0,0 +1,19 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mcp3xxx controls the Microchip MCP3004/MCP3008 (10 bits) and
+// MCP3204/MCP3208 (12 bits) Analog-Digital Converters (ADC) over SPI.
+//
+// # More details
+//
+// The 4 or 8 inputs are read single-ended against VSS, or as
+// pseudo-differential pairs. The readings are ratiometric to the voltage on
+// VREF, set with Opts.Reference.
+//
+// # Datasheet
+//
+// MCP3004/3008: https://ww1.microchip.com/downloads/en/DeviceDoc/21295d.pdf
+//
+// MCP3204/3208: https://ww1.microchip.com/downloads/en/DeviceDoc/21298e.pdf
+package mcp3xxx
@@ -0,0 +1,41 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp3xxx_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/mcp3xxx"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use spireg SPI port registry to find the first available SPI bus.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	d, err := mcp3xxx.NewMCP3008(p, mcp3xxx.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize mcp3008: %v", err)
+	}
+
+	for _, c := range []mcp3xxx.Channel{mcp3xxx.Channel0, mcp3xxx.Channel2Minus3} {
+		s, err := d.Read(c)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s: %s\n", c, s.V)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp3xxx
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+	"periph.io/x/conn/v3/spi"
+)
+
+// MaxSPIFrequency is the SPI clock frequency used, the maximum of all the
+// devices at 2.7V. At 5V, the MCP3004/3008 support 3.6MHz and the MCP3204/3208
+// 2MHz.
+const MaxSPIFrequency = physic.MegaHertz
+
+// Channel is the analog reading to do. It can be either a single-ended
+// reading against VSS or a pseudo-differential reading between two inputs,
+// which reads 0 when the negative input is above the positive one.
+type Channel uint8
+
+// Channels, encoded as the SGL/DIFF bit followed by D2, D1 and D0.
+const (
+	// Single-ended reading.
+	Channel0 Channel = 8
+	Channel1 Channel = 9
+	Channel2 Channel = 10
+	Channel3 Channel = 11
+	Channel4 Channel = 12 // MCP3008 and MCP3208 only
+	Channel5 Channel = 13 // MCP3008 and MCP3208 only
+	Channel6 Channel = 14 // MCP3008 and MCP3208 only
+	Channel7 Channel = 15 // MCP3008 and MCP3208 only
+
+	// Pseudo-differential reading.
+	Channel0Minus1 Channel = 0
+	Channel1Minus0 Channel = 1
+	Channel2Minus3 Channel = 2
+	Channel3Minus2 Channel = 3
+	Channel4Minus5 Channel = 4 // MCP3008 and MCP3208 only
+	Channel5Minus4 Channel = 5 // MCP3008 and MCP3208 only
+	Channel6Minus7 Channel = 6 // MCP3008 and MCP3208 only
+	Channel7Minus6 Channel = 7 // MCP3008 and MCP3208 only
+)
+
+func (c Channel) String() string {
+	switch {
+	case c >= Channel0 && c <= Channel7:
+		return fmt.Sprintf("%d", c-Channel0)
+	case c <= Channel7Minus6:
+		pos := int(c)
+		return fmt.Sprintf("%d-%d", pos, pos^1)
+	default:
+		return "Invalid"
+	}
+}
+
+// ErrInvalidOpts is returned when the options are not supported.
+var ErrInvalidOpts = errors.New("mcp3xxx: invalid options")
+
+// DefaultOpts are the options of a device powered at 3.3V, with VREF tied to
+// VDD.
+var DefaultOpts = Opts{
+	Reference: 3300 * physic.MilliVolt,
+}
+
+// Opts holds the configuration options.
+//
+// Reference: voltage on VREF, the full-scale input range.
+type Opts struct {
+	Reference physic.ElectricPotential
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Reference <= 0 {
+		return fmt.Errorf("%w: Reference %s, want more than 0", ErrInvalidOpts, o.Reference)
+	}
+	return nil
+}
+
+// Dev is a handle to an MCP3004, MCP3008, MCP3204 or MCP3208 ADC.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c        conn.Conn
+	name     string
+	bits     uint
+	channels int
+	opts     Opts
+
+	mu sync.Mutex
+}
+
+// NewMCP3004 creates a new driver for the MCP3004 (10 bits, 4 inputs).
+func NewMCP3004(p spi.Port, opts Opts) (*Dev, error) {
+	return newDev(p, "MCP3004", 10, 4, opts)
+}
+
+// NewMCP3008 creates a new driver for the MCP3008 (10 bits, 8 inputs).
+func NewMCP3008(p spi.Port, opts Opts) (*Dev, error) {
+	return newDev(p, "MCP3008", 10, 8, opts)
+}
+
+// NewMCP3204 creates a new driver for the MCP3204 (12 bits, 4 inputs).
+func NewMCP3204(p spi.Port, opts Opts) (*Dev, error) {
+	return newDev(p, "MCP3204", 12, 4, opts)
+}
+
+// NewMCP3208 creates a new driver for the MCP3208 (12 bits, 8 inputs).
+func NewMCP3208(p spi.Port, opts Opts) (*Dev, error) {
+	return newDev(p, "MCP3208", 12, 8, opts)
+}
+
+// newDev connects the port in mode 0 at MaxSPIFrequency.
+func newDev(p spi.Port, name string, bits uint, channels int, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("mcp3xxx: connecting SPI: %w", err)
+	}
+	return &Dev{c: c, name: name, bits: bits, channels: channels, opts: opts}, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return d.name
+}
+
+// Halt implements conn.Resource.
+//
+// The device powers down between conversions, so there is nothing to do.
+func (d *Dev) Halt() error {
+	return nil
+}
+
+// Read converts the voltage of a channel.
+func (d *Dev) Read(c Channel) (analog.Sample, error) {
+	if err := d.validate(c); err != nil {
+		return analog.Sample{}, err
+	}
+	// The start bit is followed by the channel and a null bit before the
+	// result, MSB first; the 10 bits devices read the result one bit later.
+	var w [3]byte
+	if d.bits == 12 {
+		w[0] = 0x04 | byte(c)>>2
+		w[1] = byte(c) << 6
+	} else {
+		w[0] = 0x01
+		w[1] = byte(c) << 4
+	}
+	var r [3]byte
+	d.mu.Lock()
+	err := d.c.Tx(w[:], r[:])
+	d.mu.Unlock()
+	if err != nil {
+		return analog.Sample{}, fmt.Errorf("mcp3xxx: reading channel %s: %w", c, err)
+	}
+	raw := int32(r[1])<<8 | int32(r[2])
+	raw &= 1<<d.bits - 1
+	return analog.Sample{Raw: raw, V: d.Voltage(raw)}, nil
+}
+
+// Voltage converts a raw reading to the input voltage, relative to
+// Opts.Reference.
+func (d *Dev) Voltage(raw int32) physic.ElectricPotential {
+	return physic.ElectricPotential(raw) * d.opts.Reference / physic.ElectricPotential(int64(1)<<d.bits)
+}
+
+// PinForChannel returns an analog pin reading the channel.
+func (d *Dev) PinForChannel(c Channel) (analog.PinADC, error) {
+	if err := d.validate(c); err != nil {
+		return nil, err
+	}
+	return &analogPin{adc: d, c: c}, nil
+}
+
+// validate returns an error if the device doesn't have the channel.
+func (d *Dev) validate(c Channel) error {
+	if c > Channel7 || int(c&7) >= d.channels {
+		return fmt.Errorf("mcp3xxx: %s has no channel %s", d.name, c)
+	}
+	return nil
+}
+
+type analogPin struct {
+	adc *Dev
+	c   Channel
+}
+
+// Range returns the maximum supported range [min, max] of the values.
+func (p *analogPin) Range() (analog.Sample, analog.Sample) {
+	max := int32(1)<<p.adc.bits - 1
+	return analog.Sample{}, analog.Sample{Raw: max, V: p.adc.Voltage(max)}
+}
+
+// Read returns the current pin level.
+func (p *analogPin) Read() (analog.Sample, error) {
+	return p.adc.Read(p.c)
+}
+
+func (p *analogPin) Name() string {
+	return p.adc.name + "(" + p.c.String() + ")"
+}
+
+// Number returns the single-ended channels first, then the differential
+// ones.
+func (p *analogPin) Number() int {
+	if p.c >= Channel0 {
+		return int(p.c - Channel0)
+	}
+	return int(p.c) + 8
+}
+
+func (p *analogPin) Function() string {
+	return string(p.Func())
+}
+
+// Func implements pin.PinFunc.
+func (p *analogPin) Func() pin.Func {
+	return analog.ADC
+}
+
+// SupportedFuncs implements pin.PinFunc.
+func (p *analogPin) SupportedFuncs() []pin.Func {
+	return []pin.Func{analog.ADC}
+}
+
+// SetFunc implements pin.PinFunc.
+func (p *analogPin) SetFunc(f pin.Func) error {
+	if f == analog.ADC {
+		return nil
+	}
+	return errors.New("pin function cannot be changed")
+}
+
+func (p *analogPin) Halt() error {
+	return nil
+}
+
+func (p *analogPin) String() string {
+	return p.Name()
+}
+
+var _ conn.Resource = &Dev{}
+var _ analog.PinADC = &analogPin{}
+var _ pin.PinFunc = &analogPin{}
@@ -0,0 +1,193 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp3xxx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func newPort(ops ...conntest.IO) *spitest.Playback {
+	return &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+}
+
+func TestChannel_String(t *testing.T) {
+	data := []struct {
+		c    Channel
+		want string
+	}{
+		{Channel0, "0"},
+		{Channel7, "7"},
+		{Channel0Minus1, "0-1"},
+		{Channel1Minus0, "1-0"},
+		{Channel6Minus7, "6-7"},
+		{Channel7Minus6, "7-6"},
+		{Channel(16), "Invalid"},
+	}
+	for _, line := range data {
+		if s := line.c.String(); s != line.want {
+			t.Fatalf("%s != %s", s, line.want)
+		}
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	o := Opts{Reference: -physic.Volt}
+	err := o.Validate()
+	if err == nil || err.Error() != "mcp3xxx: invalid options: Reference -1V, want more than 0" {
+		t.Fatal(err)
+	}
+	if !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if _, err := NewMCP3008(newPort(), o); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := DefaultOpts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead(t *testing.T) {
+	data := []struct {
+		name string
+		new  func(p *spitest.Playback) (*Dev, error)
+		c    Channel
+		io   conntest.IO
+		raw  int32
+		v    physic.ElectricPotential
+	}{
+		{
+			"MCP3004", func(p *spitest.Playback) (*Dev, error) { return NewMCP3004(p, DefaultOpts) },
+			Channel3, conntest.IO{W: []byte{0x01, 0xB0, 0x00}, R: []byte{0xFF, 0xFE, 0x00}},
+			512, 1650 * physic.MilliVolt,
+		},
+		{
+			"MCP3008", func(p *spitest.Playback) (*Dev, error) { return NewMCP3008(p, DefaultOpts) },
+			Channel5Minus4, conntest.IO{W: []byte{0x01, 0x50, 0x00}, R: []byte{0xFF, 0xFB, 0xFF}},
+			1023, 3296777343 * physic.NanoVolt,
+		},
+		{
+			"MCP3204", func(p *spitest.Playback) (*Dev, error) { return NewMCP3204(p, DefaultOpts) },
+			Channel2Minus3, conntest.IO{W: []byte{0x04, 0x80, 0x00}, R: []byte{0xFF, 0xE0, 0x01}},
+			1, 805664 * physic.NanoVolt,
+		},
+		{
+			"MCP3208", func(p *spitest.Playback) (*Dev, error) {
+				return NewMCP3208(p, Opts{Reference: 4096 * physic.MilliVolt})
+			},
+			Channel7, conntest.IO{W: []byte{0x07, 0xC0, 0x00}, R: []byte{0xFF, 0xE8, 0x00}},
+			2048, 2048 * physic.MilliVolt,
+		},
+	}
+	for _, line := range data {
+		port := newPort(line.io)
+		d, err := line.new(port)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := d.String(); s != line.name {
+			t.Fatal(s)
+		}
+		s, err := d.Read(line.c)
+		if err != nil {
+			t.Fatalf("%s: %v", line.name, err)
+		}
+		if s.Raw != line.raw || s.V != line.v {
+			t.Fatalf("%s: %d %s, want %d %s", line.name, s.Raw, s.V, line.raw, line.v)
+		}
+		if err := d.Halt(); err != nil {
+			t.Fatal(err)
+		}
+		if err := port.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRead_fail(t *testing.T) {
+	d, err := NewMCP3004(newPort(), DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []Channel{Channel4, Channel4Minus5, Channel(16)} {
+		if _, err := d.Read(c); err == nil {
+			t.Fatalf("%s: expected failure", c)
+		}
+		if _, err := d.PinForChannel(c); err == nil {
+			t.Fatalf("%s: expected failure", c)
+		}
+	}
+
+	port := &spitest.Playback{Playback: conntest.Playback{DontPanic: true}}
+	if d, err = NewMCP3008(port, DefaultOpts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Read(Channel0); err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestPinADC(t *testing.T) {
+	port := newPort(conntest.IO{W: []byte{0x06, 0x40, 0x00}, R: []byte{0xFF, 0xE4, 0xD2}})
+	d, err := NewMCP3208(port, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.PinForChannel(Channel1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := p.String(); s != "MCP3208(1)" {
+		t.Fatal(s)
+	}
+	if n := p.Number(); n != 1 {
+		t.Fatal(n)
+	}
+	if f := p.Function(); f != "ADC" {
+		t.Fatal(f)
+	}
+	if v := p.(pin.PinFunc).SupportedFuncs(); !reflect.DeepEqual(v, []pin.Func{analog.ADC}) {
+		t.Fatal(v)
+	}
+	if err := p.(pin.PinFunc).SetFunc(analog.ADC); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.(pin.PinFunc).SetFunc(pin.FuncNone); err == nil {
+		t.Fatal("expected failure")
+	}
+	min, max := p.Range()
+	if min.Raw != 0 || max.Raw != 4095 || max.V != 3299194335*physic.NanoVolt {
+		t.Fatal(min, max)
+	}
+	s, err := p.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Raw != 0x4D2 {
+		t.Fatal(s.Raw)
+	}
+	if err := p.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err = d.PinForChannel(Channel2Minus3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := p.Number(); n != 10 {
+		t.Fatal(n)
+	}
+}
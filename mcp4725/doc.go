@@ -0,0 +1,20 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mcp4725 controls a Microchip MCP4725 12 bits Digital-Analog
+// Converter (DAC) over I²C.
+//
+// # More details
+//
+// Dev implements analog.PinDAC: Out sets the output code with a fast write,
+// and OutVoltage the voltage, relative to the supply voltage in
+// Opts.Supply. The output can be disconnected and pulled down with
+// SetPowerDown.
+//
+// WriteEEPROM saves the output the device powers up with.
+//
+// # Datasheet
+//
+// https://ww1.microchip.com/downloads/en/DeviceDoc/22039d.pdf
+package mcp4725
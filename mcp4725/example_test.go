@@ -0,0 +1,45 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp4725_test
+
+import (
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/mcp4725"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := mcp4725.New(bus, mcp4725.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize mcp4725: %v", err)
+	}
+
+	// Ramp the output up to 3V.
+	for v := physic.ElectricPotential(0); v <= 3*physic.Volt; v += 100 * physic.MilliVolt {
+		if err := d.OutVoltage(v); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Power up at 1.65V.
+	if err := d.WriteEEPROM(2048, mcp4725.PowerOn); err != nil {
+		log.Fatal(err)
+	}
+}
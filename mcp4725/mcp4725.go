@@ -0,0 +1,325 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp4725
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+)
+
+// DefaultAddr is the I²C address of the MCP4725A0 with A0 low, as on most
+// breakout boards. The address is 0x61 with A0 high; the MCP4725A1 to A3
+// variants use 0x62 to 0x67.
+const DefaultAddr uint16 = 0x60
+
+// Commands, in the first byte of a write.
+const (
+	cmdFast              = 0x00 // | power down << 4, then the code
+	cmdWriteDACAndEEPROM = 0x60 // | power down << 1, then the code
+)
+
+// statusReady is set in the first byte of a read once an EEPROM write
+// completed.
+const statusReady = 0x80
+
+// Timings.
+const (
+	// eepromWriteTime is the longest duration of an EEPROM write.
+	eepromWriteTime = 50 * time.Millisecond
+	// eepromPoll is the EEPROM polling period.
+	eepromPoll = 5 * time.Millisecond
+)
+
+// maxCode is the highest 12 bits code.
+const maxCode = 1<<12 - 1
+
+var (
+	// ErrNotReady is returned when an EEPROM write doesn't complete in time.
+	ErrNotReady = errors.New("mcp4725: EEPROM write not complete")
+	// ErrInvalidOpts is returned when the options are not supported.
+	ErrInvalidOpts = errors.New("mcp4725: invalid options")
+)
+
+// PowerDown is the power-down mode of the output.
+type PowerDown uint8
+
+// Power-down modes. The output is disconnected and pulled down to ground
+// through a resistor, and the device draws 60nA.
+const (
+	PowerOn       PowerDown = 0 // the output is driven
+	PowerDown1k   PowerDown = 1
+	PowerDown100k PowerDown = 2
+	PowerDown500k PowerDown = 3
+)
+
+func (p PowerDown) String() string {
+	switch p {
+	case PowerOn:
+		return "PowerOn"
+	case PowerDown1k:
+		return "PowerDown1k"
+	case PowerDown100k:
+		return "PowerDown100k"
+	case PowerDown500k:
+		return "PowerDown500k"
+	default:
+		return fmt.Sprintf("PowerDown(%d)", p)
+	}
+}
+
+// DefaultOpts are the options of a device at DefaultAddr powered at 3.3V.
+var DefaultOpts = Opts{
+	Addr:   DefaultAddr,
+	Supply: 3300 * physic.MilliVolt,
+}
+
+// Opts holds the configuration options.
+//
+// Addr: I²C address, DefaultAddr by default.
+//
+// Supply: voltage on VDD, the reference of the DAC and its full-scale output
+// voltage.
+type Opts struct {
+	Addr   uint16
+	Supply physic.ElectricPotential
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Addr > 0x7F {
+		return fmt.Errorf("%w: Addr %#x, want at most 0x7f", ErrInvalidOpts, o.Addr)
+	}
+	if o.Supply <= 0 {
+		return fmt.Errorf("%w: Supply %s, want more than 0", ErrInvalidOpts, o.Supply)
+	}
+	return nil
+}
+
+// State is the content of the DAC register and of the EEPROM.
+type State struct {
+	// Code and PowerDown are the current output.
+	Code      int32
+	PowerDown PowerDown
+	// EEPROMCode and EEPROMPowerDown are the output at power-up.
+	EEPROMCode      int32
+	EEPROMPowerDown PowerDown
+	// Busy reports an EEPROM write in progress.
+	Busy bool
+}
+
+func (s State) String() string {
+	return fmt.Sprintf("State{Code:%d PowerDown:%s EEPROMCode:%d EEPROMPowerDown:%s Busy:%t}",
+		s.Code, s.PowerDown, s.EEPROMCode, s.EEPROMPowerDown, s.Busy)
+}
+
+// Dev is a handle to an initialized MCP4725 device. It is an analog.PinDAC
+// for its output.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c      conn.Conn
+	supply physic.ElectricPotential
+
+	mu   sync.Mutex
+	code int32 // the current code
+}
+
+// New returns a handle to a device on an I²C bus, reading its current output.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if opts.Addr == 0 {
+		opts.Addr = DefaultAddr
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: opts.Addr}, supply: opts.Supply}
+	s, err := d.state()
+	if err != nil {
+		return nil, err
+	}
+	d.code = s.Code
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MCP4725{%s}", d.c)
+}
+
+// Name implements pin.Pin.
+func (d *Dev) Name() string {
+	return "MCP4725"
+}
+
+// Number implements pin.Pin.
+func (d *Dev) Number() int {
+	return 0
+}
+
+// Function implements pin.Pin.
+func (d *Dev) Function() string {
+	return string(d.Func())
+}
+
+// Func implements pin.PinFunc.
+func (d *Dev) Func() pin.Func {
+	return analog.DAC
+}
+
+// SupportedFuncs implements pin.PinFunc.
+func (d *Dev) SupportedFuncs() []pin.Func {
+	return []pin.Func{analog.DAC}
+}
+
+// SetFunc implements pin.PinFunc.
+func (d *Dev) SetFunc(f pin.Func) error {
+	if f == analog.DAC {
+		return nil
+	}
+	return errors.New("mcp4725: pin function cannot be changed")
+}
+
+// Range implements analog.PinDAC.
+func (d *Dev) Range() (analog.Sample, analog.Sample) {
+	return analog.Sample{}, analog.Sample{Raw: maxCode, V: d.Voltage(maxCode)}
+}
+
+// Out sets the output to a code, 0 to 4095, with a fast write. It implements
+// analog.PinDAC.
+//
+// It powers the output on.
+func (d *Dev) Out(code int32) error {
+	if code < 0 || code > maxCode {
+		return fmt.Errorf("mcp4725: code %d, want 0 to %d", code, maxCode)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.fastWrite(code, PowerOn)
+}
+
+// OutVoltage sets the output to the nearest voltage, 0 to Opts.Supply.
+func (d *Dev) OutVoltage(v physic.ElectricPotential) error {
+	code, err := d.Code(v)
+	if err != nil {
+		return err
+	}
+	return d.Out(code)
+}
+
+// Voltage converts a code to the output voltage.
+func (d *Dev) Voltage(code int32) physic.ElectricPotential {
+	return physic.ElectricPotential(code) * d.supply / (maxCode + 1)
+}
+
+// Code converts an output voltage, 0 to Opts.Supply, to the nearest code.
+func (d *Dev) Code(v physic.ElectricPotential) (int32, error) {
+	if v < 0 || v > d.supply {
+		return 0, fmt.Errorf("mcp4725: voltage %s, want 0 to %s", v, d.supply)
+	}
+	code := int32(math.Round(float64(v) * (maxCode + 1) / float64(d.supply)))
+	if code > maxCode {
+		code = maxCode
+	}
+	return code, nil
+}
+
+// SetPowerDown disconnects the output as p, or powers it on with PowerOn,
+// keeping the code.
+func (d *Dev) SetPowerDown(p PowerDown) error {
+	if p > PowerDown500k {
+		return fmt.Errorf("%w: %s", ErrInvalidOpts, p)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.fastWrite(d.code, p)
+}
+
+// WriteEEPROM sets the output to a code and power-down mode, and saves them
+// in the EEPROM as the output at power-up.
+//
+// It waits for the EEPROM write, up to 50ms. The EEPROM endures about a
+// million writes.
+func (d *Dev) WriteEEPROM(code int32, p PowerDown) error {
+	if code < 0 || code > maxCode {
+		return fmt.Errorf("mcp4725: code %d, want 0 to %d", code, maxCode)
+	}
+	if p > PowerDown500k {
+		return fmt.Errorf("%w: %s", ErrInvalidOpts, p)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	w := []byte{cmdWriteDACAndEEPROM | byte(p)<<1, byte(code >> 4), byte(code << 4)}
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("mcp4725: writing EEPROM: %w", err)
+	}
+	d.code = code
+	for i := time.Duration(0); i*eepromPoll < 2*eepromWriteTime; i++ {
+		doSleep(eepromPoll)
+		var s [1]byte
+		if err := d.c.Tx(nil, s[:]); err != nil {
+			return fmt.Errorf("mcp4725: reading status: %w", err)
+		}
+		if s[0]&statusReady != 0 {
+			return nil
+		}
+	}
+	return ErrNotReady
+}
+
+// State reads the DAC register and the EEPROM.
+func (d *Dev) State() (State, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state()
+}
+
+// Halt powers the output down with the 500kΩ pull-down. It implements
+// conn.Resource.
+//
+// Out powers it on again.
+func (d *Dev) Halt() error {
+	return d.SetPowerDown(PowerDown500k)
+}
+
+// state must be called with d.mu held.
+func (d *Dev) state() (State, error) {
+	var b [5]byte
+	if err := d.c.Tx(nil, b[:]); err != nil {
+		return State{}, fmt.Errorf("mcp4725: reading state: %w", err)
+	}
+	return State{
+		Code:            int32(b[1])<<4 | int32(b[2])>>4,
+		PowerDown:       PowerDown(b[0] >> 1 & 3),
+		EEPROMCode:      int32(b[3]&0x0F)<<8 | int32(b[4]),
+		EEPROMPowerDown: PowerDown(b[3] >> 5 & 3),
+		Busy:            b[0]&statusReady == 0,
+	}, nil
+}
+
+// fastWrite sets the DAC register with the 2 bytes fast mode write.
+//
+// It must be called with d.mu held.
+func (d *Dev) fastWrite(code int32, p PowerDown) error {
+	if err := d.c.Tx([]byte{cmdFast | byte(p)<<4 | byte(code>>8), byte(code)}, nil); err != nil {
+		return fmt.Errorf("mcp4725: writing DAC: %w", err)
+	}
+	d.code = code
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ analog.PinDAC = &Dev{}
+var _ pin.PinFunc = &Dev{}
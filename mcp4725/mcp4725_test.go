@@ -0,0 +1,275 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp4725
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// stateIO is the read of the state of a device powered on, outputting 0x800
+// and powering up with 0x123 and PowerDown100k.
+var stateIO = i2ctest.IO{Addr: 0x60, R: []byte{0xC0, 0x80, 0x00, 0x41, 0x23}}
+
+func newBus(ops ...i2ctest.IO) *i2ctest.Playback {
+	return &i2ctest.Playback{Ops: append([]i2ctest.IO{stateIO}, ops...)}
+}
+
+func TestNew(t *testing.T) {
+	bus := newBus()
+	d, err := New(bus, Opts{Supply: 5 * physic.Volt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "MCP4725{playback(96)}" {
+		t.Fatal(s)
+	}
+	if d.code != 0x800 {
+		t.Fatal(d.code)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	if _, err := New(&i2ctest.Playback{}, Opts{}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if _, err := New(&i2ctest.Playback{DontPanic: true}, DefaultOpts); err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		o    Opts
+		want string
+	}{
+		{Opts{Addr: 0x80, Supply: physic.Volt}, "mcp4725: invalid options: Addr 0x80, want at most 0x7f"},
+		{Opts{Addr: DefaultAddr}, "mcp4725: invalid options: Supply 0V, want more than 0"},
+	}
+	for i, line := range data {
+		err := line.o.Validate()
+		if err == nil || err.Error() != line.want {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if !errors.Is(err, ErrInvalidOpts) {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+	if err := DefaultOpts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOut(t *testing.T) {
+	bus := newBus(
+		i2ctest.IO{Addr: 0x60, W: []byte{0x0F, 0xFF}},
+		i2ctest.IO{Addr: 0x60, W: []byte{0x04, 0xD9}},
+		i2ctest.IO{Addr: 0x60, W: []byte{0x00, 0x00}},
+	)
+	d, err := New(bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Out(4095); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.OutVoltage(physic.Volt); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.OutVoltage(0); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []int32{-1, 4096} {
+		if err := d.Out(c); err == nil {
+			t.Fatalf("%d: expected failure", c)
+		}
+	}
+	if err := d.OutVoltage(3301 * physic.MilliVolt); err == nil {
+		t.Fatal("expected failure")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVoltage(t *testing.T) {
+	d := &Dev{supply: 4096 * physic.MilliVolt}
+	if v := d.Voltage(2048); v != 2048*physic.MilliVolt {
+		t.Fatal(v)
+	}
+	data := []struct {
+		v    physic.ElectricPotential
+		code int32
+	}{
+		{0, 0},
+		{1500 * physic.MicroVolt, 2},
+		{2048 * physic.MilliVolt, 2048},
+		{4096 * physic.MilliVolt, 4095},
+	}
+	for _, line := range data {
+		c, err := d.Code(line.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c != line.code {
+			t.Fatalf("%s: %d, want %d", line.v, c, line.code)
+		}
+	}
+	if _, err := d.Code(-physic.MilliVolt); err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestSetPowerDown(t *testing.T) {
+	bus := newBus(
+		i2ctest.IO{Addr: 0x60, W: []byte{0x28, 0x00}},
+		i2ctest.IO{Addr: 0x60, W: []byte{0x08, 0x00}},
+		// Halt.
+		i2ctest.IO{Addr: 0x60, W: []byte{0x38, 0x00}},
+	)
+	d, err := New(bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []PowerDown{PowerDown100k, PowerOn} {
+		if err := d.SetPowerDown(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetPowerDown(4); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteEEPROM(t *testing.T) {
+	bus := newBus(
+		i2ctest.IO{Addr: 0x60, W: []byte{0x62, 0x12, 0x30}},
+		i2ctest.IO{Addr: 0x60, R: []byte{0x40}},
+		i2ctest.IO{Addr: 0x60, R: []byte{0xC0}},
+	)
+	d, err := New(bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WriteEEPROM(0x123, PowerDown1k); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WriteEEPROM(4096, PowerOn); err == nil {
+		t.Fatal("expected failure")
+	}
+	if err := d.WriteEEPROM(0, 4); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteEEPROM_fail(t *testing.T) {
+	ops := []i2ctest.IO{{Addr: 0x60, W: []byte{0x60, 0x00, 0x00}}}
+	for i := 0; i < 20; i++ {
+		ops = append(ops, i2ctest.IO{Addr: 0x60, R: []byte{0x40}})
+	}
+	bus := newBus(ops...)
+	d, err := New(bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WriteEEPROM(0, PowerOn); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestState(t *testing.T) {
+	bus := newBus(stateIO)
+	d, err := New(bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := State{Code: 0x800, PowerDown: PowerOn, EEPROMCode: 0x123, EEPROMPowerDown: PowerDown100k}
+	if s != want {
+		t.Fatalf("%s, want %s", s, want)
+	}
+	if str := s.String(); str != "State{Code:2048 PowerDown:PowerOn EEPROMCode:291 EEPROMPowerDown:PowerDown100k Busy:false}" {
+		t.Fatal(str)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPinDAC(t *testing.T) {
+	d, err := New(newBus(), DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := d.Name(); n != "MCP4725" {
+		t.Fatal(n)
+	}
+	if n := d.Number(); n != 0 {
+		t.Fatal(n)
+	}
+	if f := d.Function(); f != "DAC" {
+		t.Fatal(f)
+	}
+	if v := d.SupportedFuncs(); !reflect.DeepEqual(v, []pin.Func{analog.DAC}) {
+		t.Fatal(v)
+	}
+	if err := d.SetFunc(analog.DAC); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetFunc(pin.FuncNone); err == nil {
+		t.Fatal("expected failure")
+	}
+	min, max := d.Range()
+	if min.Raw != 0 || max.Raw != 4095 || max.V != 3299194335*physic.NanoVolt {
+		t.Fatal(min, max)
+	}
+}
+
+func TestPowerDown_String(t *testing.T) {
+	data := []struct {
+		p    PowerDown
+		want string
+	}{
+		{PowerOn, "PowerOn"},
+		{PowerDown1k, "PowerDown1k"},
+		{PowerDown100k, "PowerDown100k"},
+		{PowerDown500k, "PowerDown500k"},
+		{PowerDown(4), "PowerDown(4)"},
+	}
+	for _, line := range data {
+		if s := line.p.String(); s != line.want {
+			t.Fatalf("%s != %s", s, line.want)
+		}
+	}
+}
@@ -121,7 +121,14 @@ func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error)
 			select {
 			case <-time.After(interval):
 				t, _, _ := d.readTemperature()
-				env <- physic.Env{Temperature: t}
+				// Don't block forever if the caller stopped reading
+				// before calling Halt.
+				select {
+				case env <- physic.Env{Temperature: t}:
+				case <-d.stop:
+					wg.Done()
+					return
+				}
 			case <-d.stop:
 				wg.Done()
 				return
@@ -220,6 +220,41 @@ func TestSenseContinuous(t *testing.T) {
 	}
 }
 
+func TestSenseContinuous_halt_unread(t *testing.T) {
+	bus := i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			{Addr: 0x18, W: []byte{temperature}, R: []byte{0x00, 0xa0}},
+			{Addr: 0x18, W: []byte{temperature}, R: []byte{0x00, 0xa0}},
+			{Addr: 0x18, W: []byte{configuration, 0x01, 0x00}, R: nil},
+		},
+	}
+	mcp9808 := &Dev{
+		m: mmr.Dev8{
+			Conn:  &i2c.Dev{Bus: &bus, Addr: 0x18},
+			Order: binary.BigEndian,
+		},
+		res:     Low,
+		enabled: true,
+		stop:    make(chan struct{}, 1),
+	}
+	env, err := mcp9808.SenseContinuous(30 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-env
+	// Stop reading; the next measurement is pending when Halt is called.
+	time.Sleep(45 * time.Millisecond)
+	if err := mcp9808.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if e, ok := <-env; ok {
+		t.Fatalf("SenseContinuous() got %v after Halt()", e)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestPrecision(t *testing.T) {
 	tests := []struct {
 		name string
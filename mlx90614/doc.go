@@ -0,0 +1,27 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mlx90614 controls a Melexis MLX90614 infrared thermometer over
+// SMBus.
+//
+// # More details
+//
+// The MLX90614 measures continuously the temperature of the object in its
+// field of view and its own, the ambient temperature, from -70°C to 380°C
+// and -40°C to 125°C respectively at 0.02°C resolution.
+//
+// Each SMBus word is followed by a PEC, a CRC-8 of the whole transaction
+// including the addresses, which the driver verifies on reads and sends on
+// writes. The device ignores EEPROM writes with a bad PEC.
+//
+// The object temperature depends on the emissivity of its surface, 1.0 by
+// default, which is stored in EEPROM and set with SetEmissivity. Most
+// versions apply a new emissivity only after a power cycle.
+//
+// The SMBus is limited to 100kHz.
+//
+// # Datasheet
+//
+// https://www.melexis.com/-/media/files/documents/datasheets/mlx90614-datasheet-melexis.pdf
+package mlx90614
@@ -0,0 +1,38 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90614_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/mlx90614"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use i2creg I²C bus registry to find the first available I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := mlx90614.New(bus, mlx90614.Opts{})
+	if err != nil {
+		log.Fatalf("failed to initialize mlx90614: %v", err)
+	}
+	m, err := d.Read()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("object %s, ambient %s\n", m.Object, m.Ambient)
+}
@@ -0,0 +1,319 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90614
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// DefaultAddr is the factory SMBus address.
+const DefaultAddr = 0x5A
+
+// Commands: RAM and EEPROM addresses.
+const (
+	cmdAmbient    = 0x06
+	cmdObject1    = 0x07
+	cmdObject2    = 0x08
+	cmdEmissivity = 0x24
+	cmdConfig1    = 0x25
+)
+
+// Timings.
+const (
+	// eepromWriteTime is the duration of an EEPROM erase or write.
+	eepromWriteTime = 10 * time.Millisecond
+	// refreshTime is the refresh period of the measurements with the
+	// factory filter settings.
+	refreshTime = 100 * time.Millisecond
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrPEC is returned when the PEC of a read word doesn't match.
+	ErrPEC = errors.New("mlx90614: PEC mismatch")
+	// ErrNotReady is returned when the device flags a measurement as invalid,
+	// e.g. while starting up.
+	ErrNotReady = errors.New("mlx90614: measurement not valid")
+	// ErrInvalidOpts is returned for options or arguments that are out of
+	// range.
+	ErrInvalidOpts = errors.New("mlx90614: invalid options")
+)
+
+// Opts holds the configuration options.
+type Opts struct {
+	Addr uint16 // SMBus address, DefaultAddr if 0.
+}
+
+// Measurement is a read of both temperatures.
+type Measurement struct {
+	// Object is the temperature of the object in the field of view.
+	Object physic.Temperature
+	// Ambient is the temperature of the device itself.
+	Ambient physic.Temperature
+}
+
+func (m Measurement) String() string {
+	return fmt.Sprintf("Measurement{Object:%s Ambient:%s}", m.Object, m.Ambient)
+}
+
+// Dev is a handle to an initialized MLX90614 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	addr uint16
+	dual bool // the device has two object sensors
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a handle to a device on a SMBus, reading its configuration.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	if addr > 0x7F {
+		return nil, fmt.Errorf("%w: Addr %#x, want at most 0x7f", ErrInvalidOpts, addr)
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: addr}, addr: addr}
+	cfg, err := d.readWord(cmdConfig1)
+	if err != nil {
+		return nil, err
+	}
+	d.dual = cfg&0x40 != 0
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MLX90614{%s}", d.c)
+}
+
+// Read reads the object and ambient temperatures.
+func (d *Dev) Read() (Measurement, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return Measurement{}, errors.New("mlx90614: already sensing continuously")
+	}
+	return d.read()
+}
+
+// ReadObject2 reads the temperature seen by the second sensor of the dual
+// zone versions, e.g. MLX90614xBx.
+func (d *Dev) ReadObject2() (physic.Temperature, error) {
+	if !d.dual {
+		return 0, errors.New("mlx90614: single zone device")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readTemperature(cmdObject2)
+}
+
+// Sense reads the object temperature. It implements physic.SenseEnv.
+//
+// The humidity and the pressure are not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("mlx90614: already sensing continuously")
+	}
+	t, err := d.readTemperature(cmdObject1)
+	if err != nil {
+		return err
+	}
+	e.Temperature = t
+	return nil
+}
+
+// SenseContinuous returns measurements of the object temperature on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be at least the refresh period of the device, 100ms.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval < refreshTime {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, refreshTime)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		d.mu.Lock()
+		temp, err := d.readTemperature(cmdObject1)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- physic.Env{Temperature: temp}:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 20 * physic.MilliKelvin
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device keeps measuring.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// Emissivity reads the emissivity used to compute the object temperature,
+// from 0.1 to 1.0.
+func (d *Dev) Emissivity() (float64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	w, err := d.readWord(cmdEmissivity)
+	if err != nil {
+		return 0, err
+	}
+	return float64(w) / 0xFFFF, nil
+}
+
+// SetEmissivity writes the emissivity e, from 0.1 to 1.0, in EEPROM.
+//
+// It takes 20ms and wears the EEPROM, so it should only be called when the
+// value changes. Most versions apply it after a power cycle.
+func (d *Dev) SetEmissivity(e float64) error {
+	if !(e >= 0.1 && e <= 1) {
+		return fmt.Errorf("%w: emissivity %g, want 0.1 to 1.0", ErrInvalidOpts, e)
+	}
+	w := uint16(math.Round(e * 0xFFFF))
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeEEPROM(cmdEmissivity, w)
+}
+
+func (d *Dev) read() (Measurement, error) {
+	o, err := d.readTemperature(cmdObject1)
+	if err != nil {
+		return Measurement{}, err
+	}
+	a, err := d.readTemperature(cmdAmbient)
+	if err != nil {
+		return Measurement{}, err
+	}
+	return Measurement{Object: o, Ambient: a}, nil
+}
+
+// readTemperature reads a temperature in RAM, in 0.02K.
+func (d *Dev) readTemperature(cmd byte) (physic.Temperature, error) {
+	w, err := d.readWord(cmd)
+	if err != nil {
+		return 0, err
+	}
+	if w&0x8000 != 0 {
+		return 0, ErrNotReady
+	}
+	return physic.Temperature(w) * 20 * physic.MilliKelvin, nil
+}
+
+// writeEEPROM erases then writes an EEPROM cell, as required by the device.
+func (d *Dev) writeEEPROM(cmd byte, w uint16) error {
+	if err := d.writeWord(cmd, 0); err != nil {
+		return err
+	}
+	doSleep(eepromWriteTime)
+	if err := d.writeWord(cmd, w); err != nil {
+		return err
+	}
+	doSleep(eepromWriteTime)
+	return nil
+}
+
+func (d *Dev) readWord(cmd byte) (uint16, error) {
+	var b [3]byte
+	if err := d.c.Tx([]byte{cmd}, b[:]); err != nil {
+		return 0, fmt.Errorf("mlx90614: reading %#02x: %w", cmd, err)
+	}
+	if p := pec(byte(d.addr<<1), cmd, byte(d.addr<<1)|1, b[0], b[1]); p != b[2] {
+		return 0, fmt.Errorf("%w: reading %#02x: %#02x, want %#02x", ErrPEC, cmd, b[2], p)
+	}
+	return uint16(b[1])<<8 | uint16(b[0]), nil
+}
+
+func (d *Dev) writeWord(cmd byte, w uint16) error {
+	b := []byte{cmd, byte(w), byte(w >> 8)}
+	b = append(b, pec(append([]byte{byte(d.addr << 1)}, b...)...))
+	if err := d.c.Tx(b, nil); err != nil {
+		return fmt.Errorf("mlx90614: writing %#02x: %w", cmd, err)
+	}
+	return nil
+}
+
+// pec returns the SMBus packet error code of b: a CRC-8 with polynomial
+// x^8+x^2+x+1 (0x07) and initial value 0.
+func pec(b ...byte) byte {
+	var crc byte
+	for _, v := range b {
+		crc ^= v
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
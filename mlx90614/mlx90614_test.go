@@ -0,0 +1,274 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90614
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// readOp is the read of the word w at cmd, followed by its PEC.
+func readOp(cmd byte, w uint16) i2ctest.IO {
+	lsb, msb := byte(w), byte(w>>8)
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{cmd}, R: []byte{lsb, msb, pec(DefaultAddr<<1, cmd, DefaultAddr<<1|1, lsb, msb)}}
+}
+
+// writeOp is the write of the word w at cmd, followed by its PEC.
+func writeOp(cmd byte, w uint16) i2ctest.IO {
+	lsb, msb := byte(w), byte(w>>8)
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{cmd, lsb, msb, pec(DefaultAddr<<1, cmd, lsb, msb)}}
+}
+
+// initOps are the bus transactions issued by New, reading the factory
+// configuration of a single zone device.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{readOp(cmdConfig1, 0x9FB4)}
+}
+
+// 28.01°C and 27.89°C.
+const (
+	testObject  = 0x3AD2
+	testAmbient = 0x3ACC
+)
+
+var testMeasurement = Measurement{
+	Object:  15058 * 20 * physic.MilliKelvin,
+	Ambient: 15052 * 20 * physic.MilliKelvin,
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.dual {
+		t.Fatal("expected single zone")
+	}
+	if s := d.String(); s != "MLX90614{playback(90)}" {
+		t.Fatal(s)
+	}
+	if _, err := d.ReadObject2(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	bus := i2ctest.Playback{DontPanic: true}
+	if _, err := New(&bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	const want = "mlx90614: invalid options: Addr 0x80, want at most 0x7f"
+	if _, err := New(&bus, Opts{Addr: 0x80}); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+}
+
+func TestNew_bad_pec(t *testing.T) {
+	ops := initOps()
+	ops[0].R[2]++
+	bus := i2ctest.Playback{Ops: ops}
+	_, err := New(&bus, Opts{})
+	const want = "mlx90614: PEC mismatch: reading 0x25: 0x2c, want 0x2b"
+	if !errors.Is(err, ErrPEC) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+}
+
+func TestRead(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), readOp(cmdObject1, testObject), readOp(cmdAmbient, testAmbient))}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := d.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != testMeasurement {
+		t.Fatalf("%s, want %s", m, testMeasurement)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead_fail(t *testing.T) {
+	ops := append(initOps(), readOp(cmdObject1, testObject), readOp(cmdAmbient, testAmbient))
+	for i := len(initOps()); i < len(ops); i++ {
+		bus := i2ctest.Playback{Ops: ops[:i], DontPanic: true}
+		d, err := New(&bus, Opts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := d.Read(); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestReadObject2(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{readOp(cmdConfig1, 0x9FF4), readOp(cmdObject2, testAmbient)}}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := d.ReadObject2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if temp != testMeasurement.Ambient {
+		t.Fatal(temp)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), readOp(cmdObject1, testObject))}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if want := (physic.Env{Temperature: testMeasurement.Object}); e != want {
+		t.Fatalf("%#v, want %#v", e, want)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_not_ready(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), readOp(cmdObject1, 0x8000|testObject))}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), readOp(cmdObject1, testObject), readOp(cmdObject1, testObject))}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(10 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(100 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := physic.Env{Temperature: testMeasurement.Object}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != want {
+			t.Fatalf("#%d: %#v, want %#v", i, e, want)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.Read(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 20*physic.MilliKelvin {
+		t.Fatal(e)
+	}
+}
+
+func TestEmissivity(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		readOp(cmdEmissivity, 0xFFFF),
+		writeOp(cmdEmissivity, 0),
+		writeOp(cmdEmissivity, 0xF332),
+	)}
+	d, err := New(&bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := d.Emissivity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e != 1 {
+		t.Fatal(e)
+	}
+	if err := d.SetEmissivity(0.95); err != nil {
+		t.Fatal(err)
+	}
+	const want = "mlx90614: invalid options: emissivity 0.05, want 0.1 to 1.0"
+	if err := d.SetEmissivity(0.05); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+	if err := d.SetEmissivity(1.1); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetEmissivity_fail(t *testing.T) {
+	ops := append(initOps(), writeOp(cmdEmissivity, 0), writeOp(cmdEmissivity, 0xF332))
+	for i := len(initOps()); i < len(ops); i++ {
+		bus := i2ctest.Playback{Ops: ops[:i], DontPanic: true}
+		d, err := New(&bus, Opts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := d.SetEmissivity(0.95); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestPEC(t *testing.T) {
+	// Example of the datasheet: reading 0x3AD2 at RAM 0x07.
+	if p := pec(0xB4, 0x07, 0xB5, 0xD2, 0x3A); p != 0x30 {
+		t.Fatalf("%#02x, want 0x30", p)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640
+
+import "math"
+
+// RAM offsets of the auxiliary data, from 0x0400.
+const (
+	ramTaVbe   = 768 // 0x0700
+	ramCPSP0   = 776 // 0x0708
+	ramGain    = 778 // 0x070A
+	ramTaPTAT  = 800 // 0x0720
+	ramCPSP1   = 808 // 0x0728
+	ramVddPix  = 810 // 0x072A
+	ramWords   = 832
+	kelvinZero = 273.15
+	// taShift is the difference between the ambient temperature and the
+	// temperature reflected to the sensor, in open air.
+	taShift = 8
+)
+
+// subpage is a measurement of one of the two subpages.
+type subpage struct {
+	ram  [ramWords]uint16
+	ctrl uint16 // control register 1
+	page int
+}
+
+// vdd returns the supply voltage, in V.
+func (p *params) vdd(s *subpage) float64 {
+	resolutionRAM := int(s.ctrl&0x0C00) >> 10
+	correction := pow2(p.resolutionEE - resolutionRAM)
+	return (correction*float64(int16(s.ram[ramVddPix]))-p.vdd25)/p.kVdd + 3.3
+}
+
+// ta returns the ambient temperature, i.e. of the sensor, in °C.
+func (p *params) ta(s *subpage, vdd float64) float64 {
+	ptat := float64(int16(s.ram[ramTaPTAT]))
+	vbe := float64(int16(s.ram[ramTaVbe]))
+	ptatArt := ptat / (ptat*p.alphaPTAT + vbe) * (1 << 18)
+	return (ptatArt/(1+p.kvPTAT*(vdd-3.3))-p.vPTAT25)/p.ktPTAT + 25
+}
+
+// temperatures computes the object temperatures, in °C, of the pixels of
+// subpage s into to. It returns the ambient temperature.
+//
+// It implements section 11.2.2 of the datasheet.
+func (p *params) temperatures(s *subpage, emissivity float64, to *[Pixels]float64) float64 {
+	vdd := p.vdd(s)
+	ta := p.ta(s, vdd)
+	tr := ta - taShift
+	ta4 := math.Pow(ta+kelvinZero, 4)
+	tr4 := math.Pow(tr+kelvinZero, 4)
+	taTr := tr4 - (tr4-ta4)/emissivity
+
+	alphaCorrR := [4]float64{
+		1 / (1 + p.ksTo[0]*40),
+		1,
+		1 + p.ksTo[1]*p.ct[2],
+	}
+	alphaCorrR[3] = alphaCorrR[2] * (1 + p.ksTo[2]*(p.ct[3]-p.ct[2]))
+
+	gain := p.gainEE / float64(int16(s.ram[ramGain]))
+	mode := (s.ctrl & 0x1000) >> 5
+	dta, dvdd := ta-25, vdd-3.3
+
+	// Compensation pixels.
+	irCP := [2]float64{
+		float64(int16(s.ram[ramCPSP0])) * gain,
+		float64(int16(s.ram[ramCPSP1])) * gain,
+	}
+	irCP[0] -= p.cpOffset[0] * (1 + p.cpKta*dta) * (1 + p.cpKv*dvdd)
+	if mode == p.calibrationModeEE {
+		irCP[1] -= p.cpOffset[1] * (1 + p.cpKta*dta) * (1 + p.cpKv*dvdd)
+	} else {
+		irCP[1] -= (p.cpOffset[1] + p.ilChessC[0]) * (1 + p.cpKta*dta) * (1 + p.cpKv*dvdd)
+	}
+
+	for i := 0; i < Pixels; i++ {
+		ilPattern := i / Width % 2
+		pattern := ilPattern
+		if mode != 0 {
+			// Chess pattern.
+			pattern ^= i % 2
+		}
+		if pattern != s.page {
+			continue
+		}
+		ir := float64(int16(s.ram[i])) * gain
+		ir -= p.offset[i] * (1 + p.kta[i]*dta) * (1 + p.kv[i]*dvdd)
+		if mode != p.calibrationModeEE {
+			conversionPattern := ((i+2)/4 - (i+3)/4 + (i+1)/4 - i/4) * (1 - 2*ilPattern)
+			ir += p.ilChessC[2]*float64(2*ilPattern-1) - p.ilChessC[1]*float64(conversionPattern)
+		}
+		ir /= emissivity
+		ir -= p.tgc * irCP[s.page]
+
+		alpha := (p.alpha[i] - p.tgc*p.cpAlpha[s.page]) * (1 + p.ksTa*dta)
+		sx := alpha * alpha * alpha * (ir + alpha*taTr)
+		sx = math.Sqrt(math.Sqrt(sx)) * p.ksTo[1]
+		t := math.Sqrt(math.Sqrt(ir/(alpha*(1-p.ksTo[1]*kelvinZero)+sx)+taTr)) - kelvinZero
+
+		// Extended temperature ranges.
+		r := 3
+		switch {
+		case t < p.ct[1]:
+			r = 0
+		case t < p.ct[2]:
+			r = 1
+		case t < p.ct[3]:
+			r = 2
+		}
+		to[i] = math.Sqrt(math.Sqrt(ir/(alpha*alphaCorrR[r]*(1+p.ksTo[r]*(t-p.ct[r])))+taTr)) - kelvinZero
+	}
+	return ta
+}
+
+// fixBadPixels replaces the temperature of the broken and outlier pixels with
+// the mean of their valid neighbors in the same row and column.
+func (p *params) fixBadPixels(to *[Pixels]float64) {
+	if len(p.badPixels) == 0 {
+		return
+	}
+	bad := make(map[int]bool, len(p.badPixels))
+	for _, i := range p.badPixels {
+		bad[i] = true
+	}
+	for _, i := range p.badPixels {
+		x, y := i%Width, i/Width
+		sum, n := 0., 0
+		for _, d := range [4][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			nx, ny := x+d[0], y+d[1]
+			if nx < 0 || nx >= Width || ny < 0 || ny >= Height || bad[ny*Width+nx] {
+				continue
+			}
+			sum += to[ny*Width+nx]
+			n++
+		}
+		if n != 0 {
+			to[i] = sum / float64(n)
+		}
+	}
+}
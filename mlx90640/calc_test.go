@@ -0,0 +1,107 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640
+
+import (
+	"math"
+	"testing"
+)
+
+func testSubpage(t *testing.T, ctrl uint16, page int) (*params, *subpage) {
+	p, err := newParams(testEEPROM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &subpage{ctrl: ctrl, page: page}
+	copy(s.ram[:], testRAM(p, 35))
+	return p, s
+}
+
+func TestVdd(t *testing.T) {
+	p, s := testSubpage(t, testCtrl, 0)
+	// Example of the datasheet.
+	if v := p.vdd(s); math.Abs(v-3.3186) > 1e-4 {
+		t.Fatal(v)
+	}
+	// 19 bits instead of 18.
+	s.ctrl = testCtrl | 0x0C00
+	if v := p.vdd(s); math.Abs(v-((-13115./2+13056)/-3168+3.3)) > 1e-9 {
+		t.Fatal(v)
+	}
+}
+
+func TestTa(t *testing.T) {
+	p, s := testSubpage(t, testCtrl, 0)
+	ptatArt := 1711. / (1711*9 + 19442) * (1 << 18)
+	if ta := p.ta(s, 3.3); math.Abs(ta-((ptatArt-12273)/42.25+25)) > 1e-9 {
+		t.Fatal(ta)
+	}
+}
+
+func TestTemperatures_patterns(t *testing.T) {
+	data := []struct {
+		ctrl uint16
+		page int
+		in   func(i int) bool
+	}{
+		{testCtrl, 0, func(i int) bool { return (i/Width+i)%2 == 0 }},
+		{testCtrl, 1, func(i int) bool { return (i/Width+i)%2 == 1 }},
+		// Interleaved: even rows then odd rows.
+		{testCtrl &^ 0x1000, 0, func(i int) bool { return i/Width%2 == 0 }},
+		{testCtrl &^ 0x1000, 1, func(i int) bool { return i/Width%2 == 1 }},
+	}
+	for n, line := range data {
+		p, s := testSubpage(t, line.ctrl, line.page)
+		var to [Pixels]float64
+		p.temperatures(s, 0.95, &to)
+		for i, v := range to {
+			if i == testBroken {
+				continue
+			}
+			if line.in(i) {
+				// The interleaved mode differs from the calibration, so it is
+				// corrected with ilChessC, 0 here.
+				if math.Abs(v-35) > 0.02 {
+					t.Fatalf("#%d: pixel %d: %g, want 35", n, i, v)
+				}
+			} else if v != 0 {
+				t.Fatalf("#%d: pixel %d: %g, want untouched", n, i, v)
+			}
+		}
+	}
+}
+
+func TestTemperatures_emissivity(t *testing.T) {
+	p, s := testSubpage(t, testCtrl, 0)
+	var to [Pixels]float64
+	p.temperatures(s, 0.95, &to)
+	a := to[0]
+	// A lower emissivity means a hotter object for the same radiation.
+	p.temperatures(s, 0.5, &to)
+	if to[0] <= a {
+		t.Fatal(to[0], a)
+	}
+}
+
+func TestFixBadPixels(t *testing.T) {
+	p := &params{badPixels: []int{0, 1, 33}}
+	var to [Pixels]float64
+	for i := range to {
+		to[i] = float64(i)
+	}
+	p.fixBadPixels(&to)
+	// Pixel 0: 32; 1 being bad.
+	if to[0] != 32 {
+		t.Fatal(to[0])
+	}
+	// Pixel 1: 2; 0 and 33 being bad.
+	if to[1] != 2 {
+		t.Fatal(to[1])
+	}
+	// Pixel 33: 32, 34, 65; 1 being bad.
+	if to[33] != (32.+34+65)/3 {
+		t.Fatal(to[33])
+	}
+}
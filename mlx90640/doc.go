@@ -0,0 +1,27 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mlx90640 controls a Melexis MLX90640 32x24 pixels thermal camera
+// over I²C.
+//
+// # More details
+//
+// The device measures half of its pixels at a time, a subpage, in a chess
+// pattern, and a frame is made of the two subpages. The raw readings are
+// meaningless on their own: each pixel has its own offset, sensitivity and
+// temperature and supply drifts, stored in EEPROM at the factory. New reads
+// and extracts them once and each subpage is converted to object
+// temperatures with the formulas of section 11 of the datasheet, including
+// the compensation pixels, the extended temperature ranges and the
+// correction of the few broken or outlier pixels, interpolated from their
+// neighbors.
+//
+// The object temperatures depend on their emissivity, set with
+// Opts.Emissivity. The temperature reflected by the objects is assumed to be
+// 8°C below the ambient temperature, as with the sensor in open air.
+//
+// # Datasheet
+//
+// https://www.melexis.com/-/media/files/documents/datasheets/mlx90640-datasheet-melexis.pdf
+package mlx90640
@@ -0,0 +1,55 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/mlx90640"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use i2creg I²C bus registry to find the first available I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	opts := mlx90640.DefaultOpts
+	opts.Rate = 4 * physic.Hertz
+	d, err := mlx90640.New(bus, opts)
+	if err != nil {
+		log.Fatalf("failed to initialize mlx90640: %v", err)
+	}
+	frames, err := d.StreamFrames()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		f := <-frames
+		hottest := f.Pixels[0][0]
+		for _, row := range f.Pixels {
+			for _, t := range row {
+				if t > hottest {
+					hottest = t
+				}
+			}
+		}
+		fmt.Printf("hottest %s, ambient %s\n", hottest, f.Ambient)
+	}
+	if err := d.Halt(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// DefaultAddr is the factory I²C address.
+const DefaultAddr = 0x33
+
+// Size of the sensor.
+const (
+	Width  = 32
+	Height = 24
+	Pixels = Width * Height
+)
+
+// Registers.
+const (
+	regRAM      = 0x0400
+	regEEPROM   = 0x2400
+	regStatus   = 0x8000
+	regControl1 = 0x800D
+)
+
+// Status register bits.
+const (
+	statusSubpage   = 0x0001
+	statusDataReady = 0x0008
+	// statusClear clears the data ready flag, keeping the data overwrite
+	// enabled.
+	statusClear = 0x0030
+)
+
+// maxTornReads is the number of times the RAM is read again when a new
+// subpage arrived during the read.
+const maxTornReads = 5
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadEEPROM is returned by New when the calibration is not usable.
+	ErrBadEEPROM = errors.New("mlx90640: invalid EEPROM")
+	// ErrNotReady is returned when no new subpage became available in time
+	// or the subpages kept being overwritten while read.
+	ErrNotReady = errors.New("mlx90640: data not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("mlx90640: invalid options")
+)
+
+// rates are the supported refresh rates, indexed by their code in the control
+// register.
+var rates = [...]physic.Frequency{
+	500 * physic.MilliHertz,
+	physic.Hertz,
+	2 * physic.Hertz,
+	4 * physic.Hertz,
+	8 * physic.Hertz,
+	16 * physic.Hertz,
+	32 * physic.Hertz,
+	64 * physic.Hertz,
+}
+
+// DefaultOpts are the recommended options.
+var DefaultOpts = Opts{
+	Rate:       2 * physic.Hertz,
+	Emissivity: 0.95,
+}
+
+// Opts holds the configuration options.
+//
+// Rate: refresh rate of the subpages, one of 500mHz, 1Hz, 2Hz, 4Hz, 8Hz, 16Hz,
+// 32Hz or 64Hz. A frame being made of two subpages, frames are refreshed at
+// half this rate. The noise increases with the rate. Reading a subpage being
+// 1664 bytes, the bus must run at 1MHz above 16Hz.
+//
+// Emissivity: emissivity of the objects, from 0.1 to 1.
+type Opts struct {
+	Addr       uint16 // I²C address, DefaultAddr if 0.
+	Rate       physic.Frequency
+	Emissivity float64
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Addr > 0x7F {
+		return fmt.Errorf("%w: Addr %#x, want at most 0x7f", ErrInvalidOpts, o.Addr)
+	}
+	if o.rateCode() < 0 {
+		return fmt.Errorf("%w: Rate %s, want 500mHz, 1Hz, 2Hz, 4Hz, 8Hz, 16Hz, 32Hz or 64Hz", ErrInvalidOpts, o.Rate)
+	}
+	if !(o.Emissivity >= 0.1 && o.Emissivity <= 1) {
+		return fmt.Errorf("%w: Emissivity %g, want 0.1 to 1", ErrInvalidOpts, o.Emissivity)
+	}
+	return nil
+}
+
+// rateCode returns the code of Rate, or -1.
+func (o *Opts) rateCode() int {
+	for i, r := range rates {
+		if r == o.Rate {
+			return i
+		}
+	}
+	return -1
+}
+
+// Frame is a thermal image.
+type Frame struct {
+	// Pixels are the object temperatures, indexed by row then column as in
+	// the RAM of the device.
+	Pixels [Height][Width]physic.Temperature
+	// Ambient is the temperature of the sensor.
+	Ambient physic.Temperature
+}
+
+// Dev is a handle to an initialized MLX90640 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c      conn.Conn
+	opts   Opts
+	params *params
+
+	mu   sync.Mutex
+	to   [Pixels]float64
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New reads the calibration of a device on an I²C bus and sets its refresh
+// rate.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: addr}, opts: opts}
+	ee := make([]uint16, eepromWords)
+	if err := d.readWords(regEEPROM, ee); err != nil {
+		return nil, err
+	}
+	p, err := newParams(ee)
+	if err != nil {
+		return nil, err
+	}
+	d.params = p
+	ctrl, err := d.readReg(regControl1)
+	if err != nil {
+		return nil, err
+	}
+	ctrl = ctrl&^0x0380 | uint16(opts.rateCode())<<7
+	if err := d.writeReg(regControl1, ctrl); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MLX90640{%s}", d.c)
+}
+
+// ReadFrame reads the two next subpages into f.
+//
+// It blocks for up to two subpage periods, e.g. 1s at 2Hz.
+func (d *Dev) ReadFrame(f *Frame) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("mlx90640: already streaming")
+	}
+	return d.readFrame(f)
+}
+
+// StreamFrames returns frames on a continuous basis, as fast as the device
+// refreshes them.
+//
+// The application must call Halt() to stop the streaming when done to close
+// the channel. The device keeps refreshing while a frame is not consumed, so
+// a slow reader skips frames.
+func (d *Dev) StreamFrames() (<-chan Frame, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	frames := make(chan Frame)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(frames)
+		d.streaming(frames, stop)
+	}(d.stop)
+	return frames, nil
+}
+
+func (d *Dev) streaming(frames chan<- Frame, stop <-chan struct{}) {
+	for {
+		var f Frame
+		d.mu.Lock()
+		err := d.readFrame(&f)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to read frame: %v", d, err)
+			return
+		}
+		select {
+		case frames <- f:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Halt stops the streaming initiated by StreamFrames(). The device keeps
+// measuring.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// readFrame reads subpages until both were read.
+//
+// It must be called with d.mu held.
+func (d *Dev) readFrame(f *Frame) error {
+	var s subpage
+	var seen [2]bool
+	var ta float64
+	for i := 0; i < 4 && !(seen[0] && seen[1]); i++ {
+		if err := d.readSubpage(&s); err != nil {
+			return err
+		}
+		ta = d.params.temperatures(&s, d.opts.Emissivity, &d.to)
+		seen[s.page] = true
+	}
+	if !(seen[0] && seen[1]) {
+		return fmt.Errorf("%w: subpage %d repeated", ErrNotReady, s.page)
+	}
+	d.params.fixBadPixels(&d.to)
+	for i, t := range d.to {
+		f.Pixels[i/Width][i%Width] = celsius(t)
+	}
+	f.Ambient = celsius(ta)
+	return nil
+}
+
+// readSubpage waits for a new subpage and reads it.
+func (d *Dev) readSubpage(s *subpage) error {
+	if err := d.waitReady(); err != nil {
+		return err
+	}
+	for i := 0; ; i++ {
+		if i == maxTornReads {
+			return fmt.Errorf("%w: subpages overwritten while read", ErrNotReady)
+		}
+		if err := d.writeReg(regStatus, statusClear); err != nil {
+			return err
+		}
+		if err := d.readWords(regRAM, s.ram[:]); err != nil {
+			return err
+		}
+		status, err := d.readReg(regStatus)
+		if err != nil {
+			return err
+		}
+		if status&statusDataReady == 0 {
+			s.page = int(status & statusSubpage)
+			break
+		}
+	}
+	ctrl, err := d.readReg(regControl1)
+	if err != nil {
+		return err
+	}
+	s.ctrl = ctrl
+	return nil
+}
+
+// waitReady polls the status register until a new subpage is available, for
+// up to two refresh periods.
+func (d *Dev) waitReady() error {
+	const polls = 16
+	period := d.opts.Rate.Period()
+	for i := 0; i < polls; i++ {
+		status, err := d.readReg(regStatus)
+		if err != nil {
+			return err
+		}
+		if status&statusDataReady != 0 {
+			return nil
+		}
+		doSleep(2 * period / polls)
+	}
+	return ErrNotReady
+}
+
+func (d *Dev) readReg(reg uint16) (uint16, error) {
+	var w [1]uint16
+	err := d.readWords(reg, w[:])
+	return w[0], err
+}
+
+// readWords reads big endian words from reg.
+func (d *Dev) readWords(reg uint16, w []uint16) error {
+	b := make([]byte, 2*len(w))
+	if err := d.c.Tx([]byte{byte(reg >> 8), byte(reg)}, b); err != nil {
+		return fmt.Errorf("mlx90640: reading %#04x: %w", reg, err)
+	}
+	for i := range w {
+		w[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+	}
+	return nil
+}
+
+func (d *Dev) writeReg(reg, v uint16) error {
+	if err := d.c.Tx([]byte{byte(reg >> 8), byte(reg), byte(v >> 8), byte(v)}, nil); err != nil {
+		return fmt.Errorf("mlx90640: writing %#04x: %w", reg, err)
+	}
+	return nil
+}
+
+// celsius converts a temperature in °C.
+func celsius(t float64) physic.Temperature {
+	return physic.ZeroCelsius + physic.Temperature(math.Round(t*float64(physic.Celsius)))
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
@@ -0,0 +1,319 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// testCtrl is the factory control register 1: chess pattern, 18 bits, 2Hz.
+const testCtrl = 0x1901
+
+// testBroken is a broken pixel of testEEPROM.
+const testBroken = 100
+
+// testEEPROM returns a calibration with the supply and PTAT parameters of the
+// example of the datasheet, uniform pixels and no drifts, so objects
+// temperatures can be simulated exactly.
+func testEEPROM() []uint16 {
+	ee := make([]uint16, eepromWords)
+	ee[16] = 0x4000 // alphaPTAT 9
+	ee[17] = 0xFFD8 // offset -40
+	ee[33] = 1074   // alpha 1e-6
+	ee[48] = 0x1900 // gain
+	ee[49] = 0x2FF1 // vPTAT25 12273
+	ee[50] = 0x0152 // ktPTAT 42.25
+	ee[51] = 0x9D68 // kVdd -3168, vdd25 -13056
+	ee[56] = 0x2000 // resolution 18 bits
+	ee[63] = 0x2494
+	for i := 0; i < Pixels; i++ {
+		ee[64+i] = 0x0010
+	}
+	ee[64+testBroken] = 0
+	return ee
+}
+
+// testRAM returns the RAM content of a scene uniformly at t°C, the broken
+// pixel reading garbage.
+func testRAM(p *params, t float64) []uint16 {
+	ram := make([]uint16, ramWords)
+	ram[ramTaVbe] = 19442
+	ram[ramTaPTAT] = 1711
+	ram[ramGain] = 0x1900
+	ram[ramVddPix] = 0xCCC5
+	s := subpage{ctrl: testCtrl}
+	copy(s.ram[:], ram)
+	vdd := p.vdd(&s)
+	ta := p.ta(&s, vdd)
+	ta4 := math.Pow(ta+kelvinZero, 4)
+	tr4 := math.Pow(ta-taShift+kelvinZero, 4)
+	taTr := tr4 - (tr4-ta4)/DefaultOpts.Emissivity
+	for i := 0; i < Pixels; i++ {
+		ir := (math.Pow(t+kelvinZero, 4) - taTr) * p.alpha[i]
+		ram[i] = uint16(int16(math.Round(ir*DefaultOpts.Emissivity + p.offset[i])))
+	}
+	ram[testBroken] = 0x7FFF
+	return ram
+}
+
+func wordsOp(reg uint16, w []uint16) i2ctest.IO {
+	b := make([]byte, 0, 2*len(w))
+	for _, v := range w {
+		b = append(b, byte(v>>8), byte(v))
+	}
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{byte(reg >> 8), byte(reg)}, R: b}
+}
+
+func writeOp(reg, v uint16) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{byte(reg >> 8), byte(reg), byte(v >> 8), byte(v)}}
+}
+
+// initOps are the bus transactions issued by New with DefaultOpts.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		wordsOp(regEEPROM, testEEPROM()),
+		wordsOp(regControl1, []uint16{testCtrl}),
+		writeOp(regControl1, testCtrl),
+	}
+}
+
+// subpageOps are the bus transactions of the read of a subpage, once
+// ready.
+func subpageOps(page uint16, ram []uint16) []i2ctest.IO {
+	return []i2ctest.IO{
+		writeOp(regStatus, statusClear),
+		wordsOp(regRAM, ram),
+		wordsOp(regStatus, []uint16{page}),
+		wordsOp(regControl1, []uint16{testCtrl}),
+	}
+}
+
+// frameOps are the bus transactions of the read of a frame of a scene at
+// 35°C.
+func frameOps() []i2ctest.IO {
+	p, err := newParams(testEEPROM())
+	if err != nil {
+		panic(err)
+	}
+	ram := testRAM(p, 35)
+	ops := append([]i2ctest.IO{wordsOp(regStatus, []uint16{0x0009})}, subpageOps(1, ram)...)
+	ops = append(ops, wordsOp(regStatus, []uint16{0x0008}))
+	return append(ops, subpageOps(0, ram)...)
+}
+
+func checkFrame(t *testing.T, f *Frame) {
+	want := physic.ZeroCelsius + 35*physic.Celsius
+	for y := range f.Pixels {
+		for x, v := range f.Pixels[y] {
+			if v < want-20*physic.MilliKelvin || v > want+20*physic.MilliKelvin {
+				t.Fatalf("pixel (%d, %d): %s, want %s", x, y, v, want)
+			}
+		}
+	}
+	if a := physic.ZeroCelsius + 39220*physic.MilliKelvin; f.Ambient < a-10*physic.MilliKelvin || f.Ambient > a+10*physic.MilliKelvin {
+		t.Fatalf("ambient %s, want %s", f.Ambient, a)
+	}
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "MLX90640{playback(51)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_rate(t *testing.T) {
+	ops := initOps()
+	ops[2] = writeOp(regControl1, 0x1B01)
+	bus := i2ctest.Playback{Ops: ops}
+	if _, err := New(&bus, Opts{Rate: 32 * physic.Hertz, Emissivity: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(initOps()); i++ {
+		bus := i2ctest.Playback{Ops: initOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestNew_bad_eeprom(t *testing.T) {
+	ee := testEEPROM()
+	ee[10] = 0x0040
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{wordsOp(regEEPROM, ee)}}
+	_, err := New(&bus, DefaultOpts)
+	if !errors.Is(err, ErrBadEEPROM) || err.Error() != "mlx90640: invalid EEPROM: not a MLX90640" {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Addr: 0x80, Rate: physic.Hertz, Emissivity: 1}, "mlx90640: invalid options: Addr 0x80, want at most 0x7f"},
+		{Opts{Rate: 3 * physic.Hertz, Emissivity: 1}, "mlx90640: invalid options: Rate 3Hz, want 500mHz, 1Hz, 2Hz, 4Hz, 8Hz, 16Hz, 32Hz or 64Hz"},
+		{Opts{Rate: physic.Hertz}, "mlx90640: invalid options: Emissivity 0, want 0.1 to 1"},
+		{Opts{Rate: physic.Hertz, Emissivity: 1.5}, "mlx90640: invalid options: Emissivity 1.5, want 0.1 to 1"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestReadFrame(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), frameOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Frame
+	if err := d.ReadFrame(&f); err != nil {
+		t.Fatal(err)
+	}
+	checkFrame(t, &f)
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFrame_fail(t *testing.T) {
+	for i := 0; i < len(frameOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), frameOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var f Frame
+		if err := d.ReadFrame(&f); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestReadFrame_not_ready(t *testing.T) {
+	ops := initOps()
+	for i := 0; i < 16; i++ {
+		ops = append(ops, wordsOp(regStatus, []uint16{0x0001}))
+	}
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Frame
+	if err := d.ReadFrame(&f); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFrame_torn(t *testing.T) {
+	ops := append(initOps(), wordsOp(regStatus, []uint16{0x0008}))
+	ram := make([]uint16, ramWords)
+	for i := 0; i < maxTornReads; i++ {
+		ops = append(ops,
+			writeOp(regStatus, statusClear),
+			wordsOp(regRAM, ram),
+			wordsOp(regStatus, []uint16{0x0009}),
+		)
+	}
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Frame
+	const want = "mlx90640: data not ready: subpages overwritten while read"
+	if err := d.ReadFrame(&f); !errors.Is(err, ErrNotReady) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFrame_repeated_subpage(t *testing.T) {
+	p, err := newParams(testEEPROM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops := initOps()
+	for i := 0; i < 4; i++ {
+		ops = append(ops, wordsOp(regStatus, []uint16{0x0008}))
+		ops = append(ops, subpageOps(0, testRAM(p, 35))...)
+	}
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f Frame
+	if err := d.ReadFrame(&f); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamFrames(t *testing.T) {
+	// The streaming stops on the failure of the read of the next frame.
+	bus := i2ctest.Playback{Ops: append(initOps(), frameOps()...), DontPanic: true}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.StreamFrames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := <-c
+	checkFrame(t, &f)
+	if err := d.ReadFrame(&f); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640
+
+import (
+	"fmt"
+	"math"
+)
+
+// eepromWords is the size of the EEPROM, at 0x2400.
+const eepromWords = 832
+
+// maxBadPixels is the maximum number of broken or outlier pixels of a
+// device, each.
+const maxBadPixels = 4
+
+// params holds the calibration parameters extracted from the EEPROM, as
+// described in section 11.1 of the datasheet.
+type params struct {
+	kVdd, vdd25             float64
+	kvPTAT, ktPTAT, vPTAT25 float64
+	alphaPTAT               float64
+	gainEE                  float64
+	tgc                     float64
+	ksTa                    float64
+	resolutionEE            int
+	ct                      [5]float64 // corner temperatures of the ranges
+	ksTo                    [5]float64
+	alpha, offset, kta, kv  [Pixels]float64
+	cpAlpha, cpOffset       [2]float64 // compensation pixels, per subpage
+	cpKta, cpKv             float64
+	calibrationModeEE       uint16
+	ilChessC                [3]float64
+	badPixels               []int // broken and outlier pixels
+}
+
+// newParams extracts the parameters from the EEPROM content.
+func newParams(ee []uint16) (*params, error) {
+	if ee[10]&0x0040 != 0 {
+		return nil, fmt.Errorf("%w: not a MLX90640", ErrBadEEPROM)
+	}
+	p := &params{}
+	p.extractVdd(ee)
+	p.extractPTAT(ee)
+	p.gainEE = float64(int16(ee[48]))
+	p.tgc = float64(signed(ee[60]&0xFF, 8)) / 32
+	p.resolutionEE = int(ee[56]&0x3000) >> 12
+	p.ksTa = float64(signed(ee[60]>>8, 8)) / 8192
+	p.extractKsTo(ee)
+	p.extractCP(ee)
+	p.extractAlpha(ee)
+	p.extractOffset(ee)
+	p.extractKta(ee)
+	p.extractKv(ee)
+	p.extractCILC(ee)
+	if err := p.extractBadPixels(ee); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *params) extractVdd(ee []uint16) {
+	p.kVdd = float64(signed(ee[51]>>8, 8) * 32)
+	p.vdd25 = float64((int(ee[51]&0xFF)-256)<<5 - 8192)
+}
+
+func (p *params) extractPTAT(ee []uint16) {
+	p.kvPTAT = float64(signed(ee[50]>>10, 6)) / 4096
+	p.ktPTAT = float64(signed(ee[50]&0x03FF, 10)) / 8
+	p.vPTAT25 = float64(int16(ee[49]))
+	p.alphaPTAT = float64(ee[16]>>12)/4 + 8
+}
+
+func (p *params) extractKsTo(ee []uint16) {
+	step := float64((ee[63]&0x3000)>>12) * 10
+	p.ct[0] = -40
+	p.ct[1] = 0
+	p.ct[2] = float64((ee[63]&0x00F0)>>4) * step
+	p.ct[3] = p.ct[2] + float64((ee[63]&0x0F00)>>8)*step
+	p.ct[4] = 400
+	scale := pow2(int(ee[63]&0x000F) + 8)
+	p.ksTo[0] = float64(signed(ee[61]&0xFF, 8)) / scale
+	p.ksTo[1] = float64(signed(ee[61]>>8, 8)) / scale
+	p.ksTo[2] = float64(signed(ee[62]&0xFF, 8)) / scale
+	p.ksTo[3] = float64(signed(ee[62]>>8, 8)) / scale
+	p.ksTo[4] = -0.0002
+}
+
+func (p *params) extractCP(ee []uint16) {
+	alphaScale := pow2(int(ee[32]>>12) + 27)
+	p.cpOffset[0] = float64(signed(ee[58]&0x03FF, 10))
+	p.cpOffset[1] = float64(signed(ee[58]>>10, 6)) + p.cpOffset[0]
+	p.cpAlpha[0] = float64(signed(ee[57]&0x03FF, 10)) / alphaScale
+	p.cpAlpha[1] = (1 + float64(signed(ee[57]>>10, 6))/128) * p.cpAlpha[0]
+	p.cpKta = float64(signed(ee[59]&0xFF, 8)) / pow2(int(ee[56]&0x00F0)>>4+8)
+	p.cpKv = float64(signed(ee[59]>>8, 8)) / pow2(int(ee[56]&0x0F00)>>8)
+}
+
+func (p *params) extractAlpha(ee []uint16) {
+	remScale := ee[32] & 0x000F
+	colScale := (ee[32] & 0x00F0) >> 4
+	rowScale := (ee[32] & 0x0F00) >> 8
+	alphaScale := pow2(int(ee[32]>>12) + 30)
+	alphaRef := int(ee[33])
+	rows, cols := nibbles(ee[34:40]), nibbles(ee[40:48])
+	for i := 0; i < Pixels; i++ {
+		a := signed((ee[64+i]&0x03F0)>>4, 6) << remScale
+		a += alphaRef + rows[i/Width]<<rowScale + cols[i%Width]<<colScale
+		p.alpha[i] = float64(a) / alphaScale
+	}
+}
+
+func (p *params) extractOffset(ee []uint16) {
+	remScale := ee[16] & 0x000F
+	colScale := (ee[16] & 0x00F0) >> 4
+	rowScale := (ee[16] & 0x0F00) >> 8
+	offsetRef := int(int16(ee[17]))
+	rows, cols := nibbles(ee[18:24]), nibbles(ee[24:32])
+	for i := 0; i < Pixels; i++ {
+		o := signed(ee[64+i]>>10, 6) << remScale
+		p.offset[i] = float64(offsetRef + rows[i/Width]<<rowScale + cols[i%Width]<<colScale + o)
+	}
+}
+
+func (p *params) extractKta(ee []uint16) {
+	// Indexed by split: odd or even row and column, counting from 1.
+	ktaRC := [4]int{
+		signed(ee[54]>>8, 8),   // odd row, odd column
+		signed(ee[55]>>8, 8),   // odd row, even column
+		signed(ee[54]&0xFF, 8), // even row, odd column
+		signed(ee[55]&0xFF, 8), // even row, even column
+	}
+	scale1 := pow2(int(ee[56]&0x00F0)>>4 + 8)
+	scale2 := ee[56] & 0x000F
+	for i := 0; i < Pixels; i++ {
+		k := signed((ee[64+i]&0x000E)>>1, 3) << scale2
+		p.kta[i] = float64(ktaRC[split(i)]+k) / scale1
+	}
+}
+
+func (p *params) extractKv(ee []uint16) {
+	kvT := [4]int{
+		signed(ee[52]>>12, 4),
+		signed((ee[52]&0x00F0)>>4, 4),
+		signed((ee[52]&0x0F00)>>8, 4),
+		signed(ee[52]&0x000F, 4),
+	}
+	scale := pow2(int(ee[56]&0x0F00) >> 8)
+	for i := 0; i < Pixels; i++ {
+		p.kv[i] = float64(kvT[split(i)]) / scale
+	}
+}
+
+func (p *params) extractCILC(ee []uint16) {
+	p.calibrationModeEE = (ee[10]&0x0800)>>4 ^ 0x80
+	p.ilChessC[0] = float64(signed(ee[53]&0x003F, 6)) / 16
+	p.ilChessC[1] = float64(signed((ee[53]&0x07C0)>>6, 5)) / 2
+	p.ilChessC[2] = float64(signed(ee[53]>>11, 5)) / 8
+}
+
+// extractBadPixels lists the broken pixels, whose calibration is 0, and the
+// outliers, flagged in the calibration.
+func (p *params) extractBadPixels(ee []uint16) error {
+	broken, outliers := 0, 0
+	for i := 0; i < Pixels; i++ {
+		switch {
+		case ee[64+i] == 0:
+			broken++
+		case ee[64+i]&1 != 0:
+			outliers++
+		default:
+			continue
+		}
+		p.badPixels = append(p.badPixels, i)
+	}
+	if broken > maxBadPixels || outliers > maxBadPixels {
+		return fmt.Errorf("%w: %d broken and %d outlier pixels, want at most %d each", ErrBadEEPROM, broken, outliers, maxBadPixels)
+	}
+	return nil
+}
+
+// split returns the index of the row and column parity of pixel i.
+func split(i int) int {
+	return 2*(i/Width%2) + i%2
+}
+
+// nibbles returns the signed nibbles of w, LSB first.
+func nibbles(w []uint16) []int {
+	n := make([]int, 0, 4*len(w))
+	for _, v := range w {
+		for s := 0; s < 16; s += 4 {
+			n = append(n, signed(v>>s&0xF, 4))
+		}
+	}
+	return n
+}
+
+// signed returns the two's complement value of the low bits of v.
+func signed(v uint16, bits uint) int {
+	return int(int16(v<<(16-bits)) >> (16 - bits))
+}
+
+// pow2 returns 2^n.
+func pow2(n int) float64 {
+	return math.Ldexp(1, n)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mlx90640
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+func TestNewParams(t *testing.T) {
+	ee := testEEPROM()
+	ee[10] = 0x0800 // calibrated in interleaved mode
+	ee[32] = 0x1321 // alpha scales: 31, row 3, column 2, remainder 1
+	ee[34] = 0x000F // row 0: -1
+	ee[40] = 0x0070 // column 1: 7
+	ee[52] = 0x12F4 // kv
+	ee[53] = 0x8FFF // ilChessC
+	ee[54] = 0x7F80 // kta odd row: odd column 127, even row: odd column -128
+	ee[55] = 0x0102
+	ee[56] = 0x2512 // resolution 2, kvScale 5, ktaScale1 9, ktaScale2 2
+	ee[57] = 0x0402 // cpAlpha
+	ee[58] = 0xFC05 // cpOffset
+	ee[59] = 0xFF80 // cpKv, cpKta
+	ee[60] = 0xF020 // ksTa, tgc
+	ee[61] = 0x9701
+	ee[62] = 0x7F80
+	ee[64+33] = 0x07F3 // pixel (1, 1): offset 1, alpha -1, kta 1, outlier
+	p, err := newParams(ee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []struct {
+		name      string
+		got, want float64
+	}{
+		{"kVdd", p.kVdd, -3168},
+		{"vdd25", p.vdd25, -13056},
+		{"kvPTAT", p.kvPTAT, 0},
+		{"ktPTAT", p.ktPTAT, 42.25},
+		{"vPTAT25", p.vPTAT25, 12273},
+		{"alphaPTAT", p.alphaPTAT, 9},
+		{"gainEE", p.gainEE, 6400},
+		{"tgc", p.tgc, 1},
+		{"ksTa", p.ksTa, -16. / 8192},
+		{"ct[2]", p.ct[2], 180},
+		{"ct[3]", p.ct[3], 260},
+		{"ksTo[0]", p.ksTo[0], 1. / 4096},
+		{"ksTo[1]", p.ksTo[1], -105. / 4096},
+		{"ksTo[2]", p.ksTo[2], -128. / 4096},
+		{"ksTo[3]", p.ksTo[3], 127. / 4096},
+		{"ksTo[4]", p.ksTo[4], -0.0002},
+		{"cpOffset[0]", p.cpOffset[0], 5},
+		{"cpOffset[1]", p.cpOffset[1], 4},
+		{"cpAlpha[0]", p.cpAlpha[0], 2 / math.Ldexp(1, 28)},
+		{"cpAlpha[1]", p.cpAlpha[1], (1 + 1./128) * 2 / math.Ldexp(1, 28)},
+		{"cpKta", p.cpKta, -128. / 512},
+		{"cpKv", p.cpKv, -1. / 32},
+		{"ilChessC[0]", p.ilChessC[0], -1. / 16},
+		{"ilChessC[1]", p.ilChessC[1], -1. / 2},
+		{"ilChessC[2]", p.ilChessC[2], -15. / 8},
+		// Pixel (0, 0): row -1 << 3, column 0, remainder 1 << 1.
+		{"alpha[0]", p.alpha[0], (1074 - 8 + 2) / math.Ldexp(1, 31)},
+		{"offset[0]", p.offset[0], -40},
+		{"kta[0]", p.kta[0], 127. / 512},
+		{"kv[0]", p.kv[0], 1. / 32},
+		// Pixel (1, 1): row 0, column 7 << 2, remainder -1 << 1.
+		{"alpha[33]", p.alpha[33], (1074 + 28 - 2) / math.Ldexp(1, 31)},
+		{"offset[33]", p.offset[33], -40 + 1},
+		{"kta[33]", p.kta[33], (2 + 1<<2) / 512.},
+		{"kv[33]", p.kv[33], 4. / 32},
+	}
+	for _, line := range data {
+		if !near(line.got, line.want) {
+			t.Errorf("%s: %g, want %g", line.name, line.got, line.want)
+		}
+	}
+	if p.resolutionEE != 2 {
+		t.Error(p.resolutionEE)
+	}
+	if p.calibrationModeEE != 0 {
+		t.Error(p.calibrationModeEE)
+	}
+	if len(p.badPixels) != 2 || p.badPixels[0] != 33 || p.badPixels[1] != testBroken {
+		t.Error(p.badPixels)
+	}
+}
+
+func TestNewParams_bad_pixels(t *testing.T) {
+	ee := testEEPROM()
+	for i := 0; i < maxBadPixels; i++ {
+		ee[64+i] = 0
+	}
+	const want = "mlx90640: invalid EEPROM: 5 broken and 0 outlier pixels, want at most 4 each"
+	if _, err := newParams(ee); !errors.Is(err, ErrBadEEPROM) || err.Error() != want {
+		t.Fatalf("%v, want %q", err, want)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	for i, want := range map[int]int{0: 0, 1: 1, 32: 2, 33: 3, 64: 0, 767: 3} {
+		if s := split(i); s != want {
+			t.Errorf("split(%d) = %d, want %d", i, s, want)
+		}
+	}
+}
+
+func TestSigned(t *testing.T) {
+	data := []struct {
+		v    uint16
+		bits uint
+		want int
+	}{
+		{0x7, 4, 7},
+		{0x8, 4, -8},
+		{0xF, 4, -1},
+		{0x1F, 6, 31},
+		{0x20, 6, -32},
+		{0x3FF, 10, -1},
+		{0x8000, 16, -32768},
+	}
+	for _, line := range data {
+		if s := signed(line.v, line.bits); s != line.want {
+			t.Errorf("signed(%#x, %d) = %d, want %d", line.v, line.bits, s, line.want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mmc5983ma controls a MEMSIC MMC5983MA 3-axis magnetometer over I²C.
+//
+// # More details
+//
+// The MMC5983MA is an anisotropic magneto-resistive sensor with an 18 bits
+// output over ±8 Gauss, 0.4mG noise and an integrated SET/RESET coil. Pulsing
+// the coil degausses the sensing elements and flips their polarity: the
+// difference of a measurement after SET and one after RESET cancels the
+// bridge offset and its temperature drift, see CalibrateOffset. The chip can
+// also pulse SET itself before measurements, see Opts.AutoSetReset and
+// Opts.PeriodicSet.
+//
+// SenseRaw returns the counts, at 16384 per Gauss, that is 6.1nT, and Sense
+// returns µT×10.
+//
+// # Datasheet
+//
+// https://www.memsic.com/Public/Uploads/uploadfile/files/20220119/MMC5983MADatasheetRevA.pdf
+package mmc5983ma
@@ -0,0 +1,45 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mmc5983ma_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/mmc5983ma"
+	"periph.io/x/devices/v3/sensor"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := mmc5983ma.New(bus, mmc5983ma.Opts{ODRHz: 50, AutoSetReset: true, PeriodicSet: 100})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	// Cancel the bridge offset with a SET/RESET pair.
+	if _, err := d.CalibrateOffset(); err != nil {
+		log.Fatal(err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(f)
+}
@@ -0,0 +1,473 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mmc5983ma
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address of the MMC5983MA, which can't be changed.
+const DefaultAddr = 0x30
+
+// Register map. The control registers are write only and their command bits
+// clear themselves.
+const (
+	regXOUT0   = 0x00 // X[17:10], X[9:2], Y[17:10], Y[9:2], Z[17:10], Z[9:2], XYZ[1:0]
+	regTOUT    = 0x07
+	regSTATUS  = 0x08
+	regCTRL0   = 0x09
+	regCTRL1   = 0x0A // BW bits 1..0, SW_RST bit 7
+	regCTRL2   = 0x0B // CM_freq bits 2..0, Cmm_en bit 3, Prd_set bits 6..4, En_prd_set bit 7
+	regPRODUCT = 0x2F
+)
+
+// productID is the value of the product ID register.
+const productID = 0x30
+
+// Register bits.
+const (
+	statusMeasMDone = 0x01
+	statusMeasTDone = 0x02
+
+	ctrl0TMM      = 0x01 // take a magnetic measurement
+	ctrl0TMT      = 0x02 // take a temperature measurement
+	ctrl0Set      = 0x08
+	ctrl0Reset    = 0x10
+	ctrl0AutoSR   = 0x20
+	ctrl1SWReset  = 0x80
+	ctrl2CmmEn    = 0x08
+	ctrl2EnPrdSet = 0x80
+)
+
+// Timings from the datasheet, rounded up.
+const (
+	resetTime    = 10 * time.Millisecond // power on time after a software reset
+	setResetTime = time.Millisecond      // the SET and RESET pulses last 500ns
+	tempTime     = 2 * time.Millisecond
+)
+
+// zeroCount is the 18 bits output for no field; the output is offset binary.
+const zeroCount = 1 << 17
+
+// countsPerGauss is the sensitivity of the 18 bits output.
+const countsPerGauss = 16384
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the product ID register doesn't read
+	// 0x30.
+	ErrBadID = errors.New("mmc5983ma: bad product ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("mmc5983ma: device halted")
+	// ErrNotReady is returned when a measurement didn't complete in time.
+	ErrNotReady = errors.New("mmc5983ma: measurement not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("mmc5983ma: invalid options")
+)
+
+// bandwidths are the filter bandwidths selected by the BW bits of CTRL1, with
+// the duration of a measurement.
+var bandwidths = [...]struct {
+	hz   int
+	meas time.Duration
+}{
+	{100, 8 * time.Millisecond},
+	{200, 4 * time.Millisecond},
+	{400, 2 * time.Millisecond},
+	{800, 500 * time.Microsecond},
+}
+
+// odrs are the continuous measurement rates selected by the CM_freq bits of
+// CTRL2, from 1.
+var odrs = [...]int{1, 10, 20, 50, 100, 200, 1000}
+
+// periodicSets are the measurement counts between SET pulses selected by the
+// Prd_set bits of CTRL2.
+var periodicSets = [...]int{1, 25, 75, 100, 250, 500, 1000, 2000}
+
+// Opts holds initialization options.
+//
+// BandwidthHz: filter bandwidth, 100 (default), 200, 400 or 800Hz. Higher
+// bandwidths shorten the measurement and increase the noise.
+// ODRHz: continuous measurement rate, 1, 10, 20, 50, 100, 200 or 1000Hz. 200Hz
+// requires a BandwidthHz of at least 200 and 1000Hz one of 800. 0 (default)
+// takes a measurement on demand in each Sense call.
+// AutoSetReset: let the chip pulse SET before each measurement, see
+// PeriodicSet.
+// PeriodicSet: in continuous mode with AutoSetReset, pulse SET only every
+// this many measurements, 1, 25, 75, 100, 250, 500, 1000 or 2000, to save
+// power. 0 (default) pulses before each measurement.
+type Opts struct {
+	BandwidthHz  int
+	ODRHz        int
+	AutoSetReset bool
+	PeriodicSet  int
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	bw := o.bandwidth()
+	if bw < 0 {
+		return fmt.Errorf("%w: BandwidthHz %d, want 100, 200, 400 or 800", ErrInvalidOpts, o.BandwidthHz)
+	}
+	if o.ODRHz != 0 && o.odr() == 0 {
+		return fmt.Errorf("%w: ODRHz %d, want 1, 10, 20, 50, 100, 200 or 1000", ErrInvalidOpts, o.ODRHz)
+	}
+	if (o.ODRHz == 200 && bw < 1) || (o.ODRHz == 1000 && bw < 3) {
+		return fmt.Errorf("%w: ODRHz %d requires a higher BandwidthHz than %d", ErrInvalidOpts, o.ODRHz, bandwidths[bw].hz)
+	}
+	if o.PeriodicSet != 0 {
+		if o.prdSet() < 0 {
+			return fmt.Errorf("%w: PeriodicSet %d, want 1, 25, 75, 100, 250, 500, 1000 or 2000", ErrInvalidOpts, o.PeriodicSet)
+		}
+		if o.ODRHz == 0 || !o.AutoSetReset {
+			return fmt.Errorf("%w: PeriodicSet requires ODRHz and AutoSetReset", ErrInvalidOpts)
+		}
+	}
+	return nil
+}
+
+// bandwidth returns the BW bits, or -1.
+func (o *Opts) bandwidth() int {
+	if o.BandwidthHz == 0 {
+		return 0
+	}
+	for i, b := range bandwidths {
+		if b.hz == o.BandwidthHz {
+			return i
+		}
+	}
+	return -1
+}
+
+// odr returns the CM_freq bits, 0 when off or unsupported.
+func (o *Opts) odr() byte {
+	for i, f := range odrs {
+		if f == o.ODRHz {
+			return byte(i + 1)
+		}
+	}
+	return 0
+}
+
+// prdSet returns the Prd_set bits, or -1.
+func (o *Opts) prdSet() int {
+	for i, n := range periodicSets {
+		if n == o.PeriodicSet {
+			return i
+		}
+	}
+	return -1
+}
+
+// Dev represents an MMC5983MA device.
+// Sense returns X,Y,Z values in µT×10.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c      conn.Conn
+	opts   Opts
+	ctrl0  byte // CTRL0 bits kept with every command
+	ctrl2  byte
+	meas   time.Duration
+	offset [3]int32 // bridge offset in counts, see CalibrateOffset
+	halted bool
+	data   [7]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets the device on an I²C bus and configures it.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	bw := opts.bandwidth()
+	d := &Dev{c: &i2c.Dev{Addr: DefaultAddr, Bus: bus}, opts: opts, meas: bandwidths[bw].meas}
+	if opts.AutoSetReset {
+		d.ctrl0 = ctrl0AutoSR
+	}
+	if f := opts.odr(); f != 0 {
+		d.ctrl2 = f | ctrl2CmmEn
+		if opts.PeriodicSet != 0 {
+			d.ctrl2 |= byte(opts.prdSet())<<4 | ctrl2EnPrdSet
+		}
+	}
+	id, err := d.readReg(regPRODUCT)
+	if err != nil {
+		return nil, err
+	}
+	if id != productID {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, productID)
+	}
+	if err := d.writeReg(regCTRL1, ctrl1SWReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// CTRL0 to CTRL2 in one transaction; CTRL2 starts continuous mode.
+	if err := d.writeRegs(regCTRL0, d.ctrl0, byte(bw), d.ctrl2); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	if d.opts.ODRHz == 0 {
+		return fmt.Sprintf("MMC5983MA{%s, on demand}", d.c)
+	}
+	return fmt.Sprintf("MMC5983MA{%s, %dHz}", d.c, d.opts.ODRHz)
+}
+
+// Halt stops SenseMagneticContinuous and continuous measurements. It
+// implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeReg(regCTRL2, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns X,Y,Z as signed 18 bits counts, at 16384 counts/Gauss.
+//
+// It takes a measurement when ODRHz is 0, otherwise it reads the latest one.
+// The offset measured by CalibrateOffset isn't subtracted.
+func (d *Dev) SenseRaw() (int32, int32, int32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	return c[0], c[1], c[2], err
+}
+
+func (d *Dev) senseRaw() ([3]int32, error) {
+	if d.halted {
+		return [3]int32{}, ErrHalted
+	}
+	if d.ctrl2&ctrl2CmmEn == 0 {
+		return d.measure()
+	}
+	return d.readData()
+}
+
+// measure takes one magnetic measurement on demand.
+func (d *Dev) measure() ([3]int32, error) {
+	if err := d.writeReg(regCTRL0, d.ctrl0|ctrl0TMM); err != nil {
+		return [3]int32{}, err
+	}
+	if err := d.waitStatus(statusMeasMDone, d.meas); err != nil {
+		return [3]int32{}, err
+	}
+	return d.readData()
+}
+
+// readData reads the output registers.
+func (d *Dev) readData() ([3]int32, error) {
+	if err := d.readRegBlock(regXOUT0, d.data[:]); err != nil {
+		return [3]int32{}, err
+	}
+	b := d.data
+	return [3]int32{
+		int32(b[0])<<10 | int32(b[1])<<2 | int32(b[6]>>6&3) - zeroCount,
+		int32(b[2])<<10 | int32(b[3])<<2 | int32(b[6]>>4&3) - zeroCount,
+		int32(b[4])<<10 | int32(b[5])<<2 | int32(b[6]>>2&3) - zeroCount,
+	}, nil
+}
+
+// waitStatus waits delay, then polls STATUS until bit is set, a few times.
+func (d *Dev) waitStatus(bit byte, delay time.Duration) error {
+	doSleep(delay)
+	for i := 0; i < 10; i++ {
+		s, err := d.readReg(regSTATUS)
+		if err != nil {
+			return err
+		}
+		if s&bit != 0 {
+			return nil
+		}
+		doSleep(delay / 4)
+	}
+	return ErrNotReady
+}
+
+// Sense reads and scales to µT×10 (int16) for X,Y,Z, subtracting the offset
+// measured by CalibrateOffset.
+//
+// The values are truncated to 0.1µT; use SenseMagnetic for full resolution.
+func (d *Dev) Sense() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// µT×10 = counts / counts_per_Gauss * 100 * 10
+	var v [3]int16
+	for i := range c {
+		v[i] = int16((c[i] - d.offset[i]) * 1000 / countsPerGauss)
+	}
+	return v[0], v[1], v[2], nil
+}
+
+// SenseMagnetic reads a measurement, subtracting the offset measured by
+// CalibrateOffset. It implements sensor.Magnetometer.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	if err != nil {
+		return err
+	}
+	f.X = toFlux(c[0] - d.offset[0])
+	f.Y = toFlux(c[1] - d.offset[1])
+	f.Z = toFlux(c[2] - d.offset[2])
+	return nil
+}
+
+// toFlux converts counts to flux density, rounded down to the nT.
+func toFlux(c int32) physic.MagneticFluxDensity {
+	// 1 Gauss = 100000nT.
+	return physic.MagneticFluxDensity(int64(c) * 100000 / countsPerGauss)
+}
+
+// SenseMagneticContinuous returns a channel delivering measurements every
+// interval, until Halt is called. It implements sensor.Magnetometer.
+//
+// An interval of 0 or less uses ODRHz, or the measurement time when
+// measuring on demand. Calling SenseMagneticContinuous again stops the
+// previous channel.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = d.meas
+		if d.opts.ODRHz != 0 {
+			interval = time.Second / time.Duration(d.opts.ODRHz)
+		}
+	}
+	c := make(chan sensor.Field)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- sensor.Field) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var f sensor.Field
+		if err := d.SenseMagnetic(&f); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- f:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseMagneticContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// Temperature takes a temperature measurement.
+//
+// The datasheet specifies about 0.8°C/LSB from -75°C, unsigned, and doesn't
+// guarantee the accuracy.
+func (d *Dev) Temperature() (physic.Temperature, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return 0, ErrHalted
+	}
+	if err := d.writeReg(regCTRL0, d.ctrl0|ctrl0TMT); err != nil {
+		return 0, err
+	}
+	if err := d.waitStatus(statusMeasTDone, tempTime); err != nil {
+		return 0, err
+	}
+	raw, err := d.readReg(regTOUT)
+	if err != nil {
+		return 0, err
+	}
+	return physic.ZeroCelsius - 75*physic.Kelvin + physic.Temperature(raw)*800*physic.MilliKelvin, nil
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if err := d.c.Tx([]byte{addr}, out); err != nil {
+		return fmt.Errorf("mmc5983ma: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+func (d *Dev) writeReg(addr, v byte) error {
+	return d.writeRegs(addr, v)
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, vals ...byte) error {
+	if err := d.c.Tx(append([]byte{addr}, vals...), nil); err != nil {
+		return fmt.Errorf("mmc5983ma: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
@@ -0,0 +1,229 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mmc5983ma
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given CTRL0 to
+// CTRL2.
+func initOps(ctrl0, ctrl1, ctrl2 byte) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regPRODUCT}, R: []byte{productID}},
+		{Addr: DefaultAddr, W: []byte{regCTRL1, ctrl1SWReset}},
+		{Addr: DefaultAddr, W: []byte{regCTRL0, ctrl0, ctrl1, ctrl2}},
+	}
+}
+
+// dataOp returns a read of the output registers for the signed counts.
+func dataOp(x, y, z int32) i2ctest.IO {
+	u := [3]uint32{uint32(x + zeroCount), uint32(y + zeroCount), uint32(z + zeroCount)}
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regXOUT0}, R: []byte{
+		byte(u[0] >> 10), byte(u[0] >> 2), byte(u[1] >> 10), byte(u[1] >> 2), byte(u[2] >> 10), byte(u[2] >> 2),
+		byte(u[0]&3<<6 | u[1]&3<<4 | u[2]&3<<2),
+	}}
+}
+
+// measureOps are the bus transactions of an on demand measurement.
+func measureOps(ctrl0 byte, x, y, z int32) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regCTRL0, ctrl0 | ctrl0TMM}},
+		{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{0x00}},
+		{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{statusMeasMDone}},
+		dataOp(x, y, z),
+	}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts                Opts
+		ctrl0, ctrl1, ctrl2 byte
+		s                   string
+	}{
+		{Opts{}, 0x00, 0x00, 0x00, "MMC5983MA{playback(48), on demand}"},
+		{Opts{BandwidthHz: 800, ODRHz: 1000}, 0x00, 0x03, 0x0F, "MMC5983MA{playback(48), 1000Hz}"},
+		{Opts{ODRHz: 50, AutoSetReset: true, PeriodicSet: 100}, 0x20, 0x00, 0xBC, "MMC5983MA{playback(48), 50Hz}"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.ctrl0, line.ctrl1, line.ctrl2)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regPRODUCT}, R: []byte{0x00}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{BandwidthHz: 200, ODRHz: 200}, ""},
+		{Opts{BandwidthHz: 300}, "mmc5983ma: invalid options: BandwidthHz 300, want 100, 200, 400 or 800"},
+		{Opts{ODRHz: 30}, "mmc5983ma: invalid options: ODRHz 30, want 1, 10, 20, 50, 100, 200 or 1000"},
+		{Opts{BandwidthHz: 400, ODRHz: 1000}, "mmc5983ma: invalid options: ODRHz 1000 requires a higher BandwidthHz than 400"},
+		{Opts{ODRHz: 10, AutoSetReset: true, PeriodicSet: 3}, "mmc5983ma: invalid options: PeriodicSet 3, want 1, 25, 75, 100, 250, 500, 1000 or 2000"},
+		{Opts{AutoSetReset: true, PeriodicSet: 25}, "mmc5983ma: invalid options: PeriodicSet requires ODRHz and AutoSetReset"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{ODRHz: 30}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := initOps(0, 0, 0)
+	ops = append(ops, measureOps(0, 8192, -16384, 131071)...)
+	ops = append(ops, measureOps(0, 8192, -16384, -131072)...)
+	ops = append(ops, measureOps(0, 1, -2, 3)...)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 16384 counts/Gauss.
+	if x, y, z, err := d.Sense(); err != nil || x != 500 || y != -1000 || z != 7999 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := (sensor.Field{X: 50 * physic.MicroTesla, Y: -100 * physic.MicroTesla, Z: -800 * physic.MicroTesla}); f != want {
+		t.Fatalf("SenseMagnetic() = %s, want %s", f, want)
+	}
+	if x, y, z, err := d.SenseRaw(); err != nil || x != 1 || y != -2 || z != 3 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_NotReady(t *testing.T) {
+	ops := append(initOps(0, 0, 0), i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL0, ctrl0TMM}})
+	for i := 0; i < 10; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{0x00}})
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrNotReady {
+		t.Fatalf("SenseRaw() = %v, want ErrNotReady", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	ops := append(initOps(0, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL0, ctrl0TMT}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{statusMeasTDone}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regTOUT}, R: []byte{125}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 125 LSB at 0.8°C/LSB from -75°C.
+	if temp, err := d.Temperature(); err != nil || temp != physic.ZeroCelsius+25*physic.Kelvin {
+		t.Fatalf("Temperature() = %s, %v", temp, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	ops := append(initOps(0, 0, 0x0D),
+		dataOp(16384, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL2, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := <-c; f.X != 100*physic.MicroTesla {
+		t.Fatalf("got %s", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrHalted {
+		t.Fatalf("SenseRaw() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseMagneticContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseMagneticContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(0, 0, 0), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.Temperature(); err == nil {
+		t.Fatal("expected error")
+	}
+}
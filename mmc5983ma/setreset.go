@@ -0,0 +1,99 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mmc5983ma
+
+import "periph.io/x/devices/v3/sensor"
+
+// Set pulses the SET coil, which magnetizes the sensing elements in the
+// positive direction and clears the effect of a strong field.
+func (d *Dev) Set() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.pulse(ctrl0Set)
+}
+
+// Reset pulses the RESET coil, which magnetizes the sensing elements in the
+// negative direction. The following measurements are inverted, plus the
+// offset, until the next SET.
+func (d *Dev) Reset() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.pulse(ctrl0Reset)
+}
+
+func (d *Dev) pulse(bit byte) error {
+	if d.halted {
+		return ErrHalted
+	}
+	if err := d.writeReg(regCTRL0, d.ctrl0|bit); err != nil {
+		return err
+	}
+	doSleep(setResetTime)
+	return nil
+}
+
+// CalibrateOffset measures the offset of the bridges with a SET and a RESET
+// measurement, and subtracts it from the following Sense and SenseMagnetic
+// results.
+//
+// A measurement after SET reads field+offset and one after RESET reads
+// -field+offset, so their half sum is the offset. It drifts with the
+// temperature; calibrate again when it changes. Continuous measurements are
+// paused meanwhile, and the elements are left SET. The offset is returned as a
+// field.
+func (d *Dev) CalibrateOffset() (sensor.Field, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return sensor.Field{}, ErrHalted
+	}
+	if d.ctrl2&ctrl2CmmEn != 0 {
+		if err := d.writeReg(regCTRL2, 0); err != nil {
+			return sensor.Field{}, err
+		}
+	}
+	off, err := d.measureOffset()
+	if d.ctrl2&ctrl2CmmEn != 0 {
+		if err2 := d.writeReg(regCTRL2, d.ctrl2); err == nil {
+			err = err2
+		}
+	}
+	if err != nil {
+		return sensor.Field{}, err
+	}
+	d.offset = off
+	return sensor.Field{X: toFlux(off[0]), Y: toFlux(off[1]), Z: toFlux(off[2])}, nil
+}
+
+// measureOffset returns the half sum of a RESET and a SET measurement, in
+// counts.
+func (d *Dev) measureOffset() ([3]int32, error) {
+	if err := d.pulse(ctrl0Reset); err != nil {
+		return [3]int32{}, err
+	}
+	neg, err := d.measure()
+	if err != nil {
+		return [3]int32{}, err
+	}
+	if err := d.pulse(ctrl0Set); err != nil {
+		return [3]int32{}, err
+	}
+	pos, err := d.measure()
+	if err != nil {
+		return [3]int32{}, err
+	}
+	var off [3]int32
+	for i := range off {
+		off[i] = (pos[i] + neg[i]) / 2
+	}
+	return off, nil
+}
+
+// Offset returns the offset measured by CalibrateOffset, zero before.
+func (d *Dev) Offset() sensor.Field {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return sensor.Field{X: toFlux(d.offset[0]), Y: toFlux(d.offset[1]), Z: toFlux(d.offset[2])}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mmc5983ma
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func TestCalibrateOffset(t *testing.T) {
+	// A field of 8192 counts with an offset of 100 counts on X.
+	ops := append(initOps(0x20, 0, 0x0D),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL2, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL0, 0x20 | ctrl0Reset}},
+	)
+	ops = append(ops, measureOps(0x20, -8192+100, 0, 0)...)
+	ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL0, 0x20 | ctrl0Set}})
+	ops = append(ops, measureOps(0x20, 8192+100, 0, 0)...)
+	ops = append(ops,
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL2, 0x0D}},
+		dataOp(8192+100, 0, 0),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 100, AutoSetReset: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	off, err := d.CalibrateOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if off.X != 610*physic.NanoTesla || d.Offset() != off {
+		t.Fatalf("CalibrateOffset() = %s", off)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil || f.X != 50*physic.MicroTesla {
+		t.Fatalf("SenseMagnetic() = %s, %v", f, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetReset(t *testing.T) {
+	ops := append(initOps(0, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL0, ctrl0Set}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL0, ctrl0Reset}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL2, 0}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set(); err != ErrHalted {
+		t.Fatalf("Set() = %v, want ErrHalted", err)
+	}
+	if _, err := d.CalibrateOffset(); err != ErrHalted {
+		t.Fatalf("CalibrateOffset() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
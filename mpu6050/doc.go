@@ -0,0 +1,31 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mpu6050 controls an InvenSense MPU-6050 3-axis accelerometer and
+// 3-axis gyroscope over I²C.
+//
+// # More details
+//
+// The accelerometer measures ±2, ±4, ±8 or ±16g and the gyroscope ±250,
+// ±500, ±1000 or ±2000°/s. Both go through the digital low pass filter
+// selected by Opts.DLPF, then are sampled at the gyroscope output rate, 8kHz
+// without filter and 1kHz otherwise, divided by 1 + Opts.SampleRateDivider.
+//
+// Sense returns the latest sample, scaled to m/s² and rad/s, along with the
+// raw counts, like the Sample of the hmc5983 package. SenseContinuous streams
+// every sample, paced by the data ready interrupt, on the INT pin when
+// Opts.INT is set or polled otherwise.
+//
+// With Opts.FIFO, the accelerometer and gyroscope samples are also queued in
+// the 1024 bytes FIFO of the device, which ReadFIFO drains in batches. This
+// keeps every sample at high rates without reading each one in time.
+//
+// The digital motion processor and the auxiliary I²C bus are not supported.
+//
+// # Datasheet
+//
+// https://invensense.tdk.com/wp-content/uploads/2015/02/MPU-6000-Datasheet1.pdf
+//
+// https://invensense.tdk.com/wp-content/uploads/2015/02/MPU-6000-Register-Map1.pdf
+package mpu6050
@@ -0,0 +1,74 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/mpu6050"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := mpu6050.New(bus, mpu6050.Opts{AccelRange: 4, GyroRange: 500, DLPF: 3})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	var s mpu6050.Sample
+	if err := d.Sense(&s); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(s, s.Temperature)
+}
+
+func ExampleDev_ReadFIFO() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// 1kHz / (1 + 4) = 200Hz.
+	d, err := mpu6050.New(bus, mpu6050.Opts{DLPF: 2, SampleRateDivider: 4, FIFO: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	s := make([]mpu6050.Sample, 64)
+	for i := 0; i < 10; i++ {
+		time.Sleep(100 * time.Millisecond)
+		n, err := d.ReadFIFO(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, v := range s[:n] {
+			fmt.Println(v)
+		}
+	}
+}
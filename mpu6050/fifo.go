@@ -0,0 +1,95 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050
+
+import (
+	"errors"
+	"time"
+)
+
+// FIFOLen returns the number of complete samples queued in the FIFO.
+func (d *Dev) FIFOLen() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	return d.fifoLen()
+}
+
+// ReadFIFO reads up to len(s) samples from the FIFO, oldest first, and
+// returns the number read. It requires Opts.FIFO.
+//
+// The samples have no temperature. Their timestamps are estimated from the
+// sample rate, the newest one being the time of the read.
+//
+// When the FIFO overflowed since the last call, it is reset and ReadFIFO
+// returns ErrFIFOOverflow instead, as the oldest samples were lost.
+func (d *Dev) ReadFIFO(s []Sample) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return 0, err
+	}
+	if _, err := d.interruptStatus(); err != nil {
+		return 0, err
+	}
+	if d.overflow {
+		d.overflow = false
+		if err := d.writeRegs(regUSERCTRL, userFIFOEN|userFIFOReset); err != nil {
+			return 0, err
+		}
+		return 0, ErrFIFOOverflow
+	}
+	n, err := d.fifoLen()
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	n = min(n, len(s))
+	b := d.fifoBuf[:n*fifoSampleLen]
+	if err := d.readRegBlock(regFIFORW, b); err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	period := d.rate.Period()
+	for i := range s[:n] {
+		f := b[i*fifoSampleLen:]
+		d.decode(&s[i], f[:6], f[6:12])
+		s[i].Temperature = 0
+		s[i].Timestamp = now.Add(-time.Duration(n-1-i) * period)
+	}
+	return n, nil
+}
+
+// ResetFIFO discards the samples queued in the FIFO. It requires Opts.FIFO.
+func (d *Dev) ResetFIFO() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.checkFIFO(); err != nil {
+		return err
+	}
+	d.overflow = false
+	return d.writeRegs(regUSERCTRL, userFIFOEN|userFIFOReset)
+}
+
+func (d *Dev) checkFIFO() error {
+	if d.halted {
+		return ErrHalted
+	}
+	if !d.fifo {
+		return errFIFODisabled
+	}
+	return nil
+}
+
+func (d *Dev) fifoLen() (int, error) {
+	var b [2]byte
+	if err := d.readRegBlock(regFIFOCOUNTH, b[:]); err != nil {
+		return 0, err
+	}
+	return (int(b[0])<<8 | int(b[1])) / fifoSampleLen, nil
+}
+
+var errFIFODisabled = errors.New("mpu6050: FIFO not enabled in Opts")
@@ -0,0 +1,124 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050
+
+import (
+	"math"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// fifoSample encodes a sample as queued in the FIFO.
+func fifoSample(accel, gyro [3]int16) []byte {
+	var b []byte
+	for _, v := range append(accel[:], gyro[:]...) {
+		b = append(b, byte(uint16(v)>>8), byte(v))
+	}
+	return b
+}
+
+func TestReadFIFO(t *testing.T) {
+	// 2 complete samples and a partial one at 100Hz.
+	fifo := append(fifoSample([3]int16{16384, 0, 0}, [3]int16{131, 0, 0}), fifoSample([3]int16{0, 8192, 0}, [3]int16{0, 0, -131})...)
+	ops := append(initOps([4]byte{9, 1, 0, 0}, true),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCOUNTH}, R: []byte{0, 30}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intDataReady}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCOUNTH}, R: []byte{0, 30}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFORW}, R: fifo},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{DLPF: 1, SampleRateDivider: 9, FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.FIFOLen(); err != nil || n != 2 {
+		t.Fatalf("FIFOLen() = %d, %v", n, err)
+	}
+	s := make([]Sample, 4)
+	n, err := d.ReadFIFO(s)
+	if err != nil || n != 2 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if math.Abs(s[0].Accel[0]-standardGravity) > 1e-9 || math.Abs(s[0].Gyro[0]-math.Pi/180) > 1e-9 {
+		t.Fatalf("s[0] = %s", s[0])
+	}
+	if s[1].RawAccel != [3]int16{0, 8192, 0} || s[1].RawGyro != [3]int16{0, 0, -131} {
+		t.Fatalf("s[1] = %v, %v", s[1].RawAccel, s[1].RawGyro)
+	}
+	if dt := s[1].Timestamp.Sub(s[0].Timestamp); dt != d.SampleRate().Period() {
+		t.Fatalf("timestamps %s apart", dt)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Short(t *testing.T) {
+	ops := append(initOps([4]byte{}, true),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCOUNTH}, R: []byte{0, 36}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFORW}, R: fifoSample([3]int16{1, 2, 3}, [3]int16{4, 5, 6})},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCOUNTH}, R: []byte{0, 0}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only as many samples as fit are read.
+	s := make([]Sample, 1)
+	if n, err := d.ReadFIFO(s); err != nil || n != 1 || s[0].RawGyro != [3]int16{4, 5, 6} {
+		t.Fatalf("ReadFIFO() = %d, %v, %v", n, s[0].RawGyro, err)
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Overflow(t *testing.T) {
+	ops := append(initOps([4]byte{}, true),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intFIFOOverflow | intDataReady}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regUSERCTRL, userFIFOEN | userFIFOReset}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOCOUNTH}, R: []byte{0, 0}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]Sample, 8)
+	if _, err := d.ReadFIFO(s); err != ErrFIFOOverflow {
+		t.Fatalf("ReadFIFO() = %v, want ErrFIFOOverflow", err)
+	}
+	if n, err := d.ReadFIFO(s); err != nil || n != 0 {
+		t.Fatalf("ReadFIFO() = %d, %v", n, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadFIFO_Disabled(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: initOps([4]byte{}, false)}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != errFIFODisabled {
+		t.Fatalf("ReadFIFO() = %v", err)
+	}
+	if err := d.ResetFIFO(); err != errFIFODisabled {
+		t.Fatalf("ResetFIFO() = %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
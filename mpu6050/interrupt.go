@@ -0,0 +1,141 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050
+
+import (
+	"log"
+	"time"
+)
+
+// Interrupt is the interrupt status of the device.
+type Interrupt struct {
+	// DataReady reports that a new sample is in the data registers.
+	DataReady bool
+	// FIFOOverflow reports that the FIFO was full and lost samples.
+	FIFOOverflow bool
+}
+
+// InterruptStatus reads and clears the interrupt status, releasing the INT
+// pin.
+//
+// A FIFO overflow is still reported by the next ReadFIFO.
+func (d *Dev) InterruptStatus() (Interrupt, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return Interrupt{}, ErrHalted
+	}
+	return d.interruptStatus()
+}
+
+func (d *Dev) interruptStatus() (Interrupt, error) {
+	s, err := d.readReg(regINTSTATUS)
+	if err != nil {
+		return Interrupt{}, err
+	}
+	i := Interrupt{DataReady: s&intDataReady != 0, FIFOOverflow: s&intFIFOOverflow != 0}
+	if i.FIFOOverflow {
+		d.overflow = true
+	}
+	return i, nil
+}
+
+// waitDataReady waits for the next sample, on the INT pin or by polling the
+// interrupt status.
+func (d *Dev) waitDataReady() error {
+	period := d.rate.Period()
+	timeout := 2*period + 10*time.Millisecond
+	if d.int != nil && !d.int.WaitForEdge(timeout) {
+		return ErrNotReady
+	}
+	for i := 0; i < 10; i++ {
+		d.mu.Lock()
+		s, err := d.interruptStatus()
+		d.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if s.DataReady {
+			return nil
+		}
+		doSleep(period / 4)
+	}
+	return ErrNotReady
+}
+
+// SenseContinuous returns a channel delivering samples every interval, until
+// Halt is called.
+//
+// An interval of 0 or less delivers every sample at the sample rate, waiting
+// for the data ready interrupt on Opts.INT, or polling the interrupt status.
+// At high rates, prefer Opts.FIFO and ReadFIFO. Calling SenseContinuous again
+// stops the previous channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan Sample, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	c := make(chan Sample)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- Sample) {
+	var tick <-chan time.Time
+	if interval > 0 {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		if tick != nil {
+			select {
+			case <-stop:
+				return
+			case <-tick:
+			}
+		} else {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if err := d.waitDataReady(); err != nil {
+				log.Printf("%s: failed to sense: %v", d, err)
+				return
+			}
+		}
+		var s Sample
+		if err := d.Sense(&s); err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- s:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestInterruptStatus(t *testing.T) {
+	ops := append(initOps([4]byte{}, true),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intFIFOOverflow}},
+		// The overflow seen above is reported by ReadFIFO.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intDataReady}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regUSERCTRL, userFIFOEN | userFIFOReset}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{FIFO: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i, err := d.InterruptStatus(); err != nil || i != (Interrupt{FIFOOverflow: true}) {
+		t.Fatalf("InterruptStatus() = %+v, %v", i, err)
+	}
+	if _, err := d.ReadFIFO(make([]Sample, 1)); err != ErrFIFOOverflow {
+		t.Fatalf("ReadFIFO() = %v, want ErrFIFOOverflow", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous_Polled(t *testing.T) {
+	ops := append(initOps([4]byte{}, false),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intDataReady}},
+		dataOp([3]int16{100, 0, 0}, 0, [3]int16{}),
+	)
+	// The next sample never becomes ready.
+	for i := 0; i < 10; i++ {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{0}})
+	}
+	ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regPWRMGMT1, pwrSleep}})
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-c; s.RawAccel[0] != 100 {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed on ErrNotReady")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous_INT(t *testing.T) {
+	pin := &gpiotest.Pin{N: "INT", L: gpio.Low, EdgesChan: make(chan gpio.Level)}
+	ops := append(initOps([4]byte{}, false),
+		// Released by New.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intDataReady}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regINTSTATUS}, R: []byte{intDataReady}},
+		dataOp([3]int16{0, 200, 0}, 0, [3]int16{}),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regPWRMGMT1, pwrSleep}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{INT: pin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pin.EdgesChan <- gpio.High
+	if s := <-c; s.RawAccel[1] != 200 {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	// No edge follows.
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed on ErrNotReady")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous_Interval(t *testing.T) {
+	ops := append(initOps([4]byte{}, false),
+		dataOp([3]int16{0, 0, 300}, 0, [3]int16{}),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regPWRMGMT1, pwrSleep}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-c; s.RawAccel[2] != 300 {
+		t.Fatalf("got %v", s.RawAccel)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
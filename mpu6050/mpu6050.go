@@ -0,0 +1,365 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// DefaultAddr is the I²C address with AD0 low; AltAddr is used with AD0
+// high.
+const (
+	DefaultAddr = 0x68
+	AltAddr     = 0x69
+)
+
+// Register map.
+const (
+	regSMPLRTDIV   = 0x19 // followed by CONFIG, GYRO_CONFIG and ACCEL_CONFIG
+	regFIFOEN      = 0x23
+	regINTPINCFG   = 0x37 // followed by INT_ENABLE
+	regINTSTATUS   = 0x3A
+	regACCELXOUTH  = 0x3B // accelerometer, temperature and gyroscope, MSB first
+	regUSERCTRL    = 0x6A
+	regPWRMGMT1    = 0x6B
+	regFIFOCOUNTH  = 0x72
+	regFIFORW      = 0x74
+	regWHOAMI      = 0x75
+	whoAmI         = 0x68
+	dataLen        = 14
+	fifoSampleLen  = 12 // accelerometer then gyroscope
+	fifoSize       = 1024
+	pwrDeviceReset = 0x80
+	pwrSleep       = 0x40
+	pwrClkPLLX     = 0x01 // PLL with the X gyroscope as reference
+)
+
+// Register values.
+const (
+	intPinLatch     = 0x20 // INT held until INT_STATUS is read
+	intDataReady    = 0x01
+	intFIFOOverflow = 0x10
+	fifoENAccelGyro = 0x78 // XG, YG, ZG and ACCEL
+	userFIFOEN      = 0x40
+	userFIFOReset   = 0x04
+)
+
+// resetTime is the time to wait after a device reset, from the register map.
+const resetTime = 100 * time.Millisecond
+
+// standardGravity is in m/s² per g.
+const standardGravity = 9.80665
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when WHO_AM_I doesn't read 0x68.
+	ErrBadID = errors.New("mpu6050: bad chip ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("mpu6050: device halted")
+	// ErrNotReady is returned when no sample became ready in time.
+	ErrNotReady = errors.New("mpu6050: data not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("mpu6050: invalid options")
+	// ErrFIFOOverflow is returned by ReadFIFO when samples were lost because
+	// the FIFO was full. The FIFO is reset.
+	ErrFIFOOverflow = errors.New("mpu6050: FIFO overflow")
+)
+
+// Opts holds initialization options.
+//
+// AccelRange: accelerometer full scale in g, 2 (default), 4, 8 or 16.
+// GyroRange: gyroscope full scale in °/s, 250 (default), 500, 1000 or 2000.
+// DLPF: digital low pass filter configuration 0 to 6; the accelerometer
+// bandwidth is 260, 184, 94, 44, 21, 10 and 5Hz and the gyroscope bandwidth
+// 256, 188, 98, 42, 20, 10 and 5Hz. 0 (default) also runs the gyroscope at
+// 8kHz instead of 1kHz.
+// SampleRateDivider: the sample rate is the gyroscope output rate divided by
+// 1 + SampleRateDivider.
+// FIFO: queue the accelerometer and gyroscope samples in the FIFO, read with
+// ReadFIFO.
+// INT: optional pin connected to the INT output, waited on by
+// SenseContinuous instead of polling the interrupt status.
+// Addr: I²C address, DefaultAddr by default.
+type Opts struct {
+	AccelRange        int
+	GyroRange         int
+	DLPF              int
+	SampleRateDivider uint8
+	FIFO              bool
+	INT               gpio.PinIn
+	Addr              uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if accelFS(o.AccelRange) < 0 {
+		return fmt.Errorf("%w: AccelRange %d, want 2, 4, 8 or 16", ErrInvalidOpts, o.AccelRange)
+	}
+	if gyroFS(o.GyroRange) < 0 {
+		return fmt.Errorf("%w: GyroRange %d, want 250, 500, 1000 or 2000", ErrInvalidOpts, o.GyroRange)
+	}
+	if o.DLPF < 0 || o.DLPF > 6 {
+		return fmt.Errorf("%w: DLPF %d, want 0 to 6", ErrInvalidOpts, o.DLPF)
+	}
+	return nil
+}
+
+// accelFS returns AFS_SEL for a range in g, or -1.
+func accelFS(g int) int {
+	switch g {
+	case 0, 2:
+		return 0
+	case 4:
+		return 1
+	case 8:
+		return 2
+	case 16:
+		return 3
+	}
+	return -1
+}
+
+// gyroFS returns FS_SEL for a range in °/s, or -1.
+func gyroFS(dps int) int {
+	switch dps {
+	case 0, 250:
+		return 0
+	case 500:
+		return 1
+	case 1000:
+		return 2
+	case 2000:
+		return 3
+	}
+	return -1
+}
+
+// Sample is a timestamped measurement.
+type Sample struct {
+	// Accel is the acceleration in m/s², in X,Y,Z order.
+	Accel [3]float64
+	// Gyro is the angular rate in rad/s, in X,Y,Z order.
+	Gyro [3]float64
+	// Temperature is the die temperature. It is 0 for samples read from the
+	// FIFO, which doesn't hold it.
+	Temperature physic.Temperature
+	// RawAccel and RawGyro are the counts Accel and Gyro were computed from.
+	RawAccel, RawGyro [3]int16
+	// Timestamp is the time at which the sample was read from the device, or
+	// estimated from the sample rate for samples read from the FIFO.
+	Timestamp time.Time
+}
+
+// String returns the scaled values.
+func (s Sample) String() string {
+	return fmt.Sprintf("accel=%.3f,%.3f,%.3fm/s² gyro=%.4f,%.4f,%.4frad/s",
+		s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2])
+}
+
+// Dev represents an MPU-6050 device.
+// Sense returns values in m/s² and rad/s.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c          conn.Conn
+	int        gpio.PinIn
+	accelRange int
+	gyroRange  int
+	accelLSB   float64 // LSB per m/s²
+	gyroLSB    float64 // LSB per rad/s
+	rate       physic.Frequency
+	fifo       bool
+	overflow   bool // FIFO overflow seen in INT_STATUS
+	halted     bool
+	data       [dataLen]byte
+	fifoBuf    [fifoSize]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets and configures a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	afs, gfs := accelFS(opts.AccelRange), gyroFS(opts.GyroRange)
+	gyroRate := physic.KiloHertz
+	if opts.DLPF == 0 {
+		gyroRate = 8 * physic.KiloHertz
+	}
+	d := &Dev{
+		c:          &i2c.Dev{Addr: addr, Bus: bus},
+		int:        opts.INT,
+		accelRange: 2 << afs,
+		gyroRange:  250 << gfs,
+		accelLSB:   float64(int(16384)>>afs) / standardGravity,
+		// 131 LSB/(°/s) at ±250°/s.
+		gyroLSB: 131 / float64(int(1)<<gfs) * 180 / math.Pi,
+		rate:    gyroRate / physic.Frequency(1+int(opts.SampleRateDivider)),
+		fifo:    opts.FIFO,
+	}
+	id, err := d.readReg(regWHOAMI)
+	if err != nil {
+		return nil, err
+	}
+	if id != whoAmI {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, whoAmI)
+	}
+	if err := d.writeRegs(regPWRMGMT1, pwrDeviceReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	if err := d.writeRegs(regPWRMGMT1, pwrClkPLLX); err != nil {
+		return nil, err
+	}
+	if err := d.writeRegs(regSMPLRTDIV, opts.SampleRateDivider, byte(opts.DLPF), byte(gfs<<3), byte(afs<<3)); err != nil {
+		return nil, err
+	}
+	intEnable := byte(intDataReady)
+	if opts.FIFO {
+		intEnable |= intFIFOOverflow
+	}
+	if err := d.writeRegs(regINTPINCFG, intPinLatch, intEnable); err != nil {
+		return nil, err
+	}
+	if d.int != nil {
+		if err := d.int.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("mpu6050: configuring INT: %w", err)
+		}
+		// Release INT, so the next sample raises an edge.
+		if _, err := d.interruptStatus(); err != nil {
+			return nil, err
+		}
+	}
+	if opts.FIFO {
+		if err := d.writeRegs(regFIFOEN, fifoENAccelGyro); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regUSERCTRL, userFIFOEN|userFIFOReset); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("MPU6050{%s, ±%dg, ±%d°/s, %s}", d.c, d.accelRange, d.gyroRange, d.rate)
+}
+
+// SampleRate returns the rate at which samples are produced.
+func (d *Dev) SampleRate() physic.Frequency {
+	return d.rate
+}
+
+// Halt stops SenseContinuous and puts the device to sleep. It implements
+// conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil
+	}
+	if err := d.writeRegs(regPWRMGMT1, pwrSleep); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns the latest accelerometer and gyroscope counts, in X,Y,Z
+// order.
+func (d *Dev) SenseRaw() ([3]int16, [3]int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var s Sample
+	err := d.sense(&s)
+	return s.RawAccel, s.RawGyro, err
+}
+
+// Sense reads the latest sample into s.
+//
+// It doesn't allocate memory, except to report errors, so it can be called at
+// high rates without causing garbage collection.
+func (d *Dev) Sense(s *Sample) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(s)
+}
+
+func (d *Dev) sense(s *Sample) error {
+	if d.halted {
+		return ErrHalted
+	}
+	if err := d.readRegBlock(regACCELXOUTH, d.data[:]); err != nil {
+		return err
+	}
+	s.Timestamp = time.Now()
+	b := d.data[:]
+	d.decode(s, b[:6], b[8:])
+	t := int16(b[6])<<8 | int16(b[7])
+	// °C = raw / 340 + 36.53
+	s.Temperature = physic.ZeroCelsius + physic.Temperature(math.Round((float64(t)/340+36.53)*float64(physic.Kelvin)))
+	return nil
+}
+
+// decode scales the big-endian accelerometer and gyroscope counts into s.
+func (d *Dev) decode(s *Sample, accel, gyro []byte) {
+	for i := 0; i < 3; i++ {
+		s.RawAccel[i] = int16(accel[2*i])<<8 | int16(accel[2*i+1])
+		s.RawGyro[i] = int16(gyro[2*i])<<8 | int16(gyro[2*i+1])
+		s.Accel[i] = float64(s.RawAccel[i]) / d.accelLSB
+		s.Gyro[i] = float64(s.RawGyro[i]) / d.gyroLSB
+	}
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if err := d.c.Tx([]byte{addr}, out); err != nil {
+		return fmt.Errorf("mpu6050: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at addr.
+func (d *Dev) writeRegs(addr byte, v ...byte) error {
+	if err := d.c.Tx(append([]byte{addr}, v...), nil); err != nil {
+		return fmt.Errorf("mpu6050: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
@@ -0,0 +1,221 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu6050
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New, with the values of
+// SMPLRT_DIV to ACCEL_CONFIG.
+func initOps(cfg [4]byte, fifo bool) []i2ctest.IO {
+	intEnable := byte(intDataReady)
+	if fifo {
+		intEnable |= intFIFOOverflow
+	}
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{whoAmI}},
+		{Addr: DefaultAddr, W: []byte{regPWRMGMT1, pwrDeviceReset}},
+		{Addr: DefaultAddr, W: []byte{regPWRMGMT1, pwrClkPLLX}},
+		{Addr: DefaultAddr, W: append([]byte{regSMPLRTDIV}, cfg[:]...)},
+		{Addr: DefaultAddr, W: []byte{regINTPINCFG, intPinLatch, intEnable}},
+	}
+	if fifo {
+		ops = append(ops,
+			i2ctest.IO{Addr: DefaultAddr, W: []byte{regFIFOEN, fifoENAccelGyro}},
+			i2ctest.IO{Addr: DefaultAddr, W: []byte{regUSERCTRL, userFIFOEN | userFIFOReset}},
+		)
+	}
+	return ops
+}
+
+// dataOp returns a read of the data registers.
+func dataOp(accel [3]int16, temp int16, gyro [3]int16) i2ctest.IO {
+	var r []byte
+	for _, v := range append(append(accel[:], temp), gyro[:]...) {
+		r = append(r, byte(uint16(v)>>8), byte(v))
+	}
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regACCELXOUTH}, R: r}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		cfg  [4]byte
+		s    string
+	}{
+		{Opts{}, [4]byte{0, 0, 0, 0}, "MPU6050{playback(104), ±2g, ±250°/s, 8kHz}"},
+		{Opts{AccelRange: 8, GyroRange: 2000, DLPF: 3, SampleRateDivider: 9}, [4]byte{9, 3, 0x18, 0x10}, "MPU6050{playback(104), ±8g, ±2000°/s, 100Hz}"},
+		{Opts{AccelRange: 16, GyroRange: 500, DLPF: 6, SampleRateDivider: 4}, [4]byte{4, 6, 0x08, 0x18}, "MPU6050{playback(104), ±16g, ±500°/s, 200Hz}"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.cfg, false)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regWHOAMI}, R: []byte{0x71}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{AccelRange: 4, GyroRange: 1000, DLPF: 6}, ""},
+		{Opts{AccelRange: 3}, "mpu6050: invalid options: AccelRange 3, want 2, 4, 8 or 16"},
+		{Opts{GyroRange: 300}, "mpu6050: invalid options: GyroRange 300, want 250, 500, 1000 or 2000"},
+		{Opts{DLPF: 7}, "mpu6050: invalid options: DLPF 7, want 0 to 6"},
+		{Opts{DLPF: -1}, "mpu6050: invalid options: DLPF -1, want 0 to 6"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{DLPF: 7}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := append(initOps([4]byte{}, false),
+		dataOp([3]int16{16384, -8192, 0}, 340, [3]int16{131, -262, 0}),
+		dataOp([3]int16{1, 2, 3}, 0, [3]int16{-4, -5, -6}),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 16384 LSB/g and 131 LSB/(°/s).
+	want := [6]float64{standardGravity, -standardGravity / 2, 0, math.Pi / 180, -math.Pi / 90, 0}
+	got := [6]float64{s.Accel[0], s.Accel[1], s.Accel[2], s.Gyro[0], s.Gyro[1], s.Gyro[2]}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Sense() = %s, want %v", s, want)
+		}
+	}
+	if want := physic.ZeroCelsius + 37530*physic.MilliKelvin; s.Temperature != want {
+		t.Fatalf("Temperature = %s, want %s", s.Temperature, want)
+	}
+	if s.RawAccel != [3]int16{16384, -8192, 0} || s.RawGyro != [3]int16{131, -262, 0} {
+		t.Fatalf("raw = %v, %v", s.RawAccel, s.RawGyro)
+	}
+	if s.Timestamp.IsZero() {
+		t.Fatal("no timestamp")
+	}
+	if a, g, err := d.SenseRaw(); err != nil || a != [3]int16{1, 2, 3} || g != [3]int16{-4, -5, -6} {
+		t.Fatalf("SenseRaw() = %v, %v, %v", a, g, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_Range(t *testing.T) {
+	ops := append(initOps([4]byte{0, 1, 0x18, 0x18}, false),
+		dataOp([3]int16{2048, 0, 0}, 0, [3]int16{-164, 0, 0}),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{AccelRange: 16, GyroRange: 2000, DLPF: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != nil {
+		t.Fatal(err)
+	}
+	// 2048 LSB/g and 16.375 LSB/(°/s).
+	if math.Abs(s.Accel[0]-standardGravity) > 1e-9 {
+		t.Fatalf("Accel = %v", s.Accel)
+	}
+	if want := -164 / 16.375 * math.Pi / 180; math.Abs(s.Gyro[0]-want) > 1e-9 {
+		t.Fatalf("Gyro = %v, want %g", s.Gyro, want)
+	}
+	if r := d.SampleRate(); r != physic.KiloHertz {
+		t.Fatalf("SampleRate() = %s", r)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHalt(t *testing.T) {
+	ops := append(initOps([4]byte{}, false), i2ctest.IO{Addr: DefaultAddr, W: []byte{regPWRMGMT1, pwrSleep}})
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	// Halting again is a no-op.
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err != ErrHalted {
+		t.Fatalf("Sense() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps([4]byte{}, false), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Sample
+	if err := d.Sense(&s); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err == nil {
+		t.Fatal("expected error")
+	}
+}
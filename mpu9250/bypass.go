@@ -0,0 +1,136 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+// EnableMagBypass disables the I2C master and connects the auxiliary bus of
+// the AK8963 to the primary I²C bus, where MagBypass reaches it.
+//
+// It is the alternative to InitMag and ReadMag when the MPU-9250 is on an I²C
+// bus; it doesn't work over SPI.
+func (m *MPU9250) EnableMagBypass() error {
+	ctrl, err := m.transport.readByte(reg.MPU9250_USER_CTRL)
+	if err != nil {
+		return wrapf("can't read USER_CTRL => %w", err)
+	}
+	ctrl &^= reg.MPU9250_I2C_MST_EN_MASK | reg.MPU9250_I2C_IF_DIS_MASK
+	if err := m.transport.writeByte(reg.MPU9250_USER_CTRL, ctrl); err != nil {
+		return wrapf("can't disable I2C master => %w", err)
+	}
+	cfg, err := m.transport.readByte(reg.MPU9250_INT_PIN_CFG)
+	if err != nil {
+		return wrapf("can't read INT_PIN_CFG => %w", err)
+	}
+	if err := m.transport.writeByte(reg.MPU9250_INT_PIN_CFG, cfg|reg.MPU9250_BYPASS_EN_MASK); err != nil {
+		return wrapf("can't enable bypass => %w", err)
+	}
+	return nil
+}
+
+// MagBypass accesses the AK8963 directly on the I²C bus, once
+// EnableMagBypass was called.
+type MagBypass struct {
+	device *i2c.Dev
+}
+
+// NewMagBypass returns the AK8963 on bus.
+func NewMagBypass(bus i2c.Bus) *MagBypass {
+	return &MagBypass{device: &i2c.Dev{Bus: bus, Addr: reg.MPU9250_MAG_ADDRESS}}
+}
+
+// magModeDelay is the time to wait after changing the AK8963 mode, rounded
+// up from the 100µs of the datasheet.
+const magModeDelay = time.Millisecond
+
+// Init resets the AK8963, reads its factory sensitivity adjustment values and
+// starts measuring.
+//
+// scale and mode are the same as with InitMag.
+func (b *MagBypass) Init(scale, mode byte) (*MagCal, error) {
+	if err := validateMagParams(magModeDelay, magModeDelay, scale, mode); err != nil {
+		return nil, fmt.Errorf("invalid mag parameters: %w", err)
+	}
+	id, err := b.readByte(reg.MPU9250_MAG_WIA)
+	if err != nil {
+		return nil, err
+	}
+	if id != reg.MPU9250_WIA_MASK {
+		return nil, wrapf("unexpected AK8963 WIA 0x%02X, want 0x%02X", id, reg.MPU9250_WIA_MASK)
+	}
+	seq := [][]byte{
+		{reg.MPU9250_MAG_CNTL2, 0x01}, // reset
+		{reg.MPU9250_MAG_CNTL, 0x00},  // power down
+		{reg.MPU9250_MAG_CNTL, 0x0F},  // fuse ROM access
+	}
+	for _, w := range seq {
+		if err := b.writeByte(w[0], w[1]); err != nil {
+			return nil, err
+		}
+		time.Sleep(magModeDelay)
+	}
+	var asa [3]byte
+	if err := b.device.Tx([]byte{reg.MPU9250_MAG_ASAX}, asa[:]); err != nil {
+		return nil, wrapf("can't read ASA => %w", err)
+	}
+	cal := &MagCal{
+		AdjX: (float64(asa[0])-128.0)/256.0 + 1.0,
+		AdjY: (float64(asa[1])-128.0)/256.0 + 1.0,
+		AdjZ: (float64(asa[2])-128.0)/256.0 + 1.0,
+	}
+	if err := b.writeByte(reg.MPU9250_MAG_CNTL, 0x00); err != nil {
+		return nil, err
+	}
+	time.Sleep(magModeDelay)
+	if err := b.writeByte(reg.MPU9250_MAG_CNTL, scale<<4|mode); err != nil {
+		return nil, err
+	}
+	time.Sleep(magModeDelay)
+	return cal, nil
+}
+
+// Read reads one sample, like ReadMag. The overflow flag is set when the
+// sample saturated.
+//
+// It reads ST1 to ST2, reading ST2 releases the data registers for the next
+// measurement.
+func (b *MagBypass) Read(cal *MagCal) (MagData, error) {
+	var raw [8]byte
+	if err := b.device.Tx([]byte{reg.MPU9250_MAG_ST1}, raw[:]); err != nil {
+		return MagData{}, wrapf("can't read mag data => %w", err)
+	}
+	if raw[7]&0x08 != 0 {
+		return MagData{Overflow: true}, nil
+	}
+	x := int16(raw[2])<<8 | int16(raw[1])
+	y := int16(raw[4])<<8 | int16(raw[3])
+	z := int16(raw[6])<<8 | int16(raw[5])
+	return MagData{
+		X: int16(float64(x) * cal.AdjX),
+		Y: int16(float64(y) * cal.AdjY),
+		Z: int16(float64(z) * cal.AdjZ),
+	}, nil
+}
+
+func (b *MagBypass) readByte(address byte) (byte, error) {
+	var res [1]byte
+	if err := b.device.Tx([]byte{address}, res[:]); err != nil {
+		return 0, wrapf("can't read mag register %x => %w", address, err)
+	}
+	return res[0], nil
+}
+
+func (b *MagBypass) writeByte(address, value byte) error {
+	if err := b.device.Tx([]byte{address, value}, nil); err != nil {
+		return wrapf("can't write mag register %x => %w", address, err)
+	}
+	return nil
+}
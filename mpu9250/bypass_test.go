@@ -0,0 +1,78 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+func TestEnableMagBypass(t *testing.T) {
+	f := &fakeTransport{}
+	f.regs[reg.MPU9250_USER_CTRL] = reg.MPU9250_I2C_MST_EN_MASK | reg.MPU9250_I2C_IF_DIS_MASK | reg.MPU9250_FIFO_EN_MASK
+	f.regs[reg.MPU9250_INT_PIN_CFG] = reg.MPU9250_LATCH_INT_EN_MASK
+	m, err := New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.EnableMagBypass(); err != nil {
+		t.Fatal(err)
+	}
+	if v := f.regs[reg.MPU9250_USER_CTRL]; v != reg.MPU9250_FIFO_EN_MASK {
+		t.Fatalf("USER_CTRL = 0x%02X", v)
+	}
+	if v := f.regs[reg.MPU9250_INT_PIN_CFG]; v != reg.MPU9250_LATCH_INT_EN_MASK|reg.MPU9250_BYPASS_EN_MASK {
+		t.Fatalf("INT_PIN_CFG = 0x%02X", v)
+	}
+}
+
+func TestMagBypass(t *testing.T) {
+	const addr = reg.MPU9250_MAG_ADDRESS
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_WIA}, R: []byte{0x48}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_CNTL2, 0x01}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_CNTL, 0x00}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_CNTL, 0x0F}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_ASAX}, R: []byte{128, 192, 64}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_CNTL, 0x00}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_CNTL, 0x16}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_ST1}, R: []byte{0x01, 0x64, 0x00, 0x64, 0x00, 0x9C, 0xFF, 0x10}},
+		{Addr: addr, W: []byte{reg.MPU9250_MAG_ST1}, R: []byte{0x01, 0xFF, 0x7F, 0, 0, 0, 0, 0x18}},
+	}}
+	b := NewMagBypass(bus)
+	cal, err := b.Init(1, 0x06)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cal != (MagCal{AdjX: 1, AdjY: 1.25, AdjZ: 0.75}) {
+		t.Fatalf("Init() = %+v", cal)
+	}
+	if d, err := b.Read(cal); err != nil || d != (MagData{X: 100, Y: 125, Z: -75}) {
+		t.Fatalf("Read() = %+v, %v", d, err)
+	}
+	if d, err := b.Read(cal); err != nil || !d.Overflow {
+		t.Fatalf("Read() = %+v, %v", d, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMagBypass_Errors(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: reg.MPU9250_MAG_ADDRESS, W: []byte{reg.MPU9250_MAG_WIA}, R: []byte{0x09}},
+	}}
+	if _, err := NewMagBypass(bus).Init(1, 0x06); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := NewMagBypass(bus).Init(2, 0x06); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := NewMagBypass(&i2ctest.Playback{DontPanic: true}).Read(&MagCal{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"time"
+
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+// fakeTransport is a register map implementing Proto.
+//
+// Reading FIFO_R_W pops from fifo and reading INT_STATUS clears it.
+type fakeTransport struct {
+	regs   [128]byte
+	fifo   []byte
+	writes [][2]byte
+}
+
+func (f *fakeTransport) writeByte(address, value byte) error {
+	f.writes = append(f.writes, [2]byte{address, value})
+	f.regs[address] = value
+	return nil
+}
+
+func (f *fakeTransport) writeMagReg(address, value byte, writeDelay time.Duration) error {
+	return f.writeByte(address, value)
+}
+
+func (f *fakeTransport) writeMaskedReg(address, mask, value byte) error {
+	return f.writeByte(address, f.regs[address]&^mask|value&mask)
+}
+
+func (f *fakeTransport) readMaskedReg(address, mask byte) (byte, error) {
+	v, err := f.readByte(address)
+	return v & mask, err
+}
+
+func (f *fakeTransport) readByte(address byte) (byte, error) {
+	switch address {
+	case reg.MPU9250_FIFO_R_W:
+		if len(f.fifo) == 0 {
+			return 0, nil
+		}
+		b := f.fifo[0]
+		f.fifo = f.fifo[1:]
+		return b, nil
+	case reg.MPU9250_FIFO_COUNTH:
+		return byte(len(f.fifo) >> 8), nil
+	case reg.MPU9250_FIFO_COUNTL:
+		return byte(len(f.fifo)), nil
+	case reg.MPU9250_INT_STATUS:
+		v := f.regs[address]
+		f.regs[address] = 0
+		return v, nil
+	}
+	return f.regs[address], nil
+}
+
+func (f *fakeTransport) readBytes(address byte, data []byte) error {
+	for i := range data {
+		data[i], _ = f.readByte(address)
+	}
+	return nil
+}
+
+func (f *fakeTransport) readUint16(address ...byte) (uint16, error) {
+	h, _ := f.readByte(address[0])
+	l, _ := f.readByte(address[1])
+	return uint16(h)<<8 | uint16(l), nil
+}
+
+var _ Proto = &fakeTransport{}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"errors"
+
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+// fifoPacket is the size of an accelerometer and gyroscope sample in the
+// FIFO.
+const fifoPacket = 12
+
+// ErrFIFOOverflow is returned by ReadFIFOBatch when the FIFO overflowed and
+// samples were lost. The FIFO is reset.
+var ErrFIFOOverflow = errors.New("mpu9250 FIFO overflow")
+
+// FIFOSample is an accelerometer and gyroscope sample read from the FIFO.
+type FIFOSample struct {
+	Accel AccelerometerData
+	Gyro  RotationData
+}
+
+// StartFIFO resets the FIFO and starts queuing the accelerometer and
+// gyroscope samples at the sample rate, see SetSampleRateDivider.
+//
+// The FIFO holds 512 bytes, 42 samples.
+func (m *MPU9250) StartFIFO() error {
+	seq := [][]byte{
+		{reg.MPU9250_FIFO_EN, 0},
+		{reg.MPU9250_USER_CTRL, reg.MPU9250_FIFO_RST_MASK},
+		{reg.MPU9250_USER_CTRL, reg.MPU9250_FIFO_EN_MASK},
+		{reg.MPU9250_FIFO_EN, reg.MPU9250_GYRO_XOUT_MASK | reg.MPU9250_GYRO_YOUT_MASK | reg.MPU9250_GYRO_ZOUT_MASK | reg.MPU9250_ACCEL_MASK},
+	}
+	if err := m.transferBatch(seq, "error starting FIFO %d: [%x:%x] => %v"); err != nil {
+		return err
+	}
+	// Discard a stale overflow.
+	_, err := m.GetIntStatus()
+	return err
+}
+
+// StopFIFO stops queuing samples in the FIFO.
+func (m *MPU9250) StopFIFO() error {
+	return m.transport.writeByte(reg.MPU9250_FIFO_EN, 0)
+}
+
+// ReadFIFOBatch reads up to len(samples) samples from the FIFO, oldest first,
+// and returns the number read.
+//
+// When the FIFO overflowed, the oldest samples were overwritten and the
+// packets may not be aligned anymore: the FIFO is reset and ErrFIFOOverflow
+// returned. Reading the interrupt status clears the other interrupt bits.
+func (m *MPU9250) ReadFIFOBatch(samples []FIFOSample) (int, error) {
+	status, err := m.GetIntStatus()
+	if err != nil {
+		return 0, wrapf("can't read INT_STATUS => %w", err)
+	}
+	if status&reg.MPU9250_FIFO_OFLOW_INT_MASK != 0 {
+		if err := m.StartFIFO(); err != nil {
+			return 0, err
+		}
+		return 0, ErrFIFOOverflow
+	}
+	count, err := m.GetFIFOCount()
+	if err != nil {
+		return 0, wrapf("can't get FIFO => %w", err)
+	}
+	n := min(int(count)/fifoPacket, len(samples))
+	var buffer [fifoPacket]byte
+	toInt16 := func(offset int) int16 {
+		return int16(buffer[offset])<<8 | int16(buffer[offset+1])
+	}
+	for i := 0; i < n; i++ {
+		if err := m.transport.readBytes(reg.MPU9250_FIFO_R_W, buffer[:]); err != nil {
+			return i, wrapf("can't read packet %d => %w", i, err)
+		}
+		samples[i] = FIFOSample{
+			Accel: AccelerometerData{X: toInt16(0), Y: toInt16(2), Z: toInt16(4)},
+			Gyro:  RotationData{X: toInt16(6), Y: toInt16(8), Z: toInt16(10)},
+		}
+	}
+	return n, nil
+}
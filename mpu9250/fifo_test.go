@@ -0,0 +1,85 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"testing"
+
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+func TestStartFIFO(t *testing.T) {
+	f := &fakeTransport{}
+	f.regs[reg.MPU9250_INT_STATUS] = reg.MPU9250_FIFO_OFLOW_INT_MASK
+	m, err := New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.StartFIFO(); err != nil {
+		t.Fatal(err)
+	}
+	if v := f.regs[reg.MPU9250_FIFO_EN]; v != 0x78 {
+		t.Fatalf("FIFO_EN = 0x%02X", v)
+	}
+	if v := f.regs[reg.MPU9250_USER_CTRL]; v != reg.MPU9250_FIFO_EN_MASK {
+		t.Fatalf("USER_CTRL = 0x%02X", v)
+	}
+	if v := f.regs[reg.MPU9250_INT_STATUS]; v != 0 {
+		t.Fatal("stale overflow not cleared")
+	}
+	if err := m.StopFIFO(); err != nil || f.regs[reg.MPU9250_FIFO_EN] != 0 {
+		t.Fatalf("StopFIFO() = %v", err)
+	}
+}
+
+func TestReadFIFOBatch(t *testing.T) {
+	f := &fakeTransport{fifo: []byte{
+		0x40, 0x00, 0xFF, 0xFF, 0x00, 0x02, 0x00, 0x83, 0xFF, 0x7D, 0x00, 0x00,
+		0x00, 0x01, 0x00, 0x02, 0x00, 0x03, 0x00, 0x04, 0x00, 0x05, 0x00, 0x06,
+		0x00, 0x07, // partial packet
+	}}
+	m, err := New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make([]FIFOSample, 4)
+	n, err := m.ReadFIFOBatch(s)
+	if err != nil || n != 2 {
+		t.Fatalf("ReadFIFOBatch() = %d, %v", n, err)
+	}
+	want := FIFOSample{Accel: AccelerometerData{X: 16384, Y: -1, Z: 2}, Gyro: RotationData{X: 131, Y: -131, Z: 0}}
+	if s[0] != want {
+		t.Fatalf("s[0] = %+v, want %+v", s[0], want)
+	}
+	want = FIFOSample{Accel: AccelerometerData{X: 1, Y: 2, Z: 3}, Gyro: RotationData{X: 4, Y: 5, Z: 6}}
+	if s[1] != want {
+		t.Fatalf("s[1] = %+v, want %+v", s[1], want)
+	}
+	if len(f.fifo) != 2 {
+		t.Fatalf("partial packet consumed, %d bytes left", len(f.fifo))
+	}
+
+	// Only as many samples as fit are read.
+	f.fifo = make([]byte, 3*fifoPacket)
+	if n, err := m.ReadFIFOBatch(s[:1]); err != nil || n != 1 || len(f.fifo) != 2*fifoPacket {
+		t.Fatalf("ReadFIFOBatch() = %d, %v", n, err)
+	}
+}
+
+func TestReadFIFOBatch_Overflow(t *testing.T) {
+	f := &fakeTransport{fifo: make([]byte, 5)}
+	f.regs[reg.MPU9250_INT_STATUS] = reg.MPU9250_FIFO_OFLOW_INT_MASK
+	m, err := New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.ReadFIFOBatch(make([]FIFOSample, 1)); err != ErrFIFOOverflow {
+		t.Fatalf("ReadFIFOBatch() = %v, want ErrFIFOOverflow", err)
+	}
+	// The FIFO was reset and restarted.
+	if last := f.writes[len(f.writes)-1]; last != [2]byte{reg.MPU9250_FIFO_EN, 0x78} {
+		t.Fatalf("last write = %x", last)
+	}
+}
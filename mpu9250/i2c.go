@@ -0,0 +1,100 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+)
+
+// I2CAddr is the I²C address of the MPU-9250 with AD0 low; it is 0x69 with
+// AD0 high.
+const I2CAddr = 0x68
+
+// I2cTransport encapsulates the I²C transport parameters.
+//
+// Unlike SpiTransport, it allows to reach the AK8963 directly on the same bus
+// in bypass mode, see EnableMagBypass.
+type I2cTransport struct {
+	device *i2c.Dev
+	debug  DebugF
+}
+
+// NewI2cTransport creates the I²C transport on bus at addr, I2CAddr when 0.
+func NewI2cTransport(bus i2c.Bus, addr uint16) (*I2cTransport, error) {
+	if addr == 0 {
+		addr = I2CAddr
+	}
+	return &I2cTransport{device: &i2c.Dev{Bus: bus, Addr: addr}, debug: noop}, nil
+}
+
+// EnableDebug Sets the debugging output using the local print function.
+func (t *I2cTransport) EnableDebug(f DebugF) {
+	t.debug = f
+}
+
+func (t *I2cTransport) writeByte(address byte, value byte) error {
+	t.debug("write register %x value %x", address, value)
+	return t.device.Tx([]byte{address, value}, nil)
+}
+
+func (t *I2cTransport) writeMagReg(address byte, value byte, writeDelay time.Duration) error {
+	// Like SpiTransport, the AK8963 is written via the I2C master in
+	// mpu9250.go; this method exists only to satisfy the transport interface.
+	return t.writeByte(address, value)
+}
+
+func (t *I2cTransport) writeMaskedReg(address byte, mask byte, value byte) error {
+	t.debug("write masked %x, mask %x, value %x", address, mask, value)
+	regVal, err := t.readByte(address)
+	if err != nil {
+		return err
+	}
+	return t.writeByte(address, regVal&^mask|value&mask)
+}
+
+func (t *I2cTransport) readMaskedReg(address byte, mask byte) (byte, error) {
+	t.debug("read masked %x, mask %x", address, mask)
+	reg, err := t.readByte(address)
+	if err != nil {
+		return 0, err
+	}
+	return reg & mask, nil
+}
+
+func (t *I2cTransport) readByte(address byte) (byte, error) {
+	t.debug("read register %x", address)
+	var res [1]byte
+	if err := t.device.Tx([]byte{address}, res[:]); err != nil {
+		return 0, err
+	}
+	return res[0], nil
+}
+
+// readBytes reads len(data) bytes in one transaction, from consecutive
+// registers or repeatedly from FIFO_R_W.
+func (t *I2cTransport) readBytes(address byte, data []byte) error {
+	t.debug("read %d bytes from register %x", len(data), address)
+	return t.device.Tx([]byte{address}, data)
+}
+
+func (t *I2cTransport) readUint16(address ...byte) (uint16, error) {
+	if len(address) != 2 {
+		return 0, fmt.Errorf("only 2 bytes per read")
+	}
+	h, err := t.readByte(address[0])
+	if err != nil {
+		return 0, err
+	}
+	l, err := t.readByte(address[1])
+	if err != nil {
+		return 0, err
+	}
+	return uint16(h)<<8 | uint16(l), nil
+}
+
+var _ Proto = &I2cTransport{}
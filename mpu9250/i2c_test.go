@@ -0,0 +1,56 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+func TestI2cTransport(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_WHO_AM_I}, R: []byte{reg.WHOAMI_RESET_VAL}},
+		// SetGyroRange.
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_GYRO_CONFIG}, R: []byte{0xE7}},
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_GYRO_CONFIG, 0xF7}},
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_FIFO_COUNTH}, R: []byte{0x01}},
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_FIFO_COUNTL}, R: []byte{0x20}},
+		// ReadFIFOBatch reads a packet in one transaction.
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_INT_STATUS}, R: []byte{0x00}},
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_FIFO_COUNTH}, R: []byte{0x00}},
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_FIFO_COUNTL}, R: []byte{0x0C}},
+		{Addr: I2CAddr, W: []byte{reg.MPU9250_FIFO_R_W}, R: []byte{0x40, 0x00, 0xFF, 0xFF, 0, 2, 0, 131, 0xFF, 0x7D, 0, 0}},
+	}}
+	tr, err := NewI2cTransport(bus, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := New(tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, err := m.GetDeviceID(); err != nil || id != reg.WHOAMI_RESET_VAL {
+		t.Fatalf("GetDeviceID() = 0x%02X, %v", id, err)
+	}
+	if err := m.SetGyroRange(reg.MPU9250_GYRO_FULL_SCALE_1000DPS); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := m.GetFIFOCount(); err != nil || n != 0x120 {
+		t.Fatalf("GetFIFOCount() = %d, %v", n, err)
+	}
+	var s [2]FIFOSample
+	if n, err := m.ReadFIFOBatch(s[:]); err != nil || n != 1 {
+		t.Fatalf("ReadFIFOBatch() = %d, %v", n, err)
+	}
+	want := FIFOSample{Accel: AccelerometerData{X: 16384, Y: -1, Z: 2}, Gyro: RotationData{X: 131, Y: -131, Z: 0}}
+	if s[0] != want {
+		t.Fatalf("ReadFIFOBatch() = %+v, want %+v", s[0], want)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
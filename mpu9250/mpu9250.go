@@ -4,6 +4,12 @@
 
 // Package mpu9250 MPU-9250 is a 9-axis MotionTracking device that combines a 3-axis gyroscope, 3-axis accelerometer, 3-axis magnetometer and a Digital Motion Processor™ (DMP)
 //
+// The device is reached with NewSpiTransport or NewI2cTransport. The AK8963
+// magnetometer is read through the internal I2C master with InitMag and
+// ReadMag, or, on I²C only, directly with MagBypass after EnableMagBypass.
+// StartFIFO and ReadFIFOBatch batch accelerometer and gyroscope samples, and
+// EnableWakeOnMotion runs the low power wake-on-motion mode.
+//
 // # Datasheet
 //
 // https://www.invensense.com/wp-content/uploads/2015/02/PS-MPU-9250A-01-v1.1.pdf
@@ -35,6 +41,7 @@ type Proto interface {
 	writeMaskedReg(address byte, mask byte, value byte) error
 	readMaskedReg(address byte, mask byte) (byte, error)
 	readByte(address byte) (byte, error)
+	readBytes(address byte, data []byte) error
 	writeByte(address byte, value byte) error
 	readUint16(address ...byte) (uint16, error)
 	writeMagReg(address byte, value byte, writeDelay time.Duration) error
@@ -62,6 +69,12 @@ func (p *loggingProto) readByte(address byte) (byte, error) {
 	return value, err
 }
 
+func (p *loggingProto) readBytes(address byte, data []byte) error {
+	err := p.inner.readBytes(address, data)
+	log.Printf("[mpu9250] readBytes addr=0x%02X -> % X err=%v", address, data, err)
+	return err
+}
+
 func (p *loggingProto) writeByte(address byte, value byte) error {
 	err := p.inner.writeByte(address, value)
 	log.Printf("[mpu9250] writeByte addr=0x%02X val=0x%02X err=%v", address, value, err)
@@ -66,14 +66,12 @@ func (s *SpiTransport) writeMagReg(address byte, value byte, writeDelay time.Dur
 
 func (s *SpiTransport) writeMaskedReg(address byte, mask byte, value byte) error {
 	s.debug("write masked %x, mask %x, value %x", address, mask, value)
-	maskedValue := mask & value
-	s.debug("masked value %x", maskedValue)
 	regVal, err := s.readByte(address)
 	if err != nil {
 		return err
 	}
 	s.debug("current register %x", regVal)
-	regVal = (regVal &^ maskedValue) | maskedValue
+	regVal = regVal&^mask | value&mask
 	s.debug("new value %x", regVal)
 	return s.writeByte(address, regVal)
 }
@@ -107,6 +105,23 @@ func (s *SpiTransport) readByte(address byte) (byte, error) {
 	return res[1], nil
 }
 
+// readBytes reads len(data) bytes in one transaction, from consecutive
+// registers or repeatedly from FIFO_R_W.
+func (s *SpiTransport) readBytes(address byte, data []byte) error {
+	s.debug("read %d bytes from register %x", len(data), address)
+	buf := make([]byte, len(data)+1)
+	buf[0] = 0x80 | address
+	res := make([]byte, len(buf))
+	if err := s.cs.Out(gpio.Low); err != nil {
+		return err
+	}
+	if err := s.device.Tx(buf, res); err != nil {
+		return err
+	}
+	copy(data, res[1:])
+	return s.cs.Out(gpio.High)
+}
+
 func (s *SpiTransport) readUint16(address ...byte) (uint16, error) {
 	if len(address) != 2 {
 		return 0, fmt.Errorf("only 2 bytes per read")
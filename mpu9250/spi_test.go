@@ -0,0 +1,56 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/conn/v3/spi/spitest"
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+func TestSpiTransport_writeMaskedReg(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		{W: []byte{0x80 | reg.MPU9250_GYRO_CONFIG, 0}, R: []byte{0, 0xF7}},
+		// The masked bits are cleared before being set.
+		{W: []byte{reg.MPU9250_GYRO_CONFIG, 0xE7}, R: []byte{0, 0}},
+	}}}
+	c, err := port.Connect(physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &SpiTransport{device: c, cs: &gpiotest.Pin{N: "CS"}, debug: noop}
+	if err := s.writeMaskedReg(reg.MPU9250_GYRO_CONFIG, 0x18, 0x00); err != nil {
+		t.Fatal(err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSpiTransport_readBytes(t *testing.T) {
+	port := &spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		{W: []byte{0x80 | reg.MPU9250_FIFO_R_W, 0, 0, 0}, R: []byte{0, 1, 2, 3}},
+	}}}
+	c, err := port.Connect(physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &SpiTransport{device: c, cs: &gpiotest.Pin{N: "CS"}, debug: noop}
+	var b [3]byte
+	if err := s.readBytes(reg.MPU9250_FIFO_R_W, b[:]); err != nil {
+		t.Fatal(err)
+	}
+	if b != [3]byte{1, 2, 3} {
+		t.Fatalf("readBytes() = %v", b)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
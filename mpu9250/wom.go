@@ -0,0 +1,76 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"fmt"
+
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+// LP_ACCEL_ODR values of the wake-up rate in low power accelerometer mode.
+const (
+	WakeOnMotion0_24Hz byte = iota
+	WakeOnMotion0_49Hz
+	WakeOnMotion0_98Hz
+	WakeOnMotion1_95Hz
+	WakeOnMotion3_91Hz
+	WakeOnMotion7_81Hz
+	WakeOnMotion15_63Hz
+	WakeOnMotion31_25Hz
+	WakeOnMotion62_5Hz
+	WakeOnMotion125Hz
+	WakeOnMotion250Hz
+	WakeOnMotion500Hz
+)
+
+// EnableWakeOnMotion puts the device in low power accelerometer mode, waking
+// up at rate to compare the acceleration with the previous sample, and raises
+// the WOM interrupt when an axis changed by more than thresholdMg.
+//
+// thresholdMg is 4 to 1020mg in steps of 4mg. The gyroscope is disabled until
+// DisableWakeOnMotion. This follows the sequence of section 7.1 of the
+// datasheet.
+func (m *MPU9250) EnableWakeOnMotion(thresholdMg int, rate byte) error {
+	if thresholdMg < 4 || thresholdMg > 1020 {
+		return fmt.Errorf("invalid wake on motion threshold: %dmg (must be 4 to 1020)", thresholdMg)
+	}
+	if rate > WakeOnMotion500Hz {
+		return fmt.Errorf("invalid wake on motion rate: %d (must be 0 to %d)", rate, WakeOnMotion500Hz)
+	}
+	seq := [][]byte{
+		{reg.MPU9250_PWR_MGMT_1, 0x01},                          // clear CYCLE, SLEEP and GYRO_STANDBY
+		{reg.MPU9250_PWR_MGMT_2, reg.MPU9250_DISABLE_XYZG_MASK}, // accelerometer only
+		{reg.MPU9250_ACCEL_CONFIG2, 0x01},                       // 184Hz bandwidth
+		{reg.MPU9250_INT_ENABLE, reg.MPU9250_WOM_EN_MASK},       // motion interrupt only
+		{reg.MPU9250_MOT_DETECT_CTRL, reg.MPU9250_ACCEL_INTEL_EN_MASK | reg.MPU9250_ACCEL_INTEL_MODE_MASK},
+		{reg.MPU9250_WOM_THR, byte(thresholdMg / 4)},
+		{reg.MPU9250_LP_ACCEL_ODR, rate},
+		{reg.MPU9250_PWR_MGMT_1, reg.MPU9250_CYCLE_MASK | 0x01}, // low power cycle mode
+	}
+	return m.transferBatch(seq, "error enabling wake on motion %d: [%x:%x] => %v")
+}
+
+// DisableWakeOnMotion leaves the low power accelerometer mode and enables
+// all the sensors again.
+func (m *MPU9250) DisableWakeOnMotion() error {
+	seq := [][]byte{
+		{reg.MPU9250_PWR_MGMT_1, 0x01},
+		{reg.MPU9250_MOT_DETECT_CTRL, 0},
+		{reg.MPU9250_INT_ENABLE, 0},
+		{reg.MPU9250_PWR_MGMT_2, 0},
+	}
+	return m.transferBatch(seq, "error disabling wake on motion %d: [%x:%x] => %v")
+}
+
+// GetMotionDetected reads and clears the interrupt status and reports whether
+// motion was detected since the last read.
+func (m *MPU9250) GetMotionDetected() (bool, error) {
+	s, err := m.GetIntStatus()
+	if err != nil {
+		return false, err
+	}
+	return s&reg.MPU9250_WOM_INT_MASK != 0, nil
+}
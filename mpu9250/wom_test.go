@@ -0,0 +1,71 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mpu9250
+
+import (
+	"testing"
+
+	"periph.io/x/devices/v3/mpu9250/reg"
+)
+
+func TestEnableWakeOnMotion(t *testing.T) {
+	f := &fakeTransport{}
+	m, err := New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.EnableWakeOnMotion(100, WakeOnMotion15_63Hz); err != nil {
+		t.Fatal(err)
+	}
+	want := [][2]byte{
+		{reg.MPU9250_PWR_MGMT_1, 0x01},
+		{reg.MPU9250_PWR_MGMT_2, 0x07},
+		{reg.MPU9250_ACCEL_CONFIG2, 0x01},
+		{reg.MPU9250_INT_ENABLE, 0x40},
+		{reg.MPU9250_MOT_DETECT_CTRL, 0xC0},
+		{reg.MPU9250_WOM_THR, 25},
+		{reg.MPU9250_LP_ACCEL_ODR, 6},
+		{reg.MPU9250_PWR_MGMT_1, 0x21},
+	}
+	if len(f.writes) != len(want) {
+		t.Fatalf("writes = %x", f.writes)
+	}
+	for i := range want {
+		if f.writes[i] != want[i] {
+			t.Fatalf("write #%d = %x, want %x", i, f.writes[i], want[i])
+		}
+	}
+
+	if ok, err := m.GetMotionDetected(); err != nil || ok {
+		t.Fatalf("GetMotionDetected() = %t, %v", ok, err)
+	}
+	f.regs[reg.MPU9250_INT_STATUS] = reg.MPU9250_WOM_INT_MASK
+	if ok, err := m.GetMotionDetected(); err != nil || !ok {
+		t.Fatalf("GetMotionDetected() = %t, %v", ok, err)
+	}
+
+	if err := m.DisableWakeOnMotion(); err != nil {
+		t.Fatal(err)
+	}
+	if f.regs[reg.MPU9250_PWR_MGMT_1] != 0x01 || f.regs[reg.MPU9250_PWR_MGMT_2] != 0 || f.regs[reg.MPU9250_INT_ENABLE] != 0 {
+		t.Fatalf("registers = %x", f.regs[:])
+	}
+}
+
+func TestEnableWakeOnMotion_Invalid(t *testing.T) {
+	m, err := New(&fakeTransport{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.EnableWakeOnMotion(2, WakeOnMotion500Hz); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := m.EnableWakeOnMotion(1024, WakeOnMotion500Hz); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := m.EnableWakeOnMotion(100, 12); err == nil {
+		t.Fatal("expected error")
+	}
+}
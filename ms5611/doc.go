@@ -0,0 +1,26 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ms5611 controls a TE Connectivity MS5611 barometric pressure sensor
+// over I²C or SPI.
+//
+// # More details
+//
+// The MS5611 has no registers: each measurement is a conversion command
+// followed by a read of the 24 bits ADC, once for the pressure and once for
+// the temperature. The oversampling ratio of each conversion trades time for
+// noise: at OSR4096 a conversion takes 9ms and the pressure resolves to about
+// 1.2Pa, i.e. 10cm of altitude.
+//
+// The factory calibration is read from the PROM and checked against its CRC
+// at initialization. The measurements are compensated with the first and
+// second order formulas of the datasheet, the latter improving the accuracy
+// below 20°C.
+//
+// # Datasheet
+//
+// https://www.te.com/commerce/DocumentDelivery/DDEController?Action=showdoc&DocId=Data+Sheet%7FMS5611-01BA03%7FB3%7Fpdf%7FEnglish%7FENG_DS_MS5611-01BA03_B3.pdf
+//
+// https://www.te.com/commerce/DocumentDelivery/DDEController?Action=showdoc&DocId=Specification+Or+Standard%7FAN520%7FA%7Fpdf%7FEnglish%7FENG_SS_AN520_A.pdf
+package ms5611
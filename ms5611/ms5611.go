@@ -0,0 +1,353 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ms5611
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// I²C addresses, selected by the CSB pin.
+const (
+	DefaultAddr = 0x77 // CSB low
+	AltAddr     = 0x76 // CSB high
+)
+
+// MaxSPIFrequency is the highest SPI clock supported by the MS5611.
+const MaxSPIFrequency = 20 * physic.MegaHertz
+
+// Commands.
+const (
+	cmdReset     = 0x1E
+	cmdConvertD1 = 0x40 // pressure, OSR in bits 3..1
+	cmdConvertD2 = 0x50 // temperature, OSR in bits 3..1
+	cmdADCRead   = 0x00
+	cmdPROMRead  = 0xA0 // word in bits 3..1
+)
+
+// resetTime is the time to reload the PROM after a reset.
+const resetTime = 3 * time.Millisecond
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadPROM is returned by New when the calibration read from the PROM
+	// fails its CRC, usually because no device answers.
+	ErrBadPROM = errors.New("ms5611: invalid PROM")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("ms5611: invalid options")
+	// ErrNotReady is returned when the ADC is read before the end of the
+	// conversion, which reads as 0.
+	ErrNotReady = errors.New("ms5611: conversion not complete")
+)
+
+// Oversampling is the oversampling ratio of a conversion.
+type Oversampling uint8
+
+// Possible oversampling ratios. Each step doubles the conversion time and
+// lowers the noise.
+const (
+	OSR256  Oversampling = 0
+	OSR512  Oversampling = 1
+	OSR1024 Oversampling = 2
+	OSR2048 Oversampling = 3
+	OSR4096 Oversampling = 4
+)
+
+// convTime is the maximum conversion time of each oversampling ratio.
+var convTime = [...]time.Duration{
+	600 * time.Microsecond,
+	1170 * time.Microsecond,
+	2280 * time.Microsecond,
+	4540 * time.Microsecond,
+	9040 * time.Microsecond,
+}
+
+func (o Oversampling) String() string {
+	if o <= OSR4096 {
+		return fmt.Sprintf("OSR%d", 256<<o)
+	}
+	return fmt.Sprintf("Oversampling(%d)", uint8(o))
+}
+
+// DefaultOpts are the options for the highest resolution, at about 50
+// measurements per second.
+var DefaultOpts = Opts{
+	Pressure:    OSR4096,
+	Temperature: OSR4096,
+}
+
+// Opts holds initialization options.
+//
+// Pressure and Temperature: oversampling ratio of each conversion. The
+// temperature changes slowly; a lower ratio shortens the measurement for a
+// small loss of accuracy of the pressure.
+// Addr: I²C address, DefaultAddr by default. Ignored on SPI.
+type Opts struct {
+	Pressure    Oversampling
+	Temperature Oversampling
+	Addr        uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Pressure > OSR4096 {
+		return fmt.Errorf("%w: Pressure %s, want OSR256 to OSR4096", ErrInvalidOpts, o.Pressure)
+	}
+	if o.Temperature > OSR4096 {
+		return fmt.Errorf("%w: Temperature %s, want OSR256 to OSR4096", ErrInvalidOpts, o.Temperature)
+	}
+	return nil
+}
+
+// measDuration returns the duration of a measurement of both conversions.
+func (o *Opts) measDuration() time.Duration {
+	return convTime[o.Pressure] + convTime[o.Temperature]
+}
+
+// Dev is a handle to an initialized MS5611 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c     conn.Conn
+	isSPI bool
+	opts  Opts
+	prom  prom
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets a device on an I²C bus and reads its calibration.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Bus: bus, Addr: addr}, false, opts)
+}
+
+// NewSPI resets a device on a SPI port and reads its calibration.
+//
+// The port is connected in mode 0 at MaxSPIFrequency (20 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("ms5611: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	d := &Dev{c: c, isSPI: isSPI, opts: opts}
+	if err := d.command(cmdReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	for i := range d.prom {
+		var b [2]byte
+		if err := d.read(cmdPROMRead|byte(i)<<1, b[:]); err != nil {
+			return nil, err
+		}
+		d.prom[i] = uint16(b[0])<<8 | uint16(b[1])
+	}
+	if err := d.prom.check(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MS5611{%s}", d.c)
+}
+
+// Sense measures the pressure and the temperature. It implements
+// physic.SenseEnv.
+//
+// The humidity is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("ms5611: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseRaw returns the raw 24 bits ADC values of the pressure (D1) and the
+// temperature (D2), before compensation.
+func (d *Dev) SenseRaw() (uint32, uint32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return 0, 0, errors.New("ms5611: already sensing continuously")
+	}
+	return d.senseRaw()
+}
+
+// SenseContinuous returns measurements of the pressure and the temperature on
+// a continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than the duration of both conversions.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if m := d.opts.measDuration(); interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 10 * physic.MilliKelvin
+	e.Pressure = physic.Pascal
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device idles between conversions.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense measures and compensates.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	d1, d2, err := d.senseRaw()
+	if err != nil {
+		return err
+	}
+	t, p := d.prom.compensate(d1, d2)
+	// Convert centi °C to Kelvin.
+	e.Temperature = physic.Temperature(t)*10*physic.MilliCelsius + physic.ZeroCelsius
+	e.Pressure = physic.Pressure(p) * physic.Pascal
+	return nil
+}
+
+// senseRaw runs the pressure conversion then the temperature conversion.
+//
+// It must be called with d.mu held.
+func (d *Dev) senseRaw() (uint32, uint32, error) {
+	d1, err := d.convert(cmdConvertD1, d.opts.Pressure)
+	if err != nil {
+		return 0, 0, err
+	}
+	d2, err := d.convert(cmdConvertD2, d.opts.Temperature)
+	if err != nil {
+		return 0, 0, err
+	}
+	return d1, d2, nil
+}
+
+// convert starts a conversion, waits for it and reads the ADC.
+func (d *Dev) convert(cmd byte, o Oversampling) (uint32, error) {
+	if err := d.command(cmd | byte(o)<<1); err != nil {
+		return 0, err
+	}
+	doSleep(convTime[o])
+	var b [3]byte
+	if err := d.read(cmdADCRead, b[:]); err != nil {
+		return 0, err
+	}
+	v := uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	if v == 0 {
+		return 0, ErrNotReady
+	}
+	return v, nil
+}
+
+func (d *Dev) command(cmd byte) error {
+	if err := d.c.Tx([]byte{cmd}, nil); err != nil {
+		return fmt.Errorf("ms5611: command 0x%02x: %w", cmd, err)
+	}
+	return nil
+}
+
+// read issues the command cmd and reads its result into b.
+func (d *Dev) read(cmd byte, b []byte) error {
+	var err error
+	if d.isSPI {
+		// SPI is full duplex: the result is clocked out after the command.
+		w := make([]byte, len(b)+1)
+		r := make([]byte, len(b)+1)
+		w[0] = cmd
+		err = d.c.Tx(w, r)
+		copy(b, r[1:])
+	} else {
+		err = d.c.Tx([]byte{cmd}, b)
+	}
+	if err != nil {
+		return fmt.Errorf("ms5611: command 0x%02x: %w", cmd, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
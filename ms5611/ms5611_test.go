@@ -0,0 +1,279 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ms5611
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// testPROM is the calibration of the example of the datasheet, with its CRC.
+var testPROM = prom{0x0095, 40127, 36924, 23317, 23282, 33464, 28312, 0x0002}
+
+// initOps are the bus transactions issued by New.
+func initOps() []i2ctest.IO {
+	ops := []i2ctest.IO{{Addr: DefaultAddr, W: []byte{cmdReset}}}
+	for i, w := range testPROM {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{cmdPROMRead | byte(i)<<1}, R: []byte{byte(w >> 8), byte(w)}})
+	}
+	return ops
+}
+
+// senseOps are the bus transactions of a measurement with DefaultOpts,
+// returning the raw values of the example of the datasheet.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{0x48}},
+		{Addr: DefaultAddr, W: []byte{cmdADCRead}, R: []byte{0x8A, 0xA2, 0x1A}},
+		{Addr: DefaultAddr, W: []byte{0x58}},
+		{Addr: DefaultAddr, W: []byte{cmdADCRead}, R: []byte{0x82, 0xC1, 0x3E}},
+	}
+}
+
+// testEnv is the measurement of the example of the datasheet.
+var testEnv = physic.Env{
+	Temperature: physic.ZeroCelsius + 20070*physic.MilliCelsius,
+	Pressure:    100009 * physic.Pascal,
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.prom != testPROM {
+		t.Fatalf("%v, want %v", d.prom, testPROM)
+	}
+	if s := d.String(); s != "MS5611{playback(119)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_bad_prom(t *testing.T) {
+	data := []struct {
+		w7  uint16
+		r   byte
+		err string
+	}{
+		{0x0003, 0x00, "ms5611: invalid PROM: CRC 0x2, want 0x3"},
+		{0x0000, 0xFF, "ms5611: invalid PROM: no calibration"},
+	}
+	for i, line := range data {
+		ops := initOps()
+		ops[8].R = []byte{byte(line.w7 >> 8), byte(line.w7)}
+		if line.r != 0 {
+			for j := 1; j < len(ops); j++ {
+				ops[j].R = []byte{line.r, line.r}
+			}
+		}
+		bus := i2ctest.Playback{Ops: ops}
+		_, err := New(&bus, DefaultOpts)
+		if !errors.Is(err, ErrBadPROM) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(initOps()); i++ {
+		bus := i2ctest.Playback{Ops: initOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	ops := []conntest.IO{{W: []byte{cmdReset}}}
+	for i, w := range testPROM {
+		ops = append(ops, conntest.IO{W: []byte{cmdPROMRead | byte(i)<<1, 0, 0}, R: []byte{0, byte(w >> 8), byte(w)}})
+	}
+	ops = append(ops,
+		conntest.IO{W: []byte{0x40}},
+		conntest.IO{W: []byte{cmdADCRead, 0, 0, 0}, R: []byte{0, 0x8A, 0xA2, 0x1A}},
+		conntest.IO{W: []byte{0x52}},
+		conntest.IO{W: []byte{cmdADCRead, 0, 0, 0}, R: []byte{0, 0x82, 0xC1, 0x3E}},
+	)
+	port := spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+	d, err := NewSPI(&port, Opts{Temperature: OSR512})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		err  string
+	}{
+		{Opts{Pressure: 5}, "ms5611: invalid options: Pressure Oversampling(5), want OSR256 to OSR4096"},
+		{Opts{Temperature: 5}, "ms5611: invalid options: Temperature Oversampling(5), want OSR256 to OSR4096"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %q", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.opts); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseRaw(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d1, d2, err := d.SenseRaw()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d1 != 9085466 || d2 != 8569150 {
+		t.Fatal(d1, d2)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_not_ready(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x48}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{cmdADCRead}, R: []byte{0, 0, 0}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(initOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(10 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(20 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, _, err := d.SenseRaw(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 10*physic.MilliKelvin || e.Pressure != physic.Pascal {
+		t.Fatal(e)
+	}
+}
+
+func TestOversampling_String(t *testing.T) {
+	data := []struct {
+		o Oversampling
+		s string
+	}{
+		{OSR256, "OSR256"},
+		{OSR4096, "OSR4096"},
+		{5, "Oversampling(5)"},
+	}
+	for _, line := range data {
+		if s := line.o.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ms5611
+
+import "fmt"
+
+// prom holds the 8 words of the PROM: the factory data, the coefficients C1
+// to C6 and the CRC in the low nibble of the last word.
+type prom [8]uint16
+
+// check verifies the CRC of the PROM.
+func (p *prom) check() error {
+	blank := true
+	for _, w := range p[1:7] {
+		if w != 0 && w != 0xFFFF {
+			blank = false
+		}
+	}
+	if blank {
+		// A blank PROM would pass the CRC.
+		return fmt.Errorf("%w: no calibration", ErrBadPROM)
+	}
+	if got, want := p.crc4(), byte(p[7]&0x0F); got != want {
+		return fmt.Errorf("%w: CRC 0x%x, want 0x%x", ErrBadPROM, got, want)
+	}
+	return nil
+}
+
+// crc4 returns the CRC of the PROM, computed as in the application note AN520.
+func (p *prom) crc4() byte {
+	var rem uint16
+	for i := 0; i < 16; i++ {
+		w := p[i>>1]
+		if i == 14 || i == 15 {
+			// The CRC itself is excluded.
+			w &= 0xFF00
+		}
+		if i&1 == 1 {
+			rem ^= w & 0xFF
+		} else {
+			rem ^= w >> 8
+		}
+		for bit := 0; bit < 8; bit++ {
+			if rem&0x8000 != 0 {
+				rem = rem<<1 ^ 0x3000
+			} else {
+				rem <<= 1
+			}
+		}
+	}
+	return byte(rem >> 12 & 0x0F)
+}
+
+// compensate returns the temperature in °C with a resolution of 0.01°C and
+// the pressure in Pa from the raw pressure d1 and temperature d2, with the
+// second order compensation of the datasheet below 20°C. Output values of
+// 2007 and 100009 equal 20.07°C and 1000.09mbar.
+func (p *prom) compensate(d1, d2 uint32) (int32, int32) {
+	dT := int64(d2) - int64(p[5])<<8
+	temp := 2000 + dT*int64(p[6])>>23
+	off := int64(p[2])<<16 + int64(p[4])*dT>>7
+	sens := int64(p[1])<<15 + int64(p[3])*dT>>8
+	if temp < 2000 {
+		t2 := dT * dT >> 31
+		x := (temp - 2000) * (temp - 2000)
+		off2 := 5 * x >> 1
+		sens2 := 5 * x >> 2
+		if temp < -1500 {
+			x = (temp + 1500) * (temp + 1500)
+			off2 += 7 * x
+			sens2 += 11 * x >> 1
+		}
+		temp -= t2
+		off -= off2
+		sens -= sens2
+	}
+	pres := (int64(d1)*sens>>21 - off) >> 15
+	return int32(temp), int32(pres)
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ms5611
+
+import "testing"
+
+func TestCRC4(t *testing.T) {
+	// Example of the application note AN520.
+	p := prom{0x3132, 0x3334, 0x3536, 0x3738, 0x3940, 0x4142, 0x4344, 0x4500}
+	if c := p.crc4(); c != 0xB {
+		t.Fatalf("%#x, want 0xb", c)
+	}
+	if c := testPROM.crc4(); c != 0x2 {
+		t.Fatalf("%#x, want 0x2", c)
+	}
+}
+
+func TestCompensate(t *testing.T) {
+	data := []struct {
+		d1, d2     uint32
+		temp, pres int32
+	}{
+		// Example of the datasheet.
+		{9085466, 8569150, 2007, 100009},
+		// Second order, below 20°C.
+		{9085466, 8200000, 700, 97476},
+		// Second order, below -15°C.
+		{9085466, 7500000, -2130, 91910},
+	}
+	for _, line := range data {
+		temp, pres := testPROM.compensate(line.d1, line.d2)
+		if temp != line.temp || pres != line.pres {
+			t.Fatalf("%d, %d: %d, %d, want %d, %d", line.d1, line.d2, temp, pres, line.temp, line.pres)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package qmc5883l controls a QMC5883L 3-axis magnetometer over I²C.
+//
+// # More details
+//
+// The QMC5883L from QST is found on most cheap breakouts labeled HMC5883L. It
+// answers at address 0x0D instead of 0x1E and has a different register map,
+// so the hmc5983 package reports it with hmc5983.ErrWrongChipQMC5883L.
+//
+// The driver runs the chip in continuous measurement mode, at 10, 50, 100 or
+// 200Hz with a ±2 or ±8 Gauss range. SenseRaw returns 16 bits counts, at
+// 12000 or 3000 per Gauss, that is 8.3nT or 33nT, and Sense returns µT×10.
+// The temperature sensor is read with Temperature.
+//
+// # Datasheet
+//
+// https://www.qstcorp.com/upload/pdf/202202/13-52-04%20QMC5883L%20Datasheet%20Rev.%20A(1).pdf
+package qmc5883l
@@ -0,0 +1,416 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package qmc5883l
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address of the QMC5883L, which can't be changed.
+const DefaultAddr = 0x0D
+
+// Register map.
+const (
+	regDATA   = 0x00 // X LSB, X MSB, Y LSB, Y MSB, Z LSB, Z MSB
+	regSTATUS = 0x06
+	regTEMP   = 0x07 // TEMP LSB, TEMP MSB
+	regCTRL1  = 0x09 // OSR bits 7..6, RNG bits 5..4, ODR bits 3..2, MODE bits 1..0
+	regCTRL2  = 0x0A
+	regPERIOD = 0x0B // SET/RESET period
+	regID     = 0x0D
+)
+
+// chipID is the value of the chip ID register.
+const chipID = 0xFF
+
+// STATUS bits.
+const (
+	statusDRDY = 0x01 // new data is ready
+	statusOVL  = 0x02 // an axis exceeded the range
+	statusDOR  = 0x04 // a measurement was skipped, not read in time
+)
+
+// CTRL2 bits.
+const (
+	ctrl2SoftReset = 0x80
+	ctrl2RollOver  = 0x40 // the read pointer rolls over from STATUS to DATA
+)
+
+// MODE bits of CTRL1.
+const (
+	modeStandby    = 0x00
+	modeContinuous = 0x01
+)
+
+// resetTime is the time for the registers to be restored after a soft reset,
+// rounded up from the 350µs power on time.
+const resetTime = time.Millisecond
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the chip ID register doesn't read 0xFF.
+	ErrBadID = errors.New("qmc5883l: bad chip ID")
+	// ErrOverflow is returned along with the measurement when an axis
+	// exceeded the selected range; the values must be discarded.
+	ErrOverflow = errors.New("qmc5883l: measurement overflow")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("qmc5883l: device halted")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("qmc5883l: invalid options")
+)
+
+// Range is the full scale of the measurements.
+type Range byte
+
+// Ranges supported by the QMC5883L.
+const (
+	Range2G Range = 0 // ±2 Gauss, 12000 LSB/Gauss
+	Range8G Range = 1 // ±8 Gauss, 3000 LSB/Gauss
+)
+
+func (r Range) String() string {
+	switch r {
+	case Range2G:
+		return "±2G"
+	case Range8G:
+		return "±8G"
+	default:
+		return fmt.Sprintf("Range(%d)", byte(r))
+	}
+}
+
+// LSBPerGauss returns the sensitivity of the range.
+func (r Range) LSBPerGauss() int {
+	if r == Range8G {
+		return 3000
+	}
+	return 12000
+}
+
+// Opts holds initialization options.
+//
+// ODRHz: output data rate, 10 (default), 50, 100 or 200Hz.
+// Range: full scale, Range2G by default.
+// Oversampling: number of internal samples per measurement, 64, 128, 256 or
+// 512 (default). Larger values reduce the noise at the expense of power.
+// Addr: I²C address, DefaultAddr by default.
+// SkipIDCheck: don't fail with ErrBadID when the chip ID register doesn't
+// read 0xFF.
+type Opts struct {
+	ODRHz        int
+	Range        Range
+	Oversampling int
+	Addr         uint16
+	SkipIDCheck  bool
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	switch o.ODRHz {
+	case 0, 10, 50, 100, 200:
+	default:
+		return fmt.Errorf("%w: ODRHz %d, want 10, 50, 100 or 200", ErrInvalidOpts, o.ODRHz)
+	}
+	if o.Range > Range8G {
+		return fmt.Errorf("%w: Range %s, want Range2G or Range8G", ErrInvalidOpts, o.Range)
+	}
+	switch o.Oversampling {
+	case 0, 64, 128, 256, 512:
+	default:
+		return fmt.Errorf("%w: Oversampling %d, want 64, 128, 256 or 512", ErrInvalidOpts, o.Oversampling)
+	}
+	return nil
+}
+
+// ctrl1 returns the CTRL1 register value selected by o, in continuous mode,
+// and the output data rate.
+func (o *Opts) ctrl1() (byte, int) {
+	ctrl1 := byte(o.Range) << 4
+	odr := o.ODRHz
+	switch odr {
+	case 50:
+		ctrl1 |= 0b01 << 2
+	case 100:
+		ctrl1 |= 0b10 << 2
+	case 200:
+		ctrl1 |= 0b11 << 2
+	default:
+		odr = 10
+	}
+	switch o.Oversampling {
+	case 256:
+		ctrl1 |= 0b01 << 6
+	case 128:
+		ctrl1 |= 0b10 << 6
+	case 64:
+		ctrl1 |= 0b11 << 6
+	}
+	return ctrl1 | modeContinuous, odr
+}
+
+// Dev represents a QMC5883L device.
+// Sense returns X,Y,Z values in µT×10.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c      conn.Conn
+	rng    Range
+	odr    int
+	ctrl1  byte
+	halted bool
+	data   [regSTATUS + 1]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets the device on an I²C bus and starts continuous measurements.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Addr: addr, Bus: bus}, rng: opts.Range}
+	d.ctrl1, d.odr = opts.ctrl1()
+	if !opts.SkipIDCheck {
+		id, err := d.readReg(regID)
+		if err != nil {
+			return nil, err
+		}
+		if id != chipID {
+			return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, chipID)
+		}
+	}
+	if err := d.writeReg(regCTRL2, ctrl2SoftReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	// The datasheet recommends a SET/RESET period of 0x01.
+	if err := d.writeReg(regPERIOD, 0x01); err != nil {
+		return nil, err
+	}
+	if err := d.writeReg(regCTRL2, ctrl2RollOver); err != nil {
+		return nil, err
+	}
+	if err := d.writeReg(regCTRL1, d.ctrl1); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	return fmt.Sprintf("QMC5883L{%s, %s, %dHz}", d.c, d.rng, d.odr)
+}
+
+// Halt stops SenseMagneticContinuous and puts the device in standby. It
+// implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeReg(regCTRL1, d.ctrl1&^0x03|modeStandby); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw reads the latest measurement and returns X,Y,Z as int16 counts.
+//
+// The counts are returned along with ErrOverflow when an axis exceeded the
+// range.
+func (d *Dev) SenseRaw() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.senseRaw()
+}
+
+func (d *Dev) senseRaw() (int16, int16, int16, error) {
+	if d.halted {
+		return 0, 0, 0, ErrHalted
+	}
+	// Read DATA and STATUS in one transaction. The OVL bit applies to the
+	// measurement in the data registers.
+	if err := d.readRegBlock(regDATA, d.data[:]); err != nil {
+		return 0, 0, 0, err
+	}
+	b := d.data
+	x := int16(b[1])<<8 | int16(b[0])
+	y := int16(b[3])<<8 | int16(b[2])
+	z := int16(b[5])<<8 | int16(b[4])
+	if b[regSTATUS]&statusOVL != 0 {
+		return x, y, z, ErrOverflow
+	}
+	return x, y, z, nil
+}
+
+// Sense reads and scales to µT×10 (int16) for X,Y,Z.
+//
+// The values are truncated to 0.1µT; use SenseMagnetic for full resolution.
+// On overflow, the values are returned along with ErrOverflow.
+func (d *Dev) Sense() (int16, int16, int16, error) {
+	x, y, z, err := d.SenseRaw()
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return 0, 0, 0, err
+	}
+	// µT×10 = counts / LSB_per_Gauss * 100 * 10
+	lsb := float64(d.rng.LSBPerGauss())
+	return int16(float64(x) / lsb * 1000), int16(float64(y) / lsb * 1000), int16(float64(z) / lsb * 1000), err
+}
+
+// SenseMagnetic reads the latest measurement. It implements
+// sensor.Magnetometer.
+//
+// On overflow, the values are returned along with ErrOverflow.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	x, y, z, err := d.SenseRaw()
+	if err != nil && !errors.Is(err, ErrOverflow) {
+		return err
+	}
+	f.X = d.toFlux(x)
+	f.Y = d.toFlux(y)
+	f.Z = d.toFlux(z)
+	return err
+}
+
+// toFlux converts counts to flux density, rounded down to the nT.
+func (d *Dev) toFlux(c int16) physic.MagneticFluxDensity {
+	// 1 Gauss = 100000nT.
+	return physic.MagneticFluxDensity(int64(c) * 100000 / int64(d.rng.LSBPerGauss()))
+}
+
+// SenseMagneticContinuous returns a channel delivering measurements every
+// interval, until Halt is called. It implements sensor.Magnetometer.
+//
+// An interval of 0 or less uses the output data rate. Measurements that
+// overflow are skipped. Calling SenseMagneticContinuous again stops the
+// previous channel.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if interval <= 0 {
+		interval = time.Second / time.Duration(d.odr)
+	}
+	c := make(chan sensor.Field)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- sensor.Field) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var f sensor.Field
+		if err := d.SenseMagnetic(&f); err != nil {
+			if errors.Is(err, ErrOverflow) {
+				continue
+			}
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- f:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseMagneticContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// Temperature reads the temperature sensor.
+//
+// The sensor has a sensitivity of 100 LSB/°C but its offset isn't calibrated
+// at the factory, so only changes of the temperature are accurate.
+func (d *Dev) Temperature() (physic.Temperature, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var b [2]byte
+	if err := d.readRegBlock(regTEMP, b[:]); err != nil {
+		return 0, err
+	}
+	raw := int16(b[1])<<8 | int16(b[0])
+	return physic.ZeroCelsius + physic.Temperature(raw)*physic.Kelvin/100, nil
+}
+
+// Status reads the status register. Bit 0 reports new data, bit 1 an
+// overflow and bit 2 a skipped measurement.
+func (d *Dev) Status() (byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readReg(regSTATUS)
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if err := d.c.Tx([]byte{addr}, out); err != nil {
+		return fmt.Errorf("qmc5883l: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+func (d *Dev) writeReg(addr, v byte) error {
+	if err := d.c.Tx([]byte{addr, v}, nil); err != nil {
+		return fmt.Errorf("qmc5883l: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
@@ -0,0 +1,234 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package qmc5883l
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given CTRL1.
+func initOps(ctrl1 byte) []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regID}, R: []byte{chipID}},
+		{Addr: DefaultAddr, W: []byte{regCTRL2, ctrl2SoftReset}},
+		{Addr: DefaultAddr, W: []byte{regPERIOD, 0x01}},
+		{Addr: DefaultAddr, W: []byte{regCTRL2, ctrl2RollOver}},
+		{Addr: DefaultAddr, W: []byte{regCTRL1, ctrl1}},
+	}
+}
+
+// dataOp returns a read of DATA and STATUS.
+func dataOp(x, y, z int16, status byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regDATA}, R: []byte{
+		byte(x), byte(uint16(x) >> 8), byte(y), byte(uint16(y) >> 8), byte(z), byte(uint16(z) >> 8), status,
+	}}
+}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts  Opts
+		ctrl1 byte
+		s     string
+	}{
+		{Opts{}, 0x01, "QMC5883L{playback(13), ±2G, 10Hz}"},
+		{Opts{ODRHz: 200, Range: Range8G, Oversampling: 64}, 0xDD, "QMC5883L{playback(13), ±8G, 200Hz}"},
+		{Opts{ODRHz: 50, Oversampling: 256}, 0x45, "QMC5883L{playback(13), ±2G, 50Hz}"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: initOps(line.ctrl1)}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regID}, R: []byte{0x00}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+	bus = &i2ctest.Playback{Ops: initOps(0x01)[1:]}
+	if _, err := New(bus, Opts{SkipIDCheck: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{ODRHz: 100, Range: Range8G, Oversampling: 128}, ""},
+		{Opts{ODRHz: 75}, "qmc5883l: invalid options: ODRHz 75, want 10, 50, 100 or 200"},
+		{Opts{Range: 2}, "qmc5883l: invalid options: Range Range(2), want Range2G or Range8G"},
+		{Opts{Oversampling: 8}, "qmc5883l: invalid options: Oversampling 8, want 64, 128, 256 or 512"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{ODRHz: 75}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := append(initOps(0x01), dataOp(6000, -1200, 24000, statusDRDY), dataOp(6000, -1200, 24000, 0), dataOp(1, 2, 3, 0))
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 12000 LSB/Gauss.
+	if x, y, z, err := d.Sense(); err != nil || x != 500 || y != -100 || z != 2000 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		t.Fatal(err)
+	}
+	if want := (sensor.Field{X: 50 * physic.MicroTesla, Y: -10 * physic.MicroTesla, Z: 200 * physic.MicroTesla}); f != want {
+		t.Fatalf("SenseMagnetic() = %s, want %s", f, want)
+	}
+	if x, y, z, err := d.SenseRaw(); err != nil || x != 1 || y != 2 || z != 3 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_Overflow(t *testing.T) {
+	ops := append(initOps(0x11), dataOp(32767, 0, 0, statusDRDY|statusOVL))
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{Range: Range8G})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("SenseMagnetic() = %v, want ErrOverflow", err)
+	}
+	if f.X != 1092233*physic.NanoTesla {
+		t.Fatalf("SenseMagnetic() = %s", f)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	ops := append(initOps(0x01),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regTEMP}, R: []byte{0xC4, 0x09}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{statusDRDY}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2500 LSB at 100 LSB/°C.
+	if temp, err := d.Temperature(); err != nil || temp != physic.ZeroCelsius+25*physic.Kelvin {
+		t.Fatalf("Temperature() = %s, %v", temp, err)
+	}
+	if s, err := d.Status(); err != nil || s != statusDRDY {
+		t.Fatalf("Status() = %#x, %v", s, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous(t *testing.T) {
+	ops := append(initOps(0x0D),
+		dataOp(12, 0, 0, statusDRDY|statusOVL),
+		dataOp(120, 0, 0, statusDRDY),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCTRL1, 0x0C}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The overflowed measurement is skipped.
+	if f := <-c; f.X != physic.MicroTesla {
+		t.Fatalf("got %s", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrHalted {
+		t.Fatalf("SenseRaw() = %v, want ErrHalted", err)
+	}
+	if _, err := d.SenseMagneticContinuous(0); err != ErrHalted {
+		t.Fatalf("SenseMagneticContinuous() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(0x01), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.Temperature(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRange_String(t *testing.T) {
+	if s := Range8G.String(); s != "±8G" {
+		t.Fatal(s)
+	}
+	if s := Range(5).String(); s != "Range(5)" {
+		t.Fatal(s)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package rm3100 controls a PNI RM3100 magneto-inductive magnetometer over
+// I²C or SPI.
+//
+// # More details
+//
+// The RM3100 measures the frequency of LR oscillators instead of a bridge
+// voltage, so it has no offset and very low noise. The cycle count sets how
+// many oscillations are counted per measurement: higher counts increase the
+// gain and the resolution and lower the maximum rate. At the default of 200
+// cycles, the gain is 75 LSB/µT and the noise about 15nT.
+//
+// The driver takes single measurements on demand, or runs the continuous
+// measurement mode at Opts.ODRHz. The DRDY pin, when connected, is waited on
+// instead of polling the status register.
+//
+// SenseRaw returns the counts, at Gain LSB/µT, that is 13nT at 200 cycles, and
+// Sense returns µT×10.
+//
+// # Datasheet
+//
+// https://www.pnicorp.com/wp-content/uploads/RM3100-RM2100-Sensor-Suite-User-Manual-R09-1.pdf
+package rm3100
@@ -0,0 +1,42 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package rm3100_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/rm3100"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := rm3100.New(bus, rm3100.Opts{CycleCount: 400, ODRHz: 37})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	c, err := d.SenseMagneticContinuous(0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for f := range c {
+		fmt.Println(f, f.Magnitude())
+	}
+}
@@ -0,0 +1,489 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package rm3100
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/sensor"
+)
+
+// DefaultAddr is the I²C address with SA0 and SA1 low. The address is 0x20
+// to 0x23 depending on these pins.
+const DefaultAddr = 0x20
+
+// MaxSPIFrequency is the highest SPI clock supported by the RM3100.
+const MaxSPIFrequency = physic.MegaHertz
+
+// Register map.
+const (
+	regPOLL   = 0x00 // PMZ bit 6, PMY bit 5, PMX bit 4
+	regCMM    = 0x01 // CMZ bit 6, CMY bit 5, CMX bit 4, DRDM bits 3..2, START bit 0
+	regCCX    = 0x04 // CCX MSB, CCX LSB, CCY MSB, CCY LSB, CCZ MSB, CCZ LSB
+	regTMRC   = 0x0B
+	regMX     = 0x24 // MX, MY and MZ, 24 bits MSB first
+	regSTATUS = 0x34 // DRDY bit 7
+	regREVID  = 0x36
+)
+
+// revID is the value of the REVID register.
+const revID = 0x22
+
+// Register bits.
+const (
+	pollXYZ    = 0x70
+	cmmXYZ     = 0x70
+	cmmDRDMAll = 0x08 // DRDY once all the axes are measured
+	cmmStart   = 0x01
+	statusDRDY = 0x80
+	spiRead    = 0x80 // bit 7 of the address; it auto-increments
+)
+
+// cycleTime is the approximate duration of one cycle of an axis.
+const cycleTime = 12 * time.Microsecond
+
+// maxCycleCount is the highest Opts.CycleCount.
+const maxCycleCount = 800
+
+// DefaultCycleCount is the cycle count at power up.
+const DefaultCycleCount = 200
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the REVID register doesn't read 0x22.
+	ErrBadID = errors.New("rm3100: bad revision ID")
+	// ErrHalted is returned by measurement methods after Halt was called.
+	ErrHalted = errors.New("rm3100: device halted")
+	// ErrNotReady is returned when a measurement didn't complete in time.
+	ErrNotReady = errors.New("rm3100: measurement not ready")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("rm3100: invalid options")
+)
+
+// odrs are the continuous measurement rates, for TMRC values from 0x92. The
+// rate is also limited by the cycle count: at 200 cycles, it doesn't exceed
+// about 150Hz. The rates below 1Hz, TMRC 0x9C to 0x9F, aren't selectable.
+var odrs = [...]struct {
+	hz int
+	f  physic.Frequency
+}{
+	{600, 600 * physic.Hertz},
+	{300, 300 * physic.Hertz},
+	{150, 150 * physic.Hertz},
+	{75, 75 * physic.Hertz},
+	{37, 37 * physic.Hertz},
+	{18, 18 * physic.Hertz},
+	{9, 9 * physic.Hertz},
+	{4, 4500 * physic.MilliHertz},
+	{2, 2300 * physic.MilliHertz},
+	{1, 1200 * physic.MilliHertz},
+}
+
+// Opts holds initialization options.
+//
+// CycleCount: cycle count of all the axes, 1 to 800, DefaultCycleCount by
+// default. The datasheet characterizes 50 to 400.
+// ODRHz: continuous measurement rate, 600, 300, 150, 75, 37, 18, 9, 4 (4.5Hz),
+// 2 (2.3Hz) or 1 (1.2Hz). 0 (default) takes a single measurement on demand in
+// each Sense call.
+// Addr: I²C address, DefaultAddr by default. Ignored by NewSPI.
+// DRDY: optional pin connected to the DRDY output, waited on instead of
+// polling the status register.
+type Opts struct {
+	CycleCount int
+	ODRHz      int
+	Addr       uint16
+	DRDY       gpio.PinIn
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+//
+// Zero values are valid and select the defaults.
+func (o *Opts) Validate() error {
+	if o.CycleCount < 0 || o.CycleCount > maxCycleCount {
+		return fmt.Errorf("%w: CycleCount %d, want 1 to %d", ErrInvalidOpts, o.CycleCount, maxCycleCount)
+	}
+	if o.ODRHz != 0 && o.tmrc() == 0 {
+		return fmt.Errorf("%w: ODRHz %d, want 600, 300, 150, 75, 37, 18, 9, 4, 2 or 1", ErrInvalidOpts, o.ODRHz)
+	}
+	return nil
+}
+
+// tmrc returns the TMRC register value, 0 when unsupported.
+func (o *Opts) tmrc() byte {
+	for i, r := range odrs {
+		if r.hz == o.ODRHz {
+			return 0x92 + byte(i)
+		}
+	}
+	return 0
+}
+
+// odr returns the continuous measurement rate, 0 when measuring on demand.
+func (o *Opts) odr() physic.Frequency {
+	if t := o.tmrc(); t != 0 {
+		return odrs[t-0x92].f
+	}
+	return 0
+}
+
+// Gain returns the typical gain at a cycle count, in LSB/µT.
+func Gain(cycleCount int) float64 {
+	// Linear fit of the datasheet table, 75 LSB/µT at 200 cycles.
+	return 0.3671*float64(cycleCount) + 1.5
+}
+
+// Dev represents an RM3100 device.
+// Sense returns X,Y,Z values in µT×10.
+// Raw counts can be obtained via SenseRaw.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c          conn.Conn
+	isSPI      bool
+	cycleCount int
+	gain       float64 // LSB/µT
+	odr        physic.Frequency
+	meas       time.Duration
+	drdy       gpio.PinIn
+	halted     bool
+
+	// Preallocated bus buffers, so that sensing doesn't allocate. data holds
+	// the result; w and r are the SPI transfer buffers, one byte longer for
+	// the address.
+	data [9]byte
+	w, r [10]byte
+
+	// mu serializes bus transactions and guards the fields above and stop.
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New initializes the device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	return newDev(&i2c.Dev{Addr: addr, Bus: bus}, false, opts)
+}
+
+// NewSPI initializes the device on a 4-wire SPI port.
+//
+// The port is connected in mode 0 at MaxSPIFrequency (1 MHz).
+func NewSPI(p spi.Port, opts Opts) (*Dev, error) {
+	c, err := p.Connect(MaxSPIFrequency, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("rm3100: connecting SPI: %w", err)
+	}
+	return newDev(c, true, opts)
+}
+
+func newDev(c conn.Conn, isSPI bool, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	cc := opts.CycleCount
+	if cc == 0 {
+		cc = DefaultCycleCount
+	}
+	d := &Dev{
+		c:          c,
+		isSPI:      isSPI,
+		cycleCount: cc,
+		gain:       Gain(cc),
+		odr:        opts.odr(),
+		// Three axes, plus a margin.
+		meas: 3*time.Duration(cc)*cycleTime + time.Millisecond,
+		drdy: opts.DRDY,
+	}
+	if d.drdy != nil {
+		// DRDY is high once a measurement completes, until it is read.
+		if err := d.drdy.In(gpio.PullNoChange, gpio.RisingEdge); err != nil {
+			return nil, fmt.Errorf("rm3100: configuring DRDY: %w", err)
+		}
+	}
+	id, err := d.readReg(regREVID)
+	if err != nil {
+		return nil, err
+	}
+	if id != revID {
+		return nil, fmt.Errorf("%w: read %#02x, want %#02x", ErrBadID, id, revID)
+	}
+	// Stop the continuous mode left running, before changing the cycle
+	// counts.
+	if err := d.writeRegs(regCMM, 0); err != nil {
+		return nil, err
+	}
+	hi, lo := byte(cc>>8), byte(cc)
+	if err := d.writeRegs(regCCX, hi, lo, hi, lo, hi, lo); err != nil {
+		return nil, err
+	}
+	if d.odr != 0 {
+		if err := d.writeRegs(regTMRC, opts.tmrc()); err != nil {
+			return nil, err
+		}
+		if err := d.writeRegs(regCMM, cmmXYZ|cmmDRDMAll|cmmStart); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// String implements conn.Resource.
+func (d *Dev) String() string {
+	if d.odr == 0 {
+		return fmt.Sprintf("RM3100{%s, %d cycles, on demand}", d.c, d.cycleCount)
+	}
+	return fmt.Sprintf("RM3100{%s, %d cycles, %s}", d.c, d.cycleCount, d.odr)
+}
+
+// Gain returns the gain at the configured cycle count, in LSB/µT.
+func (d *Dev) Gain() float64 {
+	return d.gain
+}
+
+// Halt stops SenseMagneticContinuous and the continuous measurement mode. It
+// implements conn.Resource.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeRegs(regCMM, 0); err != nil {
+		return err
+	}
+	d.halted = true
+	return nil
+}
+
+// SenseRaw returns X,Y,Z as signed 24 bits counts.
+//
+// It takes a single measurement when ODRHz is 0, otherwise it waits for the
+// next measurement of the continuous mode.
+func (d *Dev) SenseRaw() (int32, int32, int32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	return c[0], c[1], c[2], err
+}
+
+func (d *Dev) senseRaw() ([3]int32, error) {
+	if d.halted {
+		return [3]int32{}, ErrHalted
+	}
+	if d.odr == 0 {
+		if err := d.writeRegs(regPOLL, pollXYZ); err != nil {
+			return [3]int32{}, err
+		}
+	}
+	if err := d.waitReady(); err != nil {
+		return [3]int32{}, err
+	}
+	if err := d.readRegBlock(regMX, d.data[:]); err != nil {
+		return [3]int32{}, err
+	}
+	var c [3]int32
+	for i := range c {
+		b := d.data[3*i:]
+		// Sign extend from 24 bits.
+		c[i] = int32(uint32(b[0])<<24|uint32(b[1])<<16|uint32(b[2])<<8) >> 8
+	}
+	return c, nil
+}
+
+// waitReady waits for DRDY, or polls the status register.
+func (d *Dev) waitReady() error {
+	timeout := d.meas
+	if d.odr != 0 {
+		timeout += d.odr.Period()
+	}
+	if d.drdy != nil {
+		// DRDY may already be high.
+		if d.drdy.Read() == gpio.High || d.drdy.WaitForEdge(timeout) {
+			return nil
+		}
+		return ErrNotReady
+	}
+	delay := d.meas / 4
+	for i := time.Duration(0); i*delay < 2*timeout; i++ {
+		s, err := d.readReg(regSTATUS)
+		if err != nil {
+			return err
+		}
+		if s&statusDRDY != 0 {
+			return nil
+		}
+		doSleep(delay)
+	}
+	return ErrNotReady
+}
+
+// Sense reads and scales to µT×10 (int16) for X,Y,Z.
+//
+// The values are truncated to 0.1µT; use SenseMagnetic for full resolution.
+func (d *Dev) Sense() (int16, int16, int16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// µT×10 = counts / LSB_per_µT * 10
+	return int16(float64(c[0]) / d.gain * 10), int16(float64(c[1]) / d.gain * 10), int16(float64(c[2]) / d.gain * 10), nil
+}
+
+// SenseMagnetic reads a measurement. It implements sensor.Magnetometer.
+func (d *Dev) SenseMagnetic(f *sensor.Field) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, err := d.senseRaw()
+	if err != nil {
+		return err
+	}
+	f.X = d.toFlux(c[0])
+	f.Y = d.toFlux(c[1])
+	f.Z = d.toFlux(c[2])
+	return nil
+}
+
+// toFlux converts counts to flux density, rounded to the nearest nT.
+func (d *Dev) toFlux(c int32) physic.MagneticFluxDensity {
+	return physic.MagneticFluxDensity(math.Round(float64(c) / d.gain * 1000))
+}
+
+// SenseMagneticContinuous returns a channel delivering measurements until
+// Halt is called. It implements sensor.Magnetometer.
+//
+// In continuous mode each measurement is delivered when ready, and interval
+// is ignored; otherwise a single measurement is taken every interval. An
+// interval of 0 or less measures back to back. Calling
+// SenseMagneticContinuous again stops the previous channel.
+func (d *Dev) SenseMagneticContinuous(interval time.Duration) (<-chan sensor.Field, error) {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.halted {
+		return nil, ErrHalted
+	}
+	if d.odr != 0 || interval <= 0 {
+		interval = 0
+	}
+	c := make(chan sensor.Field)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(c)
+		d.sensingContinuous(interval, stop, c)
+	}(d.stop)
+	return c, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, stop <-chan struct{}, c chan<- sensor.Field) {
+	var tick <-chan time.Time
+	if interval > 0 {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		if tick != nil {
+			select {
+			case <-stop:
+				return
+			case <-tick:
+			}
+		} else {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+		}
+		var f sensor.Field
+		if err := d.SenseMagnetic(&f); err != nil {
+			if errors.Is(err, ErrNotReady) {
+				continue
+			}
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case c <- f:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopContinuous stops the SenseMagneticContinuous goroutine, if any.
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+func (d *Dev) readReg(addr byte) (byte, error) {
+	var b [1]byte
+	if err := d.readRegBlock(addr, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// readRegBlock reads consecutive registers; the address auto-increments on
+// both buses.
+func (d *Dev) readRegBlock(addr byte, out []byte) error {
+	if d.isSPI {
+		// SPI is full duplex: the first byte clocked out carries the address,
+		// the data follows.
+		w, r := d.w[:len(out)+1], d.r[:len(out)+1]
+		clear(w)
+		w[0] = addr | spiRead
+		if err := d.c.Tx(w, r); err != nil {
+			return fmt.Errorf("rm3100: reading register 0x%02x: %w", addr, err)
+		}
+		copy(out, r[1:])
+		return nil
+	}
+	w := append(d.w[:0], addr)
+	if err := d.c.Tx(w, out); err != nil {
+		return fmt.Errorf("rm3100: reading register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// writeRegs writes consecutive registers starting at addr in one
+// transaction.
+func (d *Dev) writeRegs(addr byte, vals ...byte) error {
+	w := append(append(d.w[:0], addr), vals...)
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("rm3100: writing register 0x%02x: %w", addr, err)
+	}
+	return nil
+}
+
+// doSleep is overridden in tests.
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ sensor.Magnetometer = &Dev{}
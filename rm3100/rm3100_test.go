@@ -0,0 +1,250 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package rm3100
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+	"periph.io/x/devices/v3/sensor"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New for the given cycle count,
+// followed by those starting the continuous mode at tmrc when not 0.
+func initOps(cc int, tmrc byte) []i2ctest.IO {
+	hi, lo := byte(cc>>8), byte(cc)
+	ops := []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regREVID}, R: []byte{revID}},
+		{Addr: DefaultAddr, W: []byte{regCMM, 0x00}},
+		{Addr: DefaultAddr, W: []byte{regCCX, hi, lo, hi, lo, hi, lo}},
+	}
+	if tmrc != 0 {
+		ops = append(ops,
+			i2ctest.IO{Addr: DefaultAddr, W: []byte{regTMRC, tmrc}},
+			i2ctest.IO{Addr: DefaultAddr, W: []byte{regCMM, 0x79}},
+		)
+	}
+	return ops
+}
+
+// dataOp returns a read of the measurement registers.
+func dataOp(x, y, z int32) i2ctest.IO {
+	var r []byte
+	for _, v := range []int32{x, y, z} {
+		r = append(r, byte(v>>16), byte(v>>8), byte(v))
+	}
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regMX}, R: r}
+}
+
+// statusOp returns a read of STATUS.
+func statusOp(s byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddr, W: []byte{regSTATUS}, R: []byte{s}}
+}
+
+// pollOp starts a single measurement.
+var pollOp = i2ctest.IO{Addr: DefaultAddr, W: []byte{regPOLL, pollXYZ}}
+
+func TestNew(t *testing.T) {
+	data := []struct {
+		opts Opts
+		ops  []i2ctest.IO
+		s    string
+	}{
+		{Opts{}, initOps(200, 0), "RM3100{playback(32), 200 cycles, on demand}"},
+		{Opts{CycleCount: 400, ODRHz: 75}, initOps(400, 0x95), "RM3100{playback(32), 400 cycles, 75Hz}"},
+		{Opts{CycleCount: 50, ODRHz: 4}, initOps(50, 0x99), "RM3100{playback(32), 50 cycles, 4.500Hz}"},
+	}
+	for i, line := range data {
+		bus := &i2ctest.Playback{Ops: line.ops}
+		d, err := New(bus, line.opts)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if s := d.String(); s != line.s {
+			t.Errorf("#%d: String() = %q", i, s)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestNew_BadID(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regREVID}, R: []byte{0x00}}}}
+	if _, err := New(bus, Opts{}); !errors.Is(err, ErrBadID) {
+		t.Fatalf("New() = %v, want ErrBadID", err)
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		opts Opts
+		want string
+	}{
+		{Opts{}, ""},
+		{Opts{CycleCount: 800, ODRHz: 600}, ""},
+		{Opts{CycleCount: 801}, "rm3100: invalid options: CycleCount 801, want 1 to 800"},
+		{Opts{ODRHz: 100}, "rm3100: invalid options: ODRHz 100, want 600, 300, 150, 75, 37, 18, 9, 4, 2 or 1"},
+	}
+	for i, line := range data {
+		err := line.opts.Validate()
+		if line.want == "" {
+			if err != nil {
+				t.Errorf("#%d: Validate() = %v", i, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.want {
+			t.Errorf("#%d: Validate() = %v, want %q", i, err, line.want)
+		}
+	}
+	if _, err := New(&i2ctest.Playback{}, Opts{CycleCount: -1}); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatalf("New() = %v, want ErrInvalidOpts", err)
+	}
+}
+
+func TestGain(t *testing.T) {
+	if g := Gain(200); g < 74.9 || g > 75.0 {
+		t.Fatalf("Gain(200) = %g", g)
+	}
+}
+
+func TestSense(t *testing.T) {
+	ops := append(initOps(200, 0),
+		pollOp, statusOp(0), statusOp(statusDRDY), dataOp(7492, -749, 74920),
+		pollOp, statusOp(statusDRDY), dataOp(7492, -7492, -14984),
+		pollOp, statusOp(statusDRDY), dataOp(-1, 2, -0x800000),
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 74.92 LSB/µT.
+	if x, y, z, err := d.Sense(); err != nil || x != 1000 || y != -99 || z != 10000 {
+		t.Fatalf("Sense() = %d, %d, %d, %v", x, y, z, err)
+	}
+	var f sensor.Field
+	if err := d.SenseMagnetic(&f); err != nil {
+		t.Fatal(err)
+	}
+	if f.X != 100*physic.MicroTesla || f.Y != -f.X || f.Z != -2*f.X {
+		t.Fatalf("SenseMagnetic() = %s", f)
+	}
+	if x, y, z, err := d.SenseRaw(); err != nil || x != -1 || y != 2 || z != -0x800000 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_NotReady(t *testing.T) {
+	ops := append(initOps(200, 0), pollOp)
+	for i := 0; i < 8; i++ {
+		ops = append(ops, statusOp(0))
+	}
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrNotReady {
+		t.Fatalf("SenseRaw() = %v, want ErrNotReady", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSPI(t *testing.T) {
+	port := spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				{W: []byte{regREVID | spiRead, 0}, R: []byte{0, revID}},
+				{W: []byte{regCMM, 0x00}},
+				{W: []byte{regCCX, 0, 200, 0, 200, 0, 200}},
+				{W: []byte{regPOLL, pollXYZ}},
+				{W: []byte{regSTATUS | spiRead, 0}, R: []byte{0, statusDRDY}},
+				{
+					W: []byte{regMX | spiRead, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+					R: []byte{0, 0, 0, 1, 0xFF, 0xFF, 0xFF, 0x01, 0x00, 0x00},
+				},
+			},
+		},
+	}
+	d, err := NewSPI(&port, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, y, z, err := d.SenseRaw(); err != nil || x != 1 || y != -1 || z != 0x010000 {
+		t.Fatalf("SenseRaw() = %d, %d, %d, %v", x, y, z, err)
+	}
+	if err := port.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseMagneticContinuous_DRDY(t *testing.T) {
+	drdy := &gpiotest.Pin{N: "DRDY", EdgesChan: make(chan gpio.Level)}
+	ops := append(initOps(200, 0x96),
+		dataOp(7492, 0, 0),
+		dataOp(14984, 0, 0),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regCMM, 0x00}},
+	)
+	bus := &i2ctest.Playback{Ops: ops}
+	d, err := New(bus, Opts{ODRHz: 37, DRDY: drdy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.SenseMagneticContinuous(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 2; i++ {
+		drdy.EdgesChan <- gpio.Low
+		if f := <-c; f.X != physic.MagneticFluxDensity(i)*100*physic.MicroTesla {
+			t.Fatalf("#%d: got %s", i, f)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("channel not closed by Halt")
+	}
+	if _, _, _, err := d.SenseRaw(); err != ErrHalted {
+		t.Fatalf("SenseRaw() = %v, want ErrHalted", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := New(bus, Opts{}); err == nil {
+		t.Fatal("expected error")
+	}
+	bus = &i2ctest.Playback{Ops: initOps(200, 0), DontPanic: true}
+	d, err := New(bus, Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.Sense(); err == nil {
+		t.Fatal("expected error")
+	}
+}
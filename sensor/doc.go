@@ -0,0 +1,12 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package sensor defines interfaces shared by drivers of the same kind of
+// sensor, so that applications can swap one model for another.
+//
+// The drivers implement them on their device type. Magnetometer is
+// implemented by the hmc5983, qmc5883l, lis3mdl, mmc5983ma, rm3100, ak09916,
+// ist8310 and icm20948 packages. All but icm20948 also have the Sense and
+// SenseRaw methods of hmc5983, returning µT×10 and the raw counts.
+package sensor
@@ -0,0 +1,42 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sensor
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Field is a magnetic field measurement along the three axes of a sensor.
+type Field struct {
+	X, Y, Z physic.MagneticFluxDensity
+}
+
+// String returns the field in µT, e.g. "X=20.500µT Y=-3.120µT Z=41µT".
+func (f Field) String() string {
+	return fmt.Sprintf("X=%s Y=%s Z=%s", f.X, f.Y, f.Z)
+}
+
+// Magnitude returns the total field, independent of the orientation of the
+// sensor.
+func (f Field) Magnitude() physic.MagneticFluxDensity {
+	x, y, z := float64(f.X), float64(f.Y), float64(f.Z)
+	return physic.MagneticFluxDensity(math.Round(math.Sqrt(x*x + y*y + z*z)))
+}
+
+// Magnetometer is a 3-axis magnetic field sensor.
+type Magnetometer interface {
+	conn.Resource
+	// SenseMagnetic reads one measurement.
+	SenseMagnetic(f *Field) error
+	// SenseMagneticContinuous returns a channel delivering measurements
+	// every interval, until Halt is called. An interval of 0 or less lets the
+	// driver choose, usually its output data rate.
+	SenseMagneticContinuous(interval time.Duration) (<-chan Field, error)
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sensor
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestField(t *testing.T) {
+	f := Field{X: 30 * physic.MicroTesla, Y: -40 * physic.MicroTesla}
+	if m := f.Magnitude(); m != 50*physic.MicroTesla {
+		t.Fatalf("Magnitude() = %s", m)
+	}
+	if s := f.String(); s != "X=30µT Y=-40µT Z=0T" {
+		t.Fatalf("String() = %q", s)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht3x
+
+import (
+	"fmt"
+	"strings"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Status is the content of the status register.
+type Status uint16
+
+// Status bits.
+const (
+	StatusAlertPending     Status = 1 << 15 // at least one alert is pending
+	StatusHeater           Status = 1 << 13 // the heater is on
+	StatusHumidityAlert    Status = 1 << 11 // the humidity is out of the limits
+	StatusTemperatureAlert Status = 1 << 10 // the temperature is out of the limits
+	StatusReset            Status = 1 << 4  // a reset occurred since the last clear
+	StatusCommandFailed    Status = 1 << 1  // the last command was not processed
+	StatusWriteCRCFailed   Status = 1 << 0  // the CRC of the last write was wrong
+)
+
+var statusNames = []struct {
+	s    Status
+	name string
+}{
+	{StatusAlertPending, "AlertPending"},
+	{StatusHeater, "Heater"},
+	{StatusHumidityAlert, "HumidityAlert"},
+	{StatusTemperatureAlert, "TemperatureAlert"},
+	{StatusReset, "Reset"},
+	{StatusCommandFailed, "CommandFailed"},
+	{StatusWriteCRCFailed, "WriteCRCFailed"},
+}
+
+func (s Status) String() string {
+	var names []string
+	for _, n := range statusNames {
+		if s&n.s != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return "Status{" + strings.Join(names, "|") + "}"
+}
+
+// Status reads the status register.
+func (d *Dev) Status() (Status, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var b [3]byte
+	if err := d.read(cmdStatus, b[:]); err != nil {
+		return 0, err
+	}
+	w, err := word(b[:])
+	return Status(w), err
+}
+
+// ClearStatus clears the alert and reset bits of the status register.
+func (d *Dev) ClearStatus() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.command(cmdClearStatus)
+}
+
+// Limit is an alert limit. The device keeps the 9 MSBs of the temperature
+// and the 7 MSBs of the humidity, a resolution of about 0.34°C and 0.8%RH.
+type Limit struct {
+	Temperature physic.Temperature
+	Humidity    physic.RelativeHumidity
+}
+
+func (l Limit) String() string {
+	return l.Temperature.String() + " " + l.Humidity.String()
+}
+
+// Limits are the alert limits, with a hysteresis between the set and the
+// clear limits.
+//
+// The ALERT pin is raised when the temperature or the humidity goes above
+// HighSet or below LowSet, and cleared when it's back below HighClear and
+// above LowClear. The limits apply in periodic mode only.
+type Limits struct {
+	HighSet, HighClear Limit
+	LowClear, LowSet   Limit
+}
+
+// Limit registers, in the order of the fields of Limits.
+var (
+	limitReadCmds  = [...]uint16{0xE11F, 0xE114, 0xE109, 0xE102}
+	limitWriteCmds = [...]uint16{0x611D, 0x6116, 0x610B, 0x6100}
+)
+
+// limits returns pointers to the fields of l, in the order of the registers.
+func (l *Limits) limits() [4]*Limit {
+	return [4]*Limit{&l.HighSet, &l.HighClear, &l.LowClear, &l.LowSet}
+}
+
+// AlertLimits reads the alert limits.
+//
+// The values are rounded down to the resolution of the registers.
+func (d *Dev) AlertLimits() (Limits, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var l Limits
+	for i, p := range l.limits() {
+		var b [3]byte
+		if err := d.read(limitReadCmds[i], b[:]); err != nil {
+			return Limits{}, err
+		}
+		w, err := word(b[:])
+		if err != nil {
+			return Limits{}, err
+		}
+		p.Temperature = temperature(w << 7)
+		p.Humidity = humidity(w & 0xFE00)
+	}
+	return l, nil
+}
+
+// SetAlertLimits writes the alert limits.
+//
+// The temperatures must be within -45°C to 130°C and the humidities within
+// 0 to 100%RH. The limits are not persisted across a reset.
+func (d *Dev) SetAlertLimits(l *Limits) error {
+	var words [4]uint16
+	for i, p := range l.limits() {
+		w, err := p.word()
+		if err != nil {
+			return err
+		}
+		words[i] = w
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, w := range words {
+		b := []byte{byte(limitWriteCmds[i] >> 8), byte(limitWriteCmds[i]), byte(w >> 8), byte(w), 0}
+		b[4] = crc8(b[2:4])
+		if err := d.c.Tx(b, nil); err != nil {
+			return fmt.Errorf("sht3x: command 0x%04x: %w", limitWriteCmds[i], err)
+		}
+	}
+	return nil
+}
+
+const (
+	minTemperature = physic.ZeroCelsius - 45*physic.Celsius
+	maxTemperature = physic.ZeroCelsius + 130*physic.Celsius
+)
+
+// word returns the register value of the limit, the 7 MSBs of the raw
+// humidity followed by the 9 MSBs of the raw temperature.
+func (l *Limit) word() (uint16, error) {
+	if l.Temperature < minTemperature || l.Temperature > maxTemperature {
+		return 0, fmt.Errorf("%w: limit temperature %s, want -45°C to 130°C", ErrInvalidOpts, l.Temperature)
+	}
+	if l.Humidity < 0 || l.Humidity > 100*physic.PercentRH {
+		return 0, fmt.Errorf("%w: limit humidity %s, want 0 to 100%%rH", ErrInvalidOpts, l.Humidity)
+	}
+	// Round to the nearest, so the limits read by AlertLimits are written
+	// unchanged.
+	t := (int64(l.Temperature-minTemperature)*0xFFFF + int64(175*physic.Celsius)/2) / int64(175*physic.Celsius)
+	h := (int64(l.Humidity)*0xFFFF + int64(100*physic.PercentRH)/2) / int64(100*physic.PercentRH)
+	return uint16(h)&0xFE00 | uint16(t)>>7, nil
+}
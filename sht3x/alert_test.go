@@ -0,0 +1,173 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht3x
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestStatus(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xF3, 0x2D}, R: []byte{0x80, 0x10, 0xE1}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x30, 0x41}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != StatusAlertPending|StatusReset {
+		t.Fatal(s)
+	}
+	if err := d.ClearStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus_crc(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xF3, 0x2D}, R: []byte{0x80, 0x10, 0xE0}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Status(); !errors.Is(err, ErrCRC) {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus_String(t *testing.T) {
+	data := []struct {
+		s    Status
+		want string
+	}{
+		{0, "Status{}"},
+		{StatusHeater, "Status{Heater}"},
+		{StatusAlertPending | StatusTemperatureAlert | StatusWriteCRCFailed, "Status{AlertPending|TemperatureAlert|WriteCRCFailed}"},
+	}
+	for _, line := range data {
+		if s := line.s.String(); s != line.want {
+			t.Fatalf("%s, want %s", s, line.want)
+		}
+	}
+}
+
+// defaultLimits are the limits after a reset, as read from the device.
+var defaultLimits = Limits{
+	HighSet:   Limit{temperature(0x133 << 7), humidity(0xCC00)},
+	HighClear: Limit{temperature(0x12D << 7), humidity(0xC800)},
+	LowClear:  Limit{temperature(0x069 << 7), humidity(0x3800)},
+	LowSet:    Limit{temperature(0x066 << 7), humidity(0x3400)},
+}
+
+func TestAlertLimits(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xE1, 0x1F}, R: []byte{0xCD, 0x33, 0xFD}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xE1, 0x14}, R: []byte{0xC9, 0x2D, 0x22}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xE1, 0x09}, R: []byte{0x38, 0x69, 0x37}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xE1, 0x02}, R: []byte{0x34, 0x66, 0xAD}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := d.AlertLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l != defaultLimits {
+		t.Fatalf("%v, want %v", l, defaultLimits)
+	}
+	// About 60°C 80%RH.
+	if l.HighSet.Temperature < physic.ZeroCelsius+59*physic.Celsius || l.HighSet.Humidity < 79*physic.PercentRH {
+		t.Fatal(l.HighSet)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAlertLimits_crc(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0xE1, 0x1F}, R: []byte{0xCD, 0x33, 0x00}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AlertLimits(); !errors.Is(err, ErrCRC) {
+		t.Fatal(err)
+	}
+}
+
+func TestSetAlertLimits(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x61, 0x1D, 0xCD, 0x33, 0xFD}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x61, 0x16, 0xC9, 0x2D, 0x22}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x61, 0x0B, 0x38, 0x69, 0x37}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x61, 0x00, 0x34, 0x66, 0xAD}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The values read back are written unchanged.
+	l := defaultLimits
+	if err := d.SetAlertLimits(&l); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetAlertLimits_invalid(t *testing.T) {
+	data := []struct {
+		l   Limit
+		err string
+	}{
+		{Limit{Temperature: physic.ZeroCelsius - 46*physic.Celsius}, "sht3x: invalid options: limit temperature -46°C, want -45°C to 130°C"},
+		{Limit{Temperature: physic.ZeroCelsius + 131*physic.Celsius}, "sht3x: invalid options: limit temperature 131°C, want -45°C to 130°C"},
+		{Limit{Temperature: physic.ZeroCelsius, Humidity: 101 * physic.PercentRH}, "sht3x: invalid options: limit humidity 101%rH, want 0 to 100%rH"},
+	}
+	for i, line := range data {
+		bus := i2ctest.Playback{Ops: initOps()}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l := defaultLimits
+		l.LowSet = line.l
+		if err := d.SetAlertLimits(&l); !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %s", i, err, line.err)
+		}
+		// Nothing is written.
+		if err := bus.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLimit_word(t *testing.T) {
+	l := Limit{Temperature: physic.ZeroCelsius + 60*physic.Celsius, Humidity: 80 * physic.PercentRH}
+	w, err := l.word()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w != 0xCD33 {
+		t.Fatalf("0x%04x, want 0xCD33", w)
+	}
+}
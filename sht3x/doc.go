@@ -0,0 +1,26 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package sht3x controls a Sensirion SHT30, SHT31 or SHT35 temperature and
+// humidity sensor over I²C.
+//
+// # More details
+//
+// The three models share the same interface and differ by accuracy, the
+// SHT31 being the most common with ±0.2°C and ±2%RH.
+//
+// With the default Opts, each Sense runs a single shot measurement. With
+// Opts.Rate, the device measures periodically at 0.5 to 10Hz and Sense
+// fetches the latest measurement. Every response is checked against its
+// CRC.
+//
+// The heater, enabled with SetHeater, warms the sensor by a few degrees to
+// evaporate condensation or to check that it works. The ALERT pin is driven
+// by the alert limits set with SetAlertLimits, for a thermostat or a
+// humidity alarm independent of the host.
+//
+// # Datasheet
+//
+// https://sensirion.com/media/documents/213E6A3B/63A5A569/Datasheet_SHT3x_DIS.pdf
+package sht3x
@@ -0,0 +1,71 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht3x_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/sht3x"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := sht3x.New(bus, sht3x.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize sht3x: %v", err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%8s %9s\n", e.Temperature, e.Humidity)
+}
+
+func ExampleDev_SetAlertLimits() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// The alert limits are only checked in periodic mode.
+	d, err := sht3x.New(bus, sht3x.Opts{Rate: physic.Hertz})
+	if err != nil {
+		log.Fatalf("failed to initialize sht3x: %v", err)
+	}
+	defer d.Halt()
+
+	// Raise ALERT above 70%RH until back below 65%RH, e.g. to run a fan.
+	l, err := d.AlertLimits()
+	if err != nil {
+		log.Fatal(err)
+	}
+	l.HighSet.Humidity = 70 * physic.PercentRH
+	l.HighClear.Humidity = 65 * physic.PercentRH
+	if err := d.SetAlertLimits(&l); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht3x
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// I²C addresses, selected by the ADDR pin.
+const (
+	DefaultAddr = 0x44 // ADDR low
+	AltAddr     = 0x45 // ADDR high
+)
+
+// Commands.
+const (
+	cmdSingleShot   = 0x2400 // clock stretching disabled, repeatability in the LSB
+	cmdFetch        = 0xE000
+	cmdBreak        = 0x3093
+	cmdSoftReset    = 0x30A2
+	cmdHeaterOn     = 0x306D
+	cmdHeaterOff    = 0x3066
+	cmdStatus       = 0xF32D
+	cmdClearStatus  = 0x3041
+	crc8Init        = 0xFF
+	crc8Polynomial  = 0x31
+	resetTime       = 2 * time.Millisecond
+	breakTime       = time.Millisecond
+	measurementSize = 6
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrCRC is returned when a response fails its CRC, usually because of
+	// noise on the bus.
+	ErrCRC = errors.New("sht3x: CRC mismatch")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("sht3x: invalid options")
+)
+
+// Repeatability is the repeatability of a measurement. A higher
+// repeatability lowers the noise and lengthens the measurement.
+type Repeatability uint8
+
+// Possible repeatabilities.
+const (
+	High   Repeatability = 0
+	Medium Repeatability = 1
+	Low    Repeatability = 2
+)
+
+// singleShotLSB is the LSB of the single shot command of each repeatability.
+var singleShotLSB = [...]byte{0x00, 0x0B, 0x16}
+
+// measTime is the maximum duration of a measurement of each repeatability.
+var measTime = [...]time.Duration{
+	15500 * time.Microsecond,
+	6500 * time.Microsecond,
+	4500 * time.Microsecond,
+}
+
+func (r Repeatability) String() string {
+	switch r {
+	case High:
+		return "High"
+	case Medium:
+		return "Medium"
+	case Low:
+		return "Low"
+	default:
+		return fmt.Sprintf("Repeatability(%d)", uint8(r))
+	}
+}
+
+// periodicCmds are the periodic mode commands of each rate, indexed by
+// repeatability.
+var periodicCmds = []struct {
+	rate physic.Frequency
+	cmds [3]uint16
+}{
+	{500 * physic.MilliHertz, [3]uint16{0x2032, 0x2024, 0x202F}},
+	{physic.Hertz, [3]uint16{0x2130, 0x2126, 0x212D}},
+	{2 * physic.Hertz, [3]uint16{0x2236, 0x2220, 0x222B}},
+	{4 * physic.Hertz, [3]uint16{0x2334, 0x2322, 0x2329}},
+	{10 * physic.Hertz, [3]uint16{0x2737, 0x2721, 0x272A}},
+}
+
+// DefaultOpts are the options for single shot measurements of high
+// repeatability.
+var DefaultOpts = Opts{}
+
+// Opts holds initialization options.
+//
+// Repeatability: repeatability of the measurements, High by default.
+// Rate: when set, the device measures periodically at this rate, one of
+// 0.5, 1, 2, 4 or 10Hz, and Sense returns the latest measurement. Otherwise
+// Sense runs a single shot measurement and the device idles in between.
+// Addr: I²C address, DefaultAddr by default.
+type Opts struct {
+	Repeatability Repeatability
+	Rate          physic.Frequency
+	Addr          uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Repeatability > Low {
+		return fmt.Errorf("%w: Repeatability %s, want High, Medium or Low", ErrInvalidOpts, o.Repeatability)
+	}
+	if o.Rate != 0 && o.periodicCmd() == 0 {
+		return fmt.Errorf("%w: Rate %s, want 0, 500mHz, 1Hz, 2Hz, 4Hz or 10Hz", ErrInvalidOpts, o.Rate)
+	}
+	return nil
+}
+
+// periodicCmd returns the command starting the periodic mode, or 0 for an
+// unsupported rate.
+func (o *Opts) periodicCmd() uint16 {
+	for _, p := range periodicCmds {
+		if p.rate == o.Rate {
+			return p.cmds[o.Repeatability]
+		}
+	}
+	return 0
+}
+
+// Dev is a handle to an initialized SHT3x device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets a device on an I²C bus, and starts the periodic mode when
+// opts.Rate is set.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: addr}, opts: opts}
+	if err := d.command(cmdSoftReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	if opts.Rate != 0 {
+		if err := d.command(opts.periodicCmd()); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("SHT3x{%s}", d.c)
+}
+
+// Sense measures the temperature and the humidity. It implements
+// physic.SenseEnv.
+//
+// In periodic mode, it returns the latest measurement. Fetching it clears
+// it, so Sense fails with an I²C error when called again before the next
+// measurement.
+//
+// The pressure is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("sht3x: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseContinuous returns measurements of the temperature and humidity on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than a single shot measurement, or than the
+// period in periodic mode.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if m := d.measDuration(); interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	// 175°C and 100%RH over 16 bits.
+	e.Temperature = 3 * physic.MilliKelvin
+	e.Humidity = 15 * physic.MicroRH
+}
+
+// SetHeater turns on or off the heater.
+func (d *Dev) SetHeater(on bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if on {
+		return d.command(cmdHeaterOn)
+	}
+	return d.command(cmdHeaterOff)
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(), and the
+// periodic mode. Sense runs single shot measurements afterward.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.opts.Rate == 0 {
+		return nil
+	}
+	if err := d.command(cmdBreak); err != nil {
+		return err
+	}
+	doSleep(breakTime)
+	d.opts.Rate = 0
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// measDuration returns the shortest interval between two measurements.
+func (d *Dev) measDuration() time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.opts.Rate != 0 {
+		return d.opts.Rate.Period()
+	}
+	return measTime[d.opts.Repeatability]
+}
+
+// sense runs or fetches a measurement.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	var b [measurementSize]byte
+	if d.opts.Rate != 0 {
+		if err := d.read(cmdFetch, b[:]); err != nil {
+			return err
+		}
+	} else {
+		if err := d.command(cmdSingleShot | uint16(singleShotLSB[d.opts.Repeatability])); err != nil {
+			return err
+		}
+		doSleep(measTime[d.opts.Repeatability])
+		if err := d.c.Tx(nil, b[:]); err != nil {
+			return fmt.Errorf("sht3x: reading measurement: %w", err)
+		}
+	}
+	t, err := word(b[0:3])
+	if err != nil {
+		return err
+	}
+	h, err := word(b[3:6])
+	if err != nil {
+		return err
+	}
+	e.Temperature = temperature(t)
+	e.Humidity = humidity(h)
+	return nil
+}
+
+// temperature converts a raw temperature.
+func temperature(raw uint16) physic.Temperature {
+	return physic.ZeroCelsius - 45*physic.Celsius + physic.Temperature(int64(raw)*int64(175*physic.Celsius)/0xFFFF)
+}
+
+// humidity converts a raw humidity.
+func humidity(raw uint16) physic.RelativeHumidity {
+	return physic.RelativeHumidity(int64(raw) * int64(100*physic.PercentRH) / 0xFFFF)
+}
+
+// command sends a command without argument.
+func (d *Dev) command(cmd uint16) error {
+	if err := d.c.Tx([]byte{byte(cmd >> 8), byte(cmd)}, nil); err != nil {
+		return fmt.Errorf("sht3x: command 0x%04x: %w", cmd, err)
+	}
+	return nil
+}
+
+// read issues the command cmd and reads its result into b, made of words
+// followed by their CRC.
+func (d *Dev) read(cmd uint16, b []byte) error {
+	if err := d.c.Tx([]byte{byte(cmd >> 8), byte(cmd)}, b); err != nil {
+		return fmt.Errorf("sht3x: command 0x%04x: %w", cmd, err)
+	}
+	return nil
+}
+
+// word returns the big endian word of b after checking the CRC in b[2].
+func word(b []byte) (uint16, error) {
+	if got, want := crc8(b[:2]), b[2]; got != want {
+		return 0, fmt.Errorf("%w: 0x%02x, want 0x%02x", ErrCRC, got, want)
+	}
+	return uint16(b[0])<<8 | uint16(b[1]), nil
+}
+
+// crc8 returns the CRC-8 of b, as computed by the device.
+func crc8(b []byte) byte {
+	crc := byte(crc8Init)
+	for _, v := range b {
+		crc ^= v
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ crc8Polynomial
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
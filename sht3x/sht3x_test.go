@@ -0,0 +1,314 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht3x
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New in single shot mode.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{{Addr: DefaultAddr, W: []byte{0x30, 0xA2}}}
+}
+
+// testMeasurement is a measurement of 25°C and 50%RH, with CRCs.
+var testMeasurement = []byte{0x66, 0x66, 0x93, 0x80, 0x00, 0xA2}
+
+// testEnv is the conversion of testMeasurement.
+var testEnv = physic.Env{
+	Temperature: physic.ZeroCelsius + 25*physic.Celsius,
+	Humidity:    5000076 * physic.TenthMicroRH,
+}
+
+// senseOps are the bus transactions of a single shot measurement with
+// DefaultOpts.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{0x24, 0x00}},
+		{Addr: DefaultAddr, R: testMeasurement},
+	}
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "SHT3x{playback(68)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_periodic(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: AltAddr, W: []byte{0x30, 0xA2}},
+		{Addr: AltAddr, W: []byte{0x27, 0x21}},
+	}}
+	if _, err := New(&bus, Opts{Repeatability: Medium, Rate: 10 * physic.Hertz, Addr: AltAddr}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	bus := i2ctest.Playback{DontPanic: true}
+	if _, err := New(&bus, DefaultOpts); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		o   Opts
+		err string
+	}{
+		{Opts{Repeatability: 3}, "sht3x: invalid options: Repeatability Repeatability(3), want High, Medium or Low"},
+		{Opts{Rate: 3 * physic.Hertz}, "sht3x: invalid options: Rate 3Hz, want 0, 500mHz, 1Hz, 2Hz, 4Hz or 10Hz"},
+	}
+	for i, line := range data {
+		err := line.o.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %s", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.o); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+	for _, o := range []Opts{DefaultOpts, {Repeatability: Low, Rate: 500 * physic.MilliHertz}} {
+		if err := o.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_repeatability(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x24, 0x16}},
+		i2ctest.IO{Addr: DefaultAddr, R: testMeasurement},
+	)}
+	d, err := New(&bus, Opts{Repeatability: Low})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_crc(t *testing.T) {
+	for i, want := range []string{
+		"sht3x: CRC mismatch: 0x93, want 0x94",
+		"sht3x: CRC mismatch: 0xa2, want 0xa3",
+	} {
+		ops := append(initOps(), senseOps()...)
+		r := append([]byte(nil), testMeasurement...)
+		r[2+3*i]++
+		ops[2].R = r
+		bus := i2ctest.Playback{Ops: ops}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); !errors.Is(err, ErrCRC) || err.Error() != want {
+			t.Fatalf("#%d: %v, want %s", i, err, want)
+		}
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(initOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(10 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(20 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPeriodic(t *testing.T) {
+	fetch := i2ctest.IO{Addr: DefaultAddr, W: []byte{0xE0, 0x00}, R: testMeasurement}
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x27, 0x37}},
+		fetch,
+		fetch,
+		fetch,
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x30, 0x93}},
+		senseOps()[0],
+		senseOps()[1],
+	)}
+	d, err := New(&bus, Opts{Rate: 10 * physic.Hertz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if _, err := d.SenseContinuous(50 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(100 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	// Halt stops the periodic mode, then Sense runs a single shot.
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetHeater(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x30, 0x6D}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x30, 0x66}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 3*physic.MilliKelvin || e.Humidity != 15*physic.MicroRH {
+		t.Fatal(e)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	if v := temperature(0); v != physic.ZeroCelsius-45*physic.Celsius {
+		t.Fatal(v)
+	}
+	if v := temperature(0xFFFF); v != physic.ZeroCelsius+130*physic.Celsius {
+		t.Fatal(v)
+	}
+	if v := humidity(0xFFFF); v != 100*physic.PercentRH {
+		t.Fatal(v)
+	}
+}
+
+func TestCRC8(t *testing.T) {
+	// Example of the datasheet.
+	if c := crc8([]byte{0xBE, 0xEF}); c != 0x92 {
+		t.Fatalf("0x%02x, want 0x92", c)
+	}
+}
+
+func TestRepeatability_String(t *testing.T) {
+	data := []struct {
+		r Repeatability
+		s string
+	}{
+		{High, "High"},
+		{Medium, "Medium"},
+		{Low, "Low"},
+		{3, "Repeatability(3)"},
+	}
+	for _, line := range data {
+		if s := line.r.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
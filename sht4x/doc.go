@@ -0,0 +1,25 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package sht4x controls a Sensirion SHT40, SHT41, SHT43 or SHT45
+// temperature and humidity sensor over I²C.
+//
+// # More details
+//
+// The device is driven by one byte commands, each measurement being a single
+// shot; every response is checked against its CRC. The models differ by
+// accuracy, down to ±0.1°C and ±1%RH for the SHT45.
+//
+// SerialNumber returns the unique serial number of the device, to tell
+// devices apart in a fleet.
+//
+// Heat pulses the heater for 0.1s or 1s, e.g. to evaporate condensation, and
+// measures at the end of the pulse. The heater may be run at most 10% of the
+// time and the temperature measured at the end of a pulse is meaningless
+// for the environment.
+//
+// # Datasheet
+//
+// https://sensirion.com/media/documents/33FD6951/6555C40E/Sensirion_Datasheet_SHT4x.pdf
+package sht4x
@@ -0,0 +1,65 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht4x
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Heater pulse durations.
+const (
+	ShortPulse = 100 * time.Millisecond
+	LongPulse  = time.Second
+)
+
+// heaterCmds are the heater commands of each power, for ShortPulse then
+// LongPulse.
+var heaterCmds = []struct {
+	power physic.Power
+	cmds  [2]byte
+}{
+	{20 * physic.MilliWatt, [2]byte{0x15, 0x1E}},
+	{110 * physic.MilliWatt, [2]byte{0x24, 0x2F}},
+	{200 * physic.MilliWatt, [2]byte{0x32, 0x39}},
+}
+
+// heaterCmd returns the command of a heater pulse, or 0 when unsupported.
+func heaterCmd(power physic.Power, duration time.Duration) byte {
+	for _, h := range heaterCmds {
+		if h.power == power {
+			switch duration {
+			case ShortPulse:
+				return h.cmds[0]
+			case LongPulse:
+				return h.cmds[1]
+			}
+		}
+	}
+	return 0
+}
+
+// Heat pulses the heater at power for duration, then measures with high
+// repeatability.
+//
+// The power is one of 20mW, 110mW or 200mW at 3.3V, and the duration
+// ShortPulse or LongPulse. The heater turns off by itself at the end of the
+// pulse; Heat blocks for its duration.
+func (d *Dev) Heat(power physic.Power, duration time.Duration, e *physic.Env) error {
+	cmd := heaterCmd(power, duration)
+	if cmd == 0 {
+		return fmt.Errorf("%w: heater %s for %s, want 20mW, 110mW or 200mW for ShortPulse or LongPulse", ErrInvalidOpts, power, duration)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("sht4x: already sensing continuously")
+	}
+	// The pulse lasts up to 10% longer.
+	return d.measure(cmd, duration+duration/10, e)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht4x
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestHeat(t *testing.T) {
+	data := []struct {
+		power    physic.Power
+		duration time.Duration
+		cmd      byte
+	}{
+		{20 * physic.MilliWatt, ShortPulse, 0x15},
+		{20 * physic.MilliWatt, LongPulse, 0x1E},
+		{110 * physic.MilliWatt, ShortPulse, 0x24},
+		{110 * physic.MilliWatt, LongPulse, 0x2F},
+		{200 * physic.MilliWatt, ShortPulse, 0x32},
+		{200 * physic.MilliWatt, LongPulse, 0x39},
+	}
+	for i, line := range data {
+		bus := i2ctest.Playback{Ops: append(initOps(),
+			i2ctest.IO{Addr: DefaultAddr, W: []byte{line.cmd}},
+			i2ctest.IO{Addr: DefaultAddr, R: testMeasurement},
+		)}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Heat(line.power, line.duration, &e); err != nil {
+			t.Fatal(i, err)
+		}
+		if e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestHeat_invalid(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	want := "sht4x: invalid options: heater 50mW for 100ms, want 20mW, 110mW or 200mW for ShortPulse or LongPulse"
+	if err := d.Heat(50*physic.MilliWatt, ShortPulse, &e); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %s", err, want)
+	}
+	if err := d.Heat(20*physic.MilliWatt, 500*time.Millisecond, &e); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
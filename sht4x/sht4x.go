@@ -0,0 +1,330 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht4x
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// I²C addresses, set at the factory and suffixed to the part number.
+const (
+	DefaultAddr = 0x44 // SHTxx-AD1B
+	AltAddr     = 0x45 // SHTxx-BD1B
+	Alt2Addr    = 0x46 // SHTxx-CD1B
+)
+
+// Commands.
+const (
+	cmdSoftReset    = 0x94
+	cmdSerialNumber = 0x89
+	crc8Init        = 0xFF
+	crc8Polynomial  = 0x31
+	resetTime       = time.Millisecond
+	serialTime      = 10 * time.Millisecond
+	measurementSize = 6
+)
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrCRC is returned when a response fails its CRC, usually because of
+	// noise on the bus.
+	ErrCRC = errors.New("sht4x: CRC mismatch")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("sht4x: invalid options")
+)
+
+// Repeatability is the repeatability of a measurement. A higher
+// repeatability lowers the noise and lengthens the measurement.
+type Repeatability uint8
+
+// Possible repeatabilities.
+const (
+	High   Repeatability = 0
+	Medium Repeatability = 1
+	Low    Repeatability = 2
+)
+
+// measureCmds is the measurement command of each repeatability.
+var measureCmds = [...]byte{0xFD, 0xF6, 0xE0}
+
+// measTime is the maximum duration of a measurement of each repeatability.
+var measTime = [...]time.Duration{
+	8300 * time.Microsecond,
+	4500 * time.Microsecond,
+	1600 * time.Microsecond,
+}
+
+func (r Repeatability) String() string {
+	switch r {
+	case High:
+		return "High"
+	case Medium:
+		return "Medium"
+	case Low:
+		return "Low"
+	default:
+		return fmt.Sprintf("Repeatability(%d)", uint8(r))
+	}
+}
+
+// DefaultOpts are the options for measurements of high repeatability.
+var DefaultOpts = Opts{}
+
+// Opts holds initialization options.
+//
+// Repeatability: repeatability of the measurements, High by default.
+// Addr: I²C address, DefaultAddr by default.
+type Opts struct {
+	Repeatability Repeatability
+	Addr          uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Repeatability > Low {
+		return fmt.Errorf("%w: Repeatability %s, want High, Medium or Low", ErrInvalidOpts, o.Repeatability)
+	}
+	return nil
+}
+
+// Dev is a handle to an initialized SHT4x device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New resets a device on an I²C bus.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: addr}, opts: opts}
+	if err := d.command(cmdSoftReset); err != nil {
+		return nil, err
+	}
+	doSleep(resetTime)
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("SHT4x{%s}", d.c)
+}
+
+// Sense measures the temperature and the humidity. It implements
+// physic.SenseEnv.
+//
+// The pressure is not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("sht4x: already sensing continuously")
+	}
+	return d.measure(measureCmds[d.opts.Repeatability], measTime[d.opts.Repeatability], e)
+}
+
+// SenseContinuous returns measurements of the temperature and humidity on a
+// continuous basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than a measurement.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if m := measTime[d.opts.Repeatability]; interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.measure(measureCmds[d.opts.Repeatability], measTime[d.opts.Repeatability], &e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	// 175°C and 125%RH over 16 bits.
+	e.Temperature = 3 * physic.MilliKelvin
+	e.Humidity = 19 * physic.MicroRH
+}
+
+// SerialNumber reads the unique serial number of the device.
+func (d *Dev) SerialNumber() (uint32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var b [6]byte
+	if err := d.read(cmdSerialNumber, serialTime, b[:]); err != nil {
+		return 0, err
+	}
+	hi, err := word(b[0:3])
+	if err != nil {
+		return 0, err
+	}
+	lo, err := word(b[3:6])
+	if err != nil {
+		return 0, err
+	}
+	return uint32(hi)<<16 | uint32(lo), nil
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous(). The
+// device idles between measurements.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// measure runs a measurement with the command cmd, lasting up to wait.
+//
+// It must be called with d.mu held.
+func (d *Dev) measure(cmd byte, wait time.Duration, e *physic.Env) error {
+	var b [measurementSize]byte
+	if err := d.read(cmd, wait, b[:]); err != nil {
+		return err
+	}
+	t, err := word(b[0:3])
+	if err != nil {
+		return err
+	}
+	h, err := word(b[3:6])
+	if err != nil {
+		return err
+	}
+	e.Temperature = temperature(t)
+	e.Humidity = humidity(h)
+	return nil
+}
+
+// temperature converts a raw temperature.
+func temperature(raw uint16) physic.Temperature {
+	return physic.ZeroCelsius - 45*physic.Celsius + physic.Temperature(int64(raw)*int64(175*physic.Celsius)/0xFFFF)
+}
+
+// humidity converts a raw humidity. The range of the conversion is -6 to
+// 119%RH; the values out of 0 to 100%RH are cropped as recommended by the
+// datasheet.
+func humidity(raw uint16) physic.RelativeHumidity {
+	h := physic.RelativeHumidity(int64(raw)*int64(125*physic.PercentRH)/0xFFFF) - 6*physic.PercentRH
+	if h < 0 {
+		return 0
+	}
+	if h > 100*physic.PercentRH {
+		return 100 * physic.PercentRH
+	}
+	return h
+}
+
+// command sends a command without response.
+func (d *Dev) command(cmd byte) error {
+	if err := d.c.Tx([]byte{cmd}, nil); err != nil {
+		return fmt.Errorf("sht4x: command 0x%02x: %w", cmd, err)
+	}
+	return nil
+}
+
+// read sends the command cmd, waits for it to complete and reads its result
+// into b, made of words followed by their CRC.
+func (d *Dev) read(cmd byte, wait time.Duration, b []byte) error {
+	if err := d.command(cmd); err != nil {
+		return err
+	}
+	doSleep(wait)
+	if err := d.c.Tx(nil, b); err != nil {
+		return fmt.Errorf("sht4x: reading command 0x%02x: %w", cmd, err)
+	}
+	return nil
+}
+
+// word returns the big endian word of b after checking the CRC in b[2].
+func word(b []byte) (uint16, error) {
+	if got, want := crc8(b[:2]), b[2]; got != want {
+		return 0, fmt.Errorf("%w: 0x%02x, want 0x%02x", ErrCRC, got, want)
+	}
+	return uint16(b[0])<<8 | uint16(b[1]), nil
+}
+
+// crc8 returns the CRC-8 of b, as computed by the device.
+func crc8(b []byte) byte {
+	crc := byte(crc8Init)
+	for _, v := range b {
+		crc ^= v
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ crc8Polynomial
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,265 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sht4x
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{{Addr: DefaultAddr, W: []byte{0x94}}}
+}
+
+// testMeasurement is a measurement of 25°C and 50%RH, with CRCs.
+var testMeasurement = []byte{0x66, 0x66, 0x93, 0x72, 0xB0, 0xDC}
+
+// testEnv is the conversion of testMeasurement.
+var testEnv = physic.Env{
+	Temperature: physic.ZeroCelsius + 25*physic.Celsius,
+	Humidity:    5000061 * physic.TenthMicroRH,
+}
+
+// senseOps are the bus transactions of a measurement with DefaultOpts.
+func senseOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{0xFD}},
+		{Addr: DefaultAddr, R: testMeasurement},
+	}
+}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "SHT4x{playback(68)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	bus := i2ctest.Playback{DontPanic: true}
+	if _, err := New(&bus, DefaultOpts); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	o := Opts{Repeatability: 3}
+	want := "sht4x: invalid options: Repeatability Repeatability(3), want High, Medium or Low"
+	if err := o.Validate(); !errors.Is(err, ErrInvalidOpts) || err.Error() != want {
+		t.Fatalf("%v, want %s", err, want)
+	}
+	if _, err := New(&i2ctest.Playback{}, o); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := DefaultOpts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_repeatability(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: Alt2Addr, W: []byte{0x94}},
+		{Addr: Alt2Addr, W: []byte{0xE0}},
+		{Addr: Alt2Addr, R: testMeasurement},
+	}}
+	d, err := New(&bus, Opts{Repeatability: Low, Addr: Alt2Addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_crc(t *testing.T) {
+	for i, want := range []string{
+		"sht4x: CRC mismatch: 0x93, want 0x94",
+		"sht4x: CRC mismatch: 0xdc, want 0xdd",
+	} {
+		ops := append(initOps(), senseOps()...)
+		r := append([]byte(nil), testMeasurement...)
+		r[2+3*i]++
+		ops[2].R = r
+		bus := i2ctest.Playback{Ops: ops}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); !errors.Is(err, ErrCRC) || err.Error() != want {
+			t.Fatalf("#%d: %v, want %s", i, err, want)
+		}
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	for i := 0; i < len(senseOps()); i++ {
+		bus := i2ctest.Playback{Ops: append(initOps(), senseOps()[:i]...), DontPanic: true}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var e physic.Env
+		if err := d.Sense(&e); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(append(initOps(), senseOps()...), senseOps()...)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(5 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Heat(20*physic.MilliWatt, ShortPulse, &e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSerialNumber(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x89}},
+		i2ctest.IO{Addr: DefaultAddr, R: []byte{0x0A, 0x1B, 0xC6, 0x2C, 0x3D, 0x60}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{0x89}},
+		i2ctest.IO{Addr: DefaultAddr, R: []byte{0x0A, 0x1B, 0xC6, 0x2C, 0x3D, 0x61}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := d.SerialNumber()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != 0x0A1B2C3D {
+		t.Fatalf("0x%08x, want 0x0A1B2C3D", s)
+	}
+	if _, err := d.SerialNumber(); !errors.Is(err, ErrCRC) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 3*physic.MilliKelvin || e.Humidity != 19*physic.MicroRH {
+		t.Fatal(e)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	if v := temperature(0); v != physic.ZeroCelsius-45*physic.Celsius {
+		t.Fatal(v)
+	}
+	if v := temperature(0xFFFF); v != physic.ZeroCelsius+130*physic.Celsius {
+		t.Fatal(v)
+	}
+	data := []struct {
+		raw  uint16
+		want physic.RelativeHumidity
+	}{
+		{0, 0},
+		{0x0C49, 0},
+		{0x8000, 5650095 * physic.TenthMicroRH},
+		{0xFFFF, 100 * physic.PercentRH},
+	}
+	for _, line := range data {
+		if v := humidity(line.raw); v != line.want {
+			t.Fatalf("0x%04x: %s, want %s", line.raw, v, line.want)
+		}
+	}
+}
+
+func TestCRC8(t *testing.T) {
+	// Example of the datasheet.
+	if c := crc8([]byte{0xBE, 0xEF}); c != 0x92 {
+		t.Fatalf("0x%02x, want 0x92", c)
+	}
+}
+
+func TestRepeatability_String(t *testing.T) {
+	data := []struct {
+		r Repeatability
+		s string
+	}{
+		{High, "High"},
+		{Medium, "Medium"},
+		{Low, "Low"},
+		{3, "Repeatability(3)"},
+	}
+	for _, line := range data {
+		if s := line.r.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117
+
+import (
+	"fmt"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Flags are the status flags of the configuration register.
+type Flags struct {
+	// High and Low report a result above the high limit or below the low
+	// limit in alert mode. In therm mode, High reports a result above the
+	// high limit until one is below the low limit, and Low is unused.
+	High, Low bool
+	// DataReady reports a conversion completed.
+	DataReady bool
+}
+
+func (f Flags) String() string {
+	return fmt.Sprintf("Flags{High:%t Low:%t DataReady:%t}", f.High, f.Low, f.DataReady)
+}
+
+// Flags reads the status flags. Reading them clears them, and the ALERT pin
+// in alert mode.
+func (d *Dev) Flags() (Flags, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readReg(regConfiguration)
+	if err != nil {
+		return Flags{}, err
+	}
+	return Flags{
+		High:      v&cfgHighAlert != 0,
+		Low:       v&cfgLowAlert != 0,
+		DataReady: v&cfgDataReady != 0,
+	}, nil
+}
+
+// AlertLimits reads the low and high limits.
+func (d *Dev) AlertLimits() (physic.Temperature, physic.Temperature, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	low, err := d.readReg(regLowLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	high, err := d.readReg(regHighLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	return toTemperature(low), toTemperature(high), nil
+}
+
+// SetAlertLimits writes the low and high limits, rounded to 7.8125m°C.
+//
+// In therm mode, low is the hysteresis of high. The limits are lost at power
+// down; a reset reloads them from the EEPROM.
+func (d *Dev) SetAlertLimits(low, high physic.Temperature) error {
+	if low >= high {
+		return fmt.Errorf("%w: low limit %s, want below the high limit %s", ErrInvalidOpts, low, high)
+	}
+	l, err := fromTemperature(low)
+	if err != nil {
+		return err
+	}
+	h, err := fromTemperature(high)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeReg(regLowLimit, l); err != nil {
+		return err
+	}
+	return d.writeReg(regHighLimit, h)
+}
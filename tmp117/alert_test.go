@@ -0,0 +1,96 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117
+
+import (
+	"errors"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestFlags(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regConfiguration}, R: []byte{0xA2, 0x20}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := d.Flags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Flags{High: true, DataReady: true}); f != want {
+		t.Fatalf("%s, want %s", f, want)
+	}
+	if s := f.String(); s != "Flags{High:true Low:false DataReady:true}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAlertLimits(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		// The values after a reset.
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regLowLimit}, R: []byte{0x80, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regHighLimit}, R: []byte{0x60, 0x00}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	low, high, err := d.AlertLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if low != physic.ZeroCelsius-256*physic.Celsius || high != physic.ZeroCelsius+192*physic.Celsius {
+		t.Fatal(low, high)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetAlertLimits(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regLowLimit, 0x0A, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regHighLimit, 0x0F, 0x00}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetAlertLimits(physic.ZeroCelsius+20*physic.Celsius, physic.ZeroCelsius+30*physic.Celsius); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetAlertLimits_invalid(t *testing.T) {
+	data := []struct {
+		low, high physic.Temperature
+		err       string
+	}{
+		{physic.ZeroCelsius + 30*physic.Celsius, physic.ZeroCelsius + 20*physic.Celsius, "tmp117: invalid options: low limit 30°C, want below the high limit 20°C"},
+		{physic.ZeroCelsius - 300*physic.Celsius, physic.ZeroCelsius, "tmp117: invalid options: temperature -300°C, want -256°C to 256°C"},
+		{physic.ZeroCelsius, physic.ZeroCelsius + 300*physic.Celsius, "tmp117: invalid options: temperature 300°C, want -256°C to 256°C"},
+	}
+	for i, line := range data {
+		bus := i2ctest.Playback{Ops: initOps()}
+		d, err := New(&bus, DefaultOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := d.SetAlertLimits(line.low, line.high); !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %s", i, err, line.err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package tmp117 controls a Texas Instruments TMP117 digital thermometer over
+// I²C.
+//
+// # More details
+//
+// The TMP117 measures the temperature with an accuracy of ±0.1°C from -20°C
+// to 50°C and a resolution of 7.8125m°C.
+//
+// In continuous mode, the device converts at the cycle time set by Opts and
+// Sense returns the latest result. In one-shot mode, each Sense triggers a
+// conversion and the device shuts down in between, for the lowest power.
+// Halt shuts the device down in both modes. Averaging lowers the noise at
+// the cost of a longer conversion.
+//
+// The ALERT pin is driven by the limits set with SetAlertLimits. The offset
+// added to each result, e.g. to calibrate a system, can be programmed in the
+// EEPROM of the device with ProgramOffset so that it survives a power cycle.
+//
+// # Datasheet
+//
+// https://www.ti.com/lit/ds/symlink/tmp117.pdf
+package tmp117
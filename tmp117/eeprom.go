@@ -0,0 +1,93 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// EEPROM_UL register bits.
+const (
+	eepromUnlock = 0x8000
+	eepromBusy   = 0x4000
+)
+
+// Programming the EEPROM takes 7ms; it is polled every eepromPollTime up to
+// eepromTimeout.
+const (
+	eepromPollTime = time.Millisecond
+	eepromTimeout  = 20 * time.Millisecond
+)
+
+// Offset reads the offset added to each result.
+func (d *Dev) Offset() (physic.Temperature, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readReg(regOffset)
+	if err != nil {
+		return 0, err
+	}
+	return physic.Temperature(int16(v)) * lsb, nil
+}
+
+// SetOffset sets the offset added to each result, rounded to 7.8125m°C, until
+// the next reset or power down.
+func (d *Dev) SetOffset(offset physic.Temperature) error {
+	v, err := fromTemperature(offset + physic.ZeroCelsius)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeReg(regOffset, v)
+}
+
+// ProgramOffset sets the offset added to each result, rounded to 7.8125m°C,
+// and programs it in the EEPROM to be loaded at power up.
+//
+// The EEPROM supports a limited number of writes; it's meant for a one time
+// calibration.
+func (d *Dev) ProgramOffset(offset physic.Temperature) error {
+	v, err := fromTemperature(offset + physic.ZeroCelsius)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.writeReg(regEEPROMUnlock, eepromUnlock); err != nil {
+		return err
+	}
+	err = d.programReg(regOffset, v)
+	// Lock the EEPROM again even on failure, or the next register writes
+	// would be programmed.
+	if err2 := d.writeReg(regEEPROMUnlock, 0); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// programReg writes a register with the EEPROM unlocked and waits for the
+// end of the programming.
+//
+// It must be called with d.mu held.
+func (d *Dev) programReg(reg byte, v uint16) error {
+	if err := d.writeReg(reg, v); err != nil {
+		return err
+	}
+	for t := time.Duration(0); t < eepromTimeout; t += eepromPollTime {
+		doSleep(eepromPollTime)
+		ul, err := d.readReg(regEEPROMUnlock)
+		if err != nil {
+			return err
+		}
+		if ul&eepromBusy == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: EEPROM still busy after %s", ErrNotReady, eepromTimeout)
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func TestOffset(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOffset}, R: []byte{0xFF, 0xC0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOffset, 0x00, 0x40}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := d.Offset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o != -500*physic.MilliKelvin {
+		t.Fatal(o)
+	}
+	if err := d.SetOffset(500 * physic.MilliKelvin); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetOffset(300 * physic.Kelvin); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProgramOffset(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock, 0x80, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOffset, 0xFF, 0xC0}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock}, R: []byte{0xC0, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock}, R: []byte{0x80, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock, 0x00, 0x00}},
+	)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ProgramOffset(-500 * physic.MilliKelvin); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProgramOffset_busy(t *testing.T) {
+	ops := append(initOps(),
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock, 0x80, 0x00}},
+		i2ctest.IO{Addr: DefaultAddr, W: []byte{regOffset, 0x00, 0x00}},
+	)
+	for i := time.Duration(0); i < eepromTimeout; i += eepromPollTime {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock}, R: []byte{0xC0, 0x00}})
+	}
+	// The EEPROM is locked again.
+	ops = append(ops, i2ctest.IO{Addr: DefaultAddr, W: []byte{regEEPROMUnlock, 0x00, 0x00}})
+	bus := i2ctest.Playback{Ops: ops}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ProgramOffset(0); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
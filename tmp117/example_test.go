@@ -0,0 +1,63 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/tmp117"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// One conversion averaged over 8 on demand, shutting down in between.
+	d, err := tmp117.New(bus, tmp117.Opts{Mode: tmp117.OneShot, Averaging: tmp117.Avg8})
+	if err != nil {
+		log.Fatalf("failed to initialize tmp117: %v", err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%8s\n", e.Temperature)
+}
+
+func ExampleDev_ProgramOffset() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	d, err := tmp117.New(bus, tmp117.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize tmp117: %v", err)
+	}
+	// The device reads 0.25°C more than a reference thermometer.
+	if err := d.ProgramOffset(-250 * physic.MilliKelvin); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,416 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// I²C addresses, selected by the connection of the ADD0 pin.
+const (
+	DefaultAddr = 0x48 // ADD0 to GND
+	AltAddr     = 0x49 // ADD0 to V+
+	Alt2Addr    = 0x4A // ADD0 to SDA
+	Alt3Addr    = 0x4B // ADD0 to SCL
+)
+
+// Registers.
+const (
+	regTemperature   = 0x00
+	regConfiguration = 0x01
+	regHighLimit     = 0x02
+	regLowLimit      = 0x03
+	regEEPROMUnlock  = 0x04
+	regOffset        = 0x07
+	regDeviceID      = 0x0F
+)
+
+// Configuration register bits.
+const (
+	cfgHighAlert = 0x8000
+	cfgLowAlert  = 0x4000
+	cfgDataReady = 0x2000
+	cfgShutdown  = 0x0400 // MOD = 01
+	cfgOneShot   = 0x0C00 // MOD = 11
+	cfgConvShift = 7
+	cfgAvgShift  = 5
+	cfgTherm     = 0x0010
+)
+
+const (
+	deviceID = 0x117 // the 4 MSBs are the revision
+
+	// resultReset is the result until the end of the first conversion.
+	resultReset = -0x8000
+)
+
+// lsb is the resolution of the temperature registers.
+const lsb = 7812500 * physic.NanoKelvin
+
+// Errors returned by the driver, to be matched with errors.Is.
+var (
+	// ErrBadID is returned by New when the device ID doesn't read 0x117.
+	ErrBadID = errors.New("tmp117: bad device ID")
+	// ErrInvalidOpts is returned for options that are out of range.
+	ErrInvalidOpts = errors.New("tmp117: invalid options")
+	// ErrNotReady is returned when the first conversion is not complete, or
+	// when the EEPROM stays busy.
+	ErrNotReady = errors.New("tmp117: not ready")
+)
+
+// Mode is the conversion mode.
+type Mode uint8
+
+// Possible conversion modes.
+const (
+	Continuous Mode = 0
+	OneShot    Mode = 1
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Continuous:
+		return "Continuous"
+	case OneShot:
+		return "OneShot"
+	default:
+		return fmt.Sprintf("Mode(%d)", uint8(m))
+	}
+}
+
+// Averaging is the number of conversions averaged in each result.
+type Averaging uint8
+
+// Possible averagings.
+const (
+	NoAveraging Averaging = 0
+	Avg8        Averaging = 1
+	Avg32       Averaging = 2
+	Avg64       Averaging = 3
+)
+
+func (a Averaging) String() string {
+	switch a {
+	case NoAveraging:
+		return "NoAveraging"
+	case Avg8:
+		return "Avg8"
+	case Avg32:
+		return "Avg32"
+	case Avg64:
+		return "Avg64"
+	default:
+		return fmt.Sprintf("Averaging(%d)", uint8(a))
+	}
+}
+
+// averagingTime is the active conversion time of each averaging.
+var averagingTime = [...]time.Duration{15500 * time.Microsecond, 125 * time.Millisecond, 500 * time.Millisecond, time.Second}
+
+// cycleTimes are the cycle times of the CONV bits, the device idling between
+// the conversions. The active conversion time is the minimum.
+var cycleTimes = [...]time.Duration{
+	15500 * time.Microsecond,
+	125 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	4 * time.Second,
+	8 * time.Second,
+	16 * time.Second,
+}
+
+// DefaultOpts are the options for continuous conversions every second,
+// averaging 8 conversions, as after a reset of the device.
+var DefaultOpts = Opts{
+	Averaging: Avg8,
+	Cycle:     time.Second,
+}
+
+// Opts holds initialization options.
+//
+// Mode: Continuous or OneShot.
+// Averaging: number of conversions averaged in each result.
+// Cycle: in Continuous mode, time between two results, one of 15.5ms,
+// 125ms, 250ms, 500ms, 1s, 4s, 8s or 16s, and at least the conversion time
+// of Averaging: 15.5ms, 125ms, 500ms or 1s. 0 converts back to back.
+// Therm: drive the ALERT pin in therm mode, active above the high limit
+// until below the low limit, instead of alert mode, active out of the limits.
+// Addr: I²C address, DefaultAddr by default.
+type Opts struct {
+	Mode      Mode
+	Averaging Averaging
+	Cycle     time.Duration
+	Therm     bool
+	Addr      uint16
+}
+
+// Validate returns an error matching ErrInvalidOpts, naming the field and the
+// accepted values, for the first unsupported option.
+func (o *Opts) Validate() error {
+	if o.Mode > OneShot {
+		return fmt.Errorf("%w: Mode %s, want Continuous or OneShot", ErrInvalidOpts, o.Mode)
+	}
+	if o.Averaging > Avg64 {
+		return fmt.Errorf("%w: Averaging %s, want NoAveraging, Avg8, Avg32 or Avg64", ErrInvalidOpts, o.Averaging)
+	}
+	if o.Cycle != 0 {
+		if o.conv() < 0 {
+			return fmt.Errorf("%w: Cycle %s, want 0, 15.5ms, 125ms, 250ms, 500ms, 1s, 4s, 8s or 16s", ErrInvalidOpts, o.Cycle)
+		}
+		if t := averagingTime[o.Averaging]; o.Cycle < t {
+			return fmt.Errorf("%w: Cycle %s shorter than the conversion time %s of %s", ErrInvalidOpts, o.Cycle, t, o.Averaging)
+		}
+	}
+	return nil
+}
+
+// conv returns the CONV bits of the cycle time, or -1.
+func (o *Opts) conv() int {
+	for i, t := range cycleTimes {
+		if t == o.Cycle {
+			return i
+		}
+	}
+	if o.Cycle == 0 {
+		return 0
+	}
+	return -1
+}
+
+// config returns the configuration register value, the device being shut
+// down in OneShot mode.
+func (o *Opts) config() uint16 {
+	c := uint16(o.conv())<<cfgConvShift | uint16(o.Averaging)<<cfgAvgShift
+	if o.Therm {
+		c |= cfgTherm
+	}
+	if o.Mode == OneShot {
+		c |= cfgShutdown
+	}
+	return c
+}
+
+// measDuration returns the time between two results.
+func (o *Opts) measDuration() time.Duration {
+	t := averagingTime[o.Averaging]
+	if o.Mode == Continuous && o.Cycle > t {
+		return o.Cycle
+	}
+	return t
+}
+
+// Dev is a handle to an initialized TMP117 device.
+//
+// Dev is safe for concurrent use.
+type Dev struct {
+	c    conn.Conn
+	opts Opts
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New checks the ID of a device on an I²C bus and configures it.
+//
+// The alert limits and the offset are kept, as loaded from the EEPROM at
+// power up.
+func New(bus i2c.Bus, opts Opts) (*Dev, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addr := opts.Addr
+	if addr == 0 {
+		addr = DefaultAddr
+	}
+	d := &Dev{c: &i2c.Dev{Bus: bus, Addr: addr}, opts: opts}
+	id, err := d.readReg(regDeviceID)
+	if err != nil {
+		return nil, err
+	}
+	if id&0x0FFF != deviceID {
+		return nil, fmt.Errorf("%w: read %#04x, want %#03x", ErrBadID, id, deviceID)
+	}
+	if err := d.writeReg(regConfiguration, opts.config()); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("TMP117{%s}", d.c)
+}
+
+// Sense measures the temperature. It implements physic.SenseEnv.
+//
+// In Continuous mode, it returns the latest result, and ErrNotReady until the
+// end of the first conversion. In OneShot mode, it triggers a conversion
+// and waits for it.
+//
+// The pressure and the humidity are not measured and left to 0.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return errors.New("tmp117: already sensing continuously")
+	}
+	return d.sense(e)
+}
+
+// SenseContinuous returns measurements of the temperature on a continuous
+// basis. It implements physic.SenseEnv.
+//
+// The interval must be longer than the cycle time in Continuous mode, or
+// than the conversion time in OneShot mode.
+//
+// The application must call Halt() to stop the sensing when done to stop the
+// sensor and close the channel.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	d.mu.Lock()
+	m := d.opts.measDuration()
+	d.mu.Unlock()
+	if interval < m {
+		return nil, fmt.Errorf("%w: interval %s shorter than the measurement duration %s", ErrInvalidOpts, interval, m)
+	}
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sensing := make(chan physic.Env)
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer d.wg.Done()
+		defer close(sensing)
+		d.sensingContinuous(interval, sensing, stop)
+	}(d.stop)
+	return sensing, nil
+}
+
+func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.Env, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Do one initial sensing right away.
+		var e physic.Env
+		d.mu.Lock()
+		err := d.sense(&e)
+		d.mu.Unlock()
+		if err != nil {
+			log.Printf("%s: failed to sense: %v", d, err)
+			return
+		}
+		select {
+		case sensing <- e:
+		case <-stop:
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = lsb
+}
+
+// Halt stops the continuous sensing initiated by SenseContinuous() and shuts
+// the device down. Sense triggers one-shot conversions afterward.
+func (d *Dev) Halt() error {
+	d.stopContinuous()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.opts.Mode == OneShot {
+		return nil
+	}
+	o := d.opts
+	o.Mode = OneShot
+	if err := d.writeReg(regConfiguration, o.config()); err != nil {
+		return err
+	}
+	d.opts = o
+	return nil
+}
+
+func (d *Dev) stopContinuous() {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		d.wg.Wait()
+	}
+}
+
+// sense reads or triggers a conversion.
+//
+// It must be called with d.mu held.
+func (d *Dev) sense(e *physic.Env) error {
+	if d.opts.Mode == OneShot {
+		if err := d.writeReg(regConfiguration, d.opts.config()&^cfgShutdown|cfgOneShot); err != nil {
+			return err
+		}
+		doSleep(averagingTime[d.opts.Averaging])
+	}
+	v, err := d.readReg(regTemperature)
+	if err != nil {
+		return err
+	}
+	if int16(v) == resultReset {
+		return ErrNotReady
+	}
+	e.Temperature = toTemperature(v)
+	return nil
+}
+
+// toTemperature converts a temperature register.
+func toTemperature(v uint16) physic.Temperature {
+	return physic.Temperature(int16(v))*lsb + physic.ZeroCelsius
+}
+
+// fromTemperature returns the register value of t, rounded to the nearest.
+func fromTemperature(t physic.Temperature) (uint16, error) {
+	t -= physic.ZeroCelsius
+	if t < -256*physic.Celsius || t > 256*physic.Celsius-lsb {
+		return 0, fmt.Errorf("%w: temperature %s, want -256°C to 256°C", ErrInvalidOpts, t+physic.ZeroCelsius)
+	}
+	if t < 0 {
+		return uint16(int16((t - lsb/2) / lsb)), nil
+	}
+	return uint16(int16((t + lsb/2) / lsb)), nil
+}
+
+func (d *Dev) readReg(reg byte) (uint16, error) {
+	var b [2]byte
+	if err := d.c.Tx([]byte{reg}, b[:]); err != nil {
+		return 0, fmt.Errorf("tmp117: reading register %#02x: %w", reg, err)
+	}
+	return uint16(b[0])<<8 | uint16(b[1]), nil
+}
+
+func (d *Dev) writeReg(reg byte, v uint16) error {
+	if err := d.c.Tx([]byte{reg, byte(v >> 8), byte(v)}, nil); err != nil {
+		return fmt.Errorf("tmp117: writing register %#02x: %w", reg, err)
+	}
+	return nil
+}
+
+var doSleep = time.Sleep
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
@@ -0,0 +1,292 @@
+// Copyright 2026 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tmp117
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func init() {
+	doSleep = func(time.Duration) {}
+}
+
+// initOps are the bus transactions issued by New with DefaultOpts.
+func initOps() []i2ctest.IO {
+	return []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regDeviceID}, R: []byte{0x01, 0x17}},
+		{Addr: DefaultAddr, W: []byte{regConfiguration, 0x02, 0x20}},
+	}
+}
+
+// readOp reads a result of 25°C.
+var readOp = i2ctest.IO{Addr: DefaultAddr, W: []byte{regTemperature}, R: []byte{0x0C, 0x80}}
+
+var testEnv = physic.Env{Temperature: physic.ZeroCelsius + 25*physic.Celsius}
+
+func TestNew(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps()}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "TMP117{playback(72)}" {
+		t.Fatal(s)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew_config(t *testing.T) {
+	data := []struct {
+		o    Opts
+		want []byte
+	}{
+		{Opts{}, []byte{0x00, 0x00}},
+		{Opts{Mode: OneShot, Averaging: Avg64}, []byte{0x04, 0x60}},
+		{Opts{Averaging: Avg32, Cycle: 16 * time.Second, Therm: true}, []byte{0x03, 0xD0}},
+	}
+	for i, line := range data {
+		line.o.Addr = Alt3Addr
+		bus := i2ctest.Playback{Ops: []i2ctest.IO{
+			{Addr: Alt3Addr, W: []byte{regDeviceID}, R: []byte{0x11, 0x17}},
+			{Addr: Alt3Addr, W: append([]byte{regConfiguration}, line.want...)},
+		}}
+		if _, err := New(&bus, line.o); err != nil {
+			t.Fatal(i, err)
+		}
+		if err := bus.Close(); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+}
+
+func TestNew_bad_id(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{{Addr: DefaultAddr, W: []byte{regDeviceID}, R: []byte{0x01, 0x16}}}}
+	want := "tmp117: bad device ID: read 0x0116, want 0x117"
+	if _, err := New(&bus, DefaultOpts); !errors.Is(err, ErrBadID) || err.Error() != want {
+		t.Fatalf("%v, want %s", err, want)
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+	for i := 0; i < len(initOps()); i++ {
+		bus := i2ctest.Playback{Ops: initOps()[:i], DontPanic: true}
+		if _, err := New(&bus, DefaultOpts); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+}
+
+func TestOpts_Validate(t *testing.T) {
+	data := []struct {
+		o   Opts
+		err string
+	}{
+		{Opts{Mode: 2}, "tmp117: invalid options: Mode Mode(2), want Continuous or OneShot"},
+		{Opts{Averaging: 4}, "tmp117: invalid options: Averaging Averaging(4), want NoAveraging, Avg8, Avg32 or Avg64"},
+		{Opts{Cycle: 2 * time.Second}, "tmp117: invalid options: Cycle 2s, want 0, 15.5ms, 125ms, 250ms, 500ms, 1s, 4s, 8s or 16s"},
+		{Opts{Averaging: Avg32, Cycle: 250 * time.Millisecond}, "tmp117: invalid options: Cycle 250ms shorter than the conversion time 500ms of Avg32"},
+	}
+	for i, line := range data {
+		err := line.o.Validate()
+		if !errors.Is(err, ErrInvalidOpts) || err.Error() != line.err {
+			t.Fatalf("#%d: %v, want %s", i, err, line.err)
+		}
+		if _, err := New(&i2ctest.Playback{}, line.o); !errors.Is(err, ErrInvalidOpts) {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), readOp)}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_not_ready(t *testing.T) {
+	bus := i2ctest.Playback{Ops: append(initOps(), i2ctest.IO{Addr: DefaultAddr, W: []byte{regTemperature}, R: []byte{0x80, 0x00}})}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); !errors.Is(err, ErrNotReady) {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_one_shot(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regDeviceID}, R: []byte{0x01, 0x17}},
+		{Addr: DefaultAddr, W: []byte{regConfiguration, 0x04, 0x20}},
+		{Addr: DefaultAddr, W: []byte{regConfiguration, 0x0C, 0x20}},
+		readOp,
+	}}
+	d, err := New(&bus, Opts{Mode: OneShot, Averaging: Avg8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e != testEnv {
+		t.Fatalf("%#v, want %#v", e, testEnv)
+	}
+	// Already shut down.
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSense_fail(t *testing.T) {
+	bus := i2ctest.Playback{Ops: initOps(), DontPanic: true}
+	d, err := New(&bus, DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	bus := i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: DefaultAddr, W: []byte{regDeviceID}, R: []byte{0x01, 0x17}},
+		{Addr: DefaultAddr, W: []byte{regConfiguration, 0x00, 0xA0}},
+		readOp,
+		readOp,
+		// Halt shuts the device down.
+		{Addr: DefaultAddr, W: []byte{regConfiguration, 0x04, 0xA0}},
+		{Addr: DefaultAddr, W: []byte{regConfiguration, 0x0C, 0xA0}},
+		readOp,
+	}}
+	d, err := New(&bus, Opts{Averaging: Avg8, Cycle: 125 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(100 * time.Millisecond); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+	c, err := d.SenseContinuous(125 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if e := <-c; e != testEnv {
+			t.Fatalf("#%d: %#v, want %#v", i, e, testEnv)
+		}
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-c; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	// Sense triggers a one-shot conversion once halted.
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d := &Dev{}
+	var e physic.Env
+	d.Precision(&e)
+	if e.Temperature != 7812500*physic.NanoKelvin {
+		t.Fatal(e)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	data := []struct {
+		v uint16
+		t physic.Temperature
+	}{
+		{0x0C80, physic.ZeroCelsius + 25*physic.Celsius},
+		{0xFF80, physic.ZeroCelsius - physic.Celsius},
+		{0x0001, physic.ZeroCelsius + 7812500*physic.NanoKelvin},
+		{0x7FFF, physic.ZeroCelsius + 256*physic.Celsius - 7812500*physic.NanoKelvin},
+	}
+	for _, line := range data {
+		if got := toTemperature(line.v); got != line.t {
+			t.Fatalf("%#04x: %s, want %s", line.v, got, line.t)
+		}
+		if got, err := fromTemperature(line.t); err != nil || got != line.v {
+			t.Fatalf("%s: %#04x, %v, want %#04x", line.t, got, err, line.v)
+		}
+	}
+	// Rounded to the nearest.
+	if got, _ := fromTemperature(physic.ZeroCelsius - 4*physic.MilliCelsius); got != 0xFFFF {
+		t.Fatalf("%#04x, want 0xFFFF", got)
+	}
+	if _, err := fromTemperature(physic.ZeroCelsius + 256*physic.Celsius); !errors.Is(err, ErrInvalidOpts) {
+		t.Fatal(err)
+	}
+}
+
+func TestMode_String(t *testing.T) {
+	data := []struct {
+		m Mode
+		s string
+	}{
+		{Continuous, "Continuous"},
+		{OneShot, "OneShot"},
+		{2, "Mode(2)"},
+	}
+	for _, line := range data {
+		if s := line.m.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}
+
+func TestAveraging_String(t *testing.T) {
+	data := []struct {
+		a Averaging
+		s string
+	}{
+		{NoAveraging, "NoAveraging"},
+		{Avg8, "Avg8"},
+		{Avg32, "Avg32"},
+		{Avg64, "Avg64"},
+		{4, "Averaging(4)"},
+	}
+	for _, line := range data {
+		if s := line.a.String(); s != line.s {
+			t.Fatalf("%s, want %s", s, line.s)
+		}
+	}
+}